@@ -0,0 +1,78 @@
+// breachedbloom compacts a breached password list, one password per line, into a bloom filter.
+//
+//	go run ./cmd/breachedbloom -in passwords.txt -out passwords.bloom
+//
+// Point passwordPolicy.breachedListFile at the .bloom file.
+package main
+
+import (
+	"bufio"
+	"flag"
+	"log/slog"
+	"mysite/pkgs/logger"
+	"mysite/pkgs/validate"
+	"os"
+	"strings"
+
+	"github.com/pkg/errors"
+)
+
+func main() {
+	in := flag.String("in", "", "word list, one password per line")
+	out := flag.String("out", "", "bloom filter file to write")
+	rate := flag.Float64("rate", 0.001, "false positive rate")
+	flag.Parse()
+
+	if err := run(*in, *out, *rate); err != nil {
+		slog.Error("failed to build bloom filter", logger.AttrError(err))
+		os.Exit(1)
+	}
+}
+
+func run(in, out string, rate float64) error {
+	if in == "" || out == "" {
+		return errors.New("missing -in or -out")
+	}
+	if rate <= 0 || rate >= 1 {
+		return errors.New("-rate must be between 0 and 1")
+	}
+
+	words, err := readWords(in)
+	if err != nil {
+		return errors.Wrap(err, "failed to read word list")
+	}
+
+	filter := validate.NewBloomFilter(len(words), rate)
+	for _, word := range words {
+		filter.Add(word)
+	}
+
+	file, err := os.Create(out)
+	if err != nil {
+		return errors.Wrap(err, "failed to create file")
+	}
+	defer file.Close()
+
+	if _, err := filter.WriteTo(file); err != nil {
+		return errors.Wrap(err, "failed to write bloom filter")
+	}
+	slog.Info("bloom filter written", slog.Int("passwords", len(words)), slog.String("path", out))
+	return nil
+}
+
+func readWords(path string) ([]string, error) {
+	file, err := os.Open(path)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to open file")
+	}
+	defer file.Close()
+
+	var result []string
+	scanner := bufio.NewScanner(file)
+	for scanner.Scan() {
+		if word := strings.TrimSpace(scanner.Text()); word != "" {
+			result = append(result, word)
+		}
+	}
+	return result, errors.Wrap(scanner.Err(), "failed to scan file")
+}
@@ -0,0 +1,117 @@
+package internal
+
+import (
+	"context"
+	"mysite/constants"
+	"mysite/entities"
+	"mysite/pkgs/auth"
+	"mysite/pkgs/database"
+	"mysite/pkgs/validate"
+	"mysite/repositories/rolerepo"
+	"mysite/repositories/useraccountrepo"
+	"mysite/repositories/userinforepo"
+	"time"
+
+	"github.com/pkg/errors"
+	"github.com/volatiletech/null/v8"
+	"github.com/volatiletech/sqlboiler/v4/boil"
+)
+
+type service struct {
+	repo     useraccountrepo.UserAccountRepo
+	infoRepo userinforepo.UserInfoRepo
+	roleRepo rolerepo.RoleRepo
+}
+
+// ImportRecord one user of the old system, the password stays hashed with its legacy scheme until the next login
+type ImportRecord struct {
+	UserName      string `json:"userName" validate:"email,required"`
+	PasswordHash  string `json:"passwordHash" validate:"required"`
+	EmailVerified bool   `json:"emailVerified"`
+
+	Name   *string `json:"name,omitempty"`
+	Email  *string `json:"email,omitempty" validate:"omitempty,email"`
+	Phone  *string `json:"phone,omitempty" validate:"omitempty,number"`
+	Gender *string `json:"gender,omitempty" validate:"omitempty,oneof=male female other"`
+}
+
+type ImportResult int
+
+const (
+	Imported ImportResult = iota
+	// Skipped the user name is already taken, existing accounts are never overwritten
+	Skipped
+)
+
+func NewService() service {
+	return service{
+		repo:     useraccountrepo.NewRepo(),
+		infoRepo: userinforepo.NewRepo(),
+		roleRepo: rolerepo.NewRepo(),
+	}
+}
+
+// Import inserts one user in its own transaction so that a bad record does not roll back the others
+func (s service) Import(ctx context.Context, record ImportRecord) (ImportResult, error) {
+	if err := validate.ValidateStruct(record); err != nil {
+		return 0, errors.Wrap(err, "invalid record")
+	}
+	if !auth.IsSupportedHash(record.PasswordHash) {
+		return 0, errors.New("unsupported password hash")
+	}
+
+	result := Imported
+	if err := database.NewBoilerTransaction(ctx, func(ctx context.Context, tx boil.ContextTransactor) error {
+		existing, err := s.repo.GetUserAccountByUserName(ctx, tx, record.UserName)
+		if err != nil {
+			return errors.Wrap(err, "failed get userAccount")
+		}
+		if existing != nil {
+			result = Skipped
+			return nil
+		}
+
+		user := entities.UserAccount{
+			UserName: record.UserName,
+			Password: record.PasswordHash,
+			IsActive: true,
+		}
+		if record.EmailVerified {
+			user.EmailVerifiedAt = null.TimeFrom(time.Now())
+		}
+		if err := s.repo.Insert(ctx, tx, &user); err != nil {
+			return errors.Wrap(err, "failed insert userAccount")
+		}
+
+		role, err := s.roleRepo.GetRoleByName(ctx, tx, constants.RoleMember)
+		if err != nil {
+			return errors.Wrap(err, "failed get member role")
+		}
+		if role == nil {
+			return errors.New("member role not found")
+		}
+		if err := s.roleRepo.AssignRole(ctx, tx, user.ID, role.ID); err != nil {
+			return errors.Wrap(err, "failed assign member role")
+		}
+
+		if !record.hasUserInfo() {
+			return nil
+		}
+		return s.infoRepo.Insert(ctx, tx, &entities.UserInfo{
+			UserAccountID: user.ID,
+			Name:          null.StringFromPtr(record.Name),
+			Phone:         null.StringFromPtr(record.Phone),
+			Email:         null.StringFromPtr(record.Email),
+			Gender:        null.StringFromPtr(record.Gender),
+			MembershipID:  null.IntFrom(constants.Bronze),
+		})
+	}); err != nil {
+		return 0, errors.Wrap(err, "failed import user")
+	}
+
+	return result, nil
+}
+
+func (r ImportRecord) hasUserInfo() bool {
+	return r.Email != nil || r.Gender != nil || r.Phone != nil || r.Name != nil
+}
@@ -0,0 +1,139 @@
+package internal
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"testing"
+
+	"mysite/entities"
+	"mysite/pkgs/database"
+	"mysite/testing/dbtest"
+	"mysite/testing/mocking/repomock"
+
+	"github.com/stretchr/testify/require"
+	"github.com/volatiletech/sqlboiler/v4/boil"
+)
+
+func TestMain(m *testing.M) {
+	pool, resource, err := dbtest.SetupDatabaseForTesting()
+	if err != nil {
+		return
+	}
+
+	defer func() {
+		database.Close()
+		if err := dbtest.PurgeResource(pool, resource); err != nil {
+			fmt.Println("failed to purge resource")
+		}
+	}()
+	m.Run()
+}
+
+func TestImport(t *testing.T) {
+	t.Parallel()
+	require.NoError(t, database.SetupDatabase())
+	ctx := dbtest.SetTestTransactionCtx(context.Background())
+	name := "Alice"
+	newRoleMock := func() *repomock.RoleRepoMock {
+		return &repomock.RoleRepoMock{
+			GetRoleByNameFunc: func(ctx context.Context, tx boil.ContextTransactor, name string) (*entities.Role, error) {
+				return &entities.Role{ID: 2, Name: name}, nil
+			},
+			AssignRoleFunc: func(ctx context.Context, tx boil.ContextTransactor, userAccountId int, roleId int) error {
+				return nil
+			},
+		}
+	}
+
+	{ // imported with user info
+		repoMock := &repomock.UserAccountRepoMock{
+			GetUserAccountByUserNameFunc: func(ctx context.Context, tx boil.ContextTransactor, userName string) (*entities.UserAccount, error) {
+				return nil, nil
+			},
+			InsertFunc: func(ctx context.Context, tx boil.ContextTransactor, user *entities.UserAccount) error {
+				user.ID = 1
+				return nil
+			},
+		}
+		infoMock := &repomock.UserInfoRepoMock{
+			InsertFunc: func(ctx context.Context, tx boil.ContextTransactor, userInfo *entities.UserInfo) error { return nil },
+		}
+		roleMock := newRoleMock()
+		svc := service{repo: repoMock, infoRepo: infoMock, roleRepo: roleMock}
+
+		result, err := svc.Import(ctx, ImportRecord{
+			UserName:      "a@example.com",
+			PasswordHash:  "$2a$10$hash",
+			EmailVerified: true,
+			Name:          &name,
+		})
+		require.NoError(t, err)
+		require.Equal(t, Imported, result)
+		require.Equal(t, "$2a$10$hash", repoMock.InsertCalls()[0].User.Password)
+		require.True(t, repoMock.InsertCalls()[0].User.EmailVerifiedAt.Valid)
+		require.Len(t, infoMock.InsertCalls(), 1)
+		require.Equal(t, 1, infoMock.InsertCalls()[0].UserInfo.UserAccountID)
+		require.Len(t, roleMock.AssignRoleCalls(), 1)
+		require.Equal(t, 1, roleMock.AssignRoleCalls()[0].UserAccountId)
+	}
+	{ // existing user is skipped
+		repoMock := &repomock.UserAccountRepoMock{
+			GetUserAccountByUserNameFunc: func(ctx context.Context, tx boil.ContextTransactor, userName string) (*entities.UserAccount, error) {
+				return &entities.UserAccount{ID: 1}, nil
+			},
+		}
+		svc := service{repo: repoMock, infoRepo: &repomock.UserInfoRepoMock{}, roleRepo: newRoleMock()}
+
+		result, err := svc.Import(ctx, ImportRecord{UserName: "a@example.com", PasswordHash: "$2a$10$hash"})
+		require.NoError(t, err)
+		require.Equal(t, Skipped, result)
+		require.Empty(t, repoMock.InsertCalls())
+	}
+	{ // unsupported hash
+		svc := service{repo: &repomock.UserAccountRepoMock{}, infoRepo: &repomock.UserInfoRepoMock{}, roleRepo: newRoleMock()}
+
+		_, err := svc.Import(ctx, ImportRecord{UserName: "a@example.com", PasswordHash: "plain"})
+		require.Error(t, err)
+	}
+	{ // invalid user name
+		svc := service{repo: &repomock.UserAccountRepoMock{}, infoRepo: &repomock.UserInfoRepoMock{}, roleRepo: newRoleMock()}
+
+		_, err := svc.Import(ctx, ImportRecord{UserName: "alice", PasswordHash: "$2a$10$hash"})
+		require.Error(t, err)
+	}
+	{ // member role missing
+		repoMock := &repomock.UserAccountRepoMock{
+			GetUserAccountByUserNameFunc: func(ctx context.Context, tx boil.ContextTransactor, userName string) (*entities.UserAccount, error) {
+				return nil, nil
+			},
+			InsertFunc: func(ctx context.Context, tx boil.ContextTransactor, user *entities.UserAccount) error {
+				user.ID = 1
+				return nil
+			},
+		}
+		roleMock := newRoleMock()
+		roleMock.GetRoleByNameFunc = func(ctx context.Context, tx boil.ContextTransactor, name string) (*entities.Role, error) {
+			return nil, nil
+		}
+		svc := service{repo: repoMock, infoRepo: &repomock.UserInfoRepoMock{}, roleRepo: roleMock}
+
+		_, err := svc.Import(ctx, ImportRecord{UserName: "a@example.com", PasswordHash: "$2a$10$hash"})
+		require.Error(t, err)
+		require.Empty(t, roleMock.AssignRoleCalls())
+	}
+	{ // insert failed
+		repoMock := &repomock.UserAccountRepoMock{
+			GetUserAccountByUserNameFunc: func(ctx context.Context, tx boil.ContextTransactor, userName string) (*entities.UserAccount, error) {
+				return nil, nil
+			},
+			InsertFunc: func(ctx context.Context, tx boil.ContextTransactor, user *entities.UserAccount) error {
+				return errors.New("failed insert")
+			},
+		}
+		svc := service{repo: repoMock, infoRepo: &repomock.UserInfoRepoMock{}, roleRepo: newRoleMock()}
+
+		_, err := svc.Import(ctx, ImportRecord{UserName: "a@example.com", PasswordHash: "$2a$10$hash"})
+		require.Error(t, err)
+	}
+}
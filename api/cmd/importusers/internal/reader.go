@@ -0,0 +1,124 @@
+package internal
+
+import (
+	"bufio"
+	"encoding/csv"
+	"encoding/json"
+	"io"
+	"path/filepath"
+	"strconv"
+	"strings"
+
+	"github.com/pkg/errors"
+)
+
+const (
+	FormatCsv   = "csv"
+	FormatJsonl = "jsonl"
+)
+
+// csvColumns header of a csv file, columns can be in any order and only userName and passwordHash are required
+var csvColumns = []string{"userName", "passwordHash", "emailVerified", "name", "email", "phone", "gender"}
+
+// FormatOf guesses the format from the file extension
+func FormatOf(path string) string {
+	switch strings.ToLower(filepath.Ext(path)) {
+	case ".csv":
+		return FormatCsv
+	default:
+		return FormatJsonl
+	}
+}
+
+// ReadRecords calls fn for every record with its line number, a line which can not be parsed stops the read
+func ReadRecords(r io.Reader, format string, fn func(line int, record ImportRecord) error) error {
+	switch format {
+	case FormatCsv:
+		return readCsv(r, fn)
+	case FormatJsonl:
+		return readJsonl(r, fn)
+	default:
+		return errors.Errorf("unsupported format %s", format)
+	}
+}
+
+func readJsonl(r io.Reader, fn func(line int, record ImportRecord) error) error {
+	scanner := bufio.NewScanner(r)
+	scanner.Buffer(make([]byte, 0, 64*1024), 1024*1024)
+
+	for line := 1; scanner.Scan(); line++ {
+		text := strings.TrimSpace(scanner.Text())
+		if text == "" {
+			continue
+		}
+
+		var record ImportRecord
+		if err := json.Unmarshal([]byte(text), &record); err != nil {
+			return errors.Wrapf(err, "failed decode line %d", line)
+		}
+		if err := fn(line, record); err != nil {
+			return err
+		}
+	}
+	return errors.Wrap(scanner.Err(), "failed read jsonl")
+}
+
+func readCsv(r io.Reader, fn func(line int, record ImportRecord) error) error {
+	reader := csv.NewReader(r)
+	reader.TrimLeadingSpace = true
+
+	header, err := reader.Read()
+	if err != nil {
+		return errors.Wrap(err, "failed read csv header")
+	}
+	index := map[string]int{}
+	for i, column := range header {
+		index[strings.TrimSpace(column)] = i
+	}
+	for _, column := range csvColumns[:2] {
+		if _, found := index[column]; !found {
+			return errors.Errorf("missing csv column %s", column)
+		}
+	}
+
+	for line := 2; ; line++ {
+		row, err := reader.Read()
+		if errors.Is(err, io.EOF) {
+			return nil
+		}
+		if err != nil {
+			return errors.Wrapf(err, "failed read line %d", line)
+		}
+
+		value := func(column string) string {
+			if i, found := index[column]; found && i < len(row) {
+				return strings.TrimSpace(row[i])
+			}
+			return ""
+		}
+		optional := func(column string) *string {
+			if v := value(column); v != "" {
+				return &v
+			}
+			return nil
+		}
+
+		record := ImportRecord{
+			UserName:     value("userName"),
+			PasswordHash: value("passwordHash"),
+			Name:         optional("name"),
+			Email:        optional("email"),
+			Phone:        optional("phone"),
+			Gender:       optional("gender"),
+		}
+		if v := value("emailVerified"); v != "" {
+			if record.EmailVerified, err = strconv.ParseBool(v); err != nil {
+				return errors.Wrapf(err, "invalid emailVerified at line %d", line)
+			}
+		}
+
+		if err := fn(line, record); err != nil {
+			return err
+		}
+	}
+}
@@ -0,0 +1,69 @@
+package internal
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestReadRecords(t *testing.T) {
+	collect := func(input, format string) ([]ImportRecord, []int, error) {
+		var (
+			records []ImportRecord
+			lines   []int
+		)
+		err := ReadRecords(strings.NewReader(input), format, func(line int, record ImportRecord) error {
+			records = append(records, record)
+			lines = append(lines, line)
+			return nil
+		})
+		return records, lines, err
+	}
+
+	{ // csv, columns in any order
+		input := "passwordHash,userName,emailVerified,name\n" +
+			"$2a$10$hash,a@example.com,true,Alice\n" +
+			"pbkdf2_sha256$1000$salt$hash,b@example.com,,\n"
+		records, lines, err := collect(input, FormatCsv)
+		require.NoError(t, err)
+		require.Equal(t, []int{2, 3}, lines)
+		require.Equal(t, "a@example.com", records[0].UserName)
+		require.Equal(t, "$2a$10$hash", records[0].PasswordHash)
+		require.True(t, records[0].EmailVerified)
+		require.Equal(t, "Alice", *records[0].Name)
+		require.Nil(t, records[0].Email)
+		require.False(t, records[1].EmailVerified)
+		require.Nil(t, records[1].Name)
+	}
+	{ // csv, missing required column
+		_, _, err := collect("userName,name\na@example.com,Alice\n", FormatCsv)
+		require.Error(t, err)
+	}
+	{ // csv, invalid boolean
+		_, _, err := collect("userName,passwordHash,emailVerified\na@example.com,$2a$10$hash,maybe\n", FormatCsv)
+		require.Error(t, err)
+	}
+	{ // jsonl, blank lines are ignored
+		input := `{"userName":"a@example.com","passwordHash":"$2a$10$hash","emailVerified":true,"phone":"0123"}` + "\n\n" +
+			`{"userName":"b@example.com","passwordHash":"$scrypt$ln=10,r=8,p=1$salt$hash"}` + "\n"
+		records, lines, err := collect(input, FormatJsonl)
+		require.NoError(t, err)
+		require.Equal(t, []int{1, 3}, lines)
+		require.Equal(t, "0123", *records[0].Phone)
+		require.Equal(t, "b@example.com", records[1].UserName)
+	}
+	{ // jsonl, invalid line
+		_, _, err := collect("{invalid\n", FormatJsonl)
+		require.Error(t, err)
+	}
+	{ // unsupported format
+		_, _, err := collect("", "xml")
+		require.Error(t, err)
+	}
+}
+
+func TestFormatOf(t *testing.T) {
+	require.Equal(t, FormatCsv, FormatOf("users.CSV"))
+	require.Equal(t, FormatJsonl, FormatOf("users.jsonl"))
+}
@@ -0,0 +1,80 @@
+// importusers loads users of another system into user_account and user_info.
+//
+//	go run ./cmd/importusers -file users.csv
+//
+// Password hashes are kept as they are, bcrypt, scrypt and PBKDF2 hashes are upgraded to argon2id on the next login.
+package main
+
+import (
+	"context"
+	"flag"
+	"log/slog"
+	"mysite/cmd/importusers/internal"
+	"mysite/pkgs/database"
+	"mysite/pkgs/env"
+	"mysite/pkgs/logger"
+	"os"
+
+	"github.com/pkg/errors"
+)
+
+func main() {
+	path := flag.String("file", "", "csv or jsonl file of users")
+	format := flag.String("format", "", "csv or jsonl, guessed from the file extension when empty")
+	flag.Parse()
+
+	if err := run(*path, *format); err != nil {
+		slog.Error("failed to import users", logger.AttrError(err))
+		os.Exit(1)
+	}
+}
+
+func run(path, format string) error {
+	if path == "" {
+		return errors.New("missing -file")
+	}
+	if format == "" {
+		format = internal.FormatOf(path)
+	}
+
+	logger.SetLogger(os.Stdout)
+	if err := env.ReadEnv(); err != nil {
+		return errors.Wrap(err, "failed to readEnv")
+	}
+	if err := database.SetupDatabase(); err != nil {
+		return errors.Wrap(err, "failed to setup database")
+	}
+	defer func() {
+		if err := database.Close(); err != nil {
+			slog.Error("failed to close database", logger.AttrError(err))
+		}
+	}()
+
+	file, err := os.Open(path)
+	if err != nil {
+		return errors.Wrap(err, "failed to open file")
+	}
+	defer file.Close()
+
+	var (
+		ctx                       = context.Background()
+		svc                       = internal.NewService()
+		imported, skipped, failed int
+	)
+	err = internal.ReadRecords(file, format, func(line int, record internal.ImportRecord) error {
+		result, err := svc.Import(ctx, record)
+		switch {
+		case err != nil:
+			failed++
+			slog.Error("failed to import user", slog.Int("line", line), slog.String("userName", record.UserName), logger.AttrError(err))
+		case result == internal.Skipped:
+			skipped++
+			slog.Info("user exists, skipped", slog.Int("line", line), slog.String("userName", record.UserName))
+		default:
+			imported++
+		}
+		return nil
+	})
+	slog.Info("import finished", slog.Int("imported", imported), slog.Int("skipped", skipped), slog.Int("failed", failed))
+	return errors.Wrap(err, "failed to read file")
+}
@@ -0,0 +1,7 @@
+package constants
+
+// kinds of api keys, both act as the user who created the key
+const (
+	ApiKeyPersonal = "personal"
+	ApiKeyService  = "service"
+)
@@ -0,0 +1,11 @@
+package constants
+
+// AppCode tells apart errors which share the same http status
+const (
+	AppCodeEmailNotVerified = 1001
+	AppCodeLoginDelayed     = 1002
+	AppCodeAccountLocked    = 1003
+	AppCodeRateLimited      = 1004
+	AppCodeWeakPassword     = 1005
+	AppCodeQuotaExceeded    = 1006
+)
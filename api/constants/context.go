@@ -3,5 +3,6 @@ package constants
 type contextKey string
 
 const (
-	Testing contextKey = "testing"
+	Testing   contextKey = "testing"
+	Principal contextKey = "principal"
 )
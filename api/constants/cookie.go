@@ -0,0 +1,7 @@
+package constants
+
+const (
+	AccessTokenCookie  = "accessToken"
+	RefreshTokenCookie = "refreshToken"
+	OidcStateCookie    = "oidcState"
+)
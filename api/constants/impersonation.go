@@ -0,0 +1,7 @@
+package constants
+
+// actions of the impersonation audit, the token is issued once and used by any number of requests
+const (
+	ImpersonationIssued  = "issued"
+	ImpersonationRequest = "request"
+)
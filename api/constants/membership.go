@@ -1,7 +1,8 @@
 package constants
 
+// ids of the membership rows seeded by the membership migration, a higher tier has a higher rank
 const (
-	Bronze = iota
+	Bronze = iota + 1
 	Silver
 	Gold
 	Diamond
@@ -0,0 +1,8 @@
+package constants
+
+// grant types of the oauth token endpoint, a client is registered for a subset of them
+const (
+	GrantAuthorizationCode = "authorization_code"
+	GrantRefreshToken      = "refresh_token"
+	GrantClientCredentials = "client_credentials"
+)
@@ -0,0 +1,6 @@
+package constants
+
+// quota names of the quota config, requests is counted by the quota middleware
+const (
+	QuotaRequests = "requests"
+)
@@ -0,0 +1,16 @@
+package constants
+
+// roles seeded by the rbac migration
+const (
+	RoleAdmin  = "admin"
+	RoleMember = "member"
+)
+
+// permissions seeded by the rbac, oauth and impersonation migrations, granted to roles through role_permission
+const (
+	PermissionUsersRead        = "users:read"
+	PermissionUsersWrite       = "users:write"
+	PermissionRolesWrite       = "roles:write"
+	PermissionClientsWrite     = "clients:write"
+	PermissionUsersImpersonate = "users:impersonate"
+)
@@ -3,11 +3,146 @@
 // Code generated by github.com/deepmap/oapi-codegen/v2 version v2.1.0 DO NOT EDIT.
 package dtos
 
+import (
+	"time"
+)
+
+// Defines values for ApiKeyResponseKind.
+const (
+	ApiKeyResponseKindPersonal ApiKeyResponseKind = "personal"
+	ApiKeyResponseKindService  ApiKeyResponseKind = "service"
+)
+
+// Defines values for CreateApiKeyRequestKind.
+const (
+	CreateApiKeyRequestKindPersonal CreateApiKeyRequestKind = "personal"
+	CreateApiKeyRequestKindService  CreateApiKeyRequestKind = "service"
+)
+
+// Defines values for OauthIntrospectResponseTokenType.
+const (
+	OauthIntrospectResponseTokenTypeAccessToken  OauthIntrospectResponseTokenType = "access_token"
+	OauthIntrospectResponseTokenTypeRefreshToken OauthIntrospectResponseTokenType = "refresh_token"
+)
+
+// Defines values for OauthTokenHintRequestTokenTypeHint.
+const (
+	OauthTokenHintRequestTokenTypeHintAccessToken  OauthTokenHintRequestTokenTypeHint = "access_token"
+	OauthTokenHintRequestTokenTypeHintRefreshToken OauthTokenHintRequestTokenTypeHint = "refresh_token"
+)
+
+// Defines values for OauthTokenRequestGrantType.
+const (
+	OauthTokenRequestGrantTypeAuthorizationCode OauthTokenRequestGrantType = "authorization_code"
+	OauthTokenRequestGrantTypeClientCredentials OauthTokenRequestGrantType = "client_credentials"
+	OauthTokenRequestGrantTypeRefreshToken      OauthTokenRequestGrantType = "refresh_token"
+)
+
+// Defines values for QuotaUsagePeriod.
+const (
+	Day   QuotaUsagePeriod = "day"
+	Month QuotaUsagePeriod = "month"
+)
+
+// Defines values for RegisterOauthClientRequestGrantTypes.
+const (
+	RegisterOauthClientRequestGrantTypesAuthorizationCode RegisterOauthClientRequestGrantTypes = "authorization_code"
+	RegisterOauthClientRequestGrantTypesClientCredentials RegisterOauthClientRequestGrantTypes = "client_credentials"
+	RegisterOauthClientRequestGrantTypesRefreshToken      RegisterOauthClientRequestGrantTypes = "refresh_token"
+)
+
+// AdjustLoyaltyPointsRequest adjust loyalty points request body
+type AdjustLoyaltyPointsRequest struct {
+	// Points points to credit, negative to debit
+	Points int `json:"points"`
+
+	// Reason kept in the loyalty ledger
+	Reason string `json:"reason"`
+}
+
+// ApiKeyResponse api key without the key itself
+type ApiKeyResponse struct {
+	CreatedAt time.Time          `json:"createdAt"`
+	ExpiresAt time.Time          `json:"expiresAt"`
+	Id        int                `json:"id"`
+	Kind      ApiKeyResponseKind `json:"kind"`
+
+	// LastUsedAt omitted when the key was never used
+	LastUsedAt *time.Time `json:"lastUsedAt,omitempty"`
+	Name       string     `json:"name"`
+
+	// Prefix identifies the key, it is the part after msk_
+	Prefix string   `json:"prefix"`
+	Scopes []string `json:"scopes"`
+}
+
+// ApiKeyResponseKind defines model for ApiKeyResponse.Kind.
+type ApiKeyResponseKind string
+
+// ApiKeysResponse api keys of the user
+type ApiKeysResponse struct {
+	ApiKeys []ApiKeyResponse `json:"apiKeys"`
+}
+
+// ChangeMembershipRequest change membership request body
+type ChangeMembershipRequest struct {
+	// Membership name of the new tier
+	Membership string `json:"membership"`
+
+	// Reason kept in the membership history
+	Reason *string `json:"reason,omitempty"`
+}
+
+// ChangePasswordRequest change password request body
+type ChangePasswordRequest struct {
+	// CurrentPassword current password
+	CurrentPassword string `json:"currentPassword"`
+
+	// NewPassword new password, at least 8 characters with letters and digits
+	NewPassword string `json:"newPassword"`
+}
+
+// CreateApiKeyRequest create api key request body
+type CreateApiKeyRequest struct {
+	// ExpiresAt expiry of the key, the configured default when omitted
+	ExpiresAt *time.Time `json:"expiresAt,omitempty"`
+
+	// Kind personal by default, both act as the user who created the key
+	Kind *CreateApiKeyRequestKind `json:"kind,omitempty"`
+
+	// Name label to tell keys apart
+	Name string `json:"name"`
+
+	// Scopes permissions granted to the key
+	Scopes []string `json:"scopes"`
+}
+
+// CreateApiKeyRequestKind personal by default, both act as the user who created the key
+type CreateApiKeyRequestKind string
+
+// CreateApiKeyResponse created api key
+type CreateApiKeyResponse struct {
+	// ApiKey api key without the key itself
+	ApiKey ApiKeyResponse `json:"apiKey"`
+
+	// Key the key to send in the Authorization header as ApiKey <key>, it can not be shown again
+	Key string `json:"key"`
+}
+
 // ErrorResponse Error Response Object
 type ErrorResponse struct {
-	AppCode    *int    `json:"appCode,omitempty"`
-	ErrorText  *string `json:"errorText,omitempty"`
-	StatusText *string `json:"statusText,omitempty"`
+	AppCode   *int    `json:"appCode,omitempty"`
+	ErrorText *string `json:"errorText,omitempty"`
+
+	// FailedRules rules a rejected value failed, e.g. the password policy
+	FailedRules *[]string `json:"failedRules,omitempty"`
+	StatusText  *string   `json:"statusText,omitempty"`
+}
+
+// ForgotPasswordRequest forgot password request body
+type ForgotPasswordRequest struct {
+	// UserName email
+	UserName string `json:"userName"`
 }
 
 // HealthResponse Error Response Object
@@ -15,6 +150,45 @@ type HealthResponse struct {
 	Message *string `json:"message,omitempty"`
 }
 
+// ImpersonationResponse access token to act as the user, it has no refresh token and is not set as a cookie
+type ImpersonationResponse struct {
+	// AccessToken sent as a Bearer token, every request made with it is audited
+	AccessToken string `json:"accessToken"`
+
+	// ExpiresAt the token is rejected after this time, it can not be refreshed
+	ExpiresAt time.Time `json:"expiresAt"`
+
+	// TokenType always Bearer
+	TokenType string `json:"tokenType"`
+}
+
+// Jwk Json Web Key
+type Jwk struct {
+	Alg string  `json:"alg"`
+	Crv *string `json:"crv,omitempty"`
+	E   *string `json:"e,omitempty"`
+	Kid string  `json:"kid"`
+	Kty string  `json:"kty"`
+	N   *string `json:"n,omitempty"`
+	Use string  `json:"use"`
+	X   *string `json:"x,omitempty"`
+	Y   *string `json:"y,omitempty"`
+}
+
+// JwksResponse Json Web Key Set
+type JwksResponse struct {
+	Keys []Jwk `json:"keys"`
+}
+
+// LoginMfaRequest login mfa request body
+type LoginMfaRequest struct {
+	// Code TOTP code or recovery code
+	Code string `json:"code"`
+
+	// MfaToken mfaToken returned by login
+	MfaToken string `json:"mfaToken"`
+}
+
 // LoginRequest login request body
 type LoginRequest struct {
 	// Password password
@@ -24,12 +198,244 @@ type LoginRequest struct {
 	UserName string `json:"userName"`
 }
 
+// LoginResponse login response body
+type LoginResponse struct {
+	// MfaRequired the user has 2FA on, no cookie is set and mfaToken must be sent to loginMfa
+	MfaRequired bool `json:"mfaRequired"`
+
+	// MfaToken short-lived token of the pending login
+	MfaToken *string `json:"mfaToken,omitempty"`
+}
+
+// LoyaltyPointsResponse loyalty balance after the adjustment
+type LoyaltyPointsResponse struct {
+	// Membership tier of the user after the balance was evaluated
+	Membership string `json:"membership"`
+
+	// Points balance of the user
+	Points int `json:"points"`
+}
+
+// MagicLinkRequest magic link request body
+type MagicLinkRequest struct {
+	// UserName email
+	UserName string `json:"userName"`
+}
+
+// MeResponse current user response body
+type MeResponse struct {
+	// CreatedAt registered at
+	CreatedAt time.Time `json:"createdAt"`
+
+	// Email email of user
+	Email *string `json:"email,omitempty"`
+
+	// Gender enum of [male, female, other]
+	Gender *string `json:"gender,omitempty"`
+
+	// Id user account id
+	Id int `json:"id"`
+
+	// Name name of user
+	Name *string `json:"name,omitempty"`
+
+	// Phone phone number of user
+	Phone *string `json:"phone,omitempty"`
+
+	// UserName email
+	UserName string `json:"userName"`
+}
+
+// MembershipResponse membership tier response body
+type MembershipResponse struct {
+	// Benefits benefits of the tier
+	Benefits []string `json:"benefits"`
+
+	// Name tier name, bronze through platinum
+	Name string `json:"name"`
+
+	// Rank a higher tier has a higher rank
+	Rank int `json:"rank"`
+}
+
+// MfaCodeRequest mfa code request body
+type MfaCodeRequest struct {
+	// Code TOTP code, or a recovery code where accepted
+	Code string `json:"code"`
+}
+
+// MfaEnrollResponse mfa enrolment response body
+type MfaEnrollResponse struct {
+	// OtpauthUri otpauth uri, usually shown as QR code
+	OtpauthUri string `json:"otpauthUri"`
+
+	// Secret base32 TOTP secret, for manual entry
+	Secret string `json:"secret"`
+}
+
+// MfaRecoveryCodesResponse mfa recovery codes response body
+type MfaRecoveryCodesResponse struct {
+	// RecoveryCodes one-time recovery codes, they are not shown again
+	RecoveryCodes []string `json:"recoveryCodes"`
+}
+
+// OauthClientResponse oauth client without its secret
+type OauthClientResponse struct {
+	ClientId     string    `json:"clientId"`
+	Confidential bool      `json:"confidential"`
+	CreatedAt    time.Time `json:"createdAt"`
+	GrantTypes   []string  `json:"grantTypes"`
+	Name         string    `json:"name"`
+	RedirectUris []string  `json:"redirectUris"`
+	Scopes       []string  `json:"scopes"`
+}
+
+// OauthClientsResponse registered oauth clients
+type OauthClientsResponse struct {
+	Clients []OauthClientResponse `json:"clients"`
+}
+
+// OauthErrorResponse error response of RFC 6749
+type OauthErrorResponse struct {
+	// Error error code, such as invalid_request, invalid_client or invalid_grant
+	Error            string  `json:"error"`
+	ErrorDescription *string `json:"error_description,omitempty"`
+}
+
+// OauthIntrospectResponse introspection response of RFC 7662, only active is set for an inactive token
+type OauthIntrospectResponse struct {
+	Active bool `json:"active"`
+
+	// ClientId omitted for tokens of the first-party login
+	ClientId *string `json:"client_id,omitempty"`
+	Exp      *int    `json:"exp,omitempty"`
+	Iat      *int    `json:"iat,omitempty"`
+	Iss      *string `json:"iss,omitempty"`
+	Jti      *string `json:"jti,omitempty"`
+	Scope    *string `json:"scope,omitempty"`
+
+	// Sub user id, or the client_id for client_credentials
+	Sub       *string                           `json:"sub,omitempty"`
+	TokenType *OauthIntrospectResponseTokenType `json:"token_type,omitempty"`
+}
+
+// OauthIntrospectResponseTokenType defines model for OauthIntrospectResponse.TokenType.
+type OauthIntrospectResponseTokenType string
+
+// OauthTokenHintRequest introspection and revocation request body
+type OauthTokenHintRequest struct {
+	// ClientId required unless sent with HTTP Basic
+	ClientId *string `json:"client_id,omitempty"`
+
+	// ClientSecret confidential clients not using HTTP Basic
+	ClientSecret  *string                             `json:"client_secret,omitempty"`
+	Token         string                              `json:"token"`
+	TokenTypeHint *OauthTokenHintRequestTokenTypeHint `json:"token_type_hint,omitempty"`
+}
+
+// OauthTokenHintRequestTokenTypeHint defines model for OauthTokenHintRequest.TokenTypeHint.
+type OauthTokenHintRequestTokenTypeHint string
+
+// OauthTokenRequest token request body of RFC 6749
+type OauthTokenRequest struct {
+	// ClientId required unless sent with HTTP Basic
+	ClientId *string `json:"client_id,omitempty"`
+
+	// ClientSecret confidential clients not using HTTP Basic
+	ClientSecret *string `json:"client_secret,omitempty"`
+
+	// Code authorization_code grant
+	Code *string `json:"code,omitempty"`
+
+	// CodeVerifier authorization_code grant, the PKCE verifier of the code_challenge
+	CodeVerifier *string                    `json:"code_verifier,omitempty"`
+	GrantType    OauthTokenRequestGrantType `json:"grant_type"`
+
+	// RedirectUri authorization_code grant, the redirect_uri of the authorize request, required when it was sent there
+	RedirectUri *string `json:"redirect_uri,omitempty"`
+
+	// RefreshToken refresh_token grant
+	RefreshToken *string `json:"refresh_token,omitempty"`
+
+	// Scope space separated scopes, narrows the granted scopes
+	Scope *string `json:"scope,omitempty"`
+}
+
+// OauthTokenRequestGrantType defines model for OauthTokenRequest.GrantType.
+type OauthTokenRequestGrantType string
+
+// OauthTokenResponse token response of RFC 6749
+type OauthTokenResponse struct {
+	AccessToken string `json:"access_token"`
+
+	// ExpiresIn lifetime of the access token in seconds
+	ExpiresIn int `json:"expires_in"`
+
+	// RefreshToken omitted for client_credentials, and for clients without the refresh_token grant
+	RefreshToken *string `json:"refresh_token,omitempty"`
+
+	// Scope space separated scopes granted to the access token
+	Scope string `json:"scope"`
+
+	// TokenType always Bearer
+	TokenType string `json:"token_type"`
+}
+
+// QuotaUsage consumption of one quota in its current period
+type QuotaUsage struct {
+	// Limit allowance of the membership tier per period
+	Limit int `json:"limit"`
+
+	// Name name of the quota
+	Name string `json:"name"`
+
+	// Period the count starts over at the next UTC day or month
+	Period QuotaUsagePeriod `json:"period"`
+
+	// ResetAt start of the next period
+	ResetAt time.Time `json:"resetAt"`
+
+	// Used consumed in the current period
+	Used int `json:"used"`
+}
+
+// QuotaUsagePeriod the count starts over at the next UTC day or month
+type QuotaUsagePeriod string
+
 // RefreshRequest refresh token request body
 type RefreshRequest struct {
 	// RefreshToken refresh token
 	RefreshToken string `json:"refreshToken"`
 }
 
+// RegisterOauthClientRequest register oauth client request body
+type RegisterOauthClientRequest struct {
+	// Confidential confidential clients get a secret, public clients such as single page apps do not
+	Confidential bool `json:"confidential"`
+
+	// GrantTypes client_credentials requires a confidential client
+	GrantTypes []RegisterOauthClientRequestGrantTypes `json:"grantTypes"`
+	Name       string                                 `json:"name"`
+
+	// RedirectUris absolute uris the authorize endpoint may redirect to, required for authorization_code
+	RedirectUris []string `json:"redirectUris"`
+
+	// Scopes permissions the client may request
+	Scopes []string `json:"scopes"`
+}
+
+// RegisterOauthClientRequestGrantTypes defines model for RegisterOauthClientRequest.GrantTypes.
+type RegisterOauthClientRequestGrantTypes string
+
+// RegisterOauthClientResponse registered oauth client
+type RegisterOauthClientResponse struct {
+	// Client oauth client without its secret
+	Client OauthClientResponse `json:"client"`
+
+	// ClientSecret secret of a confidential client, it can not be shown again
+	ClientSecret *string `json:"clientSecret,omitempty"`
+}
+
 // RegisterRequest register request body
 type RegisterRequest struct {
 	// Email email of user
@@ -51,11 +457,157 @@ type RegisterRequest struct {
 	UserName string `json:"userName"`
 }
 
+// ResendVerificationRequest resend verification request body
+type ResendVerificationRequest struct {
+	// UserName email
+	UserName string `json:"userName"`
+}
+
+// ResetPasswordRequest reset password request body
+type ResetPasswordRequest struct {
+	// NewPassword new password, at least 8 characters with letters and digits
+	NewPassword string `json:"newPassword"`
+
+	// Token token of the reset link
+	Token string `json:"token"`
+}
+
+// UpdateApiKeyRequest update api key request body
+type UpdateApiKeyRequest struct {
+	// Name label to tell keys apart
+	Name *string `json:"name,omitempty"`
+
+	// Scopes permissions granted to the key, replaces the current scopes
+	Scopes *[]string `json:"scopes,omitempty"`
+}
+
+// UpdateMeRequest update current user request body
+type UpdateMeRequest struct {
+	// Email email of user
+	Email *string `json:"email,omitempty"`
+
+	// Gender enum of [male, female, other]
+	Gender *string `json:"gender,omitempty"`
+
+	// Name name of user
+	Name *string `json:"name,omitempty"`
+
+	// Phone phone number of user
+	Phone *string `json:"phone,omitempty"`
+}
+
+// UsageResponse quota consumption of the authenticated user
+type UsageResponse struct {
+	// Membership tier the quotas come from, as of when the access token was issued
+	Membership string `json:"membership"`
+
+	// Quotas quotas of the tier, unlimited quotas are not listed
+	Quotas []QuotaUsage `json:"quotas"`
+}
+
+// VerifyEmailRequest verify email request body
+type VerifyEmailRequest struct {
+	// Token token of the verification link
+	Token string `json:"token"`
+}
+
+// LoginMagicLinkVerifyParams defines parameters for LoginMagicLinkVerify.
+type LoginMagicLinkVerifyParams struct {
+	// Token token of the magic link
+	Token string `form:"token" json:"token"`
+}
+
+// LoginOidcCallbackParams defines parameters for LoginOidcCallback.
+type LoginOidcCallbackParams struct {
+	Code *string `form:"code,omitempty" json:"code,omitempty"`
+
+	// State must match the state of the oidcState cookie
+	State *string `form:"state,omitempty" json:"state,omitempty"`
+
+	// Error set by the provider instead of code when the login failed
+	Error            *string `form:"error,omitempty" json:"error,omitempty"`
+	ErrorDescription *string `form:"error_description,omitempty" json:"error_description,omitempty"`
+}
+
+// OauthAuthorizeParams defines parameters for OauthAuthorize.
+type OauthAuthorizeParams struct {
+	// ResponseType must be code
+	ResponseType *string `form:"response_type,omitempty" json:"response_type,omitempty"`
+	ClientId     string  `form:"client_id" json:"client_id"`
+
+	// RedirectUri one of the registered redirect uris, may be omitted when the client has exactly one
+	RedirectUri *string `form:"redirect_uri,omitempty" json:"redirect_uri,omitempty"`
+
+	// Scope space separated scopes, every scope of the client when omitted
+	Scope *string `form:"scope,omitempty" json:"scope,omitempty"`
+
+	// State returned unchanged with the redirect
+	State         *string `form:"state,omitempty" json:"state,omitempty"`
+	CodeChallenge *string `form:"code_challenge,omitempty" json:"code_challenge,omitempty"`
+
+	// CodeChallengeMethod must be S256
+	CodeChallengeMethod *string `form:"code_challenge_method,omitempty" json:"code_challenge_method,omitempty"`
+}
+
+// RegisterOauthClientJSONRequestBody defines body for RegisterOauthClient for application/json ContentType.
+type RegisterOauthClientJSONRequestBody = RegisterOauthClientRequest
+
+// AdjustLoyaltyPointsJSONRequestBody defines body for AdjustLoyaltyPoints for application/json ContentType.
+type AdjustLoyaltyPointsJSONRequestBody = AdjustLoyaltyPointsRequest
+
+// ChangeMembershipJSONRequestBody defines body for ChangeMembership for application/json ContentType.
+type ChangeMembershipJSONRequestBody = ChangeMembershipRequest
+
 // LoginJSONRequestBody defines body for Login for application/json ContentType.
 type LoginJSONRequestBody = LoginRequest
 
+// LoginMagicLinkJSONRequestBody defines body for LoginMagicLink for application/json ContentType.
+type LoginMagicLinkJSONRequestBody = MagicLinkRequest
+
+// LoginMfaJSONRequestBody defines body for LoginMfa for application/json ContentType.
+type LoginMfaJSONRequestBody = LoginMfaRequest
+
+// UpdateMeJSONRequestBody defines body for UpdateMe for application/json ContentType.
+type UpdateMeJSONRequestBody = UpdateMeRequest
+
+// CreateApiKeyJSONRequestBody defines body for CreateApiKey for application/json ContentType.
+type CreateApiKeyJSONRequestBody = CreateApiKeyRequest
+
+// UpdateApiKeyJSONRequestBody defines body for UpdateApiKey for application/json ContentType.
+type UpdateApiKeyJSONRequestBody = UpdateApiKeyRequest
+
+// ConfirmMfaJSONRequestBody defines body for ConfirmMfa for application/json ContentType.
+type ConfirmMfaJSONRequestBody = MfaCodeRequest
+
+// DisableMfaJSONRequestBody defines body for DisableMfa for application/json ContentType.
+type DisableMfaJSONRequestBody = MfaCodeRequest
+
+// ChangePasswordJSONRequestBody defines body for ChangePassword for application/json ContentType.
+type ChangePasswordJSONRequestBody = ChangePasswordRequest
+
+// OauthIntrospectFormdataRequestBody defines body for OauthIntrospect for application/x-www-form-urlencoded ContentType.
+type OauthIntrospectFormdataRequestBody = OauthTokenHintRequest
+
+// OauthRevokeFormdataRequestBody defines body for OauthRevoke for application/x-www-form-urlencoded ContentType.
+type OauthRevokeFormdataRequestBody = OauthTokenHintRequest
+
+// OauthTokenFormdataRequestBody defines body for OauthToken for application/x-www-form-urlencoded ContentType.
+type OauthTokenFormdataRequestBody = OauthTokenRequest
+
+// ForgotPasswordJSONRequestBody defines body for ForgotPassword for application/json ContentType.
+type ForgotPasswordJSONRequestBody = ForgotPasswordRequest
+
+// ResetPasswordJSONRequestBody defines body for ResetPassword for application/json ContentType.
+type ResetPasswordJSONRequestBody = ResetPasswordRequest
+
 // RefreshJSONRequestBody defines body for Refresh for application/json ContentType.
 type RefreshJSONRequestBody = RefreshRequest
 
 // RegisterJSONRequestBody defines body for Register for application/json ContentType.
 type RegisterJSONRequestBody = RegisterRequest
+
+// VerifyEmailJSONRequestBody defines body for VerifyEmail for application/json ContentType.
+type VerifyEmailJSONRequestBody = VerifyEmailRequest
+
+// ResendVerificationJSONRequestBody defines body for ResendVerification for application/json ContentType.
+type ResendVerificationJSONRequestBody = ResendVerificationRequest
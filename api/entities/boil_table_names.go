@@ -4,9 +4,51 @@
 package entities
 
 var TableNames = struct {
-	UserAccount string
-	UserInfo    string
+	APIKey                 string
+	ImpersonationAudit     string
+	LoginAttempt           string
+	LoyaltyPoint           string
+	MagicLinkToken         string
+	Membership             string
+	MembershipHistory      string
+	OauthAuthorizationCode string
+	OauthClient            string
+	PasswordResetToken     string
+	Permission             string
+	RevokedSubject         string
+	RevokedToken           string
+	Role                   string
+	RolePermission         string
+	UsageCounter           string
+	UserAccount            string
+	UserIdentity           string
+	UserInfo               string
+	UserMfa                string
+	UserRecoveryCode       string
+	UserRole               string
+	UserSession            string
 }{
-	UserAccount: "user_account",
-	UserInfo:    "user_info",
+	APIKey:                 "api_key",
+	ImpersonationAudit:     "impersonation_audit",
+	LoginAttempt:           "login_attempt",
+	LoyaltyPoint:           "loyalty_point",
+	MagicLinkToken:         "magic_link_token",
+	Membership:             "membership",
+	MembershipHistory:      "membership_history",
+	OauthAuthorizationCode: "oauth_authorization_code",
+	OauthClient:            "oauth_client",
+	PasswordResetToken:     "password_reset_token",
+	Permission:             "permission",
+	RevokedSubject:         "revoked_subject",
+	RevokedToken:           "revoked_token",
+	Role:                   "role",
+	RolePermission:         "role_permission",
+	UsageCounter:           "usage_counter",
+	UserAccount:            "user_account",
+	UserIdentity:           "user_identity",
+	UserInfo:               "user_info",
+	UserMfa:                "user_mfa",
+	UserRecoveryCode:       "user_recovery_code",
+	UserRole:               "user_role",
+	UserSession:            "user_session",
 }
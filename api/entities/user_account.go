@@ -24,216 +24,269 @@ import (
 
 // UserAccount is an object representing the database table.
 type UserAccount struct {
-	ID        int       `boil:"id" json:"id" toml:"id" yaml:"id"`
-	UserName  string    `boil:"user_name" json:"user_name" toml:"user_name" yaml:"user_name"`
-	Password  string    `boil:"password" json:"password" toml:"password" yaml:"password"`
-	IsActive  bool      `boil:"is_active" json:"is_active" toml:"is_active" yaml:"is_active"`
-	IsDeleted bool      `boil:"is_deleted" json:"is_deleted" toml:"is_deleted" yaml:"is_deleted"`
-	CreatedAt time.Time `boil:"created_at" json:"created_at" toml:"created_at" yaml:"created_at"`
-	UpdatedAt null.Time `boil:"updated_at" json:"updated_at,omitempty" toml:"updated_at" yaml:"updated_at,omitempty"`
-	DeletedAt null.Time `boil:"deleted_at" json:"deleted_at,omitempty" toml:"deleted_at" yaml:"deleted_at,omitempty"`
+	ID              int       `boil:"id" json:"id" toml:"id" yaml:"id"`
+	UserName        string    `boil:"user_name" json:"user_name" toml:"user_name" yaml:"user_name"`
+	Password        string    `boil:"password" json:"password" toml:"password" yaml:"password"`
+	IsActive        bool      `boil:"is_active" json:"is_active" toml:"is_active" yaml:"is_active"`
+	IsDeleted       bool      `boil:"is_deleted" json:"is_deleted" toml:"is_deleted" yaml:"is_deleted"`
+	CreatedAt       time.Time `boil:"created_at" json:"created_at" toml:"created_at" yaml:"created_at"`
+	UpdatedAt       null.Time `boil:"updated_at" json:"updated_at,omitempty" toml:"updated_at" yaml:"updated_at,omitempty"`
+	DeletedAt       null.Time `boil:"deleted_at" json:"deleted_at,omitempty" toml:"deleted_at" yaml:"deleted_at,omitempty"`
+	EmailVerifiedAt null.Time `boil:"email_verified_at" json:"email_verified_at,omitempty" toml:"email_verified_at" yaml:"email_verified_at,omitempty"`
 
 	R *userAccountR `boil:"-" json:"-" toml:"-" yaml:"-"`
 	L userAccountL  `boil:"-" json:"-" toml:"-" yaml:"-"`
 }
 
 var UserAccountColumns = struct {
-	ID        string
-	UserName  string
-	Password  string
-	IsActive  string
-	IsDeleted string
-	CreatedAt string
-	UpdatedAt string
-	DeletedAt string
+	ID              string
+	UserName        string
+	Password        string
+	IsActive        string
+	IsDeleted       string
+	CreatedAt       string
+	UpdatedAt       string
+	DeletedAt       string
+	EmailVerifiedAt string
 }{
-	ID:        "id",
-	UserName:  "user_name",
-	Password:  "password",
-	IsActive:  "is_active",
-	IsDeleted: "is_deleted",
-	CreatedAt: "created_at",
-	UpdatedAt: "updated_at",
-	DeletedAt: "deleted_at",
+	ID:              "id",
+	UserName:        "user_name",
+	Password:        "password",
+	IsActive:        "is_active",
+	IsDeleted:       "is_deleted",
+	CreatedAt:       "created_at",
+	UpdatedAt:       "updated_at",
+	DeletedAt:       "deleted_at",
+	EmailVerifiedAt: "email_verified_at",
 }
 
 var UserAccountTableColumns = struct {
-	ID        string
-	UserName  string
-	Password  string
-	IsActive  string
-	IsDeleted string
-	CreatedAt string
-	UpdatedAt string
-	DeletedAt string
+	ID              string
+	UserName        string
+	Password        string
+	IsActive        string
+	IsDeleted       string
+	CreatedAt       string
+	UpdatedAt       string
+	DeletedAt       string
+	EmailVerifiedAt string
 }{
-	ID:        "user_account.id",
-	UserName:  "user_account.user_name",
-	Password:  "user_account.password",
-	IsActive:  "user_account.is_active",
-	IsDeleted: "user_account.is_deleted",
-	CreatedAt: "user_account.created_at",
-	UpdatedAt: "user_account.updated_at",
-	DeletedAt: "user_account.deleted_at",
+	ID:              "user_account.id",
+	UserName:        "user_account.user_name",
+	Password:        "user_account.password",
+	IsActive:        "user_account.is_active",
+	IsDeleted:       "user_account.is_deleted",
+	CreatedAt:       "user_account.created_at",
+	UpdatedAt:       "user_account.updated_at",
+	DeletedAt:       "user_account.deleted_at",
+	EmailVerifiedAt: "user_account.email_verified_at",
 }
 
 // Generated where
 
-type whereHelperint struct{ field string }
+var UserAccountWhere = struct {
+	ID              whereHelperint
+	UserName        whereHelperstring
+	Password        whereHelperstring
+	IsActive        whereHelperbool
+	IsDeleted       whereHelperbool
+	CreatedAt       whereHelpertime_Time
+	UpdatedAt       whereHelpernull_Time
+	DeletedAt       whereHelpernull_Time
+	EmailVerifiedAt whereHelpernull_Time
+}{
+	ID:              whereHelperint{field: "\"user_account\".\"id\""},
+	UserName:        whereHelperstring{field: "\"user_account\".\"user_name\""},
+	Password:        whereHelperstring{field: "\"user_account\".\"password\""},
+	IsActive:        whereHelperbool{field: "\"user_account\".\"is_active\""},
+	IsDeleted:       whereHelperbool{field: "\"user_account\".\"is_deleted\""},
+	CreatedAt:       whereHelpertime_Time{field: "\"user_account\".\"created_at\""},
+	UpdatedAt:       whereHelpernull_Time{field: "\"user_account\".\"updated_at\""},
+	DeletedAt:       whereHelpernull_Time{field: "\"user_account\".\"deleted_at\""},
+	EmailVerifiedAt: whereHelpernull_Time{field: "\"user_account\".\"email_verified_at\""},
+}
 
-func (w whereHelperint) EQ(x int) qm.QueryMod  { return qmhelper.Where(w.field, qmhelper.EQ, x) }
-func (w whereHelperint) NEQ(x int) qm.QueryMod { return qmhelper.Where(w.field, qmhelper.NEQ, x) }
-func (w whereHelperint) LT(x int) qm.QueryMod  { return qmhelper.Where(w.field, qmhelper.LT, x) }
-func (w whereHelperint) LTE(x int) qm.QueryMod { return qmhelper.Where(w.field, qmhelper.LTE, x) }
-func (w whereHelperint) GT(x int) qm.QueryMod  { return qmhelper.Where(w.field, qmhelper.GT, x) }
-func (w whereHelperint) GTE(x int) qm.QueryMod { return qmhelper.Where(w.field, qmhelper.GTE, x) }
-func (w whereHelperint) IN(slice []int) qm.QueryMod {
-	values := make([]interface{}, 0, len(slice))
-	for _, value := range slice {
-		values = append(values, value)
-	}
-	return qm.WhereIn(fmt.Sprintf("%s IN ?", w.field), values...)
+// UserAccountRels is where relationship names are stored.
+var UserAccountRels = struct {
+	UserMfa                             string
+	APIKeys                             string
+	ActorUserAccountImpersonationAudits string
+	ImpersonationAudits                 string
+	LoyaltyPoints                       string
+	MagicLinkTokens                     string
+	ChangedByMembershipHistories        string
+	MembershipHistories                 string
+	OauthAuthorizationCodes             string
+	PasswordResetTokens                 string
+	UsageCounters                       string
+	UserIdentities                      string
+	UserInfos                           string
+	UserRecoveryCodes                   string
+	UserRoles                           string
+	UserSessions                        string
+}{
+	UserMfa:                             "UserMfa",
+	APIKeys:                             "APIKeys",
+	ActorUserAccountImpersonationAudits: "ActorUserAccountImpersonationAudits",
+	ImpersonationAudits:                 "ImpersonationAudits",
+	LoyaltyPoints:                       "LoyaltyPoints",
+	MagicLinkTokens:                     "MagicLinkTokens",
+	ChangedByMembershipHistories:        "ChangedByMembershipHistories",
+	MembershipHistories:                 "MembershipHistories",
+	OauthAuthorizationCodes:             "OauthAuthorizationCodes",
+	PasswordResetTokens:                 "PasswordResetTokens",
+	UsageCounters:                       "UsageCounters",
+	UserIdentities:                      "UserIdentities",
+	UserInfos:                           "UserInfos",
+	UserRecoveryCodes:                   "UserRecoveryCodes",
+	UserRoles:                           "UserRoles",
+	UserSessions:                        "UserSessions",
 }
-func (w whereHelperint) NIN(slice []int) qm.QueryMod {
-	values := make([]interface{}, 0, len(slice))
-	for _, value := range slice {
-		values = append(values, value)
-	}
-	return qm.WhereNotIn(fmt.Sprintf("%s NOT IN ?", w.field), values...)
+
+// userAccountR is where relationships are stored.
+type userAccountR struct {
+	UserMfa                             *UserMfa                    `boil:"UserMfa" json:"UserMfa" toml:"UserMfa" yaml:"UserMfa"`
+	APIKeys                             APIKeySlice                 `boil:"APIKeys" json:"APIKeys" toml:"APIKeys" yaml:"APIKeys"`
+	ActorUserAccountImpersonationAudits ImpersonationAuditSlice     `boil:"ActorUserAccountImpersonationAudits" json:"ActorUserAccountImpersonationAudits" toml:"ActorUserAccountImpersonationAudits" yaml:"ActorUserAccountImpersonationAudits"`
+	ImpersonationAudits                 ImpersonationAuditSlice     `boil:"ImpersonationAudits" json:"ImpersonationAudits" toml:"ImpersonationAudits" yaml:"ImpersonationAudits"`
+	LoyaltyPoints                       LoyaltyPointSlice           `boil:"LoyaltyPoints" json:"LoyaltyPoints" toml:"LoyaltyPoints" yaml:"LoyaltyPoints"`
+	MagicLinkTokens                     MagicLinkTokenSlice         `boil:"MagicLinkTokens" json:"MagicLinkTokens" toml:"MagicLinkTokens" yaml:"MagicLinkTokens"`
+	ChangedByMembershipHistories        MembershipHistorySlice      `boil:"ChangedByMembershipHistories" json:"ChangedByMembershipHistories" toml:"ChangedByMembershipHistories" yaml:"ChangedByMembershipHistories"`
+	MembershipHistories                 MembershipHistorySlice      `boil:"MembershipHistories" json:"MembershipHistories" toml:"MembershipHistories" yaml:"MembershipHistories"`
+	OauthAuthorizationCodes             OauthAuthorizationCodeSlice `boil:"OauthAuthorizationCodes" json:"OauthAuthorizationCodes" toml:"OauthAuthorizationCodes" yaml:"OauthAuthorizationCodes"`
+	PasswordResetTokens                 PasswordResetTokenSlice     `boil:"PasswordResetTokens" json:"PasswordResetTokens" toml:"PasswordResetTokens" yaml:"PasswordResetTokens"`
+	UsageCounters                       UsageCounterSlice           `boil:"UsageCounters" json:"UsageCounters" toml:"UsageCounters" yaml:"UsageCounters"`
+	UserIdentities                      UserIdentitySlice           `boil:"UserIdentities" json:"UserIdentities" toml:"UserIdentities" yaml:"UserIdentities"`
+	UserInfos                           UserInfoSlice               `boil:"UserInfos" json:"UserInfos" toml:"UserInfos" yaml:"UserInfos"`
+	UserRecoveryCodes                   UserRecoveryCodeSlice       `boil:"UserRecoveryCodes" json:"UserRecoveryCodes" toml:"UserRecoveryCodes" yaml:"UserRecoveryCodes"`
+	UserRoles                           UserRoleSlice               `boil:"UserRoles" json:"UserRoles" toml:"UserRoles" yaml:"UserRoles"`
+	UserSessions                        UserSessionSlice            `boil:"UserSessions" json:"UserSessions" toml:"UserSessions" yaml:"UserSessions"`
 }
 
-type whereHelperstring struct{ field string }
+// NewStruct creates a new relationship struct
+func (*userAccountR) NewStruct() *userAccountR {
+	return &userAccountR{}
+}
 
-func (w whereHelperstring) EQ(x string) qm.QueryMod     { return qmhelper.Where(w.field, qmhelper.EQ, x) }
-func (w whereHelperstring) NEQ(x string) qm.QueryMod    { return qmhelper.Where(w.field, qmhelper.NEQ, x) }
-func (w whereHelperstring) LT(x string) qm.QueryMod     { return qmhelper.Where(w.field, qmhelper.LT, x) }
-func (w whereHelperstring) LTE(x string) qm.QueryMod    { return qmhelper.Where(w.field, qmhelper.LTE, x) }
-func (w whereHelperstring) GT(x string) qm.QueryMod     { return qmhelper.Where(w.field, qmhelper.GT, x) }
-func (w whereHelperstring) GTE(x string) qm.QueryMod    { return qmhelper.Where(w.field, qmhelper.GTE, x) }
-func (w whereHelperstring) LIKE(x string) qm.QueryMod   { return qm.Where(w.field+" LIKE ?", x) }
-func (w whereHelperstring) NLIKE(x string) qm.QueryMod  { return qm.Where(w.field+" NOT LIKE ?", x) }
-func (w whereHelperstring) ILIKE(x string) qm.QueryMod  { return qm.Where(w.field+" ILIKE ?", x) }
-func (w whereHelperstring) NILIKE(x string) qm.QueryMod { return qm.Where(w.field+" NOT ILIKE ?", x) }
-func (w whereHelperstring) IN(slice []string) qm.QueryMod {
-	values := make([]interface{}, 0, len(slice))
-	for _, value := range slice {
-		values = append(values, value)
+func (r *userAccountR) GetUserMfa() *UserMfa {
+	if r == nil {
+		return nil
 	}
-	return qm.WhereIn(fmt.Sprintf("%s IN ?", w.field), values...)
+	return r.UserMfa
 }
-func (w whereHelperstring) NIN(slice []string) qm.QueryMod {
-	values := make([]interface{}, 0, len(slice))
-	for _, value := range slice {
-		values = append(values, value)
+
+func (r *userAccountR) GetAPIKeys() APIKeySlice {
+	if r == nil {
+		return nil
 	}
-	return qm.WhereNotIn(fmt.Sprintf("%s NOT IN ?", w.field), values...)
+	return r.APIKeys
 }
 
-type whereHelperbool struct{ field string }
-
-func (w whereHelperbool) EQ(x bool) qm.QueryMod  { return qmhelper.Where(w.field, qmhelper.EQ, x) }
-func (w whereHelperbool) NEQ(x bool) qm.QueryMod { return qmhelper.Where(w.field, qmhelper.NEQ, x) }
-func (w whereHelperbool) LT(x bool) qm.QueryMod  { return qmhelper.Where(w.field, qmhelper.LT, x) }
-func (w whereHelperbool) LTE(x bool) qm.QueryMod { return qmhelper.Where(w.field, qmhelper.LTE, x) }
-func (w whereHelperbool) GT(x bool) qm.QueryMod  { return qmhelper.Where(w.field, qmhelper.GT, x) }
-func (w whereHelperbool) GTE(x bool) qm.QueryMod { return qmhelper.Where(w.field, qmhelper.GTE, x) }
-
-type whereHelpertime_Time struct{ field string }
-
-func (w whereHelpertime_Time) EQ(x time.Time) qm.QueryMod {
-	return qmhelper.Where(w.field, qmhelper.EQ, x)
-}
-func (w whereHelpertime_Time) NEQ(x time.Time) qm.QueryMod {
-	return qmhelper.Where(w.field, qmhelper.NEQ, x)
-}
-func (w whereHelpertime_Time) LT(x time.Time) qm.QueryMod {
-	return qmhelper.Where(w.field, qmhelper.LT, x)
-}
-func (w whereHelpertime_Time) LTE(x time.Time) qm.QueryMod {
-	return qmhelper.Where(w.field, qmhelper.LTE, x)
-}
-func (w whereHelpertime_Time) GT(x time.Time) qm.QueryMod {
-	return qmhelper.Where(w.field, qmhelper.GT, x)
-}
-func (w whereHelpertime_Time) GTE(x time.Time) qm.QueryMod {
-	return qmhelper.Where(w.field, qmhelper.GTE, x)
+func (r *userAccountR) GetActorUserAccountImpersonationAudits() ImpersonationAuditSlice {
+	if r == nil {
+		return nil
+	}
+	return r.ActorUserAccountImpersonationAudits
 }
 
-type whereHelpernull_Time struct{ field string }
+func (r *userAccountR) GetImpersonationAudits() ImpersonationAuditSlice {
+	if r == nil {
+		return nil
+	}
+	return r.ImpersonationAudits
+}
 
-func (w whereHelpernull_Time) EQ(x null.Time) qm.QueryMod {
-	return qmhelper.WhereNullEQ(w.field, false, x)
+func (r *userAccountR) GetLoyaltyPoints() LoyaltyPointSlice {
+	if r == nil {
+		return nil
+	}
+	return r.LoyaltyPoints
 }
-func (w whereHelpernull_Time) NEQ(x null.Time) qm.QueryMod {
-	return qmhelper.WhereNullEQ(w.field, true, x)
+
+func (r *userAccountR) GetMagicLinkTokens() MagicLinkTokenSlice {
+	if r == nil {
+		return nil
+	}
+	return r.MagicLinkTokens
 }
-func (w whereHelpernull_Time) LT(x null.Time) qm.QueryMod {
-	return qmhelper.Where(w.field, qmhelper.LT, x)
+
+func (r *userAccountR) GetChangedByMembershipHistories() MembershipHistorySlice {
+	if r == nil {
+		return nil
+	}
+	return r.ChangedByMembershipHistories
 }
-func (w whereHelpernull_Time) LTE(x null.Time) qm.QueryMod {
-	return qmhelper.Where(w.field, qmhelper.LTE, x)
+
+func (r *userAccountR) GetMembershipHistories() MembershipHistorySlice {
+	if r == nil {
+		return nil
+	}
+	return r.MembershipHistories
 }
-func (w whereHelpernull_Time) GT(x null.Time) qm.QueryMod {
-	return qmhelper.Where(w.field, qmhelper.GT, x)
+
+func (r *userAccountR) GetOauthAuthorizationCodes() OauthAuthorizationCodeSlice {
+	if r == nil {
+		return nil
+	}
+	return r.OauthAuthorizationCodes
 }
-func (w whereHelpernull_Time) GTE(x null.Time) qm.QueryMod {
-	return qmhelper.Where(w.field, qmhelper.GTE, x)
+
+func (r *userAccountR) GetPasswordResetTokens() PasswordResetTokenSlice {
+	if r == nil {
+		return nil
+	}
+	return r.PasswordResetTokens
 }
 
-func (w whereHelpernull_Time) IsNull() qm.QueryMod    { return qmhelper.WhereIsNull(w.field) }
-func (w whereHelpernull_Time) IsNotNull() qm.QueryMod { return qmhelper.WhereIsNotNull(w.field) }
+func (r *userAccountR) GetUsageCounters() UsageCounterSlice {
+	if r == nil {
+		return nil
+	}
+	return r.UsageCounters
+}
 
-var UserAccountWhere = struct {
-	ID        whereHelperint
-	UserName  whereHelperstring
-	Password  whereHelperstring
-	IsActive  whereHelperbool
-	IsDeleted whereHelperbool
-	CreatedAt whereHelpertime_Time
-	UpdatedAt whereHelpernull_Time
-	DeletedAt whereHelpernull_Time
-}{
-	ID:        whereHelperint{field: "\"user_account\".\"id\""},
-	UserName:  whereHelperstring{field: "\"user_account\".\"user_name\""},
-	Password:  whereHelperstring{field: "\"user_account\".\"password\""},
-	IsActive:  whereHelperbool{field: "\"user_account\".\"is_active\""},
-	IsDeleted: whereHelperbool{field: "\"user_account\".\"is_deleted\""},
-	CreatedAt: whereHelpertime_Time{field: "\"user_account\".\"created_at\""},
-	UpdatedAt: whereHelpernull_Time{field: "\"user_account\".\"updated_at\""},
-	DeletedAt: whereHelpernull_Time{field: "\"user_account\".\"deleted_at\""},
+func (r *userAccountR) GetUserIdentities() UserIdentitySlice {
+	if r == nil {
+		return nil
+	}
+	return r.UserIdentities
 }
 
-// UserAccountRels is where relationship names are stored.
-var UserAccountRels = struct {
-	UserInfos string
-}{
-	UserInfos: "UserInfos",
+func (r *userAccountR) GetUserInfos() UserInfoSlice {
+	if r == nil {
+		return nil
+	}
+	return r.UserInfos
 }
 
-// userAccountR is where relationships are stored.
-type userAccountR struct {
-	UserInfos UserInfoSlice `boil:"UserInfos" json:"UserInfos" toml:"UserInfos" yaml:"UserInfos"`
+func (r *userAccountR) GetUserRecoveryCodes() UserRecoveryCodeSlice {
+	if r == nil {
+		return nil
+	}
+	return r.UserRecoveryCodes
 }
 
-// NewStruct creates a new relationship struct
-func (*userAccountR) NewStruct() *userAccountR {
-	return &userAccountR{}
+func (r *userAccountR) GetUserRoles() UserRoleSlice {
+	if r == nil {
+		return nil
+	}
+	return r.UserRoles
 }
 
-func (r *userAccountR) GetUserInfos() UserInfoSlice {
+func (r *userAccountR) GetUserSessions() UserSessionSlice {
 	if r == nil {
 		return nil
 	}
-	return r.UserInfos
+	return r.UserSessions
 }
 
 // userAccountL is where Load methods for each relationship are stored.
 type userAccountL struct{}
 
 var (
-	userAccountAllColumns            = []string{"id", "user_name", "password", "is_active", "is_deleted", "created_at", "updated_at", "deleted_at"}
+	userAccountAllColumns            = []string{"id", "user_name", "password", "is_active", "is_deleted", "created_at", "updated_at", "deleted_at", "email_verified_at"}
 	userAccountColumnsWithoutDefault = []string{"user_name", "password", "is_active"}
-	userAccountColumnsWithDefault    = []string{"id", "is_deleted", "created_at", "updated_at", "deleted_at"}
+	userAccountColumnsWithDefault    = []string{"id", "is_deleted", "created_at", "updated_at", "deleted_at", "email_verified_at"}
 	userAccountPrimaryKeyColumns     = []string{"id"}
 	userAccountGeneratedColumns      = []string{}
 )
@@ -543,6 +596,171 @@ func (q userAccountQuery) Exists(ctx context.Context, exec boil.ContextExecutor)
 	return count > 0, nil
 }
 
+// UserMfa pointed to by the foreign key.
+func (o *UserAccount) UserMfa(mods ...qm.QueryMod) userMfaQuery {
+	queryMods := []qm.QueryMod{
+		qm.Where("\"user_account_id\" = ?", o.ID),
+	}
+
+	queryMods = append(queryMods, mods...)
+
+	return UserMfas(queryMods...)
+}
+
+// APIKeys retrieves all the api_key's APIKeys with an executor.
+func (o *UserAccount) APIKeys(mods ...qm.QueryMod) apiKeyQuery {
+	var queryMods []qm.QueryMod
+	if len(mods) != 0 {
+		queryMods = append(queryMods, mods...)
+	}
+
+	queryMods = append(queryMods,
+		qm.Where("\"api_key\".\"user_account_id\"=?", o.ID),
+	)
+
+	return APIKeys(queryMods...)
+}
+
+// ActorUserAccountImpersonationAudits retrieves all the impersonation_audit's ImpersonationAudits with an executor via actor_user_account_id column.
+func (o *UserAccount) ActorUserAccountImpersonationAudits(mods ...qm.QueryMod) impersonationAuditQuery {
+	var queryMods []qm.QueryMod
+	if len(mods) != 0 {
+		queryMods = append(queryMods, mods...)
+	}
+
+	queryMods = append(queryMods,
+		qm.Where("\"impersonation_audit\".\"actor_user_account_id\"=?", o.ID),
+	)
+
+	return ImpersonationAudits(queryMods...)
+}
+
+// ImpersonationAudits retrieves all the impersonation_audit's ImpersonationAudits with an executor.
+func (o *UserAccount) ImpersonationAudits(mods ...qm.QueryMod) impersonationAuditQuery {
+	var queryMods []qm.QueryMod
+	if len(mods) != 0 {
+		queryMods = append(queryMods, mods...)
+	}
+
+	queryMods = append(queryMods,
+		qm.Where("\"impersonation_audit\".\"user_account_id\"=?", o.ID),
+	)
+
+	return ImpersonationAudits(queryMods...)
+}
+
+// LoyaltyPoints retrieves all the loyalty_point's LoyaltyPoints with an executor.
+func (o *UserAccount) LoyaltyPoints(mods ...qm.QueryMod) loyaltyPointQuery {
+	var queryMods []qm.QueryMod
+	if len(mods) != 0 {
+		queryMods = append(queryMods, mods...)
+	}
+
+	queryMods = append(queryMods,
+		qm.Where("\"loyalty_point\".\"user_account_id\"=?", o.ID),
+	)
+
+	return LoyaltyPoints(queryMods...)
+}
+
+// MagicLinkTokens retrieves all the magic_link_token's MagicLinkTokens with an executor.
+func (o *UserAccount) MagicLinkTokens(mods ...qm.QueryMod) magicLinkTokenQuery {
+	var queryMods []qm.QueryMod
+	if len(mods) != 0 {
+		queryMods = append(queryMods, mods...)
+	}
+
+	queryMods = append(queryMods,
+		qm.Where("\"magic_link_token\".\"user_account_id\"=?", o.ID),
+	)
+
+	return MagicLinkTokens(queryMods...)
+}
+
+// ChangedByMembershipHistories retrieves all the membership_history's MembershipHistories with an executor via changed_by column.
+func (o *UserAccount) ChangedByMembershipHistories(mods ...qm.QueryMod) membershipHistoryQuery {
+	var queryMods []qm.QueryMod
+	if len(mods) != 0 {
+		queryMods = append(queryMods, mods...)
+	}
+
+	queryMods = append(queryMods,
+		qm.Where("\"membership_history\".\"changed_by\"=?", o.ID),
+	)
+
+	return MembershipHistories(queryMods...)
+}
+
+// MembershipHistories retrieves all the membership_history's MembershipHistories with an executor.
+func (o *UserAccount) MembershipHistories(mods ...qm.QueryMod) membershipHistoryQuery {
+	var queryMods []qm.QueryMod
+	if len(mods) != 0 {
+		queryMods = append(queryMods, mods...)
+	}
+
+	queryMods = append(queryMods,
+		qm.Where("\"membership_history\".\"user_account_id\"=?", o.ID),
+	)
+
+	return MembershipHistories(queryMods...)
+}
+
+// OauthAuthorizationCodes retrieves all the oauth_authorization_code's OauthAuthorizationCodes with an executor.
+func (o *UserAccount) OauthAuthorizationCodes(mods ...qm.QueryMod) oauthAuthorizationCodeQuery {
+	var queryMods []qm.QueryMod
+	if len(mods) != 0 {
+		queryMods = append(queryMods, mods...)
+	}
+
+	queryMods = append(queryMods,
+		qm.Where("\"oauth_authorization_code\".\"user_account_id\"=?", o.ID),
+	)
+
+	return OauthAuthorizationCodes(queryMods...)
+}
+
+// PasswordResetTokens retrieves all the password_reset_token's PasswordResetTokens with an executor.
+func (o *UserAccount) PasswordResetTokens(mods ...qm.QueryMod) passwordResetTokenQuery {
+	var queryMods []qm.QueryMod
+	if len(mods) != 0 {
+		queryMods = append(queryMods, mods...)
+	}
+
+	queryMods = append(queryMods,
+		qm.Where("\"password_reset_token\".\"user_account_id\"=?", o.ID),
+	)
+
+	return PasswordResetTokens(queryMods...)
+}
+
+// UsageCounters retrieves all the usage_counter's UsageCounters with an executor.
+func (o *UserAccount) UsageCounters(mods ...qm.QueryMod) usageCounterQuery {
+	var queryMods []qm.QueryMod
+	if len(mods) != 0 {
+		queryMods = append(queryMods, mods...)
+	}
+
+	queryMods = append(queryMods,
+		qm.Where("\"usage_counter\".\"user_account_id\"=?", o.ID),
+	)
+
+	return UsageCounters(queryMods...)
+}
+
+// UserIdentities retrieves all the user_identity's UserIdentities with an executor.
+func (o *UserAccount) UserIdentities(mods ...qm.QueryMod) userIdentityQuery {
+	var queryMods []qm.QueryMod
+	if len(mods) != 0 {
+		queryMods = append(queryMods, mods...)
+	}
+
+	queryMods = append(queryMods,
+		qm.Where("\"user_identity\".\"user_account_id\"=?", o.ID),
+	)
+
+	return UserIdentities(queryMods...)
+}
+
 // UserInfos retrieves all the user_info's UserInfos with an executor.
 func (o *UserAccount) UserInfos(mods ...qm.QueryMod) userInfoQuery {
 	var queryMods []qm.QueryMod
@@ -557,9 +775,51 @@ func (o *UserAccount) UserInfos(mods ...qm.QueryMod) userInfoQuery {
 	return UserInfos(queryMods...)
 }
 
-// LoadUserInfos allows an eager lookup of values, cached into the
-// loaded structs of the objects. This is for a 1-M or N-M relationship.
-func (userAccountL) LoadUserInfos(ctx context.Context, e boil.ContextExecutor, singular bool, maybeUserAccount interface{}, mods queries.Applicator) error {
+// UserRecoveryCodes retrieves all the user_recovery_code's UserRecoveryCodes with an executor.
+func (o *UserAccount) UserRecoveryCodes(mods ...qm.QueryMod) userRecoveryCodeQuery {
+	var queryMods []qm.QueryMod
+	if len(mods) != 0 {
+		queryMods = append(queryMods, mods...)
+	}
+
+	queryMods = append(queryMods,
+		qm.Where("\"user_recovery_code\".\"user_account_id\"=?", o.ID),
+	)
+
+	return UserRecoveryCodes(queryMods...)
+}
+
+// UserRoles retrieves all the user_role's UserRoles with an executor.
+func (o *UserAccount) UserRoles(mods ...qm.QueryMod) userRoleQuery {
+	var queryMods []qm.QueryMod
+	if len(mods) != 0 {
+		queryMods = append(queryMods, mods...)
+	}
+
+	queryMods = append(queryMods,
+		qm.Where("\"user_role\".\"user_account_id\"=?", o.ID),
+	)
+
+	return UserRoles(queryMods...)
+}
+
+// UserSessions retrieves all the user_session's UserSessions with an executor.
+func (o *UserAccount) UserSessions(mods ...qm.QueryMod) userSessionQuery {
+	var queryMods []qm.QueryMod
+	if len(mods) != 0 {
+		queryMods = append(queryMods, mods...)
+	}
+
+	queryMods = append(queryMods,
+		qm.Where("\"user_session\".\"user_account_id\"=?", o.ID),
+	)
+
+	return UserSessions(queryMods...)
+}
+
+// LoadUserMfa allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for a 1-1 relationship.
+func (userAccountL) LoadUserMfa(ctx context.Context, e boil.ContextExecutor, singular bool, maybeUserAccount interface{}, mods queries.Applicator) error {
 	var slice []*UserAccount
 	var object *UserAccount
 
@@ -596,6 +856,7 @@ func (userAccountL) LoadUserInfos(ctx context.Context, e boil.ContextExecutor, s
 			if obj.R == nil {
 				obj.R = &userAccountR{}
 			}
+
 			args[obj.ID] = struct{}{}
 		}
 	}
@@ -612,8 +873,8 @@ func (userAccountL) LoadUserInfos(ctx context.Context, e boil.ContextExecutor, s
 	}
 
 	query := NewQuery(
-		qm.From(`user_info`),
-		qm.WhereIn(`user_info.user_account_id in ?`, argsSlice...),
+		qm.From(`user_mfa`),
+		qm.WhereIn(`user_mfa.user_account_id in ?`, argsSlice...),
 	)
 	if mods != nil {
 		mods.Apply(query)
@@ -621,45 +882,161 @@ func (userAccountL) LoadUserInfos(ctx context.Context, e boil.ContextExecutor, s
 
 	results, err := query.QueryContext(ctx, e)
 	if err != nil {
-		return errors.Wrap(err, "failed to eager load user_info")
+		return errors.Wrap(err, "failed to eager load UserMfa")
 	}
 
-	var resultSlice []*UserInfo
+	var resultSlice []*UserMfa
 	if err = queries.Bind(results, &resultSlice); err != nil {
-		return errors.Wrap(err, "failed to bind eager loaded slice user_info")
+		return errors.Wrap(err, "failed to bind eager loaded slice UserMfa")
 	}
 
 	if err = results.Close(); err != nil {
-		return errors.Wrap(err, "failed to close results in eager load on user_info")
+		return errors.Wrap(err, "failed to close results of eager load for user_mfa")
 	}
 	if err = results.Err(); err != nil {
-		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for user_info")
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for user_mfa")
 	}
 
-	if len(userInfoAfterSelectHooks) != 0 {
+	if len(userMfaAfterSelectHooks) != 0 {
 		for _, obj := range resultSlice {
 			if err := obj.doAfterSelectHooks(ctx, e); err != nil {
 				return err
 			}
 		}
 	}
+
+	if len(resultSlice) == 0 {
+		return nil
+	}
+
 	if singular {
-		object.R.UserInfos = resultSlice
-		for _, foreign := range resultSlice {
-			if foreign.R == nil {
-				foreign.R = &userInfoR{}
-			}
-			foreign.R.UserAccount = object
+		foreign := resultSlice[0]
+		object.R.UserMfa = foreign
+		if foreign.R == nil {
+			foreign.R = &userMfaR{}
 		}
-		return nil
+		foreign.R.UserAccount = object
+	}
+
+	for _, local := range slice {
+		for _, foreign := range resultSlice {
+			if local.ID == foreign.UserAccountID {
+				local.R.UserMfa = foreign
+				if foreign.R == nil {
+					foreign.R = &userMfaR{}
+				}
+				foreign.R.UserAccount = local
+				break
+			}
+		}
+	}
+
+	return nil
+}
+
+// LoadAPIKeys allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for a 1-M or N-M relationship.
+func (userAccountL) LoadAPIKeys(ctx context.Context, e boil.ContextExecutor, singular bool, maybeUserAccount interface{}, mods queries.Applicator) error {
+	var slice []*UserAccount
+	var object *UserAccount
+
+	if singular {
+		var ok bool
+		object, ok = maybeUserAccount.(*UserAccount)
+		if !ok {
+			object = new(UserAccount)
+			ok = queries.SetFromEmbeddedStruct(&object, &maybeUserAccount)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", object, maybeUserAccount))
+			}
+		}
+	} else {
+		s, ok := maybeUserAccount.(*[]*UserAccount)
+		if ok {
+			slice = *s
+		} else {
+			ok = queries.SetFromEmbeddedStruct(&slice, maybeUserAccount)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", slice, maybeUserAccount))
+			}
+		}
+	}
+
+	args := make(map[interface{}]struct{})
+	if singular {
+		if object.R == nil {
+			object.R = &userAccountR{}
+		}
+		args[object.ID] = struct{}{}
+	} else {
+		for _, obj := range slice {
+			if obj.R == nil {
+				obj.R = &userAccountR{}
+			}
+			args[obj.ID] = struct{}{}
+		}
+	}
+
+	if len(args) == 0 {
+		return nil
+	}
+
+	argsSlice := make([]interface{}, len(args))
+	i := 0
+	for arg := range args {
+		argsSlice[i] = arg
+		i++
+	}
+
+	query := NewQuery(
+		qm.From(`api_key`),
+		qm.WhereIn(`api_key.user_account_id in ?`, argsSlice...),
+	)
+	if mods != nil {
+		mods.Apply(query)
+	}
+
+	results, err := query.QueryContext(ctx, e)
+	if err != nil {
+		return errors.Wrap(err, "failed to eager load api_key")
+	}
+
+	var resultSlice []*APIKey
+	if err = queries.Bind(results, &resultSlice); err != nil {
+		return errors.Wrap(err, "failed to bind eager loaded slice api_key")
+	}
+
+	if err = results.Close(); err != nil {
+		return errors.Wrap(err, "failed to close results in eager load on api_key")
+	}
+	if err = results.Err(); err != nil {
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for api_key")
+	}
+
+	if len(apiKeyAfterSelectHooks) != 0 {
+		for _, obj := range resultSlice {
+			if err := obj.doAfterSelectHooks(ctx, e); err != nil {
+				return err
+			}
+		}
+	}
+	if singular {
+		object.R.APIKeys = resultSlice
+		for _, foreign := range resultSlice {
+			if foreign.R == nil {
+				foreign.R = &apiKeyR{}
+			}
+			foreign.R.UserAccount = object
+		}
+		return nil
 	}
 
 	for _, foreign := range resultSlice {
 		for _, local := range slice {
 			if local.ID == foreign.UserAccountID {
-				local.R.UserInfos = append(local.R.UserInfos, foreign)
+				local.R.APIKeys = append(local.R.APIKeys, foreign)
 				if foreign.R == nil {
-					foreign.R = &userInfoR{}
+					foreign.R = &apiKeyR{}
 				}
 				foreign.R.UserAccount = local
 				break
@@ -670,6 +1047,2295 @@ func (userAccountL) LoadUserInfos(ctx context.Context, e boil.ContextExecutor, s
 	return nil
 }
 
+// LoadActorUserAccountImpersonationAudits allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for a 1-M or N-M relationship.
+func (userAccountL) LoadActorUserAccountImpersonationAudits(ctx context.Context, e boil.ContextExecutor, singular bool, maybeUserAccount interface{}, mods queries.Applicator) error {
+	var slice []*UserAccount
+	var object *UserAccount
+
+	if singular {
+		var ok bool
+		object, ok = maybeUserAccount.(*UserAccount)
+		if !ok {
+			object = new(UserAccount)
+			ok = queries.SetFromEmbeddedStruct(&object, &maybeUserAccount)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", object, maybeUserAccount))
+			}
+		}
+	} else {
+		s, ok := maybeUserAccount.(*[]*UserAccount)
+		if ok {
+			slice = *s
+		} else {
+			ok = queries.SetFromEmbeddedStruct(&slice, maybeUserAccount)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", slice, maybeUserAccount))
+			}
+		}
+	}
+
+	args := make(map[interface{}]struct{})
+	if singular {
+		if object.R == nil {
+			object.R = &userAccountR{}
+		}
+		args[object.ID] = struct{}{}
+	} else {
+		for _, obj := range slice {
+			if obj.R == nil {
+				obj.R = &userAccountR{}
+			}
+			args[obj.ID] = struct{}{}
+		}
+	}
+
+	if len(args) == 0 {
+		return nil
+	}
+
+	argsSlice := make([]interface{}, len(args))
+	i := 0
+	for arg := range args {
+		argsSlice[i] = arg
+		i++
+	}
+
+	query := NewQuery(
+		qm.From(`impersonation_audit`),
+		qm.WhereIn(`impersonation_audit.actor_user_account_id in ?`, argsSlice...),
+	)
+	if mods != nil {
+		mods.Apply(query)
+	}
+
+	results, err := query.QueryContext(ctx, e)
+	if err != nil {
+		return errors.Wrap(err, "failed to eager load impersonation_audit")
+	}
+
+	var resultSlice []*ImpersonationAudit
+	if err = queries.Bind(results, &resultSlice); err != nil {
+		return errors.Wrap(err, "failed to bind eager loaded slice impersonation_audit")
+	}
+
+	if err = results.Close(); err != nil {
+		return errors.Wrap(err, "failed to close results in eager load on impersonation_audit")
+	}
+	if err = results.Err(); err != nil {
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for impersonation_audit")
+	}
+
+	if len(impersonationAuditAfterSelectHooks) != 0 {
+		for _, obj := range resultSlice {
+			if err := obj.doAfterSelectHooks(ctx, e); err != nil {
+				return err
+			}
+		}
+	}
+	if singular {
+		object.R.ActorUserAccountImpersonationAudits = resultSlice
+		for _, foreign := range resultSlice {
+			if foreign.R == nil {
+				foreign.R = &impersonationAuditR{}
+			}
+			foreign.R.ActorUserAccount = object
+		}
+		return nil
+	}
+
+	for _, foreign := range resultSlice {
+		for _, local := range slice {
+			if local.ID == foreign.ActorUserAccountID {
+				local.R.ActorUserAccountImpersonationAudits = append(local.R.ActorUserAccountImpersonationAudits, foreign)
+				if foreign.R == nil {
+					foreign.R = &impersonationAuditR{}
+				}
+				foreign.R.ActorUserAccount = local
+				break
+			}
+		}
+	}
+
+	return nil
+}
+
+// LoadImpersonationAudits allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for a 1-M or N-M relationship.
+func (userAccountL) LoadImpersonationAudits(ctx context.Context, e boil.ContextExecutor, singular bool, maybeUserAccount interface{}, mods queries.Applicator) error {
+	var slice []*UserAccount
+	var object *UserAccount
+
+	if singular {
+		var ok bool
+		object, ok = maybeUserAccount.(*UserAccount)
+		if !ok {
+			object = new(UserAccount)
+			ok = queries.SetFromEmbeddedStruct(&object, &maybeUserAccount)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", object, maybeUserAccount))
+			}
+		}
+	} else {
+		s, ok := maybeUserAccount.(*[]*UserAccount)
+		if ok {
+			slice = *s
+		} else {
+			ok = queries.SetFromEmbeddedStruct(&slice, maybeUserAccount)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", slice, maybeUserAccount))
+			}
+		}
+	}
+
+	args := make(map[interface{}]struct{})
+	if singular {
+		if object.R == nil {
+			object.R = &userAccountR{}
+		}
+		args[object.ID] = struct{}{}
+	} else {
+		for _, obj := range slice {
+			if obj.R == nil {
+				obj.R = &userAccountR{}
+			}
+			args[obj.ID] = struct{}{}
+		}
+	}
+
+	if len(args) == 0 {
+		return nil
+	}
+
+	argsSlice := make([]interface{}, len(args))
+	i := 0
+	for arg := range args {
+		argsSlice[i] = arg
+		i++
+	}
+
+	query := NewQuery(
+		qm.From(`impersonation_audit`),
+		qm.WhereIn(`impersonation_audit.user_account_id in ?`, argsSlice...),
+	)
+	if mods != nil {
+		mods.Apply(query)
+	}
+
+	results, err := query.QueryContext(ctx, e)
+	if err != nil {
+		return errors.Wrap(err, "failed to eager load impersonation_audit")
+	}
+
+	var resultSlice []*ImpersonationAudit
+	if err = queries.Bind(results, &resultSlice); err != nil {
+		return errors.Wrap(err, "failed to bind eager loaded slice impersonation_audit")
+	}
+
+	if err = results.Close(); err != nil {
+		return errors.Wrap(err, "failed to close results in eager load on impersonation_audit")
+	}
+	if err = results.Err(); err != nil {
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for impersonation_audit")
+	}
+
+	if len(impersonationAuditAfterSelectHooks) != 0 {
+		for _, obj := range resultSlice {
+			if err := obj.doAfterSelectHooks(ctx, e); err != nil {
+				return err
+			}
+		}
+	}
+	if singular {
+		object.R.ImpersonationAudits = resultSlice
+		for _, foreign := range resultSlice {
+			if foreign.R == nil {
+				foreign.R = &impersonationAuditR{}
+			}
+			foreign.R.UserAccount = object
+		}
+		return nil
+	}
+
+	for _, foreign := range resultSlice {
+		for _, local := range slice {
+			if local.ID == foreign.UserAccountID {
+				local.R.ImpersonationAudits = append(local.R.ImpersonationAudits, foreign)
+				if foreign.R == nil {
+					foreign.R = &impersonationAuditR{}
+				}
+				foreign.R.UserAccount = local
+				break
+			}
+		}
+	}
+
+	return nil
+}
+
+// LoadLoyaltyPoints allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for a 1-M or N-M relationship.
+func (userAccountL) LoadLoyaltyPoints(ctx context.Context, e boil.ContextExecutor, singular bool, maybeUserAccount interface{}, mods queries.Applicator) error {
+	var slice []*UserAccount
+	var object *UserAccount
+
+	if singular {
+		var ok bool
+		object, ok = maybeUserAccount.(*UserAccount)
+		if !ok {
+			object = new(UserAccount)
+			ok = queries.SetFromEmbeddedStruct(&object, &maybeUserAccount)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", object, maybeUserAccount))
+			}
+		}
+	} else {
+		s, ok := maybeUserAccount.(*[]*UserAccount)
+		if ok {
+			slice = *s
+		} else {
+			ok = queries.SetFromEmbeddedStruct(&slice, maybeUserAccount)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", slice, maybeUserAccount))
+			}
+		}
+	}
+
+	args := make(map[interface{}]struct{})
+	if singular {
+		if object.R == nil {
+			object.R = &userAccountR{}
+		}
+		args[object.ID] = struct{}{}
+	} else {
+		for _, obj := range slice {
+			if obj.R == nil {
+				obj.R = &userAccountR{}
+			}
+			args[obj.ID] = struct{}{}
+		}
+	}
+
+	if len(args) == 0 {
+		return nil
+	}
+
+	argsSlice := make([]interface{}, len(args))
+	i := 0
+	for arg := range args {
+		argsSlice[i] = arg
+		i++
+	}
+
+	query := NewQuery(
+		qm.From(`loyalty_point`),
+		qm.WhereIn(`loyalty_point.user_account_id in ?`, argsSlice...),
+	)
+	if mods != nil {
+		mods.Apply(query)
+	}
+
+	results, err := query.QueryContext(ctx, e)
+	if err != nil {
+		return errors.Wrap(err, "failed to eager load loyalty_point")
+	}
+
+	var resultSlice []*LoyaltyPoint
+	if err = queries.Bind(results, &resultSlice); err != nil {
+		return errors.Wrap(err, "failed to bind eager loaded slice loyalty_point")
+	}
+
+	if err = results.Close(); err != nil {
+		return errors.Wrap(err, "failed to close results in eager load on loyalty_point")
+	}
+	if err = results.Err(); err != nil {
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for loyalty_point")
+	}
+
+	if len(loyaltyPointAfterSelectHooks) != 0 {
+		for _, obj := range resultSlice {
+			if err := obj.doAfterSelectHooks(ctx, e); err != nil {
+				return err
+			}
+		}
+	}
+	if singular {
+		object.R.LoyaltyPoints = resultSlice
+		for _, foreign := range resultSlice {
+			if foreign.R == nil {
+				foreign.R = &loyaltyPointR{}
+			}
+			foreign.R.UserAccount = object
+		}
+		return nil
+	}
+
+	for _, foreign := range resultSlice {
+		for _, local := range slice {
+			if local.ID == foreign.UserAccountID {
+				local.R.LoyaltyPoints = append(local.R.LoyaltyPoints, foreign)
+				if foreign.R == nil {
+					foreign.R = &loyaltyPointR{}
+				}
+				foreign.R.UserAccount = local
+				break
+			}
+		}
+	}
+
+	return nil
+}
+
+// LoadMagicLinkTokens allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for a 1-M or N-M relationship.
+func (userAccountL) LoadMagicLinkTokens(ctx context.Context, e boil.ContextExecutor, singular bool, maybeUserAccount interface{}, mods queries.Applicator) error {
+	var slice []*UserAccount
+	var object *UserAccount
+
+	if singular {
+		var ok bool
+		object, ok = maybeUserAccount.(*UserAccount)
+		if !ok {
+			object = new(UserAccount)
+			ok = queries.SetFromEmbeddedStruct(&object, &maybeUserAccount)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", object, maybeUserAccount))
+			}
+		}
+	} else {
+		s, ok := maybeUserAccount.(*[]*UserAccount)
+		if ok {
+			slice = *s
+		} else {
+			ok = queries.SetFromEmbeddedStruct(&slice, maybeUserAccount)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", slice, maybeUserAccount))
+			}
+		}
+	}
+
+	args := make(map[interface{}]struct{})
+	if singular {
+		if object.R == nil {
+			object.R = &userAccountR{}
+		}
+		args[object.ID] = struct{}{}
+	} else {
+		for _, obj := range slice {
+			if obj.R == nil {
+				obj.R = &userAccountR{}
+			}
+			args[obj.ID] = struct{}{}
+		}
+	}
+
+	if len(args) == 0 {
+		return nil
+	}
+
+	argsSlice := make([]interface{}, len(args))
+	i := 0
+	for arg := range args {
+		argsSlice[i] = arg
+		i++
+	}
+
+	query := NewQuery(
+		qm.From(`magic_link_token`),
+		qm.WhereIn(`magic_link_token.user_account_id in ?`, argsSlice...),
+	)
+	if mods != nil {
+		mods.Apply(query)
+	}
+
+	results, err := query.QueryContext(ctx, e)
+	if err != nil {
+		return errors.Wrap(err, "failed to eager load magic_link_token")
+	}
+
+	var resultSlice []*MagicLinkToken
+	if err = queries.Bind(results, &resultSlice); err != nil {
+		return errors.Wrap(err, "failed to bind eager loaded slice magic_link_token")
+	}
+
+	if err = results.Close(); err != nil {
+		return errors.Wrap(err, "failed to close results in eager load on magic_link_token")
+	}
+	if err = results.Err(); err != nil {
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for magic_link_token")
+	}
+
+	if len(magicLinkTokenAfterSelectHooks) != 0 {
+		for _, obj := range resultSlice {
+			if err := obj.doAfterSelectHooks(ctx, e); err != nil {
+				return err
+			}
+		}
+	}
+	if singular {
+		object.R.MagicLinkTokens = resultSlice
+		for _, foreign := range resultSlice {
+			if foreign.R == nil {
+				foreign.R = &magicLinkTokenR{}
+			}
+			foreign.R.UserAccount = object
+		}
+		return nil
+	}
+
+	for _, foreign := range resultSlice {
+		for _, local := range slice {
+			if local.ID == foreign.UserAccountID {
+				local.R.MagicLinkTokens = append(local.R.MagicLinkTokens, foreign)
+				if foreign.R == nil {
+					foreign.R = &magicLinkTokenR{}
+				}
+				foreign.R.UserAccount = local
+				break
+			}
+		}
+	}
+
+	return nil
+}
+
+// LoadChangedByMembershipHistories allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for a 1-M or N-M relationship.
+func (userAccountL) LoadChangedByMembershipHistories(ctx context.Context, e boil.ContextExecutor, singular bool, maybeUserAccount interface{}, mods queries.Applicator) error {
+	var slice []*UserAccount
+	var object *UserAccount
+
+	if singular {
+		var ok bool
+		object, ok = maybeUserAccount.(*UserAccount)
+		if !ok {
+			object = new(UserAccount)
+			ok = queries.SetFromEmbeddedStruct(&object, &maybeUserAccount)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", object, maybeUserAccount))
+			}
+		}
+	} else {
+		s, ok := maybeUserAccount.(*[]*UserAccount)
+		if ok {
+			slice = *s
+		} else {
+			ok = queries.SetFromEmbeddedStruct(&slice, maybeUserAccount)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", slice, maybeUserAccount))
+			}
+		}
+	}
+
+	args := make(map[interface{}]struct{})
+	if singular {
+		if object.R == nil {
+			object.R = &userAccountR{}
+		}
+		args[object.ID] = struct{}{}
+	} else {
+		for _, obj := range slice {
+			if obj.R == nil {
+				obj.R = &userAccountR{}
+			}
+			args[obj.ID] = struct{}{}
+		}
+	}
+
+	if len(args) == 0 {
+		return nil
+	}
+
+	argsSlice := make([]interface{}, len(args))
+	i := 0
+	for arg := range args {
+		argsSlice[i] = arg
+		i++
+	}
+
+	query := NewQuery(
+		qm.From(`membership_history`),
+		qm.WhereIn(`membership_history.changed_by in ?`, argsSlice...),
+	)
+	if mods != nil {
+		mods.Apply(query)
+	}
+
+	results, err := query.QueryContext(ctx, e)
+	if err != nil {
+		return errors.Wrap(err, "failed to eager load membership_history")
+	}
+
+	var resultSlice []*MembershipHistory
+	if err = queries.Bind(results, &resultSlice); err != nil {
+		return errors.Wrap(err, "failed to bind eager loaded slice membership_history")
+	}
+
+	if err = results.Close(); err != nil {
+		return errors.Wrap(err, "failed to close results in eager load on membership_history")
+	}
+	if err = results.Err(); err != nil {
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for membership_history")
+	}
+
+	if len(membershipHistoryAfterSelectHooks) != 0 {
+		for _, obj := range resultSlice {
+			if err := obj.doAfterSelectHooks(ctx, e); err != nil {
+				return err
+			}
+		}
+	}
+	if singular {
+		object.R.ChangedByMembershipHistories = resultSlice
+		for _, foreign := range resultSlice {
+			if foreign.R == nil {
+				foreign.R = &membershipHistoryR{}
+			}
+			foreign.R.ChangedByUserAccount = object
+		}
+		return nil
+	}
+
+	for _, foreign := range resultSlice {
+		for _, local := range slice {
+			if queries.Equal(local.ID, foreign.ChangedBy) {
+				local.R.ChangedByMembershipHistories = append(local.R.ChangedByMembershipHistories, foreign)
+				if foreign.R == nil {
+					foreign.R = &membershipHistoryR{}
+				}
+				foreign.R.ChangedByUserAccount = local
+				break
+			}
+		}
+	}
+
+	return nil
+}
+
+// LoadMembershipHistories allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for a 1-M or N-M relationship.
+func (userAccountL) LoadMembershipHistories(ctx context.Context, e boil.ContextExecutor, singular bool, maybeUserAccount interface{}, mods queries.Applicator) error {
+	var slice []*UserAccount
+	var object *UserAccount
+
+	if singular {
+		var ok bool
+		object, ok = maybeUserAccount.(*UserAccount)
+		if !ok {
+			object = new(UserAccount)
+			ok = queries.SetFromEmbeddedStruct(&object, &maybeUserAccount)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", object, maybeUserAccount))
+			}
+		}
+	} else {
+		s, ok := maybeUserAccount.(*[]*UserAccount)
+		if ok {
+			slice = *s
+		} else {
+			ok = queries.SetFromEmbeddedStruct(&slice, maybeUserAccount)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", slice, maybeUserAccount))
+			}
+		}
+	}
+
+	args := make(map[interface{}]struct{})
+	if singular {
+		if object.R == nil {
+			object.R = &userAccountR{}
+		}
+		args[object.ID] = struct{}{}
+	} else {
+		for _, obj := range slice {
+			if obj.R == nil {
+				obj.R = &userAccountR{}
+			}
+			args[obj.ID] = struct{}{}
+		}
+	}
+
+	if len(args) == 0 {
+		return nil
+	}
+
+	argsSlice := make([]interface{}, len(args))
+	i := 0
+	for arg := range args {
+		argsSlice[i] = arg
+		i++
+	}
+
+	query := NewQuery(
+		qm.From(`membership_history`),
+		qm.WhereIn(`membership_history.user_account_id in ?`, argsSlice...),
+	)
+	if mods != nil {
+		mods.Apply(query)
+	}
+
+	results, err := query.QueryContext(ctx, e)
+	if err != nil {
+		return errors.Wrap(err, "failed to eager load membership_history")
+	}
+
+	var resultSlice []*MembershipHistory
+	if err = queries.Bind(results, &resultSlice); err != nil {
+		return errors.Wrap(err, "failed to bind eager loaded slice membership_history")
+	}
+
+	if err = results.Close(); err != nil {
+		return errors.Wrap(err, "failed to close results in eager load on membership_history")
+	}
+	if err = results.Err(); err != nil {
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for membership_history")
+	}
+
+	if len(membershipHistoryAfterSelectHooks) != 0 {
+		for _, obj := range resultSlice {
+			if err := obj.doAfterSelectHooks(ctx, e); err != nil {
+				return err
+			}
+		}
+	}
+	if singular {
+		object.R.MembershipHistories = resultSlice
+		for _, foreign := range resultSlice {
+			if foreign.R == nil {
+				foreign.R = &membershipHistoryR{}
+			}
+			foreign.R.UserAccount = object
+		}
+		return nil
+	}
+
+	for _, foreign := range resultSlice {
+		for _, local := range slice {
+			if local.ID == foreign.UserAccountID {
+				local.R.MembershipHistories = append(local.R.MembershipHistories, foreign)
+				if foreign.R == nil {
+					foreign.R = &membershipHistoryR{}
+				}
+				foreign.R.UserAccount = local
+				break
+			}
+		}
+	}
+
+	return nil
+}
+
+// LoadOauthAuthorizationCodes allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for a 1-M or N-M relationship.
+func (userAccountL) LoadOauthAuthorizationCodes(ctx context.Context, e boil.ContextExecutor, singular bool, maybeUserAccount interface{}, mods queries.Applicator) error {
+	var slice []*UserAccount
+	var object *UserAccount
+
+	if singular {
+		var ok bool
+		object, ok = maybeUserAccount.(*UserAccount)
+		if !ok {
+			object = new(UserAccount)
+			ok = queries.SetFromEmbeddedStruct(&object, &maybeUserAccount)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", object, maybeUserAccount))
+			}
+		}
+	} else {
+		s, ok := maybeUserAccount.(*[]*UserAccount)
+		if ok {
+			slice = *s
+		} else {
+			ok = queries.SetFromEmbeddedStruct(&slice, maybeUserAccount)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", slice, maybeUserAccount))
+			}
+		}
+	}
+
+	args := make(map[interface{}]struct{})
+	if singular {
+		if object.R == nil {
+			object.R = &userAccountR{}
+		}
+		args[object.ID] = struct{}{}
+	} else {
+		for _, obj := range slice {
+			if obj.R == nil {
+				obj.R = &userAccountR{}
+			}
+			args[obj.ID] = struct{}{}
+		}
+	}
+
+	if len(args) == 0 {
+		return nil
+	}
+
+	argsSlice := make([]interface{}, len(args))
+	i := 0
+	for arg := range args {
+		argsSlice[i] = arg
+		i++
+	}
+
+	query := NewQuery(
+		qm.From(`oauth_authorization_code`),
+		qm.WhereIn(`oauth_authorization_code.user_account_id in ?`, argsSlice...),
+	)
+	if mods != nil {
+		mods.Apply(query)
+	}
+
+	results, err := query.QueryContext(ctx, e)
+	if err != nil {
+		return errors.Wrap(err, "failed to eager load oauth_authorization_code")
+	}
+
+	var resultSlice []*OauthAuthorizationCode
+	if err = queries.Bind(results, &resultSlice); err != nil {
+		return errors.Wrap(err, "failed to bind eager loaded slice oauth_authorization_code")
+	}
+
+	if err = results.Close(); err != nil {
+		return errors.Wrap(err, "failed to close results in eager load on oauth_authorization_code")
+	}
+	if err = results.Err(); err != nil {
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for oauth_authorization_code")
+	}
+
+	if len(oauthAuthorizationCodeAfterSelectHooks) != 0 {
+		for _, obj := range resultSlice {
+			if err := obj.doAfterSelectHooks(ctx, e); err != nil {
+				return err
+			}
+		}
+	}
+	if singular {
+		object.R.OauthAuthorizationCodes = resultSlice
+		for _, foreign := range resultSlice {
+			if foreign.R == nil {
+				foreign.R = &oauthAuthorizationCodeR{}
+			}
+			foreign.R.UserAccount = object
+		}
+		return nil
+	}
+
+	for _, foreign := range resultSlice {
+		for _, local := range slice {
+			if local.ID == foreign.UserAccountID {
+				local.R.OauthAuthorizationCodes = append(local.R.OauthAuthorizationCodes, foreign)
+				if foreign.R == nil {
+					foreign.R = &oauthAuthorizationCodeR{}
+				}
+				foreign.R.UserAccount = local
+				break
+			}
+		}
+	}
+
+	return nil
+}
+
+// LoadPasswordResetTokens allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for a 1-M or N-M relationship.
+func (userAccountL) LoadPasswordResetTokens(ctx context.Context, e boil.ContextExecutor, singular bool, maybeUserAccount interface{}, mods queries.Applicator) error {
+	var slice []*UserAccount
+	var object *UserAccount
+
+	if singular {
+		var ok bool
+		object, ok = maybeUserAccount.(*UserAccount)
+		if !ok {
+			object = new(UserAccount)
+			ok = queries.SetFromEmbeddedStruct(&object, &maybeUserAccount)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", object, maybeUserAccount))
+			}
+		}
+	} else {
+		s, ok := maybeUserAccount.(*[]*UserAccount)
+		if ok {
+			slice = *s
+		} else {
+			ok = queries.SetFromEmbeddedStruct(&slice, maybeUserAccount)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", slice, maybeUserAccount))
+			}
+		}
+	}
+
+	args := make(map[interface{}]struct{})
+	if singular {
+		if object.R == nil {
+			object.R = &userAccountR{}
+		}
+		args[object.ID] = struct{}{}
+	} else {
+		for _, obj := range slice {
+			if obj.R == nil {
+				obj.R = &userAccountR{}
+			}
+			args[obj.ID] = struct{}{}
+		}
+	}
+
+	if len(args) == 0 {
+		return nil
+	}
+
+	argsSlice := make([]interface{}, len(args))
+	i := 0
+	for arg := range args {
+		argsSlice[i] = arg
+		i++
+	}
+
+	query := NewQuery(
+		qm.From(`password_reset_token`),
+		qm.WhereIn(`password_reset_token.user_account_id in ?`, argsSlice...),
+	)
+	if mods != nil {
+		mods.Apply(query)
+	}
+
+	results, err := query.QueryContext(ctx, e)
+	if err != nil {
+		return errors.Wrap(err, "failed to eager load password_reset_token")
+	}
+
+	var resultSlice []*PasswordResetToken
+	if err = queries.Bind(results, &resultSlice); err != nil {
+		return errors.Wrap(err, "failed to bind eager loaded slice password_reset_token")
+	}
+
+	if err = results.Close(); err != nil {
+		return errors.Wrap(err, "failed to close results in eager load on password_reset_token")
+	}
+	if err = results.Err(); err != nil {
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for password_reset_token")
+	}
+
+	if len(passwordResetTokenAfterSelectHooks) != 0 {
+		for _, obj := range resultSlice {
+			if err := obj.doAfterSelectHooks(ctx, e); err != nil {
+				return err
+			}
+		}
+	}
+	if singular {
+		object.R.PasswordResetTokens = resultSlice
+		for _, foreign := range resultSlice {
+			if foreign.R == nil {
+				foreign.R = &passwordResetTokenR{}
+			}
+			foreign.R.UserAccount = object
+		}
+		return nil
+	}
+
+	for _, foreign := range resultSlice {
+		for _, local := range slice {
+			if local.ID == foreign.UserAccountID {
+				local.R.PasswordResetTokens = append(local.R.PasswordResetTokens, foreign)
+				if foreign.R == nil {
+					foreign.R = &passwordResetTokenR{}
+				}
+				foreign.R.UserAccount = local
+				break
+			}
+		}
+	}
+
+	return nil
+}
+
+// LoadUsageCounters allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for a 1-M or N-M relationship.
+func (userAccountL) LoadUsageCounters(ctx context.Context, e boil.ContextExecutor, singular bool, maybeUserAccount interface{}, mods queries.Applicator) error {
+	var slice []*UserAccount
+	var object *UserAccount
+
+	if singular {
+		var ok bool
+		object, ok = maybeUserAccount.(*UserAccount)
+		if !ok {
+			object = new(UserAccount)
+			ok = queries.SetFromEmbeddedStruct(&object, &maybeUserAccount)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", object, maybeUserAccount))
+			}
+		}
+	} else {
+		s, ok := maybeUserAccount.(*[]*UserAccount)
+		if ok {
+			slice = *s
+		} else {
+			ok = queries.SetFromEmbeddedStruct(&slice, maybeUserAccount)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", slice, maybeUserAccount))
+			}
+		}
+	}
+
+	args := make(map[interface{}]struct{})
+	if singular {
+		if object.R == nil {
+			object.R = &userAccountR{}
+		}
+		args[object.ID] = struct{}{}
+	} else {
+		for _, obj := range slice {
+			if obj.R == nil {
+				obj.R = &userAccountR{}
+			}
+			args[obj.ID] = struct{}{}
+		}
+	}
+
+	if len(args) == 0 {
+		return nil
+	}
+
+	argsSlice := make([]interface{}, len(args))
+	i := 0
+	for arg := range args {
+		argsSlice[i] = arg
+		i++
+	}
+
+	query := NewQuery(
+		qm.From(`usage_counter`),
+		qm.WhereIn(`usage_counter.user_account_id in ?`, argsSlice...),
+	)
+	if mods != nil {
+		mods.Apply(query)
+	}
+
+	results, err := query.QueryContext(ctx, e)
+	if err != nil {
+		return errors.Wrap(err, "failed to eager load usage_counter")
+	}
+
+	var resultSlice []*UsageCounter
+	if err = queries.Bind(results, &resultSlice); err != nil {
+		return errors.Wrap(err, "failed to bind eager loaded slice usage_counter")
+	}
+
+	if err = results.Close(); err != nil {
+		return errors.Wrap(err, "failed to close results in eager load on usage_counter")
+	}
+	if err = results.Err(); err != nil {
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for usage_counter")
+	}
+
+	if len(usageCounterAfterSelectHooks) != 0 {
+		for _, obj := range resultSlice {
+			if err := obj.doAfterSelectHooks(ctx, e); err != nil {
+				return err
+			}
+		}
+	}
+	if singular {
+		object.R.UsageCounters = resultSlice
+		for _, foreign := range resultSlice {
+			if foreign.R == nil {
+				foreign.R = &usageCounterR{}
+			}
+			foreign.R.UserAccount = object
+		}
+		return nil
+	}
+
+	for _, foreign := range resultSlice {
+		for _, local := range slice {
+			if local.ID == foreign.UserAccountID {
+				local.R.UsageCounters = append(local.R.UsageCounters, foreign)
+				if foreign.R == nil {
+					foreign.R = &usageCounterR{}
+				}
+				foreign.R.UserAccount = local
+				break
+			}
+		}
+	}
+
+	return nil
+}
+
+// LoadUserIdentities allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for a 1-M or N-M relationship.
+func (userAccountL) LoadUserIdentities(ctx context.Context, e boil.ContextExecutor, singular bool, maybeUserAccount interface{}, mods queries.Applicator) error {
+	var slice []*UserAccount
+	var object *UserAccount
+
+	if singular {
+		var ok bool
+		object, ok = maybeUserAccount.(*UserAccount)
+		if !ok {
+			object = new(UserAccount)
+			ok = queries.SetFromEmbeddedStruct(&object, &maybeUserAccount)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", object, maybeUserAccount))
+			}
+		}
+	} else {
+		s, ok := maybeUserAccount.(*[]*UserAccount)
+		if ok {
+			slice = *s
+		} else {
+			ok = queries.SetFromEmbeddedStruct(&slice, maybeUserAccount)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", slice, maybeUserAccount))
+			}
+		}
+	}
+
+	args := make(map[interface{}]struct{})
+	if singular {
+		if object.R == nil {
+			object.R = &userAccountR{}
+		}
+		args[object.ID] = struct{}{}
+	} else {
+		for _, obj := range slice {
+			if obj.R == nil {
+				obj.R = &userAccountR{}
+			}
+			args[obj.ID] = struct{}{}
+		}
+	}
+
+	if len(args) == 0 {
+		return nil
+	}
+
+	argsSlice := make([]interface{}, len(args))
+	i := 0
+	for arg := range args {
+		argsSlice[i] = arg
+		i++
+	}
+
+	query := NewQuery(
+		qm.From(`user_identity`),
+		qm.WhereIn(`user_identity.user_account_id in ?`, argsSlice...),
+	)
+	if mods != nil {
+		mods.Apply(query)
+	}
+
+	results, err := query.QueryContext(ctx, e)
+	if err != nil {
+		return errors.Wrap(err, "failed to eager load user_identity")
+	}
+
+	var resultSlice []*UserIdentity
+	if err = queries.Bind(results, &resultSlice); err != nil {
+		return errors.Wrap(err, "failed to bind eager loaded slice user_identity")
+	}
+
+	if err = results.Close(); err != nil {
+		return errors.Wrap(err, "failed to close results in eager load on user_identity")
+	}
+	if err = results.Err(); err != nil {
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for user_identity")
+	}
+
+	if len(userIdentityAfterSelectHooks) != 0 {
+		for _, obj := range resultSlice {
+			if err := obj.doAfterSelectHooks(ctx, e); err != nil {
+				return err
+			}
+		}
+	}
+	if singular {
+		object.R.UserIdentities = resultSlice
+		for _, foreign := range resultSlice {
+			if foreign.R == nil {
+				foreign.R = &userIdentityR{}
+			}
+			foreign.R.UserAccount = object
+		}
+		return nil
+	}
+
+	for _, foreign := range resultSlice {
+		for _, local := range slice {
+			if local.ID == foreign.UserAccountID {
+				local.R.UserIdentities = append(local.R.UserIdentities, foreign)
+				if foreign.R == nil {
+					foreign.R = &userIdentityR{}
+				}
+				foreign.R.UserAccount = local
+				break
+			}
+		}
+	}
+
+	return nil
+}
+
+// LoadUserInfos allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for a 1-M or N-M relationship.
+func (userAccountL) LoadUserInfos(ctx context.Context, e boil.ContextExecutor, singular bool, maybeUserAccount interface{}, mods queries.Applicator) error {
+	var slice []*UserAccount
+	var object *UserAccount
+
+	if singular {
+		var ok bool
+		object, ok = maybeUserAccount.(*UserAccount)
+		if !ok {
+			object = new(UserAccount)
+			ok = queries.SetFromEmbeddedStruct(&object, &maybeUserAccount)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", object, maybeUserAccount))
+			}
+		}
+	} else {
+		s, ok := maybeUserAccount.(*[]*UserAccount)
+		if ok {
+			slice = *s
+		} else {
+			ok = queries.SetFromEmbeddedStruct(&slice, maybeUserAccount)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", slice, maybeUserAccount))
+			}
+		}
+	}
+
+	args := make(map[interface{}]struct{})
+	if singular {
+		if object.R == nil {
+			object.R = &userAccountR{}
+		}
+		args[object.ID] = struct{}{}
+	} else {
+		for _, obj := range slice {
+			if obj.R == nil {
+				obj.R = &userAccountR{}
+			}
+			args[obj.ID] = struct{}{}
+		}
+	}
+
+	if len(args) == 0 {
+		return nil
+	}
+
+	argsSlice := make([]interface{}, len(args))
+	i := 0
+	for arg := range args {
+		argsSlice[i] = arg
+		i++
+	}
+
+	query := NewQuery(
+		qm.From(`user_info`),
+		qm.WhereIn(`user_info.user_account_id in ?`, argsSlice...),
+	)
+	if mods != nil {
+		mods.Apply(query)
+	}
+
+	results, err := query.QueryContext(ctx, e)
+	if err != nil {
+		return errors.Wrap(err, "failed to eager load user_info")
+	}
+
+	var resultSlice []*UserInfo
+	if err = queries.Bind(results, &resultSlice); err != nil {
+		return errors.Wrap(err, "failed to bind eager loaded slice user_info")
+	}
+
+	if err = results.Close(); err != nil {
+		return errors.Wrap(err, "failed to close results in eager load on user_info")
+	}
+	if err = results.Err(); err != nil {
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for user_info")
+	}
+
+	if len(userInfoAfterSelectHooks) != 0 {
+		for _, obj := range resultSlice {
+			if err := obj.doAfterSelectHooks(ctx, e); err != nil {
+				return err
+			}
+		}
+	}
+	if singular {
+		object.R.UserInfos = resultSlice
+		for _, foreign := range resultSlice {
+			if foreign.R == nil {
+				foreign.R = &userInfoR{}
+			}
+			foreign.R.UserAccount = object
+		}
+		return nil
+	}
+
+	for _, foreign := range resultSlice {
+		for _, local := range slice {
+			if local.ID == foreign.UserAccountID {
+				local.R.UserInfos = append(local.R.UserInfos, foreign)
+				if foreign.R == nil {
+					foreign.R = &userInfoR{}
+				}
+				foreign.R.UserAccount = local
+				break
+			}
+		}
+	}
+
+	return nil
+}
+
+// LoadUserRecoveryCodes allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for a 1-M or N-M relationship.
+func (userAccountL) LoadUserRecoveryCodes(ctx context.Context, e boil.ContextExecutor, singular bool, maybeUserAccount interface{}, mods queries.Applicator) error {
+	var slice []*UserAccount
+	var object *UserAccount
+
+	if singular {
+		var ok bool
+		object, ok = maybeUserAccount.(*UserAccount)
+		if !ok {
+			object = new(UserAccount)
+			ok = queries.SetFromEmbeddedStruct(&object, &maybeUserAccount)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", object, maybeUserAccount))
+			}
+		}
+	} else {
+		s, ok := maybeUserAccount.(*[]*UserAccount)
+		if ok {
+			slice = *s
+		} else {
+			ok = queries.SetFromEmbeddedStruct(&slice, maybeUserAccount)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", slice, maybeUserAccount))
+			}
+		}
+	}
+
+	args := make(map[interface{}]struct{})
+	if singular {
+		if object.R == nil {
+			object.R = &userAccountR{}
+		}
+		args[object.ID] = struct{}{}
+	} else {
+		for _, obj := range slice {
+			if obj.R == nil {
+				obj.R = &userAccountR{}
+			}
+			args[obj.ID] = struct{}{}
+		}
+	}
+
+	if len(args) == 0 {
+		return nil
+	}
+
+	argsSlice := make([]interface{}, len(args))
+	i := 0
+	for arg := range args {
+		argsSlice[i] = arg
+		i++
+	}
+
+	query := NewQuery(
+		qm.From(`user_recovery_code`),
+		qm.WhereIn(`user_recovery_code.user_account_id in ?`, argsSlice...),
+	)
+	if mods != nil {
+		mods.Apply(query)
+	}
+
+	results, err := query.QueryContext(ctx, e)
+	if err != nil {
+		return errors.Wrap(err, "failed to eager load user_recovery_code")
+	}
+
+	var resultSlice []*UserRecoveryCode
+	if err = queries.Bind(results, &resultSlice); err != nil {
+		return errors.Wrap(err, "failed to bind eager loaded slice user_recovery_code")
+	}
+
+	if err = results.Close(); err != nil {
+		return errors.Wrap(err, "failed to close results in eager load on user_recovery_code")
+	}
+	if err = results.Err(); err != nil {
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for user_recovery_code")
+	}
+
+	if len(userRecoveryCodeAfterSelectHooks) != 0 {
+		for _, obj := range resultSlice {
+			if err := obj.doAfterSelectHooks(ctx, e); err != nil {
+				return err
+			}
+		}
+	}
+	if singular {
+		object.R.UserRecoveryCodes = resultSlice
+		for _, foreign := range resultSlice {
+			if foreign.R == nil {
+				foreign.R = &userRecoveryCodeR{}
+			}
+			foreign.R.UserAccount = object
+		}
+		return nil
+	}
+
+	for _, foreign := range resultSlice {
+		for _, local := range slice {
+			if local.ID == foreign.UserAccountID {
+				local.R.UserRecoveryCodes = append(local.R.UserRecoveryCodes, foreign)
+				if foreign.R == nil {
+					foreign.R = &userRecoveryCodeR{}
+				}
+				foreign.R.UserAccount = local
+				break
+			}
+		}
+	}
+
+	return nil
+}
+
+// LoadUserRoles allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for a 1-M or N-M relationship.
+func (userAccountL) LoadUserRoles(ctx context.Context, e boil.ContextExecutor, singular bool, maybeUserAccount interface{}, mods queries.Applicator) error {
+	var slice []*UserAccount
+	var object *UserAccount
+
+	if singular {
+		var ok bool
+		object, ok = maybeUserAccount.(*UserAccount)
+		if !ok {
+			object = new(UserAccount)
+			ok = queries.SetFromEmbeddedStruct(&object, &maybeUserAccount)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", object, maybeUserAccount))
+			}
+		}
+	} else {
+		s, ok := maybeUserAccount.(*[]*UserAccount)
+		if ok {
+			slice = *s
+		} else {
+			ok = queries.SetFromEmbeddedStruct(&slice, maybeUserAccount)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", slice, maybeUserAccount))
+			}
+		}
+	}
+
+	args := make(map[interface{}]struct{})
+	if singular {
+		if object.R == nil {
+			object.R = &userAccountR{}
+		}
+		args[object.ID] = struct{}{}
+	} else {
+		for _, obj := range slice {
+			if obj.R == nil {
+				obj.R = &userAccountR{}
+			}
+			args[obj.ID] = struct{}{}
+		}
+	}
+
+	if len(args) == 0 {
+		return nil
+	}
+
+	argsSlice := make([]interface{}, len(args))
+	i := 0
+	for arg := range args {
+		argsSlice[i] = arg
+		i++
+	}
+
+	query := NewQuery(
+		qm.From(`user_role`),
+		qm.WhereIn(`user_role.user_account_id in ?`, argsSlice...),
+	)
+	if mods != nil {
+		mods.Apply(query)
+	}
+
+	results, err := query.QueryContext(ctx, e)
+	if err != nil {
+		return errors.Wrap(err, "failed to eager load user_role")
+	}
+
+	var resultSlice []*UserRole
+	if err = queries.Bind(results, &resultSlice); err != nil {
+		return errors.Wrap(err, "failed to bind eager loaded slice user_role")
+	}
+
+	if err = results.Close(); err != nil {
+		return errors.Wrap(err, "failed to close results in eager load on user_role")
+	}
+	if err = results.Err(); err != nil {
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for user_role")
+	}
+
+	if len(userRoleAfterSelectHooks) != 0 {
+		for _, obj := range resultSlice {
+			if err := obj.doAfterSelectHooks(ctx, e); err != nil {
+				return err
+			}
+		}
+	}
+	if singular {
+		object.R.UserRoles = resultSlice
+		for _, foreign := range resultSlice {
+			if foreign.R == nil {
+				foreign.R = &userRoleR{}
+			}
+			foreign.R.UserAccount = object
+		}
+		return nil
+	}
+
+	for _, foreign := range resultSlice {
+		for _, local := range slice {
+			if local.ID == foreign.UserAccountID {
+				local.R.UserRoles = append(local.R.UserRoles, foreign)
+				if foreign.R == nil {
+					foreign.R = &userRoleR{}
+				}
+				foreign.R.UserAccount = local
+				break
+			}
+		}
+	}
+
+	return nil
+}
+
+// LoadUserSessions allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for a 1-M or N-M relationship.
+func (userAccountL) LoadUserSessions(ctx context.Context, e boil.ContextExecutor, singular bool, maybeUserAccount interface{}, mods queries.Applicator) error {
+	var slice []*UserAccount
+	var object *UserAccount
+
+	if singular {
+		var ok bool
+		object, ok = maybeUserAccount.(*UserAccount)
+		if !ok {
+			object = new(UserAccount)
+			ok = queries.SetFromEmbeddedStruct(&object, &maybeUserAccount)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", object, maybeUserAccount))
+			}
+		}
+	} else {
+		s, ok := maybeUserAccount.(*[]*UserAccount)
+		if ok {
+			slice = *s
+		} else {
+			ok = queries.SetFromEmbeddedStruct(&slice, maybeUserAccount)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", slice, maybeUserAccount))
+			}
+		}
+	}
+
+	args := make(map[interface{}]struct{})
+	if singular {
+		if object.R == nil {
+			object.R = &userAccountR{}
+		}
+		args[object.ID] = struct{}{}
+	} else {
+		for _, obj := range slice {
+			if obj.R == nil {
+				obj.R = &userAccountR{}
+			}
+			args[obj.ID] = struct{}{}
+		}
+	}
+
+	if len(args) == 0 {
+		return nil
+	}
+
+	argsSlice := make([]interface{}, len(args))
+	i := 0
+	for arg := range args {
+		argsSlice[i] = arg
+		i++
+	}
+
+	query := NewQuery(
+		qm.From(`user_session`),
+		qm.WhereIn(`user_session.user_account_id in ?`, argsSlice...),
+	)
+	if mods != nil {
+		mods.Apply(query)
+	}
+
+	results, err := query.QueryContext(ctx, e)
+	if err != nil {
+		return errors.Wrap(err, "failed to eager load user_session")
+	}
+
+	var resultSlice []*UserSession
+	if err = queries.Bind(results, &resultSlice); err != nil {
+		return errors.Wrap(err, "failed to bind eager loaded slice user_session")
+	}
+
+	if err = results.Close(); err != nil {
+		return errors.Wrap(err, "failed to close results in eager load on user_session")
+	}
+	if err = results.Err(); err != nil {
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for user_session")
+	}
+
+	if len(userSessionAfterSelectHooks) != 0 {
+		for _, obj := range resultSlice {
+			if err := obj.doAfterSelectHooks(ctx, e); err != nil {
+				return err
+			}
+		}
+	}
+	if singular {
+		object.R.UserSessions = resultSlice
+		for _, foreign := range resultSlice {
+			if foreign.R == nil {
+				foreign.R = &userSessionR{}
+			}
+			foreign.R.UserAccount = object
+		}
+		return nil
+	}
+
+	for _, foreign := range resultSlice {
+		for _, local := range slice {
+			if local.ID == foreign.UserAccountID {
+				local.R.UserSessions = append(local.R.UserSessions, foreign)
+				if foreign.R == nil {
+					foreign.R = &userSessionR{}
+				}
+				foreign.R.UserAccount = local
+				break
+			}
+		}
+	}
+
+	return nil
+}
+
+// SetUserMfa of the userAccount to the related item.
+// Sets o.R.UserMfa to related.
+// Adds o to related.R.UserAccount.
+func (o *UserAccount) SetUserMfa(ctx context.Context, exec boil.ContextExecutor, insert bool, related *UserMfa) error {
+	var err error
+
+	if insert {
+		related.UserAccountID = o.ID
+
+		if err = related.Insert(ctx, exec, boil.Infer()); err != nil {
+			return errors.Wrap(err, "failed to insert into foreign table")
+		}
+	} else {
+		updateQuery := fmt.Sprintf(
+			"UPDATE \"user_mfa\" SET %s WHERE %s",
+			strmangle.SetParamNames("\"", "\"", 1, []string{"user_account_id"}),
+			strmangle.WhereClause("\"", "\"", 2, userMfaPrimaryKeyColumns),
+		)
+		values := []interface{}{o.ID, related.ID}
+
+		if boil.IsDebug(ctx) {
+			writer := boil.DebugWriterFrom(ctx)
+			fmt.Fprintln(writer, updateQuery)
+			fmt.Fprintln(writer, values)
+		}
+		if _, err = exec.ExecContext(ctx, updateQuery, values...); err != nil {
+			return errors.Wrap(err, "failed to update foreign table")
+		}
+
+		related.UserAccountID = o.ID
+	}
+
+	if o.R == nil {
+		o.R = &userAccountR{
+			UserMfa: related,
+		}
+	} else {
+		o.R.UserMfa = related
+	}
+
+	if related.R == nil {
+		related.R = &userMfaR{
+			UserAccount: o,
+		}
+	} else {
+		related.R.UserAccount = o
+	}
+	return nil
+}
+
+// AddAPIKeys adds the given related objects to the existing relationships
+// of the user_account, optionally inserting them as new records.
+// Appends related to o.R.APIKeys.
+// Sets related.R.UserAccount appropriately.
+func (o *UserAccount) AddAPIKeys(ctx context.Context, exec boil.ContextExecutor, insert bool, related ...*APIKey) error {
+	var err error
+	for _, rel := range related {
+		if insert {
+			rel.UserAccountID = o.ID
+			if err = rel.Insert(ctx, exec, boil.Infer()); err != nil {
+				return errors.Wrap(err, "failed to insert into foreign table")
+			}
+		} else {
+			updateQuery := fmt.Sprintf(
+				"UPDATE \"api_key\" SET %s WHERE %s",
+				strmangle.SetParamNames("\"", "\"", 1, []string{"user_account_id"}),
+				strmangle.WhereClause("\"", "\"", 2, apiKeyPrimaryKeyColumns),
+			)
+			values := []interface{}{o.ID, rel.ID}
+
+			if boil.IsDebug(ctx) {
+				writer := boil.DebugWriterFrom(ctx)
+				fmt.Fprintln(writer, updateQuery)
+				fmt.Fprintln(writer, values)
+			}
+			if _, err = exec.ExecContext(ctx, updateQuery, values...); err != nil {
+				return errors.Wrap(err, "failed to update foreign table")
+			}
+
+			rel.UserAccountID = o.ID
+		}
+	}
+
+	if o.R == nil {
+		o.R = &userAccountR{
+			APIKeys: related,
+		}
+	} else {
+		o.R.APIKeys = append(o.R.APIKeys, related...)
+	}
+
+	for _, rel := range related {
+		if rel.R == nil {
+			rel.R = &apiKeyR{
+				UserAccount: o,
+			}
+		} else {
+			rel.R.UserAccount = o
+		}
+	}
+	return nil
+}
+
+// AddActorUserAccountImpersonationAudits adds the given related objects to the existing relationships
+// of the user_account, optionally inserting them as new records.
+// Appends related to o.R.ActorUserAccountImpersonationAudits.
+// Sets related.R.ActorUserAccount appropriately.
+func (o *UserAccount) AddActorUserAccountImpersonationAudits(ctx context.Context, exec boil.ContextExecutor, insert bool, related ...*ImpersonationAudit) error {
+	var err error
+	for _, rel := range related {
+		if insert {
+			rel.ActorUserAccountID = o.ID
+			if err = rel.Insert(ctx, exec, boil.Infer()); err != nil {
+				return errors.Wrap(err, "failed to insert into foreign table")
+			}
+		} else {
+			updateQuery := fmt.Sprintf(
+				"UPDATE \"impersonation_audit\" SET %s WHERE %s",
+				strmangle.SetParamNames("\"", "\"", 1, []string{"actor_user_account_id"}),
+				strmangle.WhereClause("\"", "\"", 2, impersonationAuditPrimaryKeyColumns),
+			)
+			values := []interface{}{o.ID, rel.ID}
+
+			if boil.IsDebug(ctx) {
+				writer := boil.DebugWriterFrom(ctx)
+				fmt.Fprintln(writer, updateQuery)
+				fmt.Fprintln(writer, values)
+			}
+			if _, err = exec.ExecContext(ctx, updateQuery, values...); err != nil {
+				return errors.Wrap(err, "failed to update foreign table")
+			}
+
+			rel.ActorUserAccountID = o.ID
+		}
+	}
+
+	if o.R == nil {
+		o.R = &userAccountR{
+			ActorUserAccountImpersonationAudits: related,
+		}
+	} else {
+		o.R.ActorUserAccountImpersonationAudits = append(o.R.ActorUserAccountImpersonationAudits, related...)
+	}
+
+	for _, rel := range related {
+		if rel.R == nil {
+			rel.R = &impersonationAuditR{
+				ActorUserAccount: o,
+			}
+		} else {
+			rel.R.ActorUserAccount = o
+		}
+	}
+	return nil
+}
+
+// AddImpersonationAudits adds the given related objects to the existing relationships
+// of the user_account, optionally inserting them as new records.
+// Appends related to o.R.ImpersonationAudits.
+// Sets related.R.UserAccount appropriately.
+func (o *UserAccount) AddImpersonationAudits(ctx context.Context, exec boil.ContextExecutor, insert bool, related ...*ImpersonationAudit) error {
+	var err error
+	for _, rel := range related {
+		if insert {
+			rel.UserAccountID = o.ID
+			if err = rel.Insert(ctx, exec, boil.Infer()); err != nil {
+				return errors.Wrap(err, "failed to insert into foreign table")
+			}
+		} else {
+			updateQuery := fmt.Sprintf(
+				"UPDATE \"impersonation_audit\" SET %s WHERE %s",
+				strmangle.SetParamNames("\"", "\"", 1, []string{"user_account_id"}),
+				strmangle.WhereClause("\"", "\"", 2, impersonationAuditPrimaryKeyColumns),
+			)
+			values := []interface{}{o.ID, rel.ID}
+
+			if boil.IsDebug(ctx) {
+				writer := boil.DebugWriterFrom(ctx)
+				fmt.Fprintln(writer, updateQuery)
+				fmt.Fprintln(writer, values)
+			}
+			if _, err = exec.ExecContext(ctx, updateQuery, values...); err != nil {
+				return errors.Wrap(err, "failed to update foreign table")
+			}
+
+			rel.UserAccountID = o.ID
+		}
+	}
+
+	if o.R == nil {
+		o.R = &userAccountR{
+			ImpersonationAudits: related,
+		}
+	} else {
+		o.R.ImpersonationAudits = append(o.R.ImpersonationAudits, related...)
+	}
+
+	for _, rel := range related {
+		if rel.R == nil {
+			rel.R = &impersonationAuditR{
+				UserAccount: o,
+			}
+		} else {
+			rel.R.UserAccount = o
+		}
+	}
+	return nil
+}
+
+// AddLoyaltyPoints adds the given related objects to the existing relationships
+// of the user_account, optionally inserting them as new records.
+// Appends related to o.R.LoyaltyPoints.
+// Sets related.R.UserAccount appropriately.
+func (o *UserAccount) AddLoyaltyPoints(ctx context.Context, exec boil.ContextExecutor, insert bool, related ...*LoyaltyPoint) error {
+	var err error
+	for _, rel := range related {
+		if insert {
+			rel.UserAccountID = o.ID
+			if err = rel.Insert(ctx, exec, boil.Infer()); err != nil {
+				return errors.Wrap(err, "failed to insert into foreign table")
+			}
+		} else {
+			updateQuery := fmt.Sprintf(
+				"UPDATE \"loyalty_point\" SET %s WHERE %s",
+				strmangle.SetParamNames("\"", "\"", 1, []string{"user_account_id"}),
+				strmangle.WhereClause("\"", "\"", 2, loyaltyPointPrimaryKeyColumns),
+			)
+			values := []interface{}{o.ID, rel.ID}
+
+			if boil.IsDebug(ctx) {
+				writer := boil.DebugWriterFrom(ctx)
+				fmt.Fprintln(writer, updateQuery)
+				fmt.Fprintln(writer, values)
+			}
+			if _, err = exec.ExecContext(ctx, updateQuery, values...); err != nil {
+				return errors.Wrap(err, "failed to update foreign table")
+			}
+
+			rel.UserAccountID = o.ID
+		}
+	}
+
+	if o.R == nil {
+		o.R = &userAccountR{
+			LoyaltyPoints: related,
+		}
+	} else {
+		o.R.LoyaltyPoints = append(o.R.LoyaltyPoints, related...)
+	}
+
+	for _, rel := range related {
+		if rel.R == nil {
+			rel.R = &loyaltyPointR{
+				UserAccount: o,
+			}
+		} else {
+			rel.R.UserAccount = o
+		}
+	}
+	return nil
+}
+
+// AddMagicLinkTokens adds the given related objects to the existing relationships
+// of the user_account, optionally inserting them as new records.
+// Appends related to o.R.MagicLinkTokens.
+// Sets related.R.UserAccount appropriately.
+func (o *UserAccount) AddMagicLinkTokens(ctx context.Context, exec boil.ContextExecutor, insert bool, related ...*MagicLinkToken) error {
+	var err error
+	for _, rel := range related {
+		if insert {
+			rel.UserAccountID = o.ID
+			if err = rel.Insert(ctx, exec, boil.Infer()); err != nil {
+				return errors.Wrap(err, "failed to insert into foreign table")
+			}
+		} else {
+			updateQuery := fmt.Sprintf(
+				"UPDATE \"magic_link_token\" SET %s WHERE %s",
+				strmangle.SetParamNames("\"", "\"", 1, []string{"user_account_id"}),
+				strmangle.WhereClause("\"", "\"", 2, magicLinkTokenPrimaryKeyColumns),
+			)
+			values := []interface{}{o.ID, rel.ID}
+
+			if boil.IsDebug(ctx) {
+				writer := boil.DebugWriterFrom(ctx)
+				fmt.Fprintln(writer, updateQuery)
+				fmt.Fprintln(writer, values)
+			}
+			if _, err = exec.ExecContext(ctx, updateQuery, values...); err != nil {
+				return errors.Wrap(err, "failed to update foreign table")
+			}
+
+			rel.UserAccountID = o.ID
+		}
+	}
+
+	if o.R == nil {
+		o.R = &userAccountR{
+			MagicLinkTokens: related,
+		}
+	} else {
+		o.R.MagicLinkTokens = append(o.R.MagicLinkTokens, related...)
+	}
+
+	for _, rel := range related {
+		if rel.R == nil {
+			rel.R = &magicLinkTokenR{
+				UserAccount: o,
+			}
+		} else {
+			rel.R.UserAccount = o
+		}
+	}
+	return nil
+}
+
+// AddChangedByMembershipHistories adds the given related objects to the existing relationships
+// of the user_account, optionally inserting them as new records.
+// Appends related to o.R.ChangedByMembershipHistories.
+// Sets related.R.ChangedByUserAccount appropriately.
+func (o *UserAccount) AddChangedByMembershipHistories(ctx context.Context, exec boil.ContextExecutor, insert bool, related ...*MembershipHistory) error {
+	var err error
+	for _, rel := range related {
+		if insert {
+			queries.Assign(&rel.ChangedBy, o.ID)
+			if err = rel.Insert(ctx, exec, boil.Infer()); err != nil {
+				return errors.Wrap(err, "failed to insert into foreign table")
+			}
+		} else {
+			updateQuery := fmt.Sprintf(
+				"UPDATE \"membership_history\" SET %s WHERE %s",
+				strmangle.SetParamNames("\"", "\"", 1, []string{"changed_by"}),
+				strmangle.WhereClause("\"", "\"", 2, membershipHistoryPrimaryKeyColumns),
+			)
+			values := []interface{}{o.ID, rel.ID}
+
+			if boil.IsDebug(ctx) {
+				writer := boil.DebugWriterFrom(ctx)
+				fmt.Fprintln(writer, updateQuery)
+				fmt.Fprintln(writer, values)
+			}
+			if _, err = exec.ExecContext(ctx, updateQuery, values...); err != nil {
+				return errors.Wrap(err, "failed to update foreign table")
+			}
+
+			queries.Assign(&rel.ChangedBy, o.ID)
+		}
+	}
+
+	if o.R == nil {
+		o.R = &userAccountR{
+			ChangedByMembershipHistories: related,
+		}
+	} else {
+		o.R.ChangedByMembershipHistories = append(o.R.ChangedByMembershipHistories, related...)
+	}
+
+	for _, rel := range related {
+		if rel.R == nil {
+			rel.R = &membershipHistoryR{
+				ChangedByUserAccount: o,
+			}
+		} else {
+			rel.R.ChangedByUserAccount = o
+		}
+	}
+	return nil
+}
+
+// SetChangedByMembershipHistories removes all previously related items of the
+// user_account replacing them completely with the passed
+// in related items, optionally inserting them as new records.
+// Sets o.R.ChangedByUserAccount's ChangedByMembershipHistories accordingly.
+// Replaces o.R.ChangedByMembershipHistories with related.
+// Sets related.R.ChangedByUserAccount's ChangedByMembershipHistories accordingly.
+func (o *UserAccount) SetChangedByMembershipHistories(ctx context.Context, exec boil.ContextExecutor, insert bool, related ...*MembershipHistory) error {
+	query := "update \"membership_history\" set \"changed_by\" = null where \"changed_by\" = $1"
+	values := []interface{}{o.ID}
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, query)
+		fmt.Fprintln(writer, values)
+	}
+	_, err := exec.ExecContext(ctx, query, values...)
+	if err != nil {
+		return errors.Wrap(err, "failed to remove relationships before set")
+	}
+
+	if o.R != nil {
+		for _, rel := range o.R.ChangedByMembershipHistories {
+			queries.SetScanner(&rel.ChangedBy, nil)
+			if rel.R == nil {
+				continue
+			}
+
+			rel.R.ChangedByUserAccount = nil
+		}
+		o.R.ChangedByMembershipHistories = nil
+	}
+
+	return o.AddChangedByMembershipHistories(ctx, exec, insert, related...)
+}
+
+// RemoveChangedByMembershipHistories relationships from objects passed in.
+// Removes related items from R.ChangedByMembershipHistories (uses pointer comparison, removal does not keep order)
+// Sets related.R.ChangedByUserAccount.
+func (o *UserAccount) RemoveChangedByMembershipHistories(ctx context.Context, exec boil.ContextExecutor, related ...*MembershipHistory) error {
+	if len(related) == 0 {
+		return nil
+	}
+
+	var err error
+	for _, rel := range related {
+		queries.SetScanner(&rel.ChangedBy, nil)
+		if rel.R != nil {
+			rel.R.ChangedByUserAccount = nil
+		}
+		if _, err = rel.Update(ctx, exec, boil.Whitelist("changed_by")); err != nil {
+			return err
+		}
+	}
+	if o.R == nil {
+		return nil
+	}
+
+	for _, rel := range related {
+		for i, ri := range o.R.ChangedByMembershipHistories {
+			if rel != ri {
+				continue
+			}
+
+			ln := len(o.R.ChangedByMembershipHistories)
+			if ln > 1 && i < ln-1 {
+				o.R.ChangedByMembershipHistories[i] = o.R.ChangedByMembershipHistories[ln-1]
+			}
+			o.R.ChangedByMembershipHistories = o.R.ChangedByMembershipHistories[:ln-1]
+			break
+		}
+	}
+
+	return nil
+}
+
+// AddMembershipHistories adds the given related objects to the existing relationships
+// of the user_account, optionally inserting them as new records.
+// Appends related to o.R.MembershipHistories.
+// Sets related.R.UserAccount appropriately.
+func (o *UserAccount) AddMembershipHistories(ctx context.Context, exec boil.ContextExecutor, insert bool, related ...*MembershipHistory) error {
+	var err error
+	for _, rel := range related {
+		if insert {
+			rel.UserAccountID = o.ID
+			if err = rel.Insert(ctx, exec, boil.Infer()); err != nil {
+				return errors.Wrap(err, "failed to insert into foreign table")
+			}
+		} else {
+			updateQuery := fmt.Sprintf(
+				"UPDATE \"membership_history\" SET %s WHERE %s",
+				strmangle.SetParamNames("\"", "\"", 1, []string{"user_account_id"}),
+				strmangle.WhereClause("\"", "\"", 2, membershipHistoryPrimaryKeyColumns),
+			)
+			values := []interface{}{o.ID, rel.ID}
+
+			if boil.IsDebug(ctx) {
+				writer := boil.DebugWriterFrom(ctx)
+				fmt.Fprintln(writer, updateQuery)
+				fmt.Fprintln(writer, values)
+			}
+			if _, err = exec.ExecContext(ctx, updateQuery, values...); err != nil {
+				return errors.Wrap(err, "failed to update foreign table")
+			}
+
+			rel.UserAccountID = o.ID
+		}
+	}
+
+	if o.R == nil {
+		o.R = &userAccountR{
+			MembershipHistories: related,
+		}
+	} else {
+		o.R.MembershipHistories = append(o.R.MembershipHistories, related...)
+	}
+
+	for _, rel := range related {
+		if rel.R == nil {
+			rel.R = &membershipHistoryR{
+				UserAccount: o,
+			}
+		} else {
+			rel.R.UserAccount = o
+		}
+	}
+	return nil
+}
+
+// AddOauthAuthorizationCodes adds the given related objects to the existing relationships
+// of the user_account, optionally inserting them as new records.
+// Appends related to o.R.OauthAuthorizationCodes.
+// Sets related.R.UserAccount appropriately.
+func (o *UserAccount) AddOauthAuthorizationCodes(ctx context.Context, exec boil.ContextExecutor, insert bool, related ...*OauthAuthorizationCode) error {
+	var err error
+	for _, rel := range related {
+		if insert {
+			rel.UserAccountID = o.ID
+			if err = rel.Insert(ctx, exec, boil.Infer()); err != nil {
+				return errors.Wrap(err, "failed to insert into foreign table")
+			}
+		} else {
+			updateQuery := fmt.Sprintf(
+				"UPDATE \"oauth_authorization_code\" SET %s WHERE %s",
+				strmangle.SetParamNames("\"", "\"", 1, []string{"user_account_id"}),
+				strmangle.WhereClause("\"", "\"", 2, oauthAuthorizationCodePrimaryKeyColumns),
+			)
+			values := []interface{}{o.ID, rel.ID}
+
+			if boil.IsDebug(ctx) {
+				writer := boil.DebugWriterFrom(ctx)
+				fmt.Fprintln(writer, updateQuery)
+				fmt.Fprintln(writer, values)
+			}
+			if _, err = exec.ExecContext(ctx, updateQuery, values...); err != nil {
+				return errors.Wrap(err, "failed to update foreign table")
+			}
+
+			rel.UserAccountID = o.ID
+		}
+	}
+
+	if o.R == nil {
+		o.R = &userAccountR{
+			OauthAuthorizationCodes: related,
+		}
+	} else {
+		o.R.OauthAuthorizationCodes = append(o.R.OauthAuthorizationCodes, related...)
+	}
+
+	for _, rel := range related {
+		if rel.R == nil {
+			rel.R = &oauthAuthorizationCodeR{
+				UserAccount: o,
+			}
+		} else {
+			rel.R.UserAccount = o
+		}
+	}
+	return nil
+}
+
+// AddPasswordResetTokens adds the given related objects to the existing relationships
+// of the user_account, optionally inserting them as new records.
+// Appends related to o.R.PasswordResetTokens.
+// Sets related.R.UserAccount appropriately.
+func (o *UserAccount) AddPasswordResetTokens(ctx context.Context, exec boil.ContextExecutor, insert bool, related ...*PasswordResetToken) error {
+	var err error
+	for _, rel := range related {
+		if insert {
+			rel.UserAccountID = o.ID
+			if err = rel.Insert(ctx, exec, boil.Infer()); err != nil {
+				return errors.Wrap(err, "failed to insert into foreign table")
+			}
+		} else {
+			updateQuery := fmt.Sprintf(
+				"UPDATE \"password_reset_token\" SET %s WHERE %s",
+				strmangle.SetParamNames("\"", "\"", 1, []string{"user_account_id"}),
+				strmangle.WhereClause("\"", "\"", 2, passwordResetTokenPrimaryKeyColumns),
+			)
+			values := []interface{}{o.ID, rel.ID}
+
+			if boil.IsDebug(ctx) {
+				writer := boil.DebugWriterFrom(ctx)
+				fmt.Fprintln(writer, updateQuery)
+				fmt.Fprintln(writer, values)
+			}
+			if _, err = exec.ExecContext(ctx, updateQuery, values...); err != nil {
+				return errors.Wrap(err, "failed to update foreign table")
+			}
+
+			rel.UserAccountID = o.ID
+		}
+	}
+
+	if o.R == nil {
+		o.R = &userAccountR{
+			PasswordResetTokens: related,
+		}
+	} else {
+		o.R.PasswordResetTokens = append(o.R.PasswordResetTokens, related...)
+	}
+
+	for _, rel := range related {
+		if rel.R == nil {
+			rel.R = &passwordResetTokenR{
+				UserAccount: o,
+			}
+		} else {
+			rel.R.UserAccount = o
+		}
+	}
+	return nil
+}
+
+// AddUsageCounters adds the given related objects to the existing relationships
+// of the user_account, optionally inserting them as new records.
+// Appends related to o.R.UsageCounters.
+// Sets related.R.UserAccount appropriately.
+func (o *UserAccount) AddUsageCounters(ctx context.Context, exec boil.ContextExecutor, insert bool, related ...*UsageCounter) error {
+	var err error
+	for _, rel := range related {
+		if insert {
+			rel.UserAccountID = o.ID
+			if err = rel.Insert(ctx, exec, boil.Infer()); err != nil {
+				return errors.Wrap(err, "failed to insert into foreign table")
+			}
+		} else {
+			updateQuery := fmt.Sprintf(
+				"UPDATE \"usage_counter\" SET %s WHERE %s",
+				strmangle.SetParamNames("\"", "\"", 1, []string{"user_account_id"}),
+				strmangle.WhereClause("\"", "\"", 2, usageCounterPrimaryKeyColumns),
+			)
+			values := []interface{}{o.ID, rel.ID}
+
+			if boil.IsDebug(ctx) {
+				writer := boil.DebugWriterFrom(ctx)
+				fmt.Fprintln(writer, updateQuery)
+				fmt.Fprintln(writer, values)
+			}
+			if _, err = exec.ExecContext(ctx, updateQuery, values...); err != nil {
+				return errors.Wrap(err, "failed to update foreign table")
+			}
+
+			rel.UserAccountID = o.ID
+		}
+	}
+
+	if o.R == nil {
+		o.R = &userAccountR{
+			UsageCounters: related,
+		}
+	} else {
+		o.R.UsageCounters = append(o.R.UsageCounters, related...)
+	}
+
+	for _, rel := range related {
+		if rel.R == nil {
+			rel.R = &usageCounterR{
+				UserAccount: o,
+			}
+		} else {
+			rel.R.UserAccount = o
+		}
+	}
+	return nil
+}
+
+// AddUserIdentities adds the given related objects to the existing relationships
+// of the user_account, optionally inserting them as new records.
+// Appends related to o.R.UserIdentities.
+// Sets related.R.UserAccount appropriately.
+func (o *UserAccount) AddUserIdentities(ctx context.Context, exec boil.ContextExecutor, insert bool, related ...*UserIdentity) error {
+	var err error
+	for _, rel := range related {
+		if insert {
+			rel.UserAccountID = o.ID
+			if err = rel.Insert(ctx, exec, boil.Infer()); err != nil {
+				return errors.Wrap(err, "failed to insert into foreign table")
+			}
+		} else {
+			updateQuery := fmt.Sprintf(
+				"UPDATE \"user_identity\" SET %s WHERE %s",
+				strmangle.SetParamNames("\"", "\"", 1, []string{"user_account_id"}),
+				strmangle.WhereClause("\"", "\"", 2, userIdentityPrimaryKeyColumns),
+			)
+			values := []interface{}{o.ID, rel.ID}
+
+			if boil.IsDebug(ctx) {
+				writer := boil.DebugWriterFrom(ctx)
+				fmt.Fprintln(writer, updateQuery)
+				fmt.Fprintln(writer, values)
+			}
+			if _, err = exec.ExecContext(ctx, updateQuery, values...); err != nil {
+				return errors.Wrap(err, "failed to update foreign table")
+			}
+
+			rel.UserAccountID = o.ID
+		}
+	}
+
+	if o.R == nil {
+		o.R = &userAccountR{
+			UserIdentities: related,
+		}
+	} else {
+		o.R.UserIdentities = append(o.R.UserIdentities, related...)
+	}
+
+	for _, rel := range related {
+		if rel.R == nil {
+			rel.R = &userIdentityR{
+				UserAccount: o,
+			}
+		} else {
+			rel.R.UserAccount = o
+		}
+	}
+	return nil
+}
+
 // AddUserInfos adds the given related objects to the existing relationships
 // of the user_account, optionally inserting them as new records.
 // Appends related to o.R.UserInfos.
@@ -723,6 +3389,165 @@ func (o *UserAccount) AddUserInfos(ctx context.Context, exec boil.ContextExecuto
 	return nil
 }
 
+// AddUserRecoveryCodes adds the given related objects to the existing relationships
+// of the user_account, optionally inserting them as new records.
+// Appends related to o.R.UserRecoveryCodes.
+// Sets related.R.UserAccount appropriately.
+func (o *UserAccount) AddUserRecoveryCodes(ctx context.Context, exec boil.ContextExecutor, insert bool, related ...*UserRecoveryCode) error {
+	var err error
+	for _, rel := range related {
+		if insert {
+			rel.UserAccountID = o.ID
+			if err = rel.Insert(ctx, exec, boil.Infer()); err != nil {
+				return errors.Wrap(err, "failed to insert into foreign table")
+			}
+		} else {
+			updateQuery := fmt.Sprintf(
+				"UPDATE \"user_recovery_code\" SET %s WHERE %s",
+				strmangle.SetParamNames("\"", "\"", 1, []string{"user_account_id"}),
+				strmangle.WhereClause("\"", "\"", 2, userRecoveryCodePrimaryKeyColumns),
+			)
+			values := []interface{}{o.ID, rel.ID}
+
+			if boil.IsDebug(ctx) {
+				writer := boil.DebugWriterFrom(ctx)
+				fmt.Fprintln(writer, updateQuery)
+				fmt.Fprintln(writer, values)
+			}
+			if _, err = exec.ExecContext(ctx, updateQuery, values...); err != nil {
+				return errors.Wrap(err, "failed to update foreign table")
+			}
+
+			rel.UserAccountID = o.ID
+		}
+	}
+
+	if o.R == nil {
+		o.R = &userAccountR{
+			UserRecoveryCodes: related,
+		}
+	} else {
+		o.R.UserRecoveryCodes = append(o.R.UserRecoveryCodes, related...)
+	}
+
+	for _, rel := range related {
+		if rel.R == nil {
+			rel.R = &userRecoveryCodeR{
+				UserAccount: o,
+			}
+		} else {
+			rel.R.UserAccount = o
+		}
+	}
+	return nil
+}
+
+// AddUserRoles adds the given related objects to the existing relationships
+// of the user_account, optionally inserting them as new records.
+// Appends related to o.R.UserRoles.
+// Sets related.R.UserAccount appropriately.
+func (o *UserAccount) AddUserRoles(ctx context.Context, exec boil.ContextExecutor, insert bool, related ...*UserRole) error {
+	var err error
+	for _, rel := range related {
+		if insert {
+			rel.UserAccountID = o.ID
+			if err = rel.Insert(ctx, exec, boil.Infer()); err != nil {
+				return errors.Wrap(err, "failed to insert into foreign table")
+			}
+		} else {
+			updateQuery := fmt.Sprintf(
+				"UPDATE \"user_role\" SET %s WHERE %s",
+				strmangle.SetParamNames("\"", "\"", 1, []string{"user_account_id"}),
+				strmangle.WhereClause("\"", "\"", 2, userRolePrimaryKeyColumns),
+			)
+			values := []interface{}{o.ID, rel.ID}
+
+			if boil.IsDebug(ctx) {
+				writer := boil.DebugWriterFrom(ctx)
+				fmt.Fprintln(writer, updateQuery)
+				fmt.Fprintln(writer, values)
+			}
+			if _, err = exec.ExecContext(ctx, updateQuery, values...); err != nil {
+				return errors.Wrap(err, "failed to update foreign table")
+			}
+
+			rel.UserAccountID = o.ID
+		}
+	}
+
+	if o.R == nil {
+		o.R = &userAccountR{
+			UserRoles: related,
+		}
+	} else {
+		o.R.UserRoles = append(o.R.UserRoles, related...)
+	}
+
+	for _, rel := range related {
+		if rel.R == nil {
+			rel.R = &userRoleR{
+				UserAccount: o,
+			}
+		} else {
+			rel.R.UserAccount = o
+		}
+	}
+	return nil
+}
+
+// AddUserSessions adds the given related objects to the existing relationships
+// of the user_account, optionally inserting them as new records.
+// Appends related to o.R.UserSessions.
+// Sets related.R.UserAccount appropriately.
+func (o *UserAccount) AddUserSessions(ctx context.Context, exec boil.ContextExecutor, insert bool, related ...*UserSession) error {
+	var err error
+	for _, rel := range related {
+		if insert {
+			rel.UserAccountID = o.ID
+			if err = rel.Insert(ctx, exec, boil.Infer()); err != nil {
+				return errors.Wrap(err, "failed to insert into foreign table")
+			}
+		} else {
+			updateQuery := fmt.Sprintf(
+				"UPDATE \"user_session\" SET %s WHERE %s",
+				strmangle.SetParamNames("\"", "\"", 1, []string{"user_account_id"}),
+				strmangle.WhereClause("\"", "\"", 2, userSessionPrimaryKeyColumns),
+			)
+			values := []interface{}{o.ID, rel.ID}
+
+			if boil.IsDebug(ctx) {
+				writer := boil.DebugWriterFrom(ctx)
+				fmt.Fprintln(writer, updateQuery)
+				fmt.Fprintln(writer, values)
+			}
+			if _, err = exec.ExecContext(ctx, updateQuery, values...); err != nil {
+				return errors.Wrap(err, "failed to update foreign table")
+			}
+
+			rel.UserAccountID = o.ID
+		}
+	}
+
+	if o.R == nil {
+		o.R = &userAccountR{
+			UserSessions: related,
+		}
+	} else {
+		o.R.UserSessions = append(o.R.UserSessions, related...)
+	}
+
+	for _, rel := range related {
+		if rel.R == nil {
+			rel.R = &userSessionR{
+				UserAccount: o,
+			}
+		} else {
+			rel.R.UserAccount = o
+		}
+	}
+	return nil
+}
+
 // UserAccounts retrieves all the records using an executor.
 func UserAccounts(mods ...qm.QueryMod) userAccountQuery {
 	mods = append(mods, qm.From("\"user_account\""))
@@ -94,94 +94,6 @@ var UserInfoTableColumns = struct {
 
 // Generated where
 
-type whereHelpernull_String struct{ field string }
-
-func (w whereHelpernull_String) EQ(x null.String) qm.QueryMod {
-	return qmhelper.WhereNullEQ(w.field, false, x)
-}
-func (w whereHelpernull_String) NEQ(x null.String) qm.QueryMod {
-	return qmhelper.WhereNullEQ(w.field, true, x)
-}
-func (w whereHelpernull_String) LT(x null.String) qm.QueryMod {
-	return qmhelper.Where(w.field, qmhelper.LT, x)
-}
-func (w whereHelpernull_String) LTE(x null.String) qm.QueryMod {
-	return qmhelper.Where(w.field, qmhelper.LTE, x)
-}
-func (w whereHelpernull_String) GT(x null.String) qm.QueryMod {
-	return qmhelper.Where(w.field, qmhelper.GT, x)
-}
-func (w whereHelpernull_String) GTE(x null.String) qm.QueryMod {
-	return qmhelper.Where(w.field, qmhelper.GTE, x)
-}
-func (w whereHelpernull_String) LIKE(x null.String) qm.QueryMod {
-	return qm.Where(w.field+" LIKE ?", x)
-}
-func (w whereHelpernull_String) NLIKE(x null.String) qm.QueryMod {
-	return qm.Where(w.field+" NOT LIKE ?", x)
-}
-func (w whereHelpernull_String) ILIKE(x null.String) qm.QueryMod {
-	return qm.Where(w.field+" ILIKE ?", x)
-}
-func (w whereHelpernull_String) NILIKE(x null.String) qm.QueryMod {
-	return qm.Where(w.field+" NOT ILIKE ?", x)
-}
-func (w whereHelpernull_String) IN(slice []string) qm.QueryMod {
-	values := make([]interface{}, 0, len(slice))
-	for _, value := range slice {
-		values = append(values, value)
-	}
-	return qm.WhereIn(fmt.Sprintf("%s IN ?", w.field), values...)
-}
-func (w whereHelpernull_String) NIN(slice []string) qm.QueryMod {
-	values := make([]interface{}, 0, len(slice))
-	for _, value := range slice {
-		values = append(values, value)
-	}
-	return qm.WhereNotIn(fmt.Sprintf("%s NOT IN ?", w.field), values...)
-}
-
-func (w whereHelpernull_String) IsNull() qm.QueryMod    { return qmhelper.WhereIsNull(w.field) }
-func (w whereHelpernull_String) IsNotNull() qm.QueryMod { return qmhelper.WhereIsNotNull(w.field) }
-
-type whereHelpernull_Int struct{ field string }
-
-func (w whereHelpernull_Int) EQ(x null.Int) qm.QueryMod {
-	return qmhelper.WhereNullEQ(w.field, false, x)
-}
-func (w whereHelpernull_Int) NEQ(x null.Int) qm.QueryMod {
-	return qmhelper.WhereNullEQ(w.field, true, x)
-}
-func (w whereHelpernull_Int) LT(x null.Int) qm.QueryMod {
-	return qmhelper.Where(w.field, qmhelper.LT, x)
-}
-func (w whereHelpernull_Int) LTE(x null.Int) qm.QueryMod {
-	return qmhelper.Where(w.field, qmhelper.LTE, x)
-}
-func (w whereHelpernull_Int) GT(x null.Int) qm.QueryMod {
-	return qmhelper.Where(w.field, qmhelper.GT, x)
-}
-func (w whereHelpernull_Int) GTE(x null.Int) qm.QueryMod {
-	return qmhelper.Where(w.field, qmhelper.GTE, x)
-}
-func (w whereHelpernull_Int) IN(slice []int) qm.QueryMod {
-	values := make([]interface{}, 0, len(slice))
-	for _, value := range slice {
-		values = append(values, value)
-	}
-	return qm.WhereIn(fmt.Sprintf("%s IN ?", w.field), values...)
-}
-func (w whereHelpernull_Int) NIN(slice []int) qm.QueryMod {
-	values := make([]interface{}, 0, len(slice))
-	for _, value := range slice {
-		values = append(values, value)
-	}
-	return qm.WhereNotIn(fmt.Sprintf("%s NOT IN ?", w.field), values...)
-}
-
-func (w whereHelpernull_Int) IsNull() qm.QueryMod    { return qmhelper.WhereIsNull(w.field) }
-func (w whereHelpernull_Int) IsNotNull() qm.QueryMod { return qmhelper.WhereIsNotNull(w.field) }
-
 var UserInfoWhere = struct {
 	ID            whereHelperint
 	UserAccountID whereHelperint
@@ -210,13 +122,16 @@ var UserInfoWhere = struct {
 
 // UserInfoRels is where relationship names are stored.
 var UserInfoRels = struct {
+	Membership  string
 	UserAccount string
 }{
+	Membership:  "Membership",
 	UserAccount: "UserAccount",
 }
 
 // userInfoR is where relationships are stored.
 type userInfoR struct {
+	Membership  *Membership  `boil:"Membership" json:"Membership" toml:"Membership" yaml:"Membership"`
 	UserAccount *UserAccount `boil:"UserAccount" json:"UserAccount" toml:"UserAccount" yaml:"UserAccount"`
 }
 
@@ -225,6 +140,13 @@ func (*userInfoR) NewStruct() *userInfoR {
 	return &userInfoR{}
 }
 
+func (r *userInfoR) GetMembership() *Membership {
+	if r == nil {
+		return nil
+	}
+	return r.Membership
+}
+
 func (r *userInfoR) GetUserAccount() *UserAccount {
 	if r == nil {
 		return nil
@@ -548,6 +470,17 @@ func (q userInfoQuery) Exists(ctx context.Context, exec boil.ContextExecutor) (b
 	return count > 0, nil
 }
 
+// Membership pointed to by the foreign key.
+func (o *UserInfo) Membership(mods ...qm.QueryMod) membershipQuery {
+	queryMods := []qm.QueryMod{
+		qm.Where("\"id\" = ?", o.MembershipID),
+	}
+
+	queryMods = append(queryMods, mods...)
+
+	return Memberships(queryMods...)
+}
+
 // UserAccount pointed to by the foreign key.
 func (o *UserInfo) UserAccount(mods ...qm.QueryMod) userAccountQuery {
 	queryMods := []qm.QueryMod{
@@ -559,6 +492,130 @@ func (o *UserInfo) UserAccount(mods ...qm.QueryMod) userAccountQuery {
 	return UserAccounts(queryMods...)
 }
 
+// LoadMembership allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for an N-1 relationship.
+func (userInfoL) LoadMembership(ctx context.Context, e boil.ContextExecutor, singular bool, maybeUserInfo interface{}, mods queries.Applicator) error {
+	var slice []*UserInfo
+	var object *UserInfo
+
+	if singular {
+		var ok bool
+		object, ok = maybeUserInfo.(*UserInfo)
+		if !ok {
+			object = new(UserInfo)
+			ok = queries.SetFromEmbeddedStruct(&object, &maybeUserInfo)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", object, maybeUserInfo))
+			}
+		}
+	} else {
+		s, ok := maybeUserInfo.(*[]*UserInfo)
+		if ok {
+			slice = *s
+		} else {
+			ok = queries.SetFromEmbeddedStruct(&slice, maybeUserInfo)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", slice, maybeUserInfo))
+			}
+		}
+	}
+
+	args := make(map[interface{}]struct{})
+	if singular {
+		if object.R == nil {
+			object.R = &userInfoR{}
+		}
+		if !queries.IsNil(object.MembershipID) {
+			args[object.MembershipID] = struct{}{}
+		}
+
+	} else {
+		for _, obj := range slice {
+			if obj.R == nil {
+				obj.R = &userInfoR{}
+			}
+
+			if !queries.IsNil(obj.MembershipID) {
+				args[obj.MembershipID] = struct{}{}
+			}
+
+		}
+	}
+
+	if len(args) == 0 {
+		return nil
+	}
+
+	argsSlice := make([]interface{}, len(args))
+	i := 0
+	for arg := range args {
+		argsSlice[i] = arg
+		i++
+	}
+
+	query := NewQuery(
+		qm.From(`membership`),
+		qm.WhereIn(`membership.id in ?`, argsSlice...),
+	)
+	if mods != nil {
+		mods.Apply(query)
+	}
+
+	results, err := query.QueryContext(ctx, e)
+	if err != nil {
+		return errors.Wrap(err, "failed to eager load Membership")
+	}
+
+	var resultSlice []*Membership
+	if err = queries.Bind(results, &resultSlice); err != nil {
+		return errors.Wrap(err, "failed to bind eager loaded slice Membership")
+	}
+
+	if err = results.Close(); err != nil {
+		return errors.Wrap(err, "failed to close results of eager load for membership")
+	}
+	if err = results.Err(); err != nil {
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for membership")
+	}
+
+	if len(membershipAfterSelectHooks) != 0 {
+		for _, obj := range resultSlice {
+			if err := obj.doAfterSelectHooks(ctx, e); err != nil {
+				return err
+			}
+		}
+	}
+
+	if len(resultSlice) == 0 {
+		return nil
+	}
+
+	if singular {
+		foreign := resultSlice[0]
+		object.R.Membership = foreign
+		if foreign.R == nil {
+			foreign.R = &membershipR{}
+		}
+		foreign.R.UserInfos = append(foreign.R.UserInfos, object)
+		return nil
+	}
+
+	for _, local := range slice {
+		for _, foreign := range resultSlice {
+			if queries.Equal(local.MembershipID, foreign.ID) {
+				local.R.Membership = foreign
+				if foreign.R == nil {
+					foreign.R = &membershipR{}
+				}
+				foreign.R.UserInfos = append(foreign.R.UserInfos, local)
+				break
+			}
+		}
+	}
+
+	return nil
+}
+
 // LoadUserAccount allows an eager lookup of values, cached into the
 // loaded structs of the objects. This is for an N-1 relationship.
 func (userInfoL) LoadUserAccount(ctx context.Context, e boil.ContextExecutor, singular bool, maybeUserInfo interface{}, mods queries.Applicator) error {
@@ -679,6 +736,86 @@ func (userInfoL) LoadUserAccount(ctx context.Context, e boil.ContextExecutor, si
 	return nil
 }
 
+// SetMembership of the userInfo to the related item.
+// Sets o.R.Membership to related.
+// Adds o to related.R.UserInfos.
+func (o *UserInfo) SetMembership(ctx context.Context, exec boil.ContextExecutor, insert bool, related *Membership) error {
+	var err error
+	if insert {
+		if err = related.Insert(ctx, exec, boil.Infer()); err != nil {
+			return errors.Wrap(err, "failed to insert into foreign table")
+		}
+	}
+
+	updateQuery := fmt.Sprintf(
+		"UPDATE \"user_info\" SET %s WHERE %s",
+		strmangle.SetParamNames("\"", "\"", 1, []string{"membership_id"}),
+		strmangle.WhereClause("\"", "\"", 2, userInfoPrimaryKeyColumns),
+	)
+	values := []interface{}{related.ID, o.ID}
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, updateQuery)
+		fmt.Fprintln(writer, values)
+	}
+	if _, err = exec.ExecContext(ctx, updateQuery, values...); err != nil {
+		return errors.Wrap(err, "failed to update local table")
+	}
+
+	queries.Assign(&o.MembershipID, related.ID)
+	if o.R == nil {
+		o.R = &userInfoR{
+			Membership: related,
+		}
+	} else {
+		o.R.Membership = related
+	}
+
+	if related.R == nil {
+		related.R = &membershipR{
+			UserInfos: UserInfoSlice{o},
+		}
+	} else {
+		related.R.UserInfos = append(related.R.UserInfos, o)
+	}
+
+	return nil
+}
+
+// RemoveMembership relationship.
+// Sets o.R.Membership to nil.
+// Removes o from all passed in related items' relationships struct.
+func (o *UserInfo) RemoveMembership(ctx context.Context, exec boil.ContextExecutor, related *Membership) error {
+	var err error
+
+	queries.SetScanner(&o.MembershipID, nil)
+	if _, err = o.Update(ctx, exec, boil.Whitelist("membership_id")); err != nil {
+		return errors.Wrap(err, "failed to update local table")
+	}
+
+	if o.R != nil {
+		o.R.Membership = nil
+	}
+	if related == nil || related.R == nil {
+		return nil
+	}
+
+	for i, ri := range related.R.UserInfos {
+		if queries.Equal(o.MembershipID, ri.MembershipID) {
+			continue
+		}
+
+		ln := len(related.R.UserInfos)
+		if ln > 1 && i < ln-1 {
+			related.R.UserInfos[i] = related.R.UserInfos[ln-1]
+		}
+		related.R.UserInfos = related.R.UserInfos[:ln-1]
+		break
+	}
+	return nil
+}
+
 // SetUserAccount of the userInfo to the related item.
 // Sets o.R.UserAccount to related.
 // Adds o to related.R.UserInfos.
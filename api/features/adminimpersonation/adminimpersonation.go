@@ -0,0 +1,58 @@
+package adminimpersonation
+
+import (
+	"context"
+	"log/slog"
+	"mysite/dtos"
+	"mysite/features/adminimpersonation/internal"
+	"mysite/pkgs/auth"
+	"mysite/pkgs/logger"
+	"mysite/utils/httputil"
+	"net/http"
+
+	"github.com/go-chi/render"
+	"github.com/pkg/errors"
+)
+
+type api struct {
+}
+
+type service interface {
+	ImpersonateUser(ctx context.Context, actorId int, userId int) (*dtos.ImpersonationResponse, error)
+}
+
+var newService = func() service {
+	return internal.NewService()
+}
+
+func NewHandler() *api {
+	return &api{}
+}
+
+func (a api) ImpersonateUser(w http.ResponseWriter, r *http.Request, userId int) {
+	principal, found := auth.PrincipalFromContext(r.Context())
+	if !found {
+		if err := render.Render(w, r, httputil.NewFailureRender(errors.Wrap(httputil.ErrUnauthorize, "missing principal"))); err != nil {
+			slog.Error("failed to render", logger.AttrError(err))
+		}
+		return
+	}
+	// the admin has to be signed in themself, not by an api key, an oauth client or another impersonation
+	if principal.ApiKeyID != 0 || principal.ClientID != "" || principal.Impersonated() {
+		if err := render.Render(w, r, httputil.NewFailureRender(errors.Wrap(httputil.ErrForbidden, "impersonation needs the session of the admin"))); err != nil {
+			slog.Error("failed to render", logger.AttrError(err))
+		}
+		return
+	}
+
+	resp, err := newService().ImpersonateUser(r.Context(), principal.UserID, userId)
+	if err != nil {
+		if err := render.Render(w, r, httputil.NewFailureRender(errors.Wrap(err, "failed to impersonate user"))); err != nil {
+			slog.Error("failed to render", logger.AttrError(err))
+		}
+		return
+	}
+
+	slog.Info("impersonation started", slog.Int("userId", userId), slog.Int("actorId", principal.UserID), slog.Time("expiresAt", resp.ExpiresAt))
+	render.JSON(w, r, resp)
+}
@@ -0,0 +1,143 @@
+package adminimpersonation
+
+import (
+	"context"
+	"encoding/json"
+	"mysite/dtos"
+	"mysite/pkgs/auth"
+	"mysite/utils/httputil"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/go-chi/chi/v5"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type mockService struct {
+	ImpersonateUserFunc func(actorId int, userId int) (*dtos.ImpersonationResponse, error)
+}
+
+func (m mockService) ImpersonateUser(ctx context.Context, actorId int, userId int) (*dtos.ImpersonationResponse, error) {
+	return m.ImpersonateUserFunc(actorId, userId)
+}
+
+// withPrincipal sets the admin, or the admin acting as user 3 when the token is "impersonation"
+func withPrincipal(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.Header.Get("Authorization") {
+		case "":
+			next.ServeHTTP(w, r)
+		case "Bearer impersonation":
+			next.ServeHTTP(w, r.WithContext(auth.WithPrincipal(r.Context(), auth.Principal{UserID: 3, ActorID: 1})))
+		default:
+			next.ServeHTTP(w, r.WithContext(auth.WithPrincipal(r.Context(), auth.Principal{UserID: 1, Permissions: []string{"users:impersonate"}})))
+		}
+	})
+}
+
+func newTestRouter() *chi.Mux {
+	router := chi.NewRouter()
+	router.Route("/api/v1", func(subr chi.Router) {
+		subr.Use(withPrincipal)
+		HandlerFromMux(NewHandler(), subr)
+	})
+	return router
+}
+
+func newRequest(path string, token string) (*http.Request, error) {
+	r, err := http.NewRequest(http.MethodPost, "http://example.com/api/v1"+path, nil)
+	if err != nil {
+		return nil, err
+	}
+	r.Header.Set("Authorization", "Bearer "+token)
+	return r, nil
+}
+
+func TestImpersonateUser(t *testing.T) {
+	t.Parallel()
+	router := newTestRouter()
+
+	tests := []struct {
+		name       string
+		req        func(context.Context) (*http.Request, error)
+		assert     func(*httptest.ResponseRecorder, *http.Request)
+		newService func() service
+	}{
+		{
+			name: "401 - without principal",
+			req: func(ctx context.Context) (*http.Request, error) {
+				return http.NewRequest(http.MethodPost, "http://example.com/api/v1/admin/users/2/impersonate", nil)
+			},
+			assert: func(w *httptest.ResponseRecorder, r *http.Request) {
+				assert.Equal(t, http.StatusUnauthorized, w.Result().StatusCode)
+			},
+		},
+		{
+			name: "403 - already impersonating",
+			req: func(ctx context.Context) (*http.Request, error) {
+				return newRequest("/admin/users/2/impersonate", "impersonation")
+			},
+			assert: func(w *httptest.ResponseRecorder, r *http.Request) {
+				assert.Equal(t, http.StatusForbidden, w.Result().StatusCode)
+			},
+		},
+		{
+			name: "404 - unknown user",
+			req: func(ctx context.Context) (*http.Request, error) {
+				return newRequest("/admin/users/99/impersonate", "token")
+			},
+			assert: func(w *httptest.ResponseRecorder, r *http.Request) {
+				assert.Equal(t, http.StatusNotFound, w.Result().StatusCode)
+			},
+			newService: func() service {
+				return mockService{ImpersonateUserFunc: func(actorId int, userId int) (*dtos.ImpersonationResponse, error) {
+					return nil, httputil.ErrNotFound
+				}}
+			},
+		},
+		{
+			name: "200 - success",
+			req: func(ctx context.Context) (*http.Request, error) {
+				return newRequest("/admin/users/2/impersonate", "token")
+			},
+			assert: func(w *httptest.ResponseRecorder, r *http.Request) {
+				assert.Equal(t, http.StatusOK, w.Result().StatusCode)
+
+				var resp dtos.ImpersonationResponse
+				require.NoError(t, json.NewDecoder(w.Body).Decode(&resp))
+				require.Equal(t, "access", resp.AccessToken)
+				require.Equal(t, "Bearer", resp.TokenType)
+				// the session cookie of the admin is left alone
+				require.Empty(t, w.Result().Cookies())
+			},
+			newService: func() service {
+				return mockService{ImpersonateUserFunc: func(actorId int, userId int) (*dtos.ImpersonationResponse, error) {
+					if actorId != 1 || userId != 2 {
+						return nil, httputil.ErrInvalidRequest
+					}
+					return &dtos.ImpersonationResponse{AccessToken: "access", TokenType: "Bearer", ExpiresAt: time.Now().Add(10 * time.Minute)}, nil
+				}}
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+
+		t.Run(tt.name, func(t *testing.T) {
+			newService = tt.newService
+			ctx := context.Background()
+			var err error
+
+			w := httptest.NewRecorder()
+			r, err := tt.req(ctx)
+			if assert.NoError(t, err) {
+				router.ServeHTTP(w, r)
+				tt.assert(w, r)
+			}
+		})
+	}
+}
@@ -0,0 +1,111 @@
+package internal
+
+import (
+	"context"
+	"mysite/constants"
+	"mysite/dtos"
+	"mysite/entities"
+	"mysite/pkgs/auth"
+	"mysite/pkgs/database"
+	"mysite/pkgs/env"
+	"mysite/repositories/impersonationauditrepo"
+	"mysite/repositories/membershiprepo"
+	"mysite/repositories/rolerepo"
+	"mysite/repositories/useraccountrepo"
+	"mysite/utils/httputil"
+	"slices"
+	"strconv"
+	"time"
+
+	"github.com/pkg/errors"
+	"github.com/volatiletech/sqlboiler/v4/boil"
+)
+
+type service struct {
+	repo           useraccountrepo.UserAccountRepo
+	roleRepo       rolerepo.RoleRepo
+	membershipRepo membershiprepo.MembershipRepo
+	auditRepo      impersonationauditrepo.ImpersonationAuditRepo
+	jwtHandler     auth.JwtHandler
+}
+
+func NewService() service {
+	return service{
+		repo:           useraccountrepo.NewRepo(),
+		roleRepo:       rolerepo.NewRepo(),
+		membershipRepo: membershiprepo.NewRepo(),
+		auditRepo:      impersonationauditrepo.NewRepo(),
+		jwtHandler:     auth.NewJwtHandler(),
+	}
+}
+
+// ImpersonateUser issues an access token of the user with the admin in the act claim,
+// there is no refresh token nor session so that it ends at its expiry
+func (s service) ImpersonateUser(ctx context.Context, actorId int, userId int) (*dtos.ImpersonationResponse, error) {
+	if actorId == userId {
+		return nil, errors.Wrap(httputil.ErrInvalidRequest, "can not impersonate oneself")
+	}
+
+	expiresAt := time.Now().Add(impersonationTtl())
+	claims := auth.NewCustomClaims[auth.AccessMetaData]().WithExpireAt(expiresAt)
+	claims.Subject = strconv.Itoa(userId)
+	claims.KeyType = auth.AccessKey
+	claims.Act = &auth.Actor{Subject: strconv.Itoa(actorId)}
+
+	var accessToken string
+	if err := database.NewBoilerTransaction(ctx, func(ctx context.Context, tx boil.ContextTransactor) error {
+		user, err := s.repo.GetActiveUserAccountById(ctx, tx, userId)
+		if err != nil {
+			return errors.Wrap(err, "failed get userAccount")
+		}
+		if user == nil {
+			return errors.Wrap(httputil.ErrNotFound, "user not found")
+		}
+
+		if claims.MetaData.Roles, err = s.roleRepo.GetRoleNamesByUserAccountId(ctx, tx, userId); err != nil {
+			return errors.Wrap(err, "failed get roles")
+		}
+		if claims.MetaData.Permissions, err = s.roleRepo.GetPermissionNamesByUserAccountId(ctx, tx, userId); err != nil {
+			return errors.Wrap(err, "failed get permissions")
+		}
+		// an admin acting as another admin could chain impersonations
+		if slices.Contains(claims.MetaData.Permissions, constants.PermissionUsersImpersonate) {
+			return errors.Wrap(httputil.ErrForbidden, "can not impersonate an admin")
+		}
+		membership, err := s.membershipRepo.GetCurrentMembershipByUserAccountId(ctx, tx, userId)
+		if err != nil {
+			return errors.Wrap(err, "failed get membership")
+		}
+		if membership != nil {
+			claims.MetaData.Membership = membership.Name
+		}
+
+		if accessToken, err = s.jwtHandler.WithClaims(claims).CreateToken(); err != nil {
+			return errors.Wrap(err, "failed create accessKey")
+		}
+
+		// the token is not handed out unless it was audited
+		return s.auditRepo.Insert(ctx, tx, &entities.ImpersonationAudit{
+			ActorUserAccountID: actorId,
+			UserAccountID:      userId,
+			TokenID:            claims.ID,
+			Action:             constants.ImpersonationIssued,
+		})
+	}); err != nil {
+		return nil, err
+	}
+
+	return &dtos.ImpersonationResponse{
+		AccessToken: accessToken,
+		TokenType:   "Bearer",
+		ExpiresAt:   expiresAt,
+	}, nil
+}
+
+func impersonationTtl() time.Duration {
+	minutes := env.GetEnv().Impersonation.ExpireMinutes
+	if minutes <= 0 {
+		minutes = 10
+	}
+	return time.Duration(minutes) * time.Minute
+}
@@ -0,0 +1,122 @@
+package internal
+
+import (
+	"context"
+	"fmt"
+	"mysite/constants"
+	"mysite/entities"
+	"mysite/pkgs/auth"
+	"mysite/pkgs/database"
+	"mysite/testing/dbtest"
+	"mysite/testing/mocking/repomock"
+	"mysite/utils/httputil"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"github.com/volatiletech/sqlboiler/v4/boil"
+)
+
+func TestMain(m *testing.M) {
+	pool, resource, err := dbtest.SetupDatabaseForTesting()
+	if err != nil {
+		return
+	}
+
+	defer func() {
+		database.Close()
+		if err := dbtest.PurgeResource(pool, resource); err != nil {
+			fmt.Println("failed to purge resource")
+		}
+	}()
+	m.Run()
+}
+
+func TestImpersonateUser(t *testing.T) {
+	t.Parallel()
+	require.NoError(t, database.SetupDatabase())
+	ctx := dbtest.SetTestTransactionCtx(context.Background())
+	newRepoMock := func(user *entities.UserAccount) *repomock.UserAccountRepoMock {
+		return &repomock.UserAccountRepoMock{
+			GetActiveUserAccountByIdFunc: func(ctx context.Context, tx boil.ContextTransactor, userId int) (*entities.UserAccount, error) {
+				return user, nil
+			},
+		}
+	}
+	newRoleMock := func(permissions ...string) *repomock.RoleRepoMock {
+		return &repomock.RoleRepoMock{
+			GetRoleNamesByUserAccountIdFunc: func(ctx context.Context, tx boil.ContextTransactor, userAccountId int) ([]string, error) {
+				return []string{constants.RoleMember}, nil
+			},
+			GetPermissionNamesByUserAccountIdFunc: func(ctx context.Context, tx boil.ContextTransactor, userAccountId int) ([]string, error) {
+				return permissions, nil
+			},
+		}
+	}
+	membershipMock := &repomock.MembershipRepoMock{
+		GetCurrentMembershipByUserAccountIdFunc: func(ctx context.Context, tx boil.ContextTransactor, userAccountId int) (*entities.Membership, error) {
+			return &entities.Membership{Name: "silver"}, nil
+		},
+	}
+	newAuditMock := func() *repomock.ImpersonationAuditRepoMock {
+		return &repomock.ImpersonationAuditRepoMock{
+			InsertFunc: func(ctx context.Context, tx boil.ContextTransactor, audit *entities.ImpersonationAudit) error {
+				return nil
+			},
+		}
+	}
+
+	{ // token of the user with the admin as actor
+		auditMock := newAuditMock()
+		svc := service{
+			repo:           newRepoMock(&entities.UserAccount{ID: 2}),
+			roleRepo:       newRoleMock(constants.PermissionUsersRead),
+			membershipRepo: membershipMock,
+			auditRepo:      auditMock,
+			jwtHandler:     auth.NewJwtHandler(),
+		}
+
+		resp, err := svc.ImpersonateUser(ctx, 1, 2)
+		require.NoError(t, err)
+		require.Equal(t, "Bearer", resp.TokenType)
+		require.WithinDuration(t, time.Now().Add(10*time.Minute), resp.ExpiresAt, time.Minute)
+
+		var claims auth.CustomClaims[auth.AccessMetaData]
+		require.NoError(t, auth.NewJwtHandler().ParseToken(resp.AccessToken, &claims))
+		require.Equal(t, "2", claims.Subject)
+		require.Equal(t, "1", claims.Act.Subject)
+		require.Equal(t, auth.AccessKey, claims.KeyType)
+		require.Equal(t, "silver", claims.MetaData.Membership)
+
+		require.Len(t, auditMock.InsertCalls(), 1)
+		audit := auditMock.InsertCalls()[0].Audit
+		require.Equal(t, 1, audit.ActorUserAccountID)
+		require.Equal(t, 2, audit.UserAccountID)
+		require.Equal(t, claims.ID, audit.TokenID)
+		require.Equal(t, constants.ImpersonationIssued, audit.Action)
+	}
+	{ // oneself
+		auditMock := newAuditMock()
+		svc := service{repo: newRepoMock(&entities.UserAccount{ID: 1}), roleRepo: newRoleMock(), membershipRepo: membershipMock, auditRepo: auditMock, jwtHandler: auth.NewJwtHandler()}
+
+		_, err := svc.ImpersonateUser(ctx, 1, 1)
+		require.ErrorIs(t, err, httputil.ErrInvalidRequest)
+		require.Empty(t, auditMock.InsertCalls())
+	}
+	{ // user not found
+		auditMock := newAuditMock()
+		svc := service{repo: newRepoMock(nil), roleRepo: newRoleMock(), membershipRepo: membershipMock, auditRepo: auditMock, jwtHandler: auth.NewJwtHandler()}
+
+		_, err := svc.ImpersonateUser(ctx, 1, 2)
+		require.ErrorIs(t, err, httputil.ErrNotFound)
+		require.Empty(t, auditMock.InsertCalls())
+	}
+	{ // another admin
+		auditMock := newAuditMock()
+		svc := service{repo: newRepoMock(&entities.UserAccount{ID: 2}), roleRepo: newRoleMock(constants.PermissionUsersImpersonate), membershipRepo: membershipMock, auditRepo: auditMock, jwtHandler: auth.NewJwtHandler()}
+
+		_, err := svc.ImpersonateUser(ctx, 1, 2)
+		require.ErrorIs(t, err, httputil.ErrForbidden)
+		require.Empty(t, auditMock.InsertCalls())
+	}
+}
@@ -0,0 +1,67 @@
+package adminloyalty
+
+import (
+	"context"
+	"log/slog"
+	"mysite/dtos"
+	"mysite/features/adminloyalty/internal"
+	"mysite/pkgs/auth"
+	"mysite/pkgs/logger"
+	"mysite/utils/httputil"
+	"net/http"
+
+	"github.com/go-chi/render"
+	"github.com/pkg/errors"
+)
+
+type api struct {
+}
+
+type service interface {
+	AdjustLoyaltyPoints(ctx context.Context, userId int, req internal.AdjustLoyaltyPointsRequest) (*dtos.LoyaltyPointsResponse, error)
+}
+
+var newService = func() service {
+	return internal.NewService()
+}
+
+func NewHandler() *api {
+	return &api{}
+}
+
+func (a api) AdjustLoyaltyPoints(w http.ResponseWriter, r *http.Request, userId int) {
+	principal, found := auth.PrincipalFromContext(r.Context())
+	if !found {
+		if err := render.Render(w, r, httputil.NewFailureRender(errors.Wrap(httputil.ErrUnauthorize, "missing principal"))); err != nil {
+			slog.Error("failed to render", logger.AttrError(err))
+		}
+		return
+	}
+
+	var body dtos.AdjustLoyaltyPointsRequest
+	if err := httputil.ParseBody(r, &body); err != nil {
+		if err := render.Render(w, r, httputil.NewFailureRender(errors.Wrap(err, "failed to parse body"))); err != nil {
+			slog.Error("failed to render", logger.AttrError(err))
+		}
+		return
+	}
+
+	params, err := internal.NewParams(body)
+	if err != nil {
+		if err := render.Render(w, r, httputil.NewFailureRender(errors.Wrap(err, "failed to parse params"))); err != nil {
+			slog.Error("failed to render", logger.AttrError(err))
+		}
+		return
+	}
+
+	resp, err := newService().AdjustLoyaltyPoints(r.Context(), userId, *params)
+	if err != nil {
+		if err := render.Render(w, r, httputil.NewFailureRender(errors.Wrap(err, "failed to adjust loyalty points"))); err != nil {
+			slog.Error("failed to render", logger.AttrError(err))
+		}
+		return
+	}
+
+	slog.Info("loyalty points adjusted", slog.Int("userId", userId), slog.Int("points", params.Points), slog.Int("changedBy", principal.UserID))
+	render.JSON(w, r, resp)
+}
@@ -0,0 +1,132 @@
+package adminloyalty
+
+import (
+	"bytes"
+	"context"
+	"mysite/dtos"
+	"mysite/features/adminloyalty/internal"
+	"mysite/pkgs/auth"
+	"mysite/utils/httputil"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/go-chi/chi/v5"
+	"github.com/stretchr/testify/assert"
+)
+
+type mockService struct {
+	AdjustLoyaltyPointsFunc func(userId int, req internal.AdjustLoyaltyPointsRequest) (*dtos.LoyaltyPointsResponse, error)
+}
+
+func (m mockService) AdjustLoyaltyPoints(ctx context.Context, userId int, req internal.AdjustLoyaltyPointsRequest) (*dtos.LoyaltyPointsResponse, error) {
+	return m.AdjustLoyaltyPointsFunc(userId, req)
+}
+
+func withPrincipal(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Header.Get("Authorization") == "" {
+			next.ServeHTTP(w, r)
+			return
+		}
+		next.ServeHTTP(w, r.WithContext(auth.WithPrincipal(r.Context(), auth.Principal{UserID: 1})))
+	})
+}
+
+func newTestRouter() *chi.Mux {
+	router := chi.NewRouter()
+	router.Route("/api/v1", func(subr chi.Router) {
+		subr.Use(withPrincipal)
+		HandlerFromMux(NewHandler(), subr)
+	})
+	return router
+}
+
+func newRequest(path string, body string) (*http.Request, error) {
+	r, err := http.NewRequest(http.MethodPost, "http://example.com/api/v1"+path, bytes.NewBufferString(body))
+	if err != nil {
+		return nil, err
+	}
+	r.Header.Set("Authorization", "Bearer token")
+	r.Header.Set("Content-Type", "application/json")
+	return r, nil
+}
+
+func TestAdjustLoyaltyPoints(t *testing.T) {
+	t.Parallel()
+	router := newTestRouter()
+
+	tests := []struct {
+		name       string
+		req        func(context.Context) (*http.Request, error)
+		assert     func(*httptest.ResponseRecorder, *http.Request)
+		newService func() service
+	}{
+		{
+			name: "200",
+			req: func(ctx context.Context) (*http.Request, error) {
+				return newRequest("/admin/users/2/loyalty-points", `{"points":-300,"reason":"refund"}`)
+			},
+			assert: func(w *httptest.ResponseRecorder, r *http.Request) {
+				assert.Equal(t, http.StatusOK, w.Result().StatusCode)
+			},
+			newService: func() service {
+				return mockService{AdjustLoyaltyPointsFunc: func(userId int, req internal.AdjustLoyaltyPointsRequest) (*dtos.LoyaltyPointsResponse, error) {
+					if userId != 2 || req.Points != -300 || req.Reason != "refund" {
+						return nil, httputil.ErrInvalidRequest
+					}
+					return &dtos.LoyaltyPointsResponse{Points: 900, Membership: "bronze"}, nil
+				}}
+			},
+		},
+		{
+			name: "400 - empty body",
+			req: func(ctx context.Context) (*http.Request, error) {
+				return newRequest("/admin/users/2/loyalty-points", ``)
+			},
+			assert: func(w *httptest.ResponseRecorder, r *http.Request) {
+				assert.Equal(t, http.StatusBadRequest, w.Result().StatusCode)
+			},
+		},
+		{
+			name: "404 - unknown user",
+			req: func(ctx context.Context) (*http.Request, error) {
+				return newRequest("/admin/users/2/loyalty-points", `{"points":100,"reason":"order"}`)
+			},
+			assert: func(w *httptest.ResponseRecorder, r *http.Request) {
+				assert.Equal(t, http.StatusNotFound, w.Result().StatusCode)
+			},
+			newService: func() service {
+				return mockService{AdjustLoyaltyPointsFunc: func(userId int, req internal.AdjustLoyaltyPointsRequest) (*dtos.LoyaltyPointsResponse, error) {
+					return nil, httputil.ErrNotFound
+				}}
+			},
+		},
+		{
+			name: "401 - without principal",
+			req: func(ctx context.Context) (*http.Request, error) {
+				return http.NewRequest(http.MethodPost, "http://example.com/api/v1/admin/users/2/loyalty-points", bytes.NewBufferString(`{"points":100,"reason":"order"}`))
+			},
+			assert: func(w *httptest.ResponseRecorder, r *http.Request) {
+				assert.Equal(t, http.StatusUnauthorized, w.Result().StatusCode)
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+
+		t.Run(tt.name, func(t *testing.T) {
+			newService = tt.newService
+			ctx := context.Background()
+			var err error
+
+			w := httptest.NewRecorder()
+			r, err := tt.req(ctx)
+			if assert.NoError(t, err) {
+				router.ServeHTTP(w, r)
+				tt.assert(w, r)
+			}
+		})
+	}
+}
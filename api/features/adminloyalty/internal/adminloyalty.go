@@ -0,0 +1,64 @@
+package internal
+
+import (
+	"context"
+	"mysite/dtos"
+	"mysite/pkgs/loyalty"
+	"mysite/pkgs/validate"
+	"mysite/utils/httputil"
+
+	"github.com/mitchellh/mapstructure"
+	"github.com/pkg/errors"
+)
+
+type service struct {
+	loyalty loyalty.Service
+}
+
+type AdjustLoyaltyPointsRequest struct {
+	// Points to credit, negative to debit
+	Points int `validate:"required"`
+
+	// Reason kept in the loyalty ledger
+	Reason string `validate:"required,max=200"`
+}
+
+func NewService() service {
+	return service{
+		loyalty: loyalty.NewService(),
+	}
+}
+
+func NewParams(req dtos.AdjustLoyaltyPointsJSONRequestBody) (*AdjustLoyaltyPointsRequest, error) {
+	var result AdjustLoyaltyPointsRequest
+	if err := mapstructure.Decode(req, &result); err != nil {
+		return nil, errors.Wrap(err, "failed decode")
+	}
+
+	return &result, nil
+}
+
+// AdjustLoyaltyPoints credits or debits the user by the sign of the points
+func (s service) AdjustLoyaltyPoints(ctx context.Context, userId int, req AdjustLoyaltyPointsRequest) (*dtos.LoyaltyPointsResponse, error) {
+	if err := validate.ValidateStruct(req); err != nil {
+		return nil, errors.Wrap(httputil.ErrInvalidRequest, err.Error())
+	}
+
+	var (
+		balance *loyalty.Balance
+		err     error
+	)
+	if req.Points > 0 {
+		balance, err = s.loyalty.Credit(ctx, userId, req.Points, req.Reason)
+	} else {
+		balance, err = s.loyalty.Debit(ctx, userId, -req.Points, req.Reason)
+	}
+	if err != nil {
+		return nil, errors.Wrap(err, "failed adjust loyalty points")
+	}
+
+	return &dtos.LoyaltyPointsResponse{
+		Points:     balance.Points,
+		Membership: balance.Membership.Name,
+	}, nil
+}
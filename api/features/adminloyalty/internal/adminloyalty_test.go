@@ -0,0 +1,61 @@
+package internal
+
+import (
+	"context"
+	"mysite/entities"
+	"mysite/pkgs/loyalty"
+	"mysite/testing/mocking/pkgmock"
+	"mysite/utils/httputil"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestAdjustLoyaltyPoints(t *testing.T) {
+	t.Parallel()
+	ctx := context.Background()
+	newLoyaltyMock := func() *pkgmock.LoyaltyServiceMock {
+		balance := &loyalty.Balance{Points: 1200, Membership: entities.Membership{Name: "silver"}}
+		return &pkgmock.LoyaltyServiceMock{
+			CreditFunc: func(ctx context.Context, userId int, points int, reason string) (*loyalty.Balance, error) {
+				return balance, nil
+			},
+			DebitFunc: func(ctx context.Context, userId int, points int, reason string) (*loyalty.Balance, error) {
+				return balance, nil
+			},
+		}
+	}
+
+	{ // credit
+		loyaltyMock := newLoyaltyMock()
+		svc := service{loyalty: loyaltyMock}
+
+		resp, err := svc.AdjustLoyaltyPoints(ctx, 2, AdjustLoyaltyPointsRequest{Points: 300, Reason: "order"})
+		require.NoError(t, err)
+		require.Equal(t, 1200, resp.Points)
+		require.Equal(t, "silver", resp.Membership)
+		require.Len(t, loyaltyMock.CreditCalls(), 1)
+		require.Equal(t, 300, loyaltyMock.CreditCalls()[0].Points)
+		require.Empty(t, loyaltyMock.DebitCalls())
+	}
+	{ // debit
+		loyaltyMock := newLoyaltyMock()
+		svc := service{loyalty: loyaltyMock}
+
+		_, err := svc.AdjustLoyaltyPoints(ctx, 2, AdjustLoyaltyPointsRequest{Points: -300, Reason: "refund"})
+		require.NoError(t, err)
+		require.Len(t, loyaltyMock.DebitCalls(), 1)
+		require.Equal(t, 300, loyaltyMock.DebitCalls()[0].Points)
+		require.Empty(t, loyaltyMock.CreditCalls())
+	}
+	{ // invalid request
+		loyaltyMock := newLoyaltyMock()
+		svc := service{loyalty: loyaltyMock}
+
+		_, err := svc.AdjustLoyaltyPoints(ctx, 2, AdjustLoyaltyPointsRequest{Points: 0, Reason: "order"})
+		require.ErrorIs(t, err, httputil.ErrInvalidRequest)
+		_, err = svc.AdjustLoyaltyPoints(ctx, 2, AdjustLoyaltyPointsRequest{Points: 10})
+		require.ErrorIs(t, err, httputil.ErrInvalidRequest)
+		require.Empty(t, loyaltyMock.CreditCalls())
+	}
+}
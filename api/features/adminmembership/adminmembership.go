@@ -0,0 +1,67 @@
+package adminmembership
+
+import (
+	"context"
+	"log/slog"
+	"mysite/dtos"
+	"mysite/features/adminmembership/internal"
+	"mysite/pkgs/auth"
+	"mysite/pkgs/logger"
+	"mysite/utils/httputil"
+	"net/http"
+
+	"github.com/go-chi/render"
+	"github.com/pkg/errors"
+)
+
+type api struct {
+}
+
+type service interface {
+	ChangeMembership(ctx context.Context, actorId int, userId int, req internal.ChangeMembershipRequest) (*dtos.MembershipResponse, error)
+}
+
+var newService = func() service {
+	return internal.NewService()
+}
+
+func NewHandler() *api {
+	return &api{}
+}
+
+func (a api) ChangeMembership(w http.ResponseWriter, r *http.Request, userId int) {
+	principal, found := auth.PrincipalFromContext(r.Context())
+	if !found {
+		if err := render.Render(w, r, httputil.NewFailureRender(errors.Wrap(httputil.ErrUnauthorize, "missing principal"))); err != nil {
+			slog.Error("failed to render", logger.AttrError(err))
+		}
+		return
+	}
+
+	var body dtos.ChangeMembershipRequest
+	if err := httputil.ParseBody(r, &body); err != nil {
+		if err := render.Render(w, r, httputil.NewFailureRender(errors.Wrap(err, "failed to parse body"))); err != nil {
+			slog.Error("failed to render", logger.AttrError(err))
+		}
+		return
+	}
+
+	params, err := internal.NewParams(body)
+	if err != nil {
+		if err := render.Render(w, r, httputil.NewFailureRender(errors.Wrap(err, "failed to parse params"))); err != nil {
+			slog.Error("failed to render", logger.AttrError(err))
+		}
+		return
+	}
+
+	resp, err := newService().ChangeMembership(r.Context(), principal.UserID, userId, *params)
+	if err != nil {
+		if err := render.Render(w, r, httputil.NewFailureRender(errors.Wrap(err, "failed to change membership"))); err != nil {
+			slog.Error("failed to render", logger.AttrError(err))
+		}
+		return
+	}
+
+	slog.Info("membership changed", slog.Int("userId", userId), slog.String("membership", resp.Name), slog.Int("changedBy", principal.UserID))
+	render.JSON(w, r, resp)
+}
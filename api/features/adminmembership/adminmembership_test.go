@@ -0,0 +1,132 @@
+package adminmembership
+
+import (
+	"bytes"
+	"context"
+	"mysite/dtos"
+	"mysite/features/adminmembership/internal"
+	"mysite/pkgs/auth"
+	"mysite/utils/httputil"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/go-chi/chi/v5"
+	"github.com/stretchr/testify/assert"
+)
+
+type mockService struct {
+	ChangeMembershipFunc func(actorId int, userId int, req internal.ChangeMembershipRequest) (*dtos.MembershipResponse, error)
+}
+
+func (m mockService) ChangeMembership(ctx context.Context, actorId int, userId int, req internal.ChangeMembershipRequest) (*dtos.MembershipResponse, error) {
+	return m.ChangeMembershipFunc(actorId, userId, req)
+}
+
+func withPrincipal(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Header.Get("Authorization") == "" {
+			next.ServeHTTP(w, r)
+			return
+		}
+		next.ServeHTTP(w, r.WithContext(auth.WithPrincipal(r.Context(), auth.Principal{UserID: 1})))
+	})
+}
+
+func newTestRouter() *chi.Mux {
+	router := chi.NewRouter()
+	router.Route("/api/v1", func(subr chi.Router) {
+		subr.Use(withPrincipal)
+		HandlerFromMux(NewHandler(), subr)
+	})
+	return router
+}
+
+func newRequest(path string, body string) (*http.Request, error) {
+	r, err := http.NewRequest(http.MethodPut, "http://example.com/api/v1"+path, bytes.NewBufferString(body))
+	if err != nil {
+		return nil, err
+	}
+	r.Header.Set("Authorization", "Bearer token")
+	r.Header.Set("Content-Type", "application/json")
+	return r, nil
+}
+
+func TestChangeMembership(t *testing.T) {
+	t.Parallel()
+	router := newTestRouter()
+
+	tests := []struct {
+		name       string
+		req        func(context.Context) (*http.Request, error)
+		assert     func(*httptest.ResponseRecorder, *http.Request)
+		newService func() service
+	}{
+		{
+			name: "200",
+			req: func(ctx context.Context) (*http.Request, error) {
+				return newRequest("/admin/users/2/membership", `{"membership":"gold","reason":"promotion"}`)
+			},
+			assert: func(w *httptest.ResponseRecorder, r *http.Request) {
+				assert.Equal(t, http.StatusOK, w.Result().StatusCode)
+			},
+			newService: func() service {
+				return mockService{ChangeMembershipFunc: func(actorId int, userId int, req internal.ChangeMembershipRequest) (*dtos.MembershipResponse, error) {
+					if actorId != 1 || userId != 2 || req.Membership != "gold" || req.Reason == nil || *req.Reason != "promotion" {
+						return nil, httputil.ErrInvalidRequest
+					}
+					return &dtos.MembershipResponse{Name: "gold", Rank: 3, Benefits: []string{}}, nil
+				}}
+			},
+		},
+		{
+			name: "400 - empty body",
+			req: func(ctx context.Context) (*http.Request, error) {
+				return newRequest("/admin/users/2/membership", ``)
+			},
+			assert: func(w *httptest.ResponseRecorder, r *http.Request) {
+				assert.Equal(t, http.StatusBadRequest, w.Result().StatusCode)
+			},
+		},
+		{
+			name: "404 - unknown membership",
+			req: func(ctx context.Context) (*http.Request, error) {
+				return newRequest("/admin/users/2/membership", `{"membership":"unknown"}`)
+			},
+			assert: func(w *httptest.ResponseRecorder, r *http.Request) {
+				assert.Equal(t, http.StatusNotFound, w.Result().StatusCode)
+			},
+			newService: func() service {
+				return mockService{ChangeMembershipFunc: func(actorId int, userId int, req internal.ChangeMembershipRequest) (*dtos.MembershipResponse, error) {
+					return nil, httputil.ErrNotFound
+				}}
+			},
+		},
+		{
+			name: "401 - without principal",
+			req: func(ctx context.Context) (*http.Request, error) {
+				return http.NewRequest(http.MethodPut, "http://example.com/api/v1/admin/users/2/membership", bytes.NewBufferString(`{"membership":"gold"}`))
+			},
+			assert: func(w *httptest.ResponseRecorder, r *http.Request) {
+				assert.Equal(t, http.StatusUnauthorized, w.Result().StatusCode)
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+
+		t.Run(tt.name, func(t *testing.T) {
+			newService = tt.newService
+			ctx := context.Background()
+			var err error
+
+			w := httptest.NewRecorder()
+			r, err := tt.req(ctx)
+			if assert.NoError(t, err) {
+				router.ServeHTTP(w, r)
+				tt.assert(w, r)
+			}
+		})
+	}
+}
@@ -0,0 +1,98 @@
+package internal
+
+import (
+	"context"
+	"mysite/dtos"
+	"mysite/entities"
+	"mysite/pkgs/database"
+	"mysite/pkgs/tier"
+	"mysite/pkgs/validate"
+	"mysite/repositories/membershiprepo"
+	"mysite/repositories/userinforepo"
+	"mysite/utils/httputil"
+
+	"github.com/mitchellh/mapstructure"
+	"github.com/pkg/errors"
+	"github.com/volatiletech/null/v8"
+	"github.com/volatiletech/sqlboiler/v4/boil"
+)
+
+type service struct {
+	repo           userinforepo.UserInfoRepo
+	membershipRepo membershiprepo.MembershipRepo
+	changer        tier.Changer
+}
+
+type ChangeMembershipRequest struct {
+	// Membership name of the new tier
+	Membership string `validate:"required,max=50"`
+
+	// Reason kept in the membership history
+	Reason *string `validate:"omitempty,max=200"`
+}
+
+func NewService() service {
+	return service{
+		repo:           userinforepo.NewRepo(),
+		membershipRepo: membershiprepo.NewRepo(),
+		changer:        tier.NewChanger(),
+	}
+}
+
+func NewParams(req dtos.ChangeMembershipJSONRequestBody) (*ChangeMembershipRequest, error) {
+	var result ChangeMembershipRequest
+	if err := mapstructure.Decode(req, &result); err != nil {
+		return nil, errors.Wrap(err, "failed decode")
+	}
+
+	return &result, nil
+}
+
+// ChangeMembership moves the user to the tier and records who changed it, the current tier again is a no-op
+func (s service) ChangeMembership(ctx context.Context, actorId int, userId int, req ChangeMembershipRequest) (*dtos.MembershipResponse, error) {
+	if err := validate.ValidateStruct(req); err != nil {
+		return nil, errors.Wrap(httputil.ErrInvalidRequest, err.Error())
+	}
+
+	var resp *dtos.MembershipResponse
+	if err := database.NewBoilerTransaction(ctx, func(ctx context.Context, tx boil.ContextTransactor) error {
+		pgUserAccount, err := s.repo.GetActiveUserAccountWithUserInfo(ctx, tx, userId)
+		if err != nil {
+			return errors.Wrap(err, "failed get userAccount with userInfo")
+		}
+		if pgUserAccount == nil {
+			return errors.Wrap(httputil.ErrNotFound, "user not found")
+		}
+
+		membership, err := s.membershipRepo.GetMembershipByName(ctx, tx, req.Membership)
+		if err != nil {
+			return errors.Wrap(err, "failed get membership")
+		}
+		if membership == nil {
+			return errors.Wrapf(httputil.ErrNotFound, "membership %s not found", req.Membership)
+		}
+		resp = newMembershipResponse(*membership)
+
+		_, err = s.changer.Change(ctx, tx, pgUserAccount, *membership, tier.Change{
+			ChangedBy: null.IntFrom(actorId),
+			Reason:    null.StringFromPtr(req.Reason),
+		})
+		return err
+	}); err != nil {
+		return nil, errors.Wrap(err, "failed change membership")
+	}
+
+	return resp, nil
+}
+
+func newMembershipResponse(membership entities.Membership) *dtos.MembershipResponse {
+	benefits := []string(membership.Benefits)
+	if benefits == nil {
+		benefits = []string{}
+	}
+	return &dtos.MembershipResponse{
+		Name:     membership.Name,
+		Rank:     membership.Rank,
+		Benefits: benefits,
+	}
+}
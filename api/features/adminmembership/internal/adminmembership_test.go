@@ -0,0 +1,107 @@
+package internal
+
+import (
+	"context"
+	"fmt"
+	"mysite/constants"
+	"mysite/entities"
+	"mysite/pkgs/database"
+	"mysite/pkgs/tier"
+	"mysite/testing/dbtest"
+	"mysite/testing/mocking/pkgmock"
+	"mysite/testing/mocking/repomock"
+	"mysite/utils/httputil"
+	"mysite/utils/ptrconv"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"github.com/volatiletech/null/v8"
+	"github.com/volatiletech/sqlboiler/v4/boil"
+)
+
+func TestMain(m *testing.M) {
+	pool, resource, err := dbtest.SetupDatabaseForTesting()
+	if err != nil {
+		return
+	}
+
+	defer func() {
+		database.Close()
+		if err := dbtest.PurgeResource(pool, resource); err != nil {
+			fmt.Println("failed to purge resource")
+		}
+	}()
+	m.Run()
+}
+
+func newUserAccount(userInfos ...*entities.UserInfo) *entities.UserAccount {
+	pgUserAccount := &entities.UserAccount{ID: 2}
+	pgUserAccount.R = pgUserAccount.R.NewStruct()
+	pgUserAccount.R.UserInfos = userInfos
+	return pgUserAccount
+}
+
+func TestChangeMembership(t *testing.T) {
+	t.Parallel()
+	require.NoError(t, database.SetupDatabase())
+	ctx := dbtest.SetTestTransactionCtx(context.Background())
+	newRepoMock := func(user *entities.UserAccount) *repomock.UserInfoRepoMock {
+		return &repomock.UserInfoRepoMock{
+			GetActiveUserAccountWithUserInfoFunc: func(ctx context.Context, tx boil.ContextTransactor, userAccountId int) (*entities.UserAccount, error) {
+				return user, nil
+			},
+		}
+	}
+	newMembershipMock := func(membership *entities.Membership) *repomock.MembershipRepoMock {
+		return &repomock.MembershipRepoMock{
+			GetMembershipByNameFunc: func(ctx context.Context, tx boil.ContextTransactor, name string) (*entities.Membership, error) {
+				return membership, nil
+			},
+		}
+	}
+	newChangerMock := func() *pkgmock.ChangerMock {
+		return &pkgmock.ChangerMock{
+			ChangeFunc: func(ctx context.Context, tx boil.ContextTransactor, pgUserAccount *entities.UserAccount, membership entities.Membership, change tier.Change) (bool, error) {
+				return true, nil
+			},
+		}
+	}
+	gold := &entities.Membership{ID: constants.Gold, Name: "gold", Rank: constants.Gold}
+
+	{ // change success
+		changerMock := newChangerMock()
+		svc := service{repo: newRepoMock(newUserAccount()), membershipRepo: newMembershipMock(gold), changer: changerMock}
+
+		resp, err := svc.ChangeMembership(ctx, 1, 2, ChangeMembershipRequest{Membership: "gold", Reason: ptrconv.String("promotion")})
+		require.NoError(t, err)
+		require.Equal(t, "gold", resp.Name)
+		require.Len(t, changerMock.ChangeCalls(), 1)
+		call := changerMock.ChangeCalls()[0]
+		require.Equal(t, 2, call.PgUserAccount.ID)
+		require.Equal(t, constants.Gold, call.Membership.ID)
+		require.Equal(t, null.IntFrom(1), call.Change.ChangedBy)
+		require.Equal(t, null.StringFrom("promotion"), call.Change.Reason)
+	}
+	{ // change failed, user not found
+		changerMock := newChangerMock()
+		svc := service{repo: newRepoMock(nil), membershipRepo: newMembershipMock(gold), changer: changerMock}
+
+		_, err := svc.ChangeMembership(ctx, 1, 2, ChangeMembershipRequest{Membership: "gold"})
+		require.ErrorIs(t, err, httputil.ErrNotFound)
+		require.Empty(t, changerMock.ChangeCalls())
+	}
+	{ // change failed, membership not found
+		changerMock := newChangerMock()
+		svc := service{repo: newRepoMock(newUserAccount()), membershipRepo: newMembershipMock(nil), changer: changerMock}
+
+		_, err := svc.ChangeMembership(ctx, 1, 2, ChangeMembershipRequest{Membership: "unknown"})
+		require.ErrorIs(t, err, httputil.ErrNotFound)
+		require.Empty(t, changerMock.ChangeCalls())
+	}
+	{ // change failed, invalid request
+		svc := service{repo: newRepoMock(newUserAccount()), membershipRepo: newMembershipMock(gold), changer: newChangerMock()}
+
+		_, err := svc.ChangeMembership(ctx, 1, 2, ChangeMembershipRequest{})
+		require.ErrorIs(t, err, httputil.ErrInvalidRequest)
+	}
+}
@@ -0,0 +1,94 @@
+package adminoauth
+
+import (
+	"context"
+	"log/slog"
+	"mysite/dtos"
+	"mysite/features/adminoauth/internal"
+	"mysite/pkgs/auth"
+	"mysite/pkgs/logger"
+	"mysite/utils/httputil"
+	"net/http"
+
+	"github.com/go-chi/render"
+	"github.com/pkg/errors"
+)
+
+type api struct {
+}
+
+type service interface {
+	RegisterOauthClient(ctx context.Context, permissions []string, req internal.RegisterOauthClientRequest) (*dtos.RegisterOauthClientResponse, error)
+	ListOauthClients(ctx context.Context) (*dtos.OauthClientsResponse, error)
+	DeleteOauthClient(ctx context.Context, clientId string) error
+}
+
+var newService = func() service {
+	return internal.NewService()
+}
+
+func NewHandler() *api {
+	return &api{}
+}
+
+func (a api) RegisterOauthClient(w http.ResponseWriter, r *http.Request) {
+	principal, found := auth.PrincipalFromContext(r.Context())
+	if !found {
+		if err := render.Render(w, r, httputil.NewFailureRender(errors.Wrap(httputil.ErrUnauthorize, "missing principal"))); err != nil {
+			slog.Error("failed to render", logger.AttrError(err))
+		}
+		return
+	}
+
+	var body dtos.RegisterOauthClientJSONRequestBody
+	if err := httputil.ParseBody(r, &body); err != nil {
+		if err := render.Render(w, r, httputil.NewFailureRender(errors.Wrap(err, "failed to parse body"))); err != nil {
+			slog.Error("failed to render", logger.AttrError(err))
+		}
+		return
+	}
+
+	params, err := internal.NewParams(body)
+	if err != nil {
+		if err := render.Render(w, r, httputil.NewFailureRender(errors.Wrap(err, "failed to parse params"))); err != nil {
+			slog.Error("failed to render", logger.AttrError(err))
+		}
+		return
+	}
+
+	resp, err := newService().RegisterOauthClient(r.Context(), principal.Permissions, *params)
+	if err != nil {
+		if err := render.Render(w, r, httputil.NewFailureRender(errors.Wrap(err, "failed to register oauth client"))); err != nil {
+			slog.Error("failed to render", logger.AttrError(err))
+		}
+		return
+	}
+
+	slog.Info("oauth client registered", slog.Int("actorId", principal.UserID), slog.String("clientId", resp.Client.ClientId))
+	render.Status(r, http.StatusCreated)
+	render.JSON(w, r, resp)
+}
+
+func (a api) ListOauthClients(w http.ResponseWriter, r *http.Request) {
+	resp, err := newService().ListOauthClients(r.Context())
+	if err != nil {
+		if err := render.Render(w, r, httputil.NewFailureRender(errors.Wrap(err, "failed to list oauth clients"))); err != nil {
+			slog.Error("failed to render", logger.AttrError(err))
+		}
+		return
+	}
+
+	render.JSON(w, r, resp)
+}
+
+func (a api) DeleteOauthClient(w http.ResponseWriter, r *http.Request, clientId string) {
+	if err := newService().DeleteOauthClient(r.Context(), clientId); err != nil {
+		if err := render.Render(w, r, httputil.NewFailureRender(errors.Wrap(err, "failed to delete oauth client"))); err != nil {
+			slog.Error("failed to render", logger.AttrError(err))
+		}
+		return
+	}
+
+	slog.Info("oauth client deleted", slog.String("clientId", clientId))
+	w.WriteHeader(http.StatusNoContent)
+}
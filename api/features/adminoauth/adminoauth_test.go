@@ -0,0 +1,196 @@
+package adminoauth
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"mysite/dtos"
+	"mysite/features/adminoauth/internal"
+	"mysite/pkgs/auth"
+	"mysite/utils/httputil"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/go-chi/chi/v5"
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type mockService struct {
+	RegisterOauthClientFunc func(permissions []string, req internal.RegisterOauthClientRequest) (*dtos.RegisterOauthClientResponse, error)
+	ListOauthClientsFunc    func() (*dtos.OauthClientsResponse, error)
+	DeleteOauthClientFunc   func(clientId string) error
+}
+
+func (m mockService) RegisterOauthClient(ctx context.Context, permissions []string, req internal.RegisterOauthClientRequest) (*dtos.RegisterOauthClientResponse, error) {
+	return m.RegisterOauthClientFunc(permissions, req)
+}
+
+func (m mockService) ListOauthClients(ctx context.Context) (*dtos.OauthClientsResponse, error) {
+	return m.ListOauthClientsFunc()
+}
+
+func (m mockService) DeleteOauthClient(ctx context.Context, clientId string) error {
+	return m.DeleteOauthClientFunc(clientId)
+}
+
+func withPrincipal(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Header.Get("Authorization") == "" {
+			next.ServeHTTP(w, r)
+			return
+		}
+		next.ServeHTTP(w, r.WithContext(auth.WithPrincipal(r.Context(), auth.Principal{UserID: 1, Permissions: []string{"users:read"}})))
+	})
+}
+
+func newTestRouter() *chi.Mux {
+	router := chi.NewRouter()
+	router.Route("/api/v1", func(subr chi.Router) {
+		subr.Use(withPrincipal)
+		HandlerFromMux(NewHandler(), subr)
+	})
+	return router
+}
+
+func newRequest(method string, path string, body interface{}) (*http.Request, error) {
+	var buf bytes.Buffer
+	if body != nil {
+		if err := json.NewEncoder(&buf).Encode(body); err != nil {
+			return nil, errors.Wrap(err, "failed encode body")
+		}
+	}
+
+	r, err := http.NewRequest(method, "http://example.com/api/v1"+path, &buf)
+	if err != nil {
+		return nil, err
+	}
+	r.Header.Set("Authorization", "Bearer token")
+	return r, nil
+}
+
+func TestAdminOauth(t *testing.T) {
+	t.Parallel()
+	router := newTestRouter()
+
+	tests := []struct {
+		name       string
+		req        func(context.Context) (*http.Request, error)
+		assert     func(*httptest.ResponseRecorder, *http.Request)
+		newService func() service
+	}{
+		{
+			name: "401 - register without principal",
+			req: func(ctx context.Context) (*http.Request, error) {
+				return http.NewRequest(http.MethodPost, "http://example.com/api/v1/admin/oauth/clients", nil)
+			},
+			assert: func(w *httptest.ResponseRecorder, r *http.Request) {
+				assert.Equal(t, http.StatusUnauthorized, w.Result().StatusCode)
+			},
+		},
+		{
+			name: "400 - register empty body",
+			req: func(ctx context.Context) (*http.Request, error) {
+				return newRequest(http.MethodPost, "/admin/oauth/clients", nil)
+			},
+			assert: func(w *httptest.ResponseRecorder, r *http.Request) {
+				assert.Equal(t, http.StatusBadRequest, w.Result().StatusCode)
+			},
+		},
+		{
+			name: "201 - register success",
+			req: func(ctx context.Context) (*http.Request, error) {
+				return newRequest(http.MethodPost, "/admin/oauth/clients", dtos.RegisterOauthClientRequest{
+					Name:         "dashboard",
+					Confidential: true,
+					RedirectUris: []string{"https://example.com/callback"},
+					GrantTypes:   []dtos.RegisterOauthClientRequestGrantTypes{dtos.RegisterOauthClientRequestGrantTypesAuthorizationCode},
+					Scopes:       []string{"users:read"},
+				})
+			},
+			assert: func(w *httptest.ResponseRecorder, r *http.Request) {
+				assert.Equal(t, http.StatusCreated, w.Result().StatusCode)
+
+				var resp dtos.RegisterOauthClientResponse
+				require.NoError(t, json.NewDecoder(w.Body).Decode(&resp))
+				require.Equal(t, "client-id", resp.Client.ClientId)
+				require.Equal(t, "secret", *resp.ClientSecret)
+			},
+			newService: func() service {
+				return mockService{RegisterOauthClientFunc: func(permissions []string, req internal.RegisterOauthClientRequest) (*dtos.RegisterOauthClientResponse, error) {
+					if len(permissions) != 1 || req.GrantTypes[0] != "authorization_code" {
+						return nil, httputil.ErrInvalidRequest
+					}
+					secret := "secret"
+					return &dtos.RegisterOauthClientResponse{Client: dtos.OauthClientResponse{ClientId: "client-id", Name: req.Name}, ClientSecret: &secret}, nil
+				}}
+			},
+		},
+		{
+			name: "200 - list success",
+			req: func(ctx context.Context) (*http.Request, error) {
+				return newRequest(http.MethodGet, "/admin/oauth/clients", nil)
+			},
+			assert: func(w *httptest.ResponseRecorder, r *http.Request) {
+				assert.Equal(t, http.StatusOK, w.Result().StatusCode)
+
+				var resp dtos.OauthClientsResponse
+				require.NoError(t, json.NewDecoder(w.Body).Decode(&resp))
+				require.Len(t, resp.Clients, 1)
+			},
+			newService: func() service {
+				return mockService{ListOauthClientsFunc: func() (*dtos.OauthClientsResponse, error) {
+					return &dtos.OauthClientsResponse{Clients: []dtos.OauthClientResponse{{ClientId: "client-id"}}}, nil
+				}}
+			},
+		},
+		{
+			name: "404 - delete unknown client",
+			req: func(ctx context.Context) (*http.Request, error) {
+				return newRequest(http.MethodDelete, "/admin/oauth/clients/unknown", nil)
+			},
+			assert: func(w *httptest.ResponseRecorder, r *http.Request) {
+				assert.Equal(t, http.StatusNotFound, w.Result().StatusCode)
+			},
+			newService: func() service {
+				return mockService{DeleteOauthClientFunc: func(clientId string) error { return httputil.ErrNotFound }}
+			},
+		},
+		{
+			name: "204 - delete success",
+			req: func(ctx context.Context) (*http.Request, error) {
+				return newRequest(http.MethodDelete, "/admin/oauth/clients/client-id", nil)
+			},
+			assert: func(w *httptest.ResponseRecorder, r *http.Request) {
+				assert.Equal(t, http.StatusNoContent, w.Result().StatusCode)
+			},
+			newService: func() service {
+				return mockService{DeleteOauthClientFunc: func(clientId string) error {
+					if clientId != "client-id" {
+						return httputil.ErrNotFound
+					}
+					return nil
+				}}
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+
+		t.Run(tt.name, func(t *testing.T) {
+			newService = tt.newService
+			ctx := context.Background()
+			var err error
+
+			w := httptest.NewRecorder()
+			r, err := tt.req(ctx)
+			if assert.NoError(t, err) {
+				router.ServeHTTP(w, r)
+				tt.assert(w, r)
+			}
+		})
+	}
+}
@@ -0,0 +1,178 @@
+package internal
+
+import (
+	"context"
+	"mysite/constants"
+	"mysite/dtos"
+	"mysite/entities"
+	"mysite/pkgs/auth"
+	"mysite/pkgs/database"
+	"mysite/pkgs/oauth"
+	"mysite/pkgs/validate"
+	"mysite/repositories/oauthclientrepo"
+	"mysite/utils/httputil"
+	"net/url"
+	"slices"
+
+	"github.com/google/uuid"
+	"github.com/mitchellh/mapstructure"
+	"github.com/pkg/errors"
+	"github.com/volatiletech/null/v8"
+	"github.com/volatiletech/sqlboiler/v4/boil"
+	"github.com/volatiletech/sqlboiler/v4/types"
+)
+
+type service struct {
+	repo oauthclientrepo.OauthClientRepo
+}
+
+type RegisterOauthClientRequest struct {
+	Name string `validate:"required,max=100"`
+
+	// Confidential clients get a secret
+	Confidential bool
+
+	RedirectUris []string `validate:"dive,url"`
+	GrantTypes   []string `validate:"required,min=1,dive,oneof=authorization_code refresh_token client_credentials"`
+
+	// Scopes permissions the client may request
+	Scopes []string `validate:"dive,required"`
+}
+
+func NewService() service {
+	return service{
+		repo: oauthclientrepo.NewRepo(),
+	}
+}
+
+func NewParams(req dtos.RegisterOauthClientJSONRequestBody) (*RegisterOauthClientRequest, error) {
+	var result RegisterOauthClientRequest
+	if err := mapstructure.Decode(req, &result); err != nil {
+		return nil, errors.Wrap(err, "failed decode")
+	}
+
+	return &result, nil
+}
+
+// RegisterOauthClient scopes are limited to the permissions of the admin, the secret is only returned here
+func (s service) RegisterOauthClient(ctx context.Context, permissions []string, req RegisterOauthClientRequest) (*dtos.RegisterOauthClientResponse, error) {
+	if err := validateParams(req, permissions); err != nil {
+		return nil, err
+	}
+
+	client := entities.OauthClient{
+		ClientID:     uuid.NewString(),
+		Name:         req.Name,
+		RedirectUris: types.StringArray(req.RedirectUris),
+		GrantTypes:   compact(req.GrantTypes),
+		Scopes:       compact(req.Scopes),
+	}
+
+	var resp dtos.RegisterOauthClientResponse
+	if req.Confidential {
+		secret, hash, err := auth.GenerateOpaqueToken()
+		if err != nil {
+			return nil, errors.Wrap(err, "failed generate client secret")
+		}
+		client.SecretHash = null.StringFrom(hash)
+		resp.ClientSecret = &secret
+	}
+
+	if err := database.NewBoilerTransaction(ctx, func(ctx context.Context, tx boil.ContextTransactor) error {
+		return s.repo.Insert(ctx, tx, &client)
+	}); err != nil {
+		return nil, errors.Wrap(err, "failed insert oauthClient")
+	}
+
+	resp.Client = newOauthClientResponse(client)
+	return &resp, nil
+}
+
+func (s service) ListOauthClients(ctx context.Context) (*dtos.OauthClientsResponse, error) {
+	var pgClients entities.OauthClientSlice
+	if err := database.NewBoilerTransaction(ctx, func(ctx context.Context, tx boil.ContextTransactor) error {
+		var err error
+		pgClients, err = s.repo.GetOauthClients(ctx, tx)
+		return err
+	}); err != nil {
+		return nil, errors.Wrap(err, "failed get oauthClients")
+	}
+
+	resp := dtos.OauthClientsResponse{Clients: []dtos.OauthClientResponse{}}
+	for _, pgClient := range pgClients {
+		resp.Clients = append(resp.Clients, newOauthClientResponse(*pgClient))
+	}
+	return &resp, nil
+}
+
+func (s service) DeleteOauthClient(ctx context.Context, clientId string) error {
+	return database.NewBoilerTransaction(ctx, func(ctx context.Context, tx boil.ContextTransactor) error {
+		deleted, err := s.repo.Delete(ctx, tx, clientId)
+		if err != nil {
+			return errors.Wrap(err, "failed delete oauthClient")
+		}
+		if !deleted {
+			return errors.Wrap(httputil.ErrNotFound, "client not found")
+		}
+		return nil
+	})
+}
+
+func validateParams(req RegisterOauthClientRequest, permissions []string) error {
+	if err := validate.ValidateStruct(req); err != nil {
+		return errors.Wrap(httputil.ErrInvalidRequest, err.Error())
+	}
+
+	hasGrant := func(grantType string) bool {
+		return slices.Contains(req.GrantTypes, grantType)
+	}
+	switch {
+	case hasGrant(constants.GrantAuthorizationCode) && len(req.RedirectUris) == 0:
+		return errors.Wrap(httputil.ErrInvalidRequest, "authorization_code needs a redirect uri")
+	case hasGrant(constants.GrantRefreshToken) && !hasGrant(constants.GrantAuthorizationCode):
+		return errors.Wrap(httputil.ErrInvalidRequest, "refresh_token needs authorization_code")
+	case hasGrant(constants.GrantClientCredentials) && !req.Confidential:
+		return errors.Wrap(httputil.ErrInvalidRequest, "client_credentials needs a confidential client")
+	}
+
+	// the code is appended to the query of the redirect uri, a fragment would hide it from the server of the client
+	for _, redirectURI := range req.RedirectUris {
+		if u, err := url.Parse(redirectURI); err != nil || u.Fragment != "" || !u.IsAbs() {
+			return errors.Wrapf(httputil.ErrInvalidRequest, "invalid redirect uri %s", redirectURI)
+		}
+	}
+
+	if _, ok := oauth.ResolveScopes(req.Scopes, permissions); !ok {
+		return errors.Wrap(httputil.ErrInvalidRequest, "scopes must be permissions of the admin")
+	}
+	return nil
+}
+
+func compact(values []string) types.StringArray {
+	result := types.StringArray{}
+	for _, value := range values {
+		if !slices.Contains(result, value) {
+			result = append(result, value)
+		}
+	}
+	return result
+}
+
+func newOauthClientResponse(client entities.OauthClient) dtos.OauthClientResponse {
+	return dtos.OauthClientResponse{
+		ClientId:     client.ClientID,
+		Name:         client.Name,
+		Confidential: oauth.IsConfidential(client),
+		RedirectUris: nonNil(client.RedirectUris),
+		GrantTypes:   nonNil(client.GrantTypes),
+		Scopes:       nonNil(client.Scopes),
+		CreatedAt:    client.CreatedAt,
+	}
+}
+
+func nonNil(values types.StringArray) []string {
+	if values == nil {
+		return []string{}
+	}
+	return values
+}
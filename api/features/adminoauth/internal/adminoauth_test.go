@@ -0,0 +1,165 @@
+package internal
+
+import (
+	"context"
+	"fmt"
+	"mysite/entities"
+	"mysite/pkgs/auth"
+	"mysite/pkgs/database"
+	"mysite/testing/dbtest"
+	"mysite/testing/mocking/repomock"
+	"mysite/utils/httputil"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"github.com/volatiletech/sqlboiler/v4/boil"
+	"github.com/volatiletech/sqlboiler/v4/types"
+)
+
+func TestMain(m *testing.M) {
+	pool, resource, err := dbtest.SetupDatabaseForTesting()
+	if err != nil {
+		return
+	}
+
+	defer func() {
+		database.Close()
+		if err := dbtest.PurgeResource(pool, resource); err != nil {
+			fmt.Println("failed to purge resource")
+		}
+	}()
+	m.Run()
+}
+
+var permissions = []string{"users:read", "users:write", "clients:write"}
+
+func TestRegisterOauthClient(t *testing.T) {
+	t.Parallel()
+	require.NoError(t, database.SetupDatabase())
+	ctx := dbtest.SetTestTransactionCtx(context.Background())
+
+	newRepoMock := func() *repomock.OauthClientRepoMock {
+		return &repomock.OauthClientRepoMock{
+			InsertFunc: func(ctx context.Context, tx boil.ContextTransactor, client *entities.OauthClient) error {
+				return nil
+			},
+		}
+	}
+
+	{ // confidential client, the stored hash matches the returned secret
+		repoMock := newRepoMock()
+		svc := service{repo: repoMock}
+		resp, err := svc.RegisterOauthClient(ctx, permissions, RegisterOauthClientRequest{
+			Name:         "partner",
+			Confidential: true,
+			RedirectUris: []string{"https://partner.example.com/callback"},
+			GrantTypes:   []string{"authorization_code", "refresh_token", "authorization_code"},
+			Scopes:       []string{"users:read"},
+		})
+		require.NoError(t, err)
+		require.NotNil(t, resp.ClientSecret)
+		require.True(t, resp.Client.Confidential)
+		require.Equal(t, []string{"authorization_code", "refresh_token"}, resp.Client.GrantTypes)
+
+		inserted := repoMock.InsertCalls()[0].Client
+		require.Equal(t, resp.Client.ClientId, inserted.ClientID)
+		require.Equal(t, auth.HashOpaqueToken(*resp.ClientSecret), inserted.SecretHash.String)
+	}
+	{ // public client has no secret
+		repoMock := newRepoMock()
+		svc := service{repo: repoMock}
+		resp, err := svc.RegisterOauthClient(ctx, permissions, RegisterOauthClientRequest{
+			Name:         "spa",
+			RedirectUris: []string{"https://spa.example.com/callback"},
+			GrantTypes:   []string{"authorization_code"},
+		})
+		require.NoError(t, err)
+		require.Nil(t, resp.ClientSecret)
+		require.False(t, resp.Client.Confidential)
+		require.Equal(t, []string{}, resp.Client.Scopes)
+		require.False(t, repoMock.InsertCalls()[0].Client.SecretHash.Valid)
+	}
+
+	failures := []struct {
+		name string
+		req  RegisterOauthClientRequest
+	}{
+		{
+			name: "authorization_code without redirect uri",
+			req:  RegisterOauthClientRequest{Name: "app", GrantTypes: []string{"authorization_code"}},
+		},
+		{
+			name: "refresh_token without authorization_code",
+			req:  RegisterOauthClientRequest{Name: "app", Confidential: true, GrantTypes: []string{"client_credentials", "refresh_token"}},
+		},
+		{
+			name: "client_credentials of a public client",
+			req:  RegisterOauthClientRequest{Name: "app", GrantTypes: []string{"client_credentials"}},
+		},
+		{
+			name: "redirect uri with fragment",
+			req:  RegisterOauthClientRequest{Name: "app", RedirectUris: []string{"https://app.example.com/callback#top"}, GrantTypes: []string{"authorization_code"}},
+		},
+		{
+			name: "scope the admin does not have",
+			req:  RegisterOauthClientRequest{Name: "app", Confidential: true, GrantTypes: []string{"client_credentials"}, Scopes: []string{"roles:write"}},
+		},
+		{
+			name: "unknown grant type",
+			req:  RegisterOauthClientRequest{Name: "app", Confidential: true, GrantTypes: []string{"password"}},
+		},
+	}
+	for _, tt := range failures {
+		repoMock := newRepoMock()
+		svc := service{repo: repoMock}
+		resp, err := svc.RegisterOauthClient(ctx, permissions, tt.req)
+		require.ErrorIs(t, err, httputil.ErrInvalidRequest, tt.name)
+		require.Nil(t, resp, tt.name)
+		require.Empty(t, repoMock.InsertCalls(), tt.name)
+	}
+}
+
+func TestListOauthClients(t *testing.T) {
+	t.Parallel()
+	require.NoError(t, database.SetupDatabase())
+	ctx := dbtest.SetTestTransactionCtx(context.Background())
+
+	repoMock := &repomock.OauthClientRepoMock{
+		GetOauthClientsFunc: func(ctx context.Context, tx boil.ContextTransactor) (entities.OauthClientSlice, error) {
+			return entities.OauthClientSlice{{
+				ClientID:   "client",
+				Name:       "partner",
+				GrantTypes: types.StringArray{"authorization_code"},
+			}}, nil
+		},
+	}
+	svc := service{repo: repoMock}
+	resp, err := svc.ListOauthClients(ctx)
+	require.NoError(t, err)
+	require.Len(t, resp.Clients, 1)
+	require.Equal(t, "client", resp.Clients[0].ClientId)
+	require.Equal(t, []string{}, resp.Clients[0].RedirectUris)
+}
+
+func TestDeleteOauthClient(t *testing.T) {
+	t.Parallel()
+	require.NoError(t, database.SetupDatabase())
+	ctx := dbtest.SetTestTransactionCtx(context.Background())
+
+	{ // delete success
+		svc := service{repo: &repomock.OauthClientRepoMock{
+			DeleteFunc: func(ctx context.Context, tx boil.ContextTransactor, clientId string) (bool, error) {
+				return true, nil
+			},
+		}}
+		require.NoError(t, svc.DeleteOauthClient(ctx, "client"))
+	}
+	{ // delete failed, unknown client
+		svc := service{repo: &repomock.OauthClientRepoMock{
+			DeleteFunc: func(ctx context.Context, tx boil.ContextTransactor, clientId string) (bool, error) {
+				return false, nil
+			},
+		}}
+		require.ErrorIs(t, svc.DeleteOauthClient(ctx, "client"), httputil.ErrNotFound)
+	}
+}
@@ -0,0 +1,172 @@
+package apikey
+
+import (
+	"context"
+	"log/slog"
+	"mysite/dtos"
+	"mysite/features/apikey/internal"
+	"mysite/pkgs/auth"
+	"mysite/pkgs/logger"
+	"mysite/utils/httputil"
+	"net/http"
+
+	"github.com/go-chi/render"
+	"github.com/pkg/errors"
+)
+
+type api struct {
+}
+
+type service interface {
+	CreateApiKey(ctx context.Context, userId int, permissions []string, req internal.CreateApiKeyRequest) (*dtos.CreateApiKeyResponse, error)
+	ListApiKeys(ctx context.Context, userId int) (*dtos.ApiKeysResponse, error)
+	GetApiKey(ctx context.Context, userId int, apiKeyId int) (*dtos.ApiKeyResponse, error)
+	UpdateApiKey(ctx context.Context, userId int, permissions []string, apiKeyId int, req internal.UpdateApiKeyRequest) (*dtos.ApiKeyResponse, error)
+	DeleteApiKey(ctx context.Context, userId int, apiKeyId int) error
+}
+
+var newService = func() service {
+	return internal.NewService()
+}
+
+func NewHandler() *api {
+	return &api{}
+}
+
+func (a api) CreateApiKey(w http.ResponseWriter, r *http.Request) {
+	principal, ok := keyOwner(w, r)
+	if !ok {
+		return
+	}
+
+	var body dtos.CreateApiKeyJSONRequestBody
+	if err := httputil.ParseBody(r, &body); err != nil {
+		if err := render.Render(w, r, httputil.NewFailureRender(errors.Wrap(err, "failed to parse body"))); err != nil {
+			slog.Error("failed to render", logger.AttrError(err))
+		}
+		return
+	}
+
+	params, err := internal.NewCreateParams(body)
+	if err != nil {
+		if err := render.Render(w, r, httputil.NewFailureRender(errors.Wrap(err, "failed to parse params"))); err != nil {
+			slog.Error("failed to render", logger.AttrError(err))
+		}
+		return
+	}
+
+	resp, err := newService().CreateApiKey(r.Context(), principal.UserID, principal.Permissions, *params)
+	if err != nil {
+		if err := render.Render(w, r, httputil.NewFailureRender(errors.Wrap(err, "failed to create api key"))); err != nil {
+			slog.Error("failed to render", logger.AttrError(err))
+		}
+		return
+	}
+
+	slog.Info("api key created", slog.Int("userId", principal.UserID), slog.Int("apiKeyId", resp.ApiKey.Id))
+	render.Status(r, http.StatusCreated)
+	render.JSON(w, r, resp)
+}
+
+func (a api) ListApiKeys(w http.ResponseWriter, r *http.Request) {
+	principal, ok := keyOwner(w, r)
+	if !ok {
+		return
+	}
+
+	resp, err := newService().ListApiKeys(r.Context(), principal.UserID)
+	if err != nil {
+		if err := render.Render(w, r, httputil.NewFailureRender(errors.Wrap(err, "failed to list api keys"))); err != nil {
+			slog.Error("failed to render", logger.AttrError(err))
+		}
+		return
+	}
+
+	render.JSON(w, r, resp)
+}
+
+func (a api) GetApiKey(w http.ResponseWriter, r *http.Request, apiKeyId int) {
+	principal, ok := keyOwner(w, r)
+	if !ok {
+		return
+	}
+
+	resp, err := newService().GetApiKey(r.Context(), principal.UserID, apiKeyId)
+	if err != nil {
+		if err := render.Render(w, r, httputil.NewFailureRender(errors.Wrap(err, "failed to get api key"))); err != nil {
+			slog.Error("failed to render", logger.AttrError(err))
+		}
+		return
+	}
+
+	render.JSON(w, r, resp)
+}
+
+func (a api) UpdateApiKey(w http.ResponseWriter, r *http.Request, apiKeyId int) {
+	principal, ok := keyOwner(w, r)
+	if !ok {
+		return
+	}
+
+	var body dtos.UpdateApiKeyJSONRequestBody
+	if err := httputil.ParseBody(r, &body); err != nil {
+		if err := render.Render(w, r, httputil.NewFailureRender(errors.Wrap(err, "failed to parse body"))); err != nil {
+			slog.Error("failed to render", logger.AttrError(err))
+		}
+		return
+	}
+
+	params, err := internal.NewUpdateParams(body)
+	if err != nil {
+		if err := render.Render(w, r, httputil.NewFailureRender(errors.Wrap(err, "failed to parse params"))); err != nil {
+			slog.Error("failed to render", logger.AttrError(err))
+		}
+		return
+	}
+
+	resp, err := newService().UpdateApiKey(r.Context(), principal.UserID, principal.Permissions, apiKeyId, *params)
+	if err != nil {
+		if err := render.Render(w, r, httputil.NewFailureRender(errors.Wrap(err, "failed to update api key"))); err != nil {
+			slog.Error("failed to render", logger.AttrError(err))
+		}
+		return
+	}
+
+	render.JSON(w, r, resp)
+}
+
+func (a api) DeleteApiKey(w http.ResponseWriter, r *http.Request, apiKeyId int) {
+	principal, ok := keyOwner(w, r)
+	if !ok {
+		return
+	}
+
+	if err := newService().DeleteApiKey(r.Context(), principal.UserID, apiKeyId); err != nil {
+		if err := render.Render(w, r, httputil.NewFailureRender(errors.Wrap(err, "failed to delete api key"))); err != nil {
+			slog.Error("failed to render", logger.AttrError(err))
+		}
+		return
+	}
+
+	slog.Info("api key revoked", slog.Int("userId", principal.UserID), slog.Int("apiKeyId", apiKeyId))
+	w.WriteHeader(http.StatusNoContent)
+}
+
+// keyOwner renders the failure itself, an api key or a token of an oauth client can not be used to manage api keys
+func keyOwner(w http.ResponseWriter, r *http.Request) (auth.Principal, bool) {
+	principal, found := auth.PrincipalFromContext(r.Context())
+	var err error
+	switch {
+	case !found:
+		err = errors.Wrap(httputil.ErrUnauthorize, "missing principal")
+	case principal.ApiKeyID != 0, principal.ClientID != "":
+		err = errors.Wrap(httputil.ErrForbidden, "only a login can manage api keys")
+	}
+	if err != nil {
+		if err := render.Render(w, r, httputil.NewFailureRender(err)); err != nil {
+			slog.Error("failed to render", logger.AttrError(err))
+		}
+		return auth.Principal{}, false
+	}
+	return principal, true
+}
@@ -0,0 +1,252 @@
+package apikey
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"mysite/dtos"
+	"mysite/features/apikey/internal"
+	"mysite/pkgs/auth"
+	"mysite/utils/httputil"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/go-chi/chi/v5"
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type mockService struct {
+	CreateApiKeyFunc func(userId int, permissions []string, req internal.CreateApiKeyRequest) (*dtos.CreateApiKeyResponse, error)
+	ListApiKeysFunc  func(userId int) (*dtos.ApiKeysResponse, error)
+	GetApiKeyFunc    func(userId int, apiKeyId int) (*dtos.ApiKeyResponse, error)
+	UpdateApiKeyFunc func(userId int, permissions []string, apiKeyId int, req internal.UpdateApiKeyRequest) (*dtos.ApiKeyResponse, error)
+	DeleteApiKeyFunc func(userId int, apiKeyId int) error
+}
+
+func (m mockService) CreateApiKey(ctx context.Context, userId int, permissions []string, req internal.CreateApiKeyRequest) (*dtos.CreateApiKeyResponse, error) {
+	return m.CreateApiKeyFunc(userId, permissions, req)
+}
+
+func (m mockService) ListApiKeys(ctx context.Context, userId int) (*dtos.ApiKeysResponse, error) {
+	return m.ListApiKeysFunc(userId)
+}
+
+func (m mockService) GetApiKey(ctx context.Context, userId int, apiKeyId int) (*dtos.ApiKeyResponse, error) {
+	return m.GetApiKeyFunc(userId, apiKeyId)
+}
+
+func (m mockService) UpdateApiKey(ctx context.Context, userId int, permissions []string, apiKeyId int, req internal.UpdateApiKeyRequest) (*dtos.ApiKeyResponse, error) {
+	return m.UpdateApiKeyFunc(userId, permissions, apiKeyId, req)
+}
+
+func (m mockService) DeleteApiKey(ctx context.Context, userId int, apiKeyId int) error {
+	return m.DeleteApiKeyFunc(userId, apiKeyId)
+}
+
+// withPrincipal authenticates as user 1, through an api key when the Authorization header is ApiKey
+// and through an oauth client when the token is "client"
+func withPrincipal(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		principal := auth.Principal{UserID: 1, Permissions: []string{"users:read"}}
+		switch r.Header.Get("Authorization") {
+		case "":
+			next.ServeHTTP(w, r)
+			return
+		case "ApiKey key":
+			principal.ApiKeyID = 1
+		case "Bearer client":
+			principal.ClientID = "client"
+		}
+		next.ServeHTTP(w, r.WithContext(auth.WithPrincipal(r.Context(), principal)))
+	})
+}
+
+func newTestRouter() *chi.Mux {
+	router := chi.NewRouter()
+	router.Route("/api/v1", func(subr chi.Router) {
+		subr.Use(withPrincipal)
+		HandlerFromMux(NewHandler(), subr)
+	})
+	return router
+}
+
+func newRequest(method string, url string, body interface{}) (*http.Request, error) {
+	var buf bytes.Buffer
+	if body != nil {
+		if err := json.NewEncoder(&buf).Encode(body); err != nil {
+			return nil, errors.Wrap(err, "failed encode body")
+		}
+	}
+
+	r, err := http.NewRequest(method, url, &buf)
+	if err != nil {
+		return nil, err
+	}
+	r.Header.Set("Authorization", "Bearer token")
+	return r, nil
+}
+
+func TestApiKey(t *testing.T) {
+	t.Parallel()
+	router := newTestRouter()
+
+	tests := []struct {
+		name       string
+		req        func(context.Context) (*http.Request, error)
+		assert     func(*httptest.ResponseRecorder, *http.Request)
+		newService func() service
+	}{
+		{
+			name: "401 - list api keys without principal",
+			req: func(ctx context.Context) (*http.Request, error) {
+				return http.NewRequest(http.MethodGet, "http://example.com/api/v1/me/api-keys", nil)
+			},
+			assert: func(w *httptest.ResponseRecorder, r *http.Request) {
+				assert.Equal(t, http.StatusUnauthorized, w.Result().StatusCode)
+			},
+		},
+		{
+			name: "403 - create api key with an api key",
+			req: func(ctx context.Context) (*http.Request, error) {
+				r, err := newRequest(http.MethodPost, "http://example.com/api/v1/me/api-keys", dtos.CreateApiKeyRequest{Name: "script", Scopes: []string{}})
+				if err != nil {
+					return nil, err
+				}
+				r.Header.Set("Authorization", "ApiKey key")
+				return r, nil
+			},
+			assert: func(w *httptest.ResponseRecorder, r *http.Request) {
+				assert.Equal(t, http.StatusForbidden, w.Result().StatusCode)
+			},
+		},
+		{
+			name: "403 - create api key with a token of an oauth client",
+			req: func(ctx context.Context) (*http.Request, error) {
+				r, err := newRequest(http.MethodPost, "http://example.com/api/v1/me/api-keys", dtos.CreateApiKeyRequest{Name: "script", Scopes: []string{}})
+				if err != nil {
+					return nil, err
+				}
+				r.Header.Set("Authorization", "Bearer client")
+				return r, nil
+			},
+			assert: func(w *httptest.ResponseRecorder, r *http.Request) {
+				assert.Equal(t, http.StatusForbidden, w.Result().StatusCode)
+			},
+		},
+		{
+			name: "400 - create api key empty body",
+			req: func(ctx context.Context) (*http.Request, error) {
+				return newRequest(http.MethodPost, "http://example.com/api/v1/me/api-keys", nil)
+			},
+			assert: func(w *httptest.ResponseRecorder, r *http.Request) {
+				assert.Equal(t, http.StatusBadRequest, w.Result().StatusCode)
+			},
+		},
+		{
+			name: "201 - create api key success",
+			req: func(ctx context.Context) (*http.Request, error) {
+				return newRequest(http.MethodPost, "http://example.com/api/v1/me/api-keys", dtos.CreateApiKeyRequest{Name: "script", Scopes: []string{"users:read"}})
+			},
+			assert: func(w *httptest.ResponseRecorder, r *http.Request) {
+				assert.Equal(t, http.StatusCreated, w.Result().StatusCode)
+
+				var resp dtos.CreateApiKeyResponse
+				require.NoError(t, json.NewDecoder(w.Body).Decode(&resp))
+				require.Equal(t, "msk_prefix_secret", resp.Key)
+				require.Equal(t, "script", resp.ApiKey.Name)
+			},
+			newService: func() service {
+				return mockService{CreateApiKeyFunc: func(userId int, permissions []string, req internal.CreateApiKeyRequest) (*dtos.CreateApiKeyResponse, error) {
+					if userId != 1 || len(permissions) != 1 {
+						return nil, errors.New("unexpected principal")
+					}
+					return &dtos.CreateApiKeyResponse{ApiKey: dtos.ApiKeyResponse{Id: 1, Name: req.Name, Scopes: req.Scopes}, Key: "msk_prefix_secret"}, nil
+				}}
+			},
+		},
+		{
+			name: "200 - list api keys success",
+			req: func(ctx context.Context) (*http.Request, error) {
+				return newRequest(http.MethodGet, "http://example.com/api/v1/me/api-keys", nil)
+			},
+			assert: func(w *httptest.ResponseRecorder, r *http.Request) {
+				assert.Equal(t, http.StatusOK, w.Result().StatusCode)
+
+				var resp dtos.ApiKeysResponse
+				require.NoError(t, json.NewDecoder(w.Body).Decode(&resp))
+				require.Len(t, resp.ApiKeys, 1)
+			},
+			newService: func() service {
+				return mockService{ListApiKeysFunc: func(userId int) (*dtos.ApiKeysResponse, error) {
+					return &dtos.ApiKeysResponse{ApiKeys: []dtos.ApiKeyResponse{{Id: 1}}}, nil
+				}}
+			},
+		},
+		{
+			name: "404 - get api key not found",
+			req: func(ctx context.Context) (*http.Request, error) {
+				return newRequest(http.MethodGet, "http://example.com/api/v1/me/api-keys/2", nil)
+			},
+			assert: func(w *httptest.ResponseRecorder, r *http.Request) {
+				assert.Equal(t, http.StatusNotFound, w.Result().StatusCode)
+			},
+			newService: func() service {
+				return mockService{GetApiKeyFunc: func(userId int, apiKeyId int) (*dtos.ApiKeyResponse, error) {
+					return nil, httputil.ErrNotFound
+				}}
+			},
+		},
+		{
+			name: "200 - update api key success",
+			req: func(ctx context.Context) (*http.Request, error) {
+				name := "renamed"
+				return newRequest(http.MethodPatch, "http://example.com/api/v1/me/api-keys/2", dtos.UpdateApiKeyRequest{Name: &name})
+			},
+			assert: func(w *httptest.ResponseRecorder, r *http.Request) {
+				assert.Equal(t, http.StatusOK, w.Result().StatusCode)
+
+				var resp dtos.ApiKeyResponse
+				require.NoError(t, json.NewDecoder(w.Body).Decode(&resp))
+				require.Equal(t, 2, resp.Id)
+				require.Equal(t, "renamed", resp.Name)
+			},
+			newService: func() service {
+				return mockService{UpdateApiKeyFunc: func(userId int, permissions []string, apiKeyId int, req internal.UpdateApiKeyRequest) (*dtos.ApiKeyResponse, error) {
+					return &dtos.ApiKeyResponse{Id: apiKeyId, Name: *req.Name}, nil
+				}}
+			},
+		},
+		{
+			name: "204 - delete api key success",
+			req: func(ctx context.Context) (*http.Request, error) {
+				return newRequest(http.MethodDelete, "http://example.com/api/v1/me/api-keys/2", nil)
+			},
+			assert: func(w *httptest.ResponseRecorder, r *http.Request) {
+				assert.Equal(t, http.StatusNoContent, w.Result().StatusCode)
+			},
+			newService: func() service {
+				return mockService{DeleteApiKeyFunc: func(userId int, apiKeyId int) error { return nil }}
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+
+		t.Run(tt.name, func(t *testing.T) {
+			newService = tt.newService
+			ctx := context.Background()
+			var err error
+
+			w := httptest.NewRecorder()
+			r, err := tt.req(ctx)
+			if assert.NoError(t, err) {
+				router.ServeHTTP(w, r)
+				tt.assert(w, r)
+			}
+		})
+	}
+}
@@ -0,0 +1,257 @@
+package internal
+
+import (
+	"context"
+	"mysite/constants"
+	"mysite/dtos"
+	"mysite/entities"
+	"mysite/pkgs/auth"
+	"mysite/pkgs/database"
+	"mysite/pkgs/env"
+	"mysite/pkgs/validate"
+	"mysite/repositories/apikeyrepo"
+	"mysite/utils/httputil"
+	"slices"
+	"time"
+
+	"github.com/mitchellh/mapstructure"
+	"github.com/pkg/errors"
+	"github.com/volatiletech/sqlboiler/v4/boil"
+	"github.com/volatiletech/sqlboiler/v4/types"
+)
+
+type service struct {
+	repo apikeyrepo.ApiKeyRepo
+}
+
+type CreateApiKeyRequest struct {
+	// Name label to tell keys apart
+	Name string `json:"name" validate:"required,max=100"`
+
+	// Kind personal by default
+	Kind *string `json:"kind,omitempty" validate:"omitempty,oneof=personal service"`
+
+	// Scopes permissions granted to the key
+	Scopes []string `json:"scopes" validate:"dive,required"`
+
+	// ExpiresAt the configured default when nil
+	ExpiresAt *time.Time `json:"expiresAt,omitempty"`
+}
+
+// UpdateApiKeyRequest nil fields are kept
+type UpdateApiKeyRequest struct {
+	// Name label to tell keys apart
+	Name *string `json:"name,omitempty" validate:"omitempty,min=1,max=100"`
+
+	// Scopes replaces the current scopes
+	Scopes *[]string `json:"scopes,omitempty" validate:"omitempty,dive,required"`
+}
+
+func NewService() service {
+	return service{
+		repo: apikeyrepo.NewRepo(),
+	}
+}
+
+func NewCreateParams(req dtos.CreateApiKeyJSONRequestBody) (*CreateApiKeyRequest, error) {
+	var result CreateApiKeyRequest
+	if err := mapstructure.Decode(req, &result); err != nil {
+		return nil, errors.Wrap(err, "failed mapping struct")
+	}
+	return &result, nil
+}
+
+func NewUpdateParams(req dtos.UpdateApiKeyJSONRequestBody) (*UpdateApiKeyRequest, error) {
+	var result UpdateApiKeyRequest
+	if err := mapstructure.Decode(req, &result); err != nil {
+		return nil, errors.Wrap(err, "failed mapping struct")
+	}
+	return &result, nil
+}
+
+// CreateApiKey scopes are limited to the permissions of the user, the key is only returned here
+func (s service) CreateApiKey(ctx context.Context, userId int, permissions []string, req CreateApiKeyRequest) (*dtos.CreateApiKeyResponse, error) {
+	// validate data
+	if err := validate.ValidateStruct(req); err != nil {
+		return nil, errors.Wrap(httputil.ErrInvalidRequest, err.Error())
+	}
+	scopes, err := checkScopes(req.Scopes, permissions)
+	if err != nil {
+		return nil, err
+	}
+	expiresAt, err := expiry(req.ExpiresAt, time.Now())
+	if err != nil {
+		return nil, err
+	}
+
+	key, prefix, hash, err := auth.GenerateApiKey()
+	if err != nil {
+		return nil, errors.Wrap(err, "failed generate api key")
+	}
+
+	apiKey := entities.APIKey{
+		UserAccountID: userId,
+		Name:          req.Name,
+		Kind:          constants.ApiKeyPersonal,
+		Prefix:        prefix,
+		KeyHash:       hash,
+		Scopes:        scopes,
+		ExpiresAt:     expiresAt,
+	}
+	if req.Kind != nil {
+		apiKey.Kind = *req.Kind
+	}
+
+	if err := database.NewBoilerTransaction(ctx, func(ctx context.Context, tx boil.ContextTransactor) error {
+		return s.repo.Insert(ctx, tx, &apiKey)
+	}); err != nil {
+		return nil, errors.Wrap(err, "failed insert apiKey")
+	}
+
+	return &dtos.CreateApiKeyResponse{
+		ApiKey: newApiKeyResponse(apiKey),
+		Key:    key,
+	}, nil
+}
+
+func (s service) ListApiKeys(ctx context.Context, userId int) (*dtos.ApiKeysResponse, error) {
+	var pgApiKeys entities.APIKeySlice
+	if err := database.NewBoilerTransaction(ctx, func(ctx context.Context, tx boil.ContextTransactor) error {
+		var err error
+		pgApiKeys, err = s.repo.GetApiKeysByUserAccountId(ctx, tx, userId)
+		return err
+	}); err != nil {
+		return nil, errors.Wrap(err, "failed get apiKeys")
+	}
+
+	resp := dtos.ApiKeysResponse{ApiKeys: []dtos.ApiKeyResponse{}}
+	for _, pgApiKey := range pgApiKeys {
+		resp.ApiKeys = append(resp.ApiKeys, newApiKeyResponse(*pgApiKey))
+	}
+	return &resp, nil
+}
+
+func (s service) GetApiKey(ctx context.Context, userId int, apiKeyId int) (*dtos.ApiKeyResponse, error) {
+	var resp dtos.ApiKeyResponse
+	if err := database.NewBoilerTransaction(ctx, func(ctx context.Context, tx boil.ContextTransactor) error {
+		pgApiKey, err := s.getApiKey(ctx, tx, userId, apiKeyId)
+		if err != nil {
+			return err
+		}
+		resp = newApiKeyResponse(*pgApiKey)
+		return nil
+	}); err != nil {
+		return nil, errors.Wrap(err, "failed get apiKey")
+	}
+
+	return &resp, nil
+}
+
+func (s service) UpdateApiKey(ctx context.Context, userId int, permissions []string, apiKeyId int, req UpdateApiKeyRequest) (*dtos.ApiKeyResponse, error) {
+	// validate data
+	if err := validate.ValidateStruct(req); err != nil {
+		return nil, errors.Wrap(httputil.ErrInvalidRequest, err.Error())
+	}
+	var scopes types.StringArray
+	if req.Scopes != nil {
+		var err error
+		if scopes, err = checkScopes(*req.Scopes, permissions); err != nil {
+			return nil, err
+		}
+	}
+
+	var resp dtos.ApiKeyResponse
+	if err := database.NewBoilerTransaction(ctx, func(ctx context.Context, tx boil.ContextTransactor) error {
+		pgApiKey, err := s.getApiKey(ctx, tx, userId, apiKeyId)
+		if err != nil {
+			return err
+		}
+
+		if req.Name != nil {
+			pgApiKey.Name = *req.Name
+		}
+		if req.Scopes != nil {
+			pgApiKey.Scopes = scopes
+		}
+		if err := s.repo.Update(ctx, tx, *pgApiKey); err != nil {
+			return errors.Wrap(err, "failed update apiKey")
+		}
+
+		resp = newApiKeyResponse(*pgApiKey)
+		return nil
+	}); err != nil {
+		return nil, errors.Wrap(err, "failed update apiKey")
+	}
+
+	return &resp, nil
+}
+
+func (s service) DeleteApiKey(ctx context.Context, userId int, apiKeyId int) error {
+	return database.NewBoilerTransaction(ctx, func(ctx context.Context, tx boil.ContextTransactor) error {
+		deleted, err := s.repo.Delete(ctx, tx, userId, apiKeyId)
+		if err != nil {
+			return errors.Wrap(err, "failed delete apiKey")
+		}
+		if !deleted {
+			return errors.Wrap(httputil.ErrNotFound, "api key not found")
+		}
+		return nil
+	})
+}
+
+func (s service) getApiKey(ctx context.Context, tx boil.ContextTransactor, userId int, apiKeyId int) (*entities.APIKey, error) {
+	pgApiKey, err := s.repo.GetApiKeyById(ctx, tx, userId, apiKeyId)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed get apiKey")
+	}
+	if pgApiKey == nil {
+		return nil, errors.Wrap(httputil.ErrNotFound, "api key not found")
+	}
+	return pgApiKey, nil
+}
+
+// checkScopes rejects scopes the user does not have, duplicates are dropped
+func checkScopes(scopes []string, permissions []string) (types.StringArray, error) {
+	result := types.StringArray{}
+	for _, scope := range scopes {
+		if !slices.Contains(permissions, scope) {
+			return nil, errors.Wrapf(httputil.ErrInvalidRequest, "unknown scope %s", scope)
+		}
+		if !slices.Contains(result, scope) {
+			result = append(result, scope)
+		}
+	}
+	return result, nil
+}
+
+// expiry defaults to the configured days, a requested expiry must be in the future and within the configured maximum
+func expiry(expiresAt *time.Time, now time.Time) (time.Time, error) {
+	cfg := env.GetEnv().ApiKey
+	if expiresAt == nil {
+		return now.AddDate(0, 0, cfg.DefaultExpireDays), nil
+	}
+	if !expiresAt.After(now) {
+		return time.Time{}, errors.Wrap(httputil.ErrInvalidRequest, "expiresAt must be in the future")
+	}
+	if expiresAt.After(now.AddDate(0, 0, cfg.MaxExpireDays)) {
+		return time.Time{}, errors.Wrapf(httputil.ErrInvalidRequest, "expiresAt must be within %d days", cfg.MaxExpireDays)
+	}
+	return *expiresAt, nil
+}
+
+func newApiKeyResponse(apiKey entities.APIKey) dtos.ApiKeyResponse {
+	scopes := []string(apiKey.Scopes)
+	if scopes == nil {
+		scopes = []string{}
+	}
+	return dtos.ApiKeyResponse{
+		Id:         apiKey.ID,
+		Name:       apiKey.Name,
+		Kind:       dtos.ApiKeyResponseKind(apiKey.Kind),
+		Prefix:     apiKey.Prefix,
+		Scopes:     scopes,
+		ExpiresAt:  apiKey.ExpiresAt,
+		LastUsedAt: apiKey.LastUsedAt.Ptr(),
+		CreatedAt:  apiKey.CreatedAt,
+	}
+}
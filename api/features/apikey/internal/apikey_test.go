@@ -0,0 +1,230 @@
+package internal
+
+import (
+	"context"
+	"fmt"
+	"mysite/dtos"
+	"mysite/entities"
+	"mysite/pkgs/auth"
+	"mysite/pkgs/database"
+	"mysite/pkgs/env"
+	"mysite/testing/dbtest"
+	"mysite/testing/mocking/repomock"
+	"mysite/utils/httputil"
+	"mysite/utils/ptrconv"
+	"testing"
+	"time"
+
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/require"
+	"github.com/volatiletech/sqlboiler/v4/boil"
+	"github.com/volatiletech/sqlboiler/v4/types"
+)
+
+func TestMain(m *testing.M) {
+	pool, resource, err := dbtest.SetupDatabaseForTesting()
+	if err != nil {
+		return
+	}
+
+	defer func() {
+		database.Close()
+		if err := dbtest.PurgeResource(pool, resource); err != nil {
+			fmt.Println("failed to purge resource")
+		}
+	}()
+	m.Run()
+}
+
+var permissions = []string{"users:read", "users:write"}
+
+func newApiKey() *entities.APIKey {
+	return &entities.APIKey{
+		ID:            2,
+		UserAccountID: 1,
+		Name:          "script",
+		Kind:          "personal",
+		Prefix:        "abcd1234",
+		Scopes:        types.StringArray{"users:read"},
+		ExpiresAt:     time.Now().Add(time.Hour),
+	}
+}
+
+func TestCreateApiKey(t *testing.T) {
+	t.Parallel()
+	require.NoError(t, database.SetupDatabase())
+	ctx := dbtest.SetTestTransactionCtx(context.Background())
+
+	{ // create success, the stored hash matches the returned key
+		repoMock := &repomock.ApiKeyRepoMock{}
+		repoMock.InsertFunc = func(ctx context.Context, tx boil.ContextTransactor, apiKey *entities.APIKey) error {
+			apiKey.ID = 2
+			return nil
+		}
+
+		svc := service{repo: repoMock}
+		resp, err := svc.CreateApiKey(ctx, 1, permissions, CreateApiKeyRequest{
+			Name:   "script",
+			Kind:   ptrconv.String("service"),
+			Scopes: []string{"users:read", "users:read"},
+		})
+		require.NoError(t, err)
+		require.Equal(t, 2, resp.ApiKey.Id)
+		require.Equal(t, dtos.ApiKeyResponseKindService, resp.ApiKey.Kind)
+		require.Equal(t, []string{"users:read"}, resp.ApiKey.Scopes)
+
+		inserted := repoMock.InsertCalls()[0].ApiKey
+		require.Equal(t, 1, inserted.UserAccountID)
+		require.True(t, auth.VerifyApiKey(resp.Key, inserted.KeyHash))
+		prefix, ok := auth.ParseApiKeyPrefix(resp.Key)
+		require.True(t, ok)
+		require.Equal(t, inserted.Prefix, prefix)
+		require.WithinDuration(t, time.Now().AddDate(0, 0, env.GetEnv().ApiKey.DefaultExpireDays), inserted.ExpiresAt, time.Minute)
+	}
+	{ // create failed, scope the user does not have
+		svc := service{repo: &repomock.ApiKeyRepoMock{}}
+		resp, err := svc.CreateApiKey(ctx, 1, permissions, CreateApiKeyRequest{Name: "script", Scopes: []string{"roles:write"}})
+		require.ErrorIs(t, err, httputil.ErrInvalidRequest)
+		require.Nil(t, resp)
+	}
+	{ // create failed, expiry out of range
+		svc := service{repo: &repomock.ApiKeyRepoMock{}}
+		past := time.Now().Add(-time.Hour)
+		resp, err := svc.CreateApiKey(ctx, 1, permissions, CreateApiKeyRequest{Name: "script", Scopes: []string{}, ExpiresAt: &past})
+		require.ErrorIs(t, err, httputil.ErrInvalidRequest)
+		require.Nil(t, resp)
+
+		farFuture := time.Now().AddDate(0, 0, env.GetEnv().ApiKey.MaxExpireDays+1)
+		resp, err = svc.CreateApiKey(ctx, 1, permissions, CreateApiKeyRequest{Name: "script", Scopes: []string{}, ExpiresAt: &farFuture})
+		require.ErrorIs(t, err, httputil.ErrInvalidRequest)
+		require.Nil(t, resp)
+	}
+	{ // create failed, validate failed
+		svc := service{repo: &repomock.ApiKeyRepoMock{}}
+		resp, err := svc.CreateApiKey(ctx, 1, permissions, CreateApiKeyRequest{Name: "script", Kind: ptrconv.String("robot")})
+		require.ErrorIs(t, err, httputil.ErrInvalidRequest)
+		require.Nil(t, resp)
+	}
+}
+
+func TestUpdateApiKey(t *testing.T) {
+	t.Parallel()
+	require.NoError(t, database.SetupDatabase())
+	ctx := dbtest.SetTestTransactionCtx(context.Background())
+
+	{ // update success, omitted name is kept
+		repoMock := &repomock.ApiKeyRepoMock{}
+		repoMock.GetApiKeyByIdFunc = func(ctx context.Context, tx boil.ContextTransactor, userAccountId int, apiKeyId int) (*entities.APIKey, error) {
+			return newApiKey(), nil
+		}
+		repoMock.UpdateFunc = func(ctx context.Context, tx boil.ContextTransactor, apiKey entities.APIKey) error {
+			return nil
+		}
+
+		svc := service{repo: repoMock}
+		resp, err := svc.UpdateApiKey(ctx, 1, permissions, 2, UpdateApiKeyRequest{Scopes: &[]string{"users:write"}})
+		require.NoError(t, err)
+		require.Equal(t, "script", resp.Name)
+		require.Equal(t, []string{"users:write"}, resp.Scopes)
+		require.Equal(t, types.StringArray{"users:write"}, repoMock.UpdateCalls()[0].ApiKey.Scopes)
+	}
+	{ // update failed, key of another user
+		repoMock := &repomock.ApiKeyRepoMock{}
+		repoMock.GetApiKeyByIdFunc = func(ctx context.Context, tx boil.ContextTransactor, userAccountId int, apiKeyId int) (*entities.APIKey, error) {
+			return nil, nil
+		}
+
+		svc := service{repo: repoMock}
+		resp, err := svc.UpdateApiKey(ctx, 1, permissions, 2, UpdateApiKeyRequest{Name: ptrconv.String("renamed")})
+		require.ErrorIs(t, err, httputil.ErrNotFound)
+		require.Nil(t, resp)
+	}
+	{ // update failed, scope the user does not have
+		svc := service{repo: &repomock.ApiKeyRepoMock{}}
+		resp, err := svc.UpdateApiKey(ctx, 1, permissions, 2, UpdateApiKeyRequest{Scopes: &[]string{"roles:write"}})
+		require.ErrorIs(t, err, httputil.ErrInvalidRequest)
+		require.Nil(t, resp)
+	}
+}
+
+func TestGetApiKeys(t *testing.T) {
+	t.Parallel()
+	require.NoError(t, database.SetupDatabase())
+	ctx := dbtest.SetTestTransactionCtx(context.Background())
+
+	{ // list success, the key itself is never returned
+		repoMock := &repomock.ApiKeyRepoMock{}
+		repoMock.GetApiKeysByUserAccountIdFunc = func(ctx context.Context, tx boil.ContextTransactor, userAccountId int) (entities.APIKeySlice, error) {
+			return entities.APIKeySlice{newApiKey()}, nil
+		}
+
+		svc := service{repo: repoMock}
+		resp, err := svc.ListApiKeys(ctx, 1)
+		require.NoError(t, err)
+		require.Len(t, resp.ApiKeys, 1)
+		require.Equal(t, "abcd1234", resp.ApiKeys[0].Prefix)
+		require.Nil(t, resp.ApiKeys[0].LastUsedAt)
+	}
+	{ // get failed, not found
+		repoMock := &repomock.ApiKeyRepoMock{}
+		repoMock.GetApiKeyByIdFunc = func(ctx context.Context, tx boil.ContextTransactor, userAccountId int, apiKeyId int) (*entities.APIKey, error) {
+			return nil, nil
+		}
+
+		svc := service{repo: repoMock}
+		resp, err := svc.GetApiKey(ctx, 1, 2)
+		require.ErrorIs(t, err, httputil.ErrNotFound)
+		require.Nil(t, resp)
+	}
+}
+
+func TestDeleteApiKey(t *testing.T) {
+	t.Parallel()
+	require.NoError(t, database.SetupDatabase())
+	ctx := dbtest.SetTestTransactionCtx(context.Background())
+
+	{ // delete success
+		repoMock := &repomock.ApiKeyRepoMock{}
+		repoMock.DeleteFunc = func(ctx context.Context, tx boil.ContextTransactor, userAccountId int, apiKeyId int) (bool, error) {
+			return true, nil
+		}
+
+		svc := service{repo: repoMock}
+		require.NoError(t, svc.DeleteApiKey(ctx, 1, 2))
+	}
+	{ // delete failed, not found
+		repoMock := &repomock.ApiKeyRepoMock{}
+		repoMock.DeleteFunc = func(ctx context.Context, tx boil.ContextTransactor, userAccountId int, apiKeyId int) (bool, error) {
+			return false, nil
+		}
+
+		svc := service{repo: repoMock}
+		require.ErrorIs(t, svc.DeleteApiKey(ctx, 1, 2), httputil.ErrNotFound)
+	}
+	{ // delete failed, delete error
+		repoMock := &repomock.ApiKeyRepoMock{}
+		repoMock.DeleteFunc = func(ctx context.Context, tx boil.ContextTransactor, userAccountId int, apiKeyId int) (bool, error) {
+			return false, errors.New("delete failed")
+		}
+
+		svc := service{repo: repoMock}
+		require.Error(t, svc.DeleteApiKey(ctx, 1, 2))
+	}
+}
+
+func TestNewParams(t *testing.T) {
+	{ // create params success
+		kind := dtos.CreateApiKeyRequestKindService
+		expiresAt := time.Now().Add(time.Hour)
+		req, err := NewCreateParams(dtos.CreateApiKeyJSONRequestBody{Name: "script", Kind: &kind, Scopes: []string{"users:read"}, ExpiresAt: &expiresAt})
+		require.NoError(t, err)
+		require.Equal(t, "service", *req.Kind)
+		require.True(t, expiresAt.Equal(*req.ExpiresAt))
+	}
+	{ // update params success
+		req, err := NewUpdateParams(dtos.UpdateApiKeyJSONRequestBody{Name: ptrconv.String("renamed")})
+		require.NoError(t, err)
+		require.Equal(t, "renamed", *req.Name)
+		require.Nil(t, req.Scopes)
+	}
+}
@@ -0,0 +1,71 @@
+package changepassword
+
+import (
+	"context"
+	"log/slog"
+	"mysite/constants"
+	"mysite/dtos"
+	"mysite/features/changepassword/internal"
+	"mysite/pkgs/auth"
+	"mysite/pkgs/logger"
+	"mysite/utils/httputil"
+	"net/http"
+
+	"github.com/go-chi/render"
+	"github.com/pkg/errors"
+)
+
+type api struct {
+}
+
+type service interface {
+	ChangePassword(ctx context.Context) error
+}
+
+var newService = func(req internal.ChangePasswordRequest) service {
+	return internal.NewService(req)
+}
+
+func NewHandler() *api {
+	return &api{}
+}
+
+func (a api) ChangePassword(w http.ResponseWriter, r *http.Request) {
+	principal, found := auth.PrincipalFromContext(r.Context())
+	if !found {
+		if err := render.Render(w, r, httputil.NewFailureRender(errors.Wrap(httputil.ErrUnauthorize, "missing principal"))); err != nil {
+			slog.Error("failed to render", logger.AttrError(err))
+		}
+		return
+	}
+
+	var body dtos.ChangePasswordJSONRequestBody
+	if err := httputil.ParseBody(r, &body); err != nil {
+		if err := render.Render(w, r, httputil.NewFailureRender(errors.Wrap(err, "failed to parse body"))); err != nil {
+			slog.Error("failed to render", logger.AttrError(err))
+		}
+		return
+	}
+
+	params, err := internal.NewParams(body)
+	if err != nil {
+		if err := render.Render(w, r, httputil.NewFailureRender(errors.Wrap(err, "failed to parse params"))); err != nil {
+			slog.Error("failed to render", logger.AttrError(err))
+		}
+		return
+	}
+	params.UserId = principal.UserID
+	params.UserName = principal.UserName
+	if cookie, err := r.Cookie(constants.RefreshTokenCookie); err == nil {
+		params.RefreshToken = cookie.Value
+	}
+
+	if err := newService(*params).ChangePassword(r.Context()); err != nil {
+		if err := render.Render(w, r, httputil.NewFailureRender(errors.Wrap(err, "failed to change password"))); err != nil {
+			slog.Error("failed to render", logger.AttrError(err))
+		}
+		return
+	}
+
+	w.WriteHeader(http.StatusNoContent)
+}
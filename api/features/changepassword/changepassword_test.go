@@ -0,0 +1,147 @@
+package changepassword
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"mysite/dtos"
+	"mysite/features/changepassword/internal"
+	"mysite/pkgs/auth"
+	"mysite/utils/httputil"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/go-chi/chi/v5"
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/assert"
+)
+
+type mockService struct {
+	ChangePasswordFunc func() error
+}
+
+func (m mockService) ChangePassword(ctx context.Context) error {
+	return m.ChangePasswordFunc()
+}
+
+func withPrincipal(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Header.Get("Authorization") == "" {
+			next.ServeHTTP(w, r)
+			return
+		}
+		next.ServeHTTP(w, r.WithContext(auth.WithPrincipal(r.Context(), auth.Principal{UserID: 1})))
+	})
+}
+
+func newTestRouter() *chi.Mux {
+	router := chi.NewRouter()
+	router.Route("/api/v1", func(subr chi.Router) {
+		subr.Use(withPrincipal)
+		HandlerFromMux(NewHandler(), subr)
+	})
+	return router
+}
+
+func newRequest(body interface{}) (*http.Request, error) {
+	var buf bytes.Buffer
+	if err := json.NewEncoder(&buf).Encode(body); err != nil {
+		return nil, errors.Wrap(err, "failed encode body")
+	}
+
+	r, err := http.NewRequest(http.MethodPost, "http://example.com/api/v1/me/password", &buf)
+	if err != nil {
+		return nil, err
+	}
+	r.Header.Set("Authorization", "Bearer token")
+	return r, nil
+}
+
+func TestDashboardGetStores(t *testing.T) {
+	t.Parallel()
+	router := newTestRouter()
+	body := dtos.ChangePasswordRequest{CurrentPassword: "oldPassword1", NewPassword: "newPassword1"}
+
+	tests := []struct {
+		name       string
+		req        func(context.Context) (*http.Request, error)
+		assert     func(*httptest.ResponseRecorder, *http.Request)
+		newService func(req internal.ChangePasswordRequest) service
+	}{
+		{
+			name: "401 - without principal",
+			req: func(ctx context.Context) (*http.Request, error) {
+				return http.NewRequest(http.MethodPost, "http://example.com/api/v1/me/password", nil)
+			},
+			assert: func(w *httptest.ResponseRecorder, r *http.Request) {
+				assert.Equal(t, http.StatusUnauthorized, w.Result().StatusCode)
+			},
+		},
+		{
+			name: "400 - Invalid request, empty body",
+			req: func(ctx context.Context) (*http.Request, error) {
+				r, err := http.NewRequest(http.MethodPost, "http://example.com/api/v1/me/password", nil)
+				if err != nil {
+					return nil, err
+				}
+				r.Header.Set("Authorization", "Bearer token")
+				return r, nil
+			},
+			assert: func(w *httptest.ResponseRecorder, r *http.Request) {
+				assert.Equal(t, http.StatusBadRequest, w.Result().StatusCode)
+			},
+		},
+		{
+			name: "400 - Invalid request",
+			req: func(ctx context.Context) (*http.Request, error) {
+				return newRequest(body)
+			},
+			assert: func(w *httptest.ResponseRecorder, r *http.Request) {
+				assert.Equal(t, http.StatusBadRequest, w.Result().StatusCode)
+			},
+			newService: func(req internal.ChangePasswordRequest) service {
+				return mockService{ChangePasswordFunc: func() error { return httputil.ErrInvalidRequest }}
+			},
+		},
+		{
+			name: "204 - success",
+			req: func(ctx context.Context) (*http.Request, error) {
+				r, err := newRequest(body)
+				if err != nil {
+					return nil, err
+				}
+				r.AddCookie(&http.Cookie{Name: "refreshToken", Value: "refresh-token"})
+				return r, nil
+			},
+			assert: func(w *httptest.ResponseRecorder, r *http.Request) {
+				assert.Equal(t, http.StatusNoContent, w.Result().StatusCode)
+			},
+			newService: func(req internal.ChangePasswordRequest) service {
+				return mockService{ChangePasswordFunc: func() error {
+					if req.UserId != 1 || req.RefreshToken != "refresh-token" || req.NewPassword != "newPassword1" {
+						return httputil.ErrInvalidRequest
+					}
+					return nil
+				}}
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+
+		t.Run(tt.name, func(t *testing.T) {
+			newService = tt.newService
+			ctx := context.Background()
+			var err error
+
+			w := httptest.NewRecorder()
+			r, err := tt.req(ctx)
+			if assert.NoError(t, err) {
+				router.ServeHTTP(w, r)
+				tt.assert(w, r)
+			}
+		})
+	}
+}
@@ -0,0 +1,145 @@
+package internal
+
+import (
+	"context"
+	"mysite/dtos"
+	"mysite/pkgs/auth"
+	"mysite/pkgs/database"
+	"mysite/pkgs/validate"
+	"mysite/repositories/useraccountrepo"
+	"mysite/repositories/usersessionrepo"
+	"mysite/utils/httputil"
+	"strconv"
+	"time"
+
+	"github.com/mitchellh/mapstructure"
+	"github.com/pkg/errors"
+	"github.com/volatiletech/sqlboiler/v4/boil"
+)
+
+type service struct {
+	repo        useraccountrepo.UserAccountRepo
+	sessionRepo usersessionrepo.UserSessionRepo
+	authSvc     auth.AuthService
+	jwtHandler  auth.JwtHandler
+	req         ChangePasswordRequest
+}
+
+type ChangePasswordRequest struct {
+	// CurrentPassword current password
+	CurrentPassword string `validate:"required"`
+
+	// NewPassword new password
+	NewPassword string `validate:"required,max=128,nefield=CurrentPassword"`
+
+	// UserId id of the authenticated user
+	UserId int `mapstructure:"-"`
+
+	// UserName of the authenticated user, the new password must not contain it
+	UserName string `mapstructure:"-"`
+
+	// RefreshToken refresh token of the current session, its session is kept
+	RefreshToken string `mapstructure:"-"`
+}
+
+func NewService(req ChangePasswordRequest) service {
+	return service{
+		repo:        useraccountrepo.NewRepo(),
+		sessionRepo: usersessionrepo.NewRepo(),
+		authSvc:     auth.NewAuthService(),
+		jwtHandler:  auth.NewJwtHandler(),
+		req:         req,
+	}
+}
+
+func NewParams(req dtos.ChangePasswordJSONRequestBody) (*ChangePasswordRequest, error) {
+	var result ChangePasswordRequest
+	if err := mapstructure.Decode(req, &result); err != nil {
+		return nil, errors.Wrap(err, "failed decode")
+	}
+
+	return &result, nil
+}
+
+func (s service) ChangePassword(ctx context.Context) error {
+	// validate params
+	if err := validateParams(s.req); err != nil {
+		return errors.Wrap(err, "failed validate change password request")
+	}
+
+	// hash new password
+	hash, err := s.authSvc.HashPassword(s.req.NewPassword)
+	if err != nil {
+		return errors.Wrap(err, "failed to hash password")
+	}
+
+	keepFamilyId := s.currentFamilyId(ctx)
+
+	if err := database.NewBoilerTransaction(ctx, func(ctx context.Context, tx boil.ContextTransactor) error {
+		user, err := s.repo.GetActiveUserAccountById(ctx, tx, s.req.UserId)
+		if err != nil || user == nil {
+			return errors.Wrap(httputil.ErrUnauthorize, "user not found")
+		}
+
+		// re-verify the current password
+		match, err := s.authSvc.ComparePasswordAndHash(s.req.CurrentPassword, user.Password)
+		if err != nil || !match {
+			return errors.Wrap(httputil.ErrInvalidRequest, "current password is incorrect")
+		}
+
+		user.Password = hash
+		if err := s.repo.Update(ctx, tx, *user); err != nil {
+			return errors.Wrap(err, "failed to update password")
+		}
+
+		// sign out every other device
+		if err := s.sessionRepo.RevokeOtherFamilies(ctx, tx, user.ID, keepFamilyId); err != nil {
+			return errors.Wrap(err, "failed to revoke other sessions")
+		}
+		return nil
+	}); err != nil {
+		return err
+	}
+
+	// access tokens of the other devices stop working too, the current device gets a new one by its refresh token
+	s.jwtHandler.RevokeSubject(strconv.Itoa(s.req.UserId), time.Now().Add(auth.MaxAccessTokenTtl()))
+	return nil
+}
+
+// currentFamilyId returns the session family of the caller refresh token, empty when it can not be resolved
+func (s service) currentFamilyId(ctx context.Context) string {
+	if s.req.RefreshToken == "" {
+		return ""
+	}
+
+	var claims auth.CustomClaims[any]
+	if err := s.jwtHandler.ParseToken(s.req.RefreshToken, &claims); err != nil {
+		return ""
+	}
+	if claims.GetKeyType() != auth.RefreshKey || claims.Subject != strconv.Itoa(s.req.UserId) {
+		return ""
+	}
+
+	var familyId string
+	if err := database.NewBoilerTransaction(ctx, func(ctx context.Context, tx boil.ContextTransactor) error {
+		session, err := s.sessionRepo.GetUserSessionByTokenId(ctx, tx, claims.ID)
+		if err != nil || session == nil || session.RevokedAt.Valid {
+			return nil
+		}
+		familyId = session.FamilyID
+		return nil
+	}); err != nil {
+		return ""
+	}
+	return familyId
+}
+
+func validateParams(params ChangePasswordRequest) error {
+	if err := validate.ValidateStruct(params); err != nil {
+		return errors.Wrap(httputil.ErrInvalidRequest, err.Error())
+	}
+	if err := validate.ValidatePassword(params.NewPassword, params.UserName); err != nil {
+		return errors.Wrap(httputil.ErrWeakPassword.WithFailedRules(validate.FailedRules(err)), err.Error())
+	}
+	return nil
+}
@@ -0,0 +1,190 @@
+package internal
+
+import (
+	"context"
+	"fmt"
+	"mysite/dtos"
+	"mysite/entities"
+	"mysite/pkgs/auth"
+	"mysite/pkgs/database"
+	"mysite/testing/dbtest"
+	"mysite/testing/mocking/pkgmock"
+	"mysite/testing/mocking/repomock"
+	"mysite/utils/httputil"
+	"testing"
+	"time"
+
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/require"
+	"github.com/volatiletech/sqlboiler/v4/boil"
+)
+
+func TestMain(m *testing.M) {
+	pool, resource, err := dbtest.SetupDatabaseForTesting()
+	if err != nil {
+		return
+	}
+
+	defer func() {
+		database.Close()
+		if err := dbtest.PurgeResource(pool, resource); err != nil {
+			fmt.Println("failed to purge resource")
+		}
+	}()
+	m.Run()
+}
+
+func TestChangePassword(t *testing.T) {
+	t.Parallel()
+	require.NoError(t, database.SetupDatabase())
+	ctx := dbtest.SetTestTransactionCtx(context.Background())
+	req := ChangePasswordRequest{
+		CurrentPassword: "oldPassword1",
+		NewPassword:     "newPassword1",
+		UserId:          1,
+		RefreshToken:    "refresh-token",
+	}
+	newRepoMock := func() *repomock.UserAccountRepoMock {
+		return &repomock.UserAccountRepoMock{
+			GetActiveUserAccountByIdFunc: func(ctx context.Context, tx boil.ContextTransactor, userId int) (*entities.UserAccount, error) {
+				return &entities.UserAccount{ID: userId, Password: "oldHash"}, nil
+			},
+			UpdateFunc: func(ctx context.Context, tx boil.ContextTransactor, pgUser entities.UserAccount) error {
+				return nil
+			},
+		}
+	}
+	newAuthMock := func(match bool) *pkgmock.AuthServiceMock {
+		return &pkgmock.AuthServiceMock{
+			HashPasswordFunc: func(password string) (string, error) { return "newHash", nil },
+			ComparePasswordAndHashFunc: func(password, encodedHash string) (bool, error) {
+				return match, nil
+			},
+		}
+	}
+	newSessionMock := func() *repomock.UserSessionRepoMock {
+		return &repomock.UserSessionRepoMock{
+			GetUserSessionByTokenIdFunc: func(ctx context.Context, tx boil.ContextTransactor, tokenId string) (*entities.UserSession, error) {
+				return &entities.UserSession{UserAccountID: 1, FamilyID: "family-id", TokenID: tokenId}, nil
+			},
+			RevokeOtherFamiliesFunc: func(ctx context.Context, tx boil.ContextTransactor, userAccountId int, keepFamilyId string) error {
+				return nil
+			},
+		}
+	}
+	newJwtMock := func() *pkgmock.JwtHandlerMock {
+		return &pkgmock.JwtHandlerMock{
+			ParseTokenFunc: func(tokenString string, claims auth.Claims) error {
+				c := claims.(*auth.CustomClaims[any])
+				c.Subject = "1"
+				c.ID = "refresh-token-id"
+				c.KeyType = auth.RefreshKey
+				return nil
+			},
+			RevokeSubjectFunc: func(subject string, expiresAt time.Time) {},
+		}
+	}
+
+	{ // change password success, other sessions and access tokens revoked
+		repoMock := newRepoMock()
+		sessionMock := newSessionMock()
+		jwtMock := newJwtMock()
+		svc := service{
+			repo:        repoMock,
+			sessionRepo: sessionMock,
+			authSvc:     newAuthMock(true),
+			jwtHandler:  jwtMock,
+			req:         req,
+		}
+
+		err := svc.ChangePassword(ctx)
+		require.NoError(t, err)
+		require.Len(t, repoMock.UpdateCalls(), 1)
+		require.Equal(t, "newHash", repoMock.UpdateCalls()[0].PgUser.Password)
+		require.Len(t, sessionMock.RevokeOtherFamiliesCalls(), 1)
+		require.Equal(t, "family-id", sessionMock.RevokeOtherFamiliesCalls()[0].KeepFamilyId)
+		require.Len(t, jwtMock.RevokeSubjectCalls(), 1)
+		require.Equal(t, "1", jwtMock.RevokeSubjectCalls()[0].Subject)
+	}
+	{ // change password success, without refresh token every session is revoked
+		req := req
+		req.RefreshToken = ""
+		sessionMock := newSessionMock()
+		svc := service{
+			repo:        newRepoMock(),
+			sessionRepo: sessionMock,
+			authSvc:     newAuthMock(true),
+			jwtHandler:  newJwtMock(),
+			req:         req,
+		}
+
+		err := svc.ChangePassword(ctx)
+		require.NoError(t, err)
+		require.Len(t, sessionMock.RevokeOtherFamiliesCalls(), 1)
+		require.Empty(t, sessionMock.RevokeOtherFamiliesCalls()[0].KeepFamilyId)
+	}
+	{ // change password failed, current password is incorrect
+		repoMock := newRepoMock()
+		jwtMock := newJwtMock()
+		svc := service{
+			repo:        repoMock,
+			sessionRepo: newSessionMock(),
+			authSvc:     newAuthMock(false),
+			jwtHandler:  jwtMock,
+			req:         req,
+		}
+
+		err := svc.ChangePassword(ctx)
+		require.ErrorIs(t, err, httputil.ErrInvalidRequest)
+		require.Empty(t, repoMock.UpdateCalls())
+		require.Empty(t, jwtMock.RevokeSubjectCalls())
+	}
+	{ // change password failed, password policy
+		for _, newPassword := range []string{"short1", "onlyletters", "1234567890", "password123", "Alice-secret-42"} {
+			req := req
+			req.NewPassword = newPassword
+			req.UserName = "alice@example.com"
+			svc := service{req: req}
+
+			err := svc.ChangePassword(ctx)
+			require.ErrorIs(t, err, httputil.ErrWeakPassword, newPassword)
+		}
+	}
+	{ // change password failed, same as the current password
+		req := req
+		req.NewPassword = req.CurrentPassword
+		svc := service{req: req}
+
+		err := svc.ChangePassword(ctx)
+		require.ErrorIs(t, err, httputil.ErrInvalidRequest)
+	}
+	{ // change password failed, update failed
+		repoMock := newRepoMock()
+		repoMock.UpdateFunc = func(ctx context.Context, tx boil.ContextTransactor, pgUser entities.UserAccount) error {
+			return errors.New("update failed")
+		}
+		svc := service{
+			repo:        repoMock,
+			sessionRepo: newSessionMock(),
+			authSvc:     newAuthMock(true),
+			jwtHandler:  newJwtMock(),
+			req:         req,
+		}
+
+		err := svc.ChangePassword(ctx)
+		require.Error(t, err)
+	}
+}
+
+func TestNewParams(t *testing.T) {
+	{ // create params success
+		testReq := dtos.ChangePasswordJSONRequestBody{
+			CurrentPassword: "oldPassword1",
+			NewPassword:     "newPassword1",
+		}
+		req, err := NewParams(testReq)
+		require.NoError(t, err)
+		require.Equal(t, "oldPassword1", req.CurrentPassword)
+		require.Equal(t, "newPassword1", req.NewPassword)
+	}
+}
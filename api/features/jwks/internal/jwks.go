@@ -0,0 +1,53 @@
+package internal
+
+import (
+	"context"
+	"mysite/dtos"
+	"mysite/pkgs/auth"
+	"mysite/utils/ptrconv"
+
+	"github.com/pkg/errors"
+)
+
+type service struct {
+	publicJwks func() ([]auth.Jwk, error)
+}
+
+func NewService() service {
+	return service{
+		publicJwks: auth.PublicJwks,
+	}
+}
+
+func (s service) GetJwks(ctx context.Context) (*dtos.JwksResponse, error) {
+	keys, err := s.publicJwks()
+	if err != nil {
+		return nil, errors.Wrap(err, "failed get public keys")
+	}
+
+	resp := dtos.JwksResponse{
+		Keys: make([]dtos.Jwk, 0, len(keys)),
+	}
+	for _, key := range keys {
+		resp.Keys = append(resp.Keys, dtos.Jwk{
+			Kty: key.Kty,
+			Kid: key.Kid,
+			Use: key.Use,
+			Alg: key.Alg,
+			N:   optionalString(key.N),
+			E:   optionalString(key.E),
+			Crv: optionalString(key.Crv),
+			X:   optionalString(key.X),
+			Y:   optionalString(key.Y),
+		})
+	}
+
+	return &resp, nil
+}
+
+func optionalString(value string) *string {
+	if value == "" {
+		return nil
+	}
+	return ptrconv.String(value)
+}
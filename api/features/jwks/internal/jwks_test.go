@@ -0,0 +1,49 @@
+package internal
+
+import (
+	"context"
+	"mysite/pkgs/auth"
+	"testing"
+
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/require"
+)
+
+func TestGetJwks(t *testing.T) {
+	t.Parallel()
+	ctx := context.Background()
+
+	{ // get jwks success
+		svc := service{
+			publicJwks: func() ([]auth.Jwk, error) {
+				return []auth.Jwk{{Kty: "OKP", Kid: "kid", Use: "sig", Alg: "EdDSA", Crv: "Ed25519", X: "x"}}, nil
+			},
+		}
+
+		resp, err := svc.GetJwks(ctx)
+		require.NoError(t, err)
+		require.Len(t, resp.Keys, 1)
+		require.Equal(t, "kid", resp.Keys[0].Kid)
+		require.Equal(t, "x", *resp.Keys[0].X)
+		require.Nil(t, resp.Keys[0].N)
+	}
+	{ // no asymmetric key, empty set
+		svc := service{
+			publicJwks: func() ([]auth.Jwk, error) { return []auth.Jwk{}, nil },
+		}
+
+		resp, err := svc.GetJwks(ctx)
+		require.NoError(t, err)
+		require.NotNil(t, resp.Keys)
+		require.Empty(t, resp.Keys)
+	}
+	{ // get jwks failed
+		svc := service{
+			publicJwks: func() ([]auth.Jwk, error) { return nil, errors.New("invalid key") },
+		}
+
+		resp, err := svc.GetJwks(ctx)
+		require.Error(t, err)
+		require.Nil(t, resp)
+	}
+}
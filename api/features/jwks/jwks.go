@@ -0,0 +1,41 @@
+package jwks
+
+import (
+	"context"
+	"log/slog"
+	"mysite/dtos"
+	"mysite/features/jwks/internal"
+	"mysite/pkgs/logger"
+	"mysite/utils/httputil"
+	"net/http"
+
+	"github.com/go-chi/render"
+	"github.com/pkg/errors"
+)
+
+type api struct {
+}
+
+type service interface {
+	GetJwks(ctx context.Context) (*dtos.JwksResponse, error)
+}
+
+var newService = func() service {
+	return internal.NewService()
+}
+
+func NewHandler() *api {
+	return &api{}
+}
+
+func (a api) Jwks(w http.ResponseWriter, r *http.Request) {
+	resp, err := newService().GetJwks(r.Context())
+	if err != nil {
+		if err := render.Render(w, r, httputil.NewFailureRender(errors.Wrap(err, "failed to get jwks"))); err != nil {
+			slog.Error("failed to render", logger.AttrError(err))
+		}
+		return
+	}
+
+	render.JSON(w, r, resp)
+}
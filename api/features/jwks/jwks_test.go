@@ -0,0 +1,90 @@
+package jwks
+
+import (
+	"context"
+	"encoding/json"
+	"mysite/dtos"
+	"mysite/utils/httputil"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/go-chi/chi/v5"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type mockService struct {
+	GetJwksFunc func() (*dtos.JwksResponse, error)
+}
+
+func (m mockService) GetJwks(ctx context.Context) (*dtos.JwksResponse, error) {
+	return m.GetJwksFunc()
+}
+
+func newTestRouter() *chi.Mux {
+	router := chi.NewRouter()
+	HandlerFromMux(NewHandler(), router)
+	return router
+}
+
+func TestDashboardGetStores(t *testing.T) {
+	t.Parallel()
+	router := newTestRouter()
+
+	tests := []struct {
+		name       string
+		req        func(context.Context) (*http.Request, error)
+		assert     func(*httptest.ResponseRecorder, *http.Request)
+		newService func() service
+	}{
+		{
+			name: "500 - internal error request",
+			req: func(ctx context.Context) (*http.Request, error) {
+				return http.NewRequest(http.MethodGet, "http://example.com/.well-known/jwks.json", nil)
+			},
+			assert: func(w *httptest.ResponseRecorder, r *http.Request) {
+				assert.Equal(t, http.StatusInternalServerError, w.Result().StatusCode)
+			},
+			newService: func() service {
+				return mockService{GetJwksFunc: func() (*dtos.JwksResponse, error) { return nil, httputil.ErrInternal }}
+			},
+		},
+		{
+			name: "200 - success",
+			req: func(ctx context.Context) (*http.Request, error) {
+				return http.NewRequest(http.MethodGet, "http://example.com/.well-known/jwks.json", nil)
+			},
+			assert: func(w *httptest.ResponseRecorder, r *http.Request) {
+				assert.Equal(t, http.StatusOK, w.Result().StatusCode)
+
+				var resp dtos.JwksResponse
+				require.NoError(t, json.NewDecoder(w.Body).Decode(&resp))
+				require.Len(t, resp.Keys, 1)
+				require.Equal(t, "kid", resp.Keys[0].Kid)
+			},
+			newService: func() service {
+				return mockService{GetJwksFunc: func() (*dtos.JwksResponse, error) {
+					return &dtos.JwksResponse{Keys: []dtos.Jwk{{Kty: "RSA", Kid: "kid", Use: "sig", Alg: "RS256"}}}, nil
+				}}
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+
+		t.Run(tt.name, func(t *testing.T) {
+			newService = tt.newService
+			ctx := context.Background()
+			var err error
+
+			w := httptest.NewRecorder()
+			r, err := tt.req(ctx)
+			if assert.NoError(t, err) {
+				router.ServeHTTP(w, r)
+				tt.assert(w, r)
+			}
+		})
+	}
+}
@@ -7,23 +7,39 @@ import (
 	"mysite/entities"
 	"mysite/pkgs/auth"
 	"mysite/pkgs/database"
+	"mysite/pkgs/emailverify"
+	"mysite/pkgs/lockout"
 	"mysite/pkgs/logger"
 	"mysite/pkgs/validate"
+	"mysite/repositories/loginattemptrepo"
+	"mysite/repositories/membershiprepo"
+	"mysite/repositories/rolerepo"
 	"mysite/repositories/useraccountrepo"
+	"mysite/repositories/usermfarepo"
+	"mysite/repositories/usersessionrepo"
 	"mysite/utils/httputil"
 	"strconv"
 	"time"
 
 	"github.com/friendsofgo/errors"
+	"github.com/google/uuid"
 	"github.com/mitchellh/mapstructure"
 	"github.com/volatiletech/sqlboiler/v4/boil"
 )
 
 type service struct {
-	repo       useraccountrepo.UserAccountRepo
-	req        LoginRequest
-	authSvc    auth.AuthService
-	jwtHandler auth.JwtHandler
+	repo           useraccountrepo.UserAccountRepo
+	sessionRepo    usersessionrepo.UserSessionRepo
+	req            LoginRequest
+	authSvc        auth.AuthService
+	jwtHandler     auth.JwtHandler
+	mfaRepo        usermfarepo.UserMfaRepo
+	attemptRepo    loginattemptrepo.LoginAttemptRepo
+	roleRepo       rolerepo.RoleRepo
+	membershipRepo membershiprepo.MembershipRepo
+	accountPolicy  lockout.Policy
+	ipPolicy       lockout.Policy
+	verifyEmail    bool
 }
 
 type LoginRequest struct {
@@ -32,6 +48,9 @@ type LoginRequest struct {
 
 	// UserName email
 	UserName string `json:"userName" validate:"email,required"`
+
+	// ClientIp set by the handler, failures are also counted per ip
+	ClientIp string `json:"-" mapstructure:"-"`
 }
 
 type LoginResponse struct {
@@ -40,14 +59,27 @@ type LoginResponse struct {
 
 	// RefreshToken refresh token
 	RefreshToken string
+
+	// MfaToken set instead of the tokens above when the user has 2FA on
+	MfaToken string
 }
 
+const mfaTokenTtl = 5 * time.Minute
+
 func NewService(req LoginRequest) *service {
 	return &service{
-		repo:       useraccountrepo.NewRepo(),
-		req:        req,
-		authSvc:    auth.NewAuthService(),
-		jwtHandler: auth.NewJwtHandler(),
+		repo:           useraccountrepo.NewRepo(),
+		sessionRepo:    usersessionrepo.NewRepo(),
+		req:            req,
+		authSvc:        auth.NewAuthService(),
+		jwtHandler:     auth.NewJwtHandler(),
+		mfaRepo:        usermfarepo.NewRepo(),
+		attemptRepo:    loginattemptrepo.NewRepo(),
+		roleRepo:       rolerepo.NewRepo(),
+		membershipRepo: membershiprepo.NewRepo(),
+		accountPolicy:  lockout.AccountPolicy(),
+		ipPolicy:       lockout.IpPolicy(),
+		verifyEmail:    emailverify.Enabled(),
 	}
 }
 
@@ -66,32 +98,138 @@ func (s *service) Login(ctx context.Context) (*LoginResponse, error) {
 		return nil, errors.Wrap(err, "failed validate login request")
 	}
 
-	var user *entities.UserAccount
-	// get password from db by userName
+	var (
+		user        *entities.UserAccount
+		unknownUser bool
+		ipKey       = ipAttemptKey(s.req.ClientIp)
+	)
+	// get password from db by userName, throttled clients and accounts are rejected before the password is checked
 	if err := database.NewBoilerTransaction(ctx, func(ctx context.Context, tx boil.ContextTransactor) error {
+		if err := checkAttempts(ctx, tx, s.attemptRepo, ipKey, s.ipPolicy); err != nil {
+			return err
+		}
+
 		var err error
 		user, err = s.repo.GetActiveUserAccountByName(ctx, tx, s.req.UserName)
 		if err != nil || user == nil {
+			unknownUser = err == nil
 			return errors.Wrap(httputil.ErrUnauthorize, "login failed at step 1")
 		}
 
-		return nil
+		return checkAttempts(ctx, tx, s.attemptRepo, accountAttemptKey(user.ID), s.accountPolicy)
 	}); err != nil {
+		if unknownUser {
+			recordFailures(ctx, s.attemptRepo, map[string]lockout.Policy{ipKey: s.ipPolicy})
+		}
 		return nil, err
 	}
 
 	// check password hash
 	match, err := s.authSvc.ComparePasswordAndHash(s.req.Password, user.Password)
 	if err != nil || !match {
+		recordFailures(ctx, s.attemptRepo, map[string]lockout.Policy{
+			accountAttemptKey(user.ID): s.accountPolicy,
+			ipKey:                      s.ipPolicy,
+		})
 		return nil, errors.Wrap(httputil.ErrUnauthorize, "login failed at step 2")
 	}
+	resetFailures(ctx, s.attemptRepo, accountAttemptKey(user.ID))
+
+	// params were raised since the hash was made, upgrade it while the password is at hand
+	if s.authSvc.NeedsRehash(user.Password) {
+		s.rehashPassword(ctx, *user)
+	}
+
+	// checked after the password so that the error does not reveal the account
+	if s.verifyEmail && !user.EmailVerifiedAt.Valid {
+		return nil, errors.Wrap(httputil.ErrEmailNotVerified, "login failed, email not verified")
+	}
+
+	return finishLogin(ctx, s.jwtHandler, s.sessionRepo, s.mfaRepo, s.roleRepo, s.membershipRepo, user.ID)
+}
 
-	// generate access token and refresh token
-	accessToken, refreshToken, err := s.generateToken(user.ID)
+// rehashPassword stores a hash made with the current params, a failure only delays the upgrade to the next login
+func (s *service) rehashPassword(ctx context.Context, user entities.UserAccount) {
+	hash, err := s.authSvc.HashPassword(s.req.Password)
 	if err != nil {
-		slog.Error("failed generate token", logger.AttrError(err))
+		slog.Error("failed rehash password", logger.AttrError(err))
+		return
+	}
+
+	// only the password column, and only while it is the hash just compared, a reset or a change committed
+	// during the compare wins over the upgrade
+	var replaced bool
+	if err := database.NewBoilerTransaction(ctx, func(ctx context.Context, tx boil.ContextTransactor) error {
+		var err error
+		replaced, err = s.repo.ReplacePassword(ctx, tx, user.ID, user.Password, hash)
+		return err
+	}); err != nil {
+		slog.Error("failed store rehashed password", logger.AttrError(err))
+		return
+	}
+	if !replaced {
+		slog.Info("password changed during login, rehash skipped", "userId", user.ID)
+	}
+}
+
+func isMfaEnabled(ctx context.Context, mfaRepo usermfarepo.UserMfaRepo, userId int) (bool, error) {
+	var enabled bool
+	err := database.NewBoilerTransaction(ctx, func(ctx context.Context, tx boil.ContextTransactor) error {
+		mfa, err := mfaRepo.GetUserMfaByUserAccountId(ctx, tx, userId)
+		if err != nil {
+			return err
+		}
+		enabled = mfa != nil && mfa.EnabledAt.Valid
+		return nil
+	})
+	return enabled, err
+}
+
+// finishLogin returns a mfa token when the user has 2FA on, the tokens are issued by LoginMfa then,
+// otherwise the token pair
+func finishLogin(ctx context.Context, jwtHandler auth.JwtHandler, sessionRepo usersessionrepo.UserSessionRepo, mfaRepo usermfarepo.UserMfaRepo, roleRepo rolerepo.RoleRepo, membershipRepo membershiprepo.MembershipRepo, userId int) (*LoginResponse, error) {
+	mfaEnabled, err := isMfaEnabled(ctx, mfaRepo, userId)
+	if err != nil {
+		slog.Error("failed get mfa", logger.AttrError(err))
 		return nil, errors.Wrap(httputil.ErrUnauthorize, "login failed at step 3")
 	}
+	if mfaEnabled {
+		mfaToken, err := generateMfaToken(jwtHandler, userId)
+		if err != nil {
+			slog.Error("failed generate mfa token", logger.AttrError(err))
+			return nil, errors.Wrap(httputil.ErrUnauthorize, "login failed at step 3")
+		}
+		return &LoginResponse{MfaToken: mfaToken}, nil
+	}
+
+	return issueTokens(ctx, jwtHandler, sessionRepo, roleRepo, membershipRepo, userId)
+}
+
+// issueTokens generates the token pair and starts a new session family for the refresh token
+func issueTokens(ctx context.Context, jwtHandler auth.JwtHandler, sessionRepo usersessionrepo.UserSessionRepo, roleRepo rolerepo.RoleRepo, membershipRepo membershiprepo.MembershipRepo, userId int) (*LoginResponse, error) {
+	metaData, err := accessMetaData(ctx, roleRepo, membershipRepo, userId)
+	if err != nil {
+		slog.Error("failed get roles", logger.AttrError(err))
+		return nil, errors.Wrap(httputil.ErrUnauthorize, "login failed at step 4")
+	}
+
+	accessToken, refreshToken, refreshClaims, err := generateToken(jwtHandler, userId, *metaData)
+	if err != nil {
+		slog.Error("failed generate token", logger.AttrError(err))
+		return nil, errors.Wrap(httputil.ErrUnauthorize, "login failed at step 4")
+	}
+
+	if err := database.NewBoilerTransaction(ctx, func(ctx context.Context, tx boil.ContextTransactor) error {
+		return sessionRepo.Insert(ctx, tx, &entities.UserSession{
+			UserAccountID: userId,
+			FamilyID:      uuid.NewString(),
+			TokenID:       refreshClaims.ID,
+			ExpiresAt:     refreshClaims.ExpiresAt.Time,
+		})
+	}); err != nil {
+		slog.Error("failed save session", logger.AttrError(err))
+		return nil, errors.Wrap(httputil.ErrUnauthorize, "login failed at step 5")
+	}
 
 	return &LoginResponse{
 		AccessToken:  accessToken,
@@ -106,23 +244,66 @@ func validateParams(params LoginRequest) error {
 	return nil
 }
 
-func (s *service) generateToken(userId int) (accessToken string, refreshToken string, err error) {
+// accessMetaData reads the roles of the user, the permissions they grant and the membership tier
+func accessMetaData(ctx context.Context, roleRepo rolerepo.RoleRepo, membershipRepo membershiprepo.MembershipRepo, userId int) (*auth.AccessMetaData, error) {
+	var result auth.AccessMetaData
+	err := database.NewBoilerTransaction(ctx, func(ctx context.Context, tx boil.ContextTransactor) error {
+		var err error
+		if result.Roles, err = roleRepo.GetRoleNamesByUserAccountId(ctx, tx, userId); err != nil {
+			return errors.Wrap(err, "failed get roles")
+		}
+		if result.Permissions, err = roleRepo.GetPermissionNamesByUserAccountId(ctx, tx, userId); err != nil {
+			return errors.Wrap(err, "failed get permissions")
+		}
+		membership, err := membershipRepo.GetCurrentMembershipByUserAccountId(ctx, tx, userId)
+		if err != nil {
+			return errors.Wrap(err, "failed get membership")
+		}
+		if membership != nil {
+			result.Membership = membership.Name
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return &result, nil
+}
+
+// generateToken puts the roles into the access token only, they are read again on refresh
+func generateToken(jwtHandler auth.JwtHandler, userId int, metaData auth.AccessMetaData) (accessToken string, refreshToken string, refreshClaims *auth.CustomClaims[any], err error) {
 	claims := auth.NewCustomClaims[any]()
 	claims.Subject = strconv.Itoa(userId)
 	accessClaims := claims.Clone().WithExpireAt(time.Now().Add(15 * time.Minute))
 	accessClaims.KeyType = auth.AccessKey
+	accessClaims.MetaData = metaData
 
-	accessToken, err = s.jwtHandler.WithClaims(accessClaims).CreateToken()
+	accessToken, err = jwtHandler.WithClaims(accessClaims).CreateToken()
 	if err != nil {
-		return "", "", errors.Wrap(err, "failed create accessKey")
+		return "", "", nil, errors.Wrap(err, "failed create accessKey")
 	}
 
-	refreshClaims := claims.Clone().WithExpireAt(time.Now().Add(72 * time.Hour))
+	refreshClaims = claims.Clone().WithExpireAt(time.Now().Add(72 * time.Hour))
 	refreshClaims.KeyType = auth.RefreshKey
-	refreshToken, err = s.jwtHandler.WithClaims(refreshClaims).CreateToken()
+	// refresh token has its own jti, it identifies the session
+	refreshClaims.ID = uuid.NewString()
+	refreshToken, err = jwtHandler.WithClaims(refreshClaims).CreateToken()
 	if err != nil {
-		return "", "", errors.Wrap(err, "failed create refreshKey")
+		return "", "", nil, errors.Wrap(err, "failed create refreshKey")
 	}
 
 	return
 }
+
+// generateMfaToken identifies a login waiting for its second factor
+func generateMfaToken(jwtHandler auth.JwtHandler, userId int) (string, error) {
+	claims := auth.NewCustomClaims[any]().WithExpireAt(time.Now().Add(mfaTokenTtl))
+	claims.Subject = strconv.Itoa(userId)
+	claims.KeyType = auth.MfaPendingKey
+
+	mfaToken, err := jwtHandler.WithClaims(claims).CreateToken()
+	if err != nil {
+		return "", errors.Wrap(err, "failed create mfaPendingKey")
+	}
+	return mfaToken, nil
+}
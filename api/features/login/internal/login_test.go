@@ -4,18 +4,22 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"mysite/constants"
 	"testing"
+	"time"
 
 	"mysite/dtos"
 	"mysite/entities"
 	"mysite/pkgs/auth"
 	"mysite/pkgs/database"
+	"mysite/pkgs/lockout"
 	"mysite/testing/dbtest"
 	"mysite/testing/mocking/pkgmock"
 	"mysite/testing/mocking/repomock"
 	"mysite/utils/httputil"
 
 	"github.com/stretchr/testify/require"
+	"github.com/volatiletech/null/v8"
 	"github.com/volatiletech/sqlboiler/v4/boil"
 )
 
@@ -33,10 +37,57 @@ func TestMain(m *testing.M) {
 	}()
 	m.Run()
 }
+func newRoleMock() *repomock.RoleRepoMock {
+	return &repomock.RoleRepoMock{
+		GetRoleNamesByUserAccountIdFunc: func(ctx context.Context, tx boil.ContextTransactor, userAccountId int) ([]string, error) {
+			return []string{constants.RoleAdmin}, nil
+		},
+		GetPermissionNamesByUserAccountIdFunc: func(ctx context.Context, tx boil.ContextTransactor, userAccountId int) ([]string, error) {
+			return []string{constants.PermissionUsersRead}, nil
+		},
+	}
+}
+
+func newMembershipMock() *repomock.MembershipRepoMock {
+	return &repomock.MembershipRepoMock{
+		GetCurrentMembershipByUserAccountIdFunc: func(ctx context.Context, tx boil.ContextTransactor, userAccountId int) (*entities.Membership, error) {
+			return &entities.Membership{ID: constants.Silver, Name: "silver"}, nil
+		},
+	}
+}
+
 func TestLogin(t *testing.T) {
 	t.Parallel()
 	require.NoError(t, database.SetupDatabase())
 	ctx := dbtest.SetTestTransactionCtx(context.Background())
+	newMfaMock := func(mfa *entities.UserMfa) *repomock.UserMfaRepoMock {
+		return &repomock.UserMfaRepoMock{
+			GetUserMfaByUserAccountIdFunc: func(ctx context.Context, tx boil.ContextTransactor, userAccountId int) (*entities.UserMfa, error) {
+				return mfa, nil
+			},
+		}
+	}
+	newAttemptMock := func(attempt *entities.LoginAttempt) *repomock.LoginAttemptRepoMock {
+		return &repomock.LoginAttemptRepoMock{
+			GetLoginAttemptByKeyFunc: func(ctx context.Context, tx boil.ContextTransactor, attemptKey string) (*entities.LoginAttempt, error) {
+				return attempt, nil
+			},
+			RecordFailureFunc: func(ctx context.Context, tx boil.ContextTransactor, attemptKey string, staleBefore time.Time) (*entities.LoginAttempt, error) {
+				return &entities.LoginAttempt{AttemptKey: attemptKey, FailedCount: 1}, nil
+			},
+			DeleteByKeyFunc: func(ctx context.Context, tx boil.ContextTransactor, attemptKey string) error {
+				return nil
+			},
+		}
+	}
+	policy := lockout.Policy{
+		BackoffAfter: 3,
+		MaxAttempts:  10,
+		BaseDelay:    time.Second,
+		MaxDelay:     time.Minute,
+		Lockout:      15 * time.Minute,
+		Window:       15 * time.Minute,
+	}
 
 	{ // login success
 		repoMock := &repomock.UserAccountRepoMock{}
@@ -48,16 +99,25 @@ func TestLogin(t *testing.T) {
 
 		authMock := &pkgmock.AuthServiceMock{}
 		authMock.ComparePasswordAndHashFunc = func(password, encodedHash string) (bool, error) { return true, nil }
+		authMock.NeedsRehashFunc = func(encodedHash string) bool { return false }
 
 		jwtMock := &pkgmock.JwtHandlerMock{}
 		jwtMock.CreateTokenFunc = func() (string, error) { return "token", nil }
 		jwtMock.ParseTokenFunc = func(tokenString string, claims auth.Claims) error { return nil }
 		jwtMock.WithClaimsFunc = func(claims auth.Claims) auth.JwtHandler { return jwtMock }
 
+		sessionMock := &repomock.UserSessionRepoMock{}
+		sessionMock.InsertFunc = func(ctx context.Context, tx boil.ContextTransactor, session *entities.UserSession) error { return nil }
+
 		svc := service{
-			repo:       repoMock,
-			authSvc:    authMock,
-			jwtHandler: jwtMock,
+			repo:           repoMock,
+			sessionRepo:    sessionMock,
+			roleRepo:       newRoleMock(),
+			membershipRepo: newMembershipMock(),
+			authSvc:        authMock,
+			jwtHandler:     jwtMock,
+			attemptRepo:    newAttemptMock(nil),
+			mfaRepo:        newMfaMock(nil),
 			req: LoginRequest{
 				Password: "password",
 				UserName: "test@gmail.com",
@@ -69,6 +129,217 @@ func TestLogin(t *testing.T) {
 		require.NotNil(t, resp)
 		require.Equal(t, "token", resp.AccessToken)
 		require.Equal(t, "token", resp.RefreshToken)
+		require.Len(t, sessionMock.InsertCalls(), 1)
+		require.Equal(t, 1, sessionMock.InsertCalls()[0].Session.UserAccountID)
+		require.NotEmpty(t, sessionMock.InsertCalls()[0].Session.FamilyID)
+		require.NotEmpty(t, sessionMock.InsertCalls()[0].Session.TokenID)
+
+		// roles and membership are in the access token only
+		accessClaims := jwtMock.WithClaimsCalls()[0].Claims.(*auth.CustomClaims[any])
+		require.Equal(t, auth.AccessKey, accessClaims.KeyType)
+		require.Equal(t, auth.AccessMetaData{
+			Roles:       []string{constants.RoleAdmin},
+			Permissions: []string{constants.PermissionUsersRead},
+			Membership:  "silver",
+		}, accessClaims.MetaData)
+		refreshClaims := jwtMock.WithClaimsCalls()[1].Claims.(*auth.CustomClaims[any])
+		require.Nil(t, refreshClaims.MetaData)
+	}
+	{ // login success, email verified
+		repoMock := &repomock.UserAccountRepoMock{}
+		repoMock.GetActiveUserAccountByNameFunc = func(ctx context.Context, tx boil.ContextTransactor, userName string) (*entities.UserAccount, error) {
+			return &entities.UserAccount{
+				ID:              1,
+				EmailVerifiedAt: null.TimeFrom(time.Now()),
+			}, nil
+		}
+
+		authMock := &pkgmock.AuthServiceMock{}
+		authMock.ComparePasswordAndHashFunc = func(password, encodedHash string) (bool, error) { return true, nil }
+		authMock.NeedsRehashFunc = func(encodedHash string) bool { return false }
+
+		jwtMock := &pkgmock.JwtHandlerMock{}
+		jwtMock.CreateTokenFunc = func() (string, error) { return "token", nil }
+		jwtMock.WithClaimsFunc = func(claims auth.Claims) auth.JwtHandler { return jwtMock }
+
+		sessionMock := &repomock.UserSessionRepoMock{}
+		sessionMock.InsertFunc = func(ctx context.Context, tx boil.ContextTransactor, session *entities.UserSession) error { return nil }
+
+		svc := service{
+			repo:           repoMock,
+			sessionRepo:    sessionMock,
+			roleRepo:       newRoleMock(),
+			membershipRepo: newMembershipMock(),
+			authSvc:        authMock,
+			jwtHandler:     jwtMock,
+			attemptRepo:    newAttemptMock(nil),
+			mfaRepo:        newMfaMock(nil),
+			verifyEmail:    true,
+			req: LoginRequest{
+				Password: "password",
+				UserName: "test@gmail.com",
+			},
+		}
+
+		resp, err := svc.Login(ctx)
+		require.NoError(t, err)
+		require.NotNil(t, resp)
+	}
+	{ // login failed, email not verified
+		repoMock := &repomock.UserAccountRepoMock{}
+		repoMock.GetActiveUserAccountByNameFunc = func(ctx context.Context, tx boil.ContextTransactor, userName string) (*entities.UserAccount, error) {
+			return &entities.UserAccount{
+				ID: 1,
+			}, nil
+		}
+
+		authMock := &pkgmock.AuthServiceMock{}
+		authMock.ComparePasswordAndHashFunc = func(password, encodedHash string) (bool, error) { return true, nil }
+		authMock.NeedsRehashFunc = func(encodedHash string) bool { return false }
+
+		jwtMock := &pkgmock.JwtHandlerMock{}
+
+		svc := service{
+			repo:        repoMock,
+			authSvc:     authMock,
+			jwtHandler:  jwtMock,
+			attemptRepo: newAttemptMock(nil),
+			verifyEmail: true,
+			req: LoginRequest{
+				Password: "password",
+				UserName: "test@gmail.com",
+			},
+		}
+
+		resp, err := svc.Login(ctx)
+		require.ErrorIs(t, err, httputil.ErrEmailNotVerified)
+		require.Nil(t, resp)
+		require.Empty(t, jwtMock.CreateTokenCalls())
+	}
+	{ // login success, 2FA on returns a mfa token only
+		repoMock := &repomock.UserAccountRepoMock{}
+		repoMock.GetActiveUserAccountByNameFunc = func(ctx context.Context, tx boil.ContextTransactor, userName string) (*entities.UserAccount, error) {
+			return &entities.UserAccount{
+				ID: 1,
+			}, nil
+		}
+
+		authMock := &pkgmock.AuthServiceMock{}
+		authMock.ComparePasswordAndHashFunc = func(password, encodedHash string) (bool, error) { return true, nil }
+		authMock.NeedsRehashFunc = func(encodedHash string) bool { return false }
+
+		var keyTypes []auth.KeyType
+		jwtMock := &pkgmock.JwtHandlerMock{}
+		jwtMock.CreateTokenFunc = func() (string, error) { return "mfa-token", nil }
+		jwtMock.WithClaimsFunc = func(claims auth.Claims) auth.JwtHandler {
+			keyTypes = append(keyTypes, claims.GetKeyType())
+			return jwtMock
+		}
+
+		sessionMock := &repomock.UserSessionRepoMock{}
+
+		svc := service{
+			repo:           repoMock,
+			sessionRepo:    sessionMock,
+			roleRepo:       newRoleMock(),
+			membershipRepo: newMembershipMock(),
+			authSvc:        authMock,
+			jwtHandler:     jwtMock,
+			attemptRepo:    newAttemptMock(nil),
+			mfaRepo:        newMfaMock(&entities.UserMfa{UserAccountID: 1, EnabledAt: null.TimeFrom(time.Now())}),
+			req: LoginRequest{
+				Password: "password",
+				UserName: "test@gmail.com",
+			},
+		}
+
+		resp, err := svc.Login(ctx)
+		require.NoError(t, err)
+		require.Equal(t, "mfa-token", resp.MfaToken)
+		require.Empty(t, resp.AccessToken)
+		require.Empty(t, resp.RefreshToken)
+		require.Equal(t, []auth.KeyType{auth.MfaPendingKey}, keyTypes)
+		require.Empty(t, sessionMock.InsertCalls())
+	}
+	{ // login success, 2FA enrolled but not confirmed
+		repoMock := &repomock.UserAccountRepoMock{}
+		repoMock.GetActiveUserAccountByNameFunc = func(ctx context.Context, tx boil.ContextTransactor, userName string) (*entities.UserAccount, error) {
+			return &entities.UserAccount{
+				ID: 1,
+			}, nil
+		}
+
+		authMock := &pkgmock.AuthServiceMock{}
+		authMock.ComparePasswordAndHashFunc = func(password, encodedHash string) (bool, error) { return true, nil }
+		authMock.NeedsRehashFunc = func(encodedHash string) bool { return false }
+
+		jwtMock := &pkgmock.JwtHandlerMock{}
+		jwtMock.CreateTokenFunc = func() (string, error) { return "token", nil }
+		jwtMock.WithClaimsFunc = func(claims auth.Claims) auth.JwtHandler { return jwtMock }
+
+		sessionMock := &repomock.UserSessionRepoMock{}
+		sessionMock.InsertFunc = func(ctx context.Context, tx boil.ContextTransactor, session *entities.UserSession) error { return nil }
+
+		svc := service{
+			repo:           repoMock,
+			sessionRepo:    sessionMock,
+			roleRepo:       newRoleMock(),
+			membershipRepo: newMembershipMock(),
+			authSvc:        authMock,
+			jwtHandler:     jwtMock,
+			attemptRepo:    newAttemptMock(nil),
+			mfaRepo:        newMfaMock(&entities.UserMfa{UserAccountID: 1}),
+			req: LoginRequest{
+				Password: "password",
+				UserName: "test@gmail.com",
+			},
+		}
+
+		resp, err := svc.Login(ctx)
+		require.NoError(t, err)
+		require.Equal(t, "token", resp.AccessToken)
+		require.Empty(t, resp.MfaToken)
+	}
+	{ // login failed, save session failed
+		repoMock := &repomock.UserAccountRepoMock{}
+		repoMock.GetActiveUserAccountByNameFunc = func(ctx context.Context, tx boil.ContextTransactor, userName string) (*entities.UserAccount, error) {
+			return &entities.UserAccount{
+				ID: 1,
+			}, nil
+		}
+
+		authMock := &pkgmock.AuthServiceMock{}
+		authMock.ComparePasswordAndHashFunc = func(password, encodedHash string) (bool, error) { return true, nil }
+		authMock.NeedsRehashFunc = func(encodedHash string) bool { return false }
+
+		jwtMock := &pkgmock.JwtHandlerMock{}
+		jwtMock.CreateTokenFunc = func() (string, error) { return "token", nil }
+		jwtMock.WithClaimsFunc = func(claims auth.Claims) auth.JwtHandler { return jwtMock }
+
+		sessionMock := &repomock.UserSessionRepoMock{}
+		sessionMock.InsertFunc = func(ctx context.Context, tx boil.ContextTransactor, session *entities.UserSession) error {
+			return errors.New("insert session failed")
+		}
+
+		svc := service{
+			repo:           repoMock,
+			sessionRepo:    sessionMock,
+			roleRepo:       newRoleMock(),
+			membershipRepo: newMembershipMock(),
+			authSvc:        authMock,
+			jwtHandler:     jwtMock,
+			attemptRepo:    newAttemptMock(nil),
+			mfaRepo:        newMfaMock(nil),
+			req: LoginRequest{
+				Password: "password",
+				UserName: "test@gmail.com",
+			},
+		}
+
+		resp, err := svc.Login(ctx)
+		require.Error(t, err)
+		require.ErrorIs(t, err, httputil.ErrUnauthorize)
+		require.Nil(t, resp)
 	}
 	{ // login failed, failed get user
 		repoMock := &repomock.UserAccountRepoMock{}
@@ -81,9 +352,10 @@ func TestLogin(t *testing.T) {
 		jwtMock := &pkgmock.JwtHandlerMock{}
 
 		svc := service{
-			repo:       repoMock,
-			authSvc:    authMock,
-			jwtHandler: jwtMock,
+			repo:        repoMock,
+			authSvc:     authMock,
+			jwtHandler:  jwtMock,
+			attemptRepo: newAttemptMock(nil),
 			req: LoginRequest{
 				Password: "password",
 				UserName: "test@gmail.com",
@@ -103,13 +375,15 @@ func TestLogin(t *testing.T) {
 
 		authMock := &pkgmock.AuthServiceMock{}
 		authMock.ComparePasswordAndHashFunc = func(password, encodedHash string) (bool, error) { return true, nil }
+		authMock.NeedsRehashFunc = func(encodedHash string) bool { return false }
 
 		jwtMock := &pkgmock.JwtHandlerMock{}
 
 		svc := service{
-			repo:       repoMock,
-			authSvc:    authMock,
-			jwtHandler: jwtMock,
+			repo:        repoMock,
+			authSvc:     authMock,
+			jwtHandler:  jwtMock,
+			attemptRepo: newAttemptMock(nil),
 			req: LoginRequest{
 				Password: "password",
 				UserName: "test@gmail.com",
@@ -135,9 +409,10 @@ func TestLogin(t *testing.T) {
 		jwtMock := &pkgmock.JwtHandlerMock{}
 
 		svc := service{
-			repo:       repoMock,
-			authSvc:    authMock,
-			jwtHandler: jwtMock,
+			repo:        repoMock,
+			authSvc:     authMock,
+			jwtHandler:  jwtMock,
+			attemptRepo: newAttemptMock(nil),
 			req: LoginRequest{
 				Password: "password",
 				UserName: "test@gmail.com",
@@ -165,9 +440,10 @@ func TestLogin(t *testing.T) {
 		jwtMock := &pkgmock.JwtHandlerMock{}
 
 		svc := service{
-			repo:       repoMock,
-			authSvc:    authMock,
-			jwtHandler: jwtMock,
+			repo:        repoMock,
+			authSvc:     authMock,
+			jwtHandler:  jwtMock,
+			attemptRepo: newAttemptMock(nil),
 			req: LoginRequest{
 				Password: "password",
 				UserName: "test@gmail.com",
@@ -189,6 +465,7 @@ func TestLogin(t *testing.T) {
 
 		authMock := &pkgmock.AuthServiceMock{}
 		authMock.ComparePasswordAndHashFunc = func(password, encodedHash string) (bool, error) { return true, nil }
+		authMock.NeedsRehashFunc = func(encodedHash string) bool { return false }
 
 		jwtMock := &pkgmock.JwtHandlerMock{}
 		jwtMock.CreateTokenFunc = func() (string, error) {
@@ -197,9 +474,11 @@ func TestLogin(t *testing.T) {
 		jwtMock.WithClaimsFunc = func(claims auth.Claims) auth.JwtHandler { return jwtMock }
 
 		svc := service{
-			repo:       repoMock,
-			authSvc:    authMock,
-			jwtHandler: jwtMock,
+			repo:        repoMock,
+			authSvc:     authMock,
+			jwtHandler:  jwtMock,
+			attemptRepo: newAttemptMock(nil),
+			mfaRepo:     newMfaMock(nil),
 			req: LoginRequest{
 				Password: "password",
 				UserName: "test@gmail.com",
@@ -211,6 +490,278 @@ func TestLogin(t *testing.T) {
 		require.Nil(t, resp)
 	}
 
+	{ // login success, account failures reset
+		repoMock := &repomock.UserAccountRepoMock{}
+		repoMock.GetActiveUserAccountByNameFunc = func(ctx context.Context, tx boil.ContextTransactor, userName string) (*entities.UserAccount, error) {
+			return &entities.UserAccount{
+				ID: 1,
+			}, nil
+		}
+
+		authMock := &pkgmock.AuthServiceMock{}
+		authMock.ComparePasswordAndHashFunc = func(password, encodedHash string) (bool, error) { return true, nil }
+		authMock.NeedsRehashFunc = func(encodedHash string) bool { return false }
+
+		jwtMock := &pkgmock.JwtHandlerMock{}
+		jwtMock.CreateTokenFunc = func() (string, error) { return "token", nil }
+		jwtMock.WithClaimsFunc = func(claims auth.Claims) auth.JwtHandler { return jwtMock }
+
+		sessionMock := &repomock.UserSessionRepoMock{}
+		sessionMock.InsertFunc = func(ctx context.Context, tx boil.ContextTransactor, session *entities.UserSession) error { return nil }
+
+		// two failures, below the back-off threshold
+		attemptMock := newAttemptMock(&entities.LoginAttempt{FailedCount: 2, LastFailedAt: time.Now()})
+
+		svc := service{
+			repo:           repoMock,
+			sessionRepo:    sessionMock,
+			roleRepo:       newRoleMock(),
+			membershipRepo: newMembershipMock(),
+			authSvc:        authMock,
+			jwtHandler:     jwtMock,
+			attemptRepo:    attemptMock,
+			accountPolicy:  policy,
+			ipPolicy:       policy,
+			mfaRepo:        newMfaMock(nil),
+			req: LoginRequest{
+				Password: "password",
+				UserName: "test@gmail.com",
+				ClientIp: "127.0.0.1",
+			},
+		}
+
+		resp, err := svc.Login(ctx)
+		require.NoError(t, err)
+		require.NotNil(t, resp)
+		require.Len(t, attemptMock.DeleteByKeyCalls(), 1)
+		require.Equal(t, "account:1", attemptMock.DeleteByKeyCalls()[0].AttemptKey)
+		require.Empty(t, attemptMock.RecordFailureCalls())
+	}
+	{ // login success, outdated hash is upgraded
+		repoMock := &repomock.UserAccountRepoMock{}
+		repoMock.GetActiveUserAccountByNameFunc = func(ctx context.Context, tx boil.ContextTransactor, userName string) (*entities.UserAccount, error) {
+			return &entities.UserAccount{
+				ID:       1,
+				Password: "old-hash",
+			}, nil
+		}
+		repoMock.ReplacePasswordFunc = func(ctx context.Context, tx boil.ContextTransactor, userId int, oldHash string, newHash string) (bool, error) {
+			return true, nil
+		}
+
+		authMock := &pkgmock.AuthServiceMock{}
+		authMock.ComparePasswordAndHashFunc = func(password, encodedHash string) (bool, error) { return true, nil }
+		authMock.NeedsRehashFunc = func(encodedHash string) bool { return encodedHash == "old-hash" }
+		authMock.HashPasswordFunc = func(password string) (string, error) { return "new-hash", nil }
+
+		jwtMock := &pkgmock.JwtHandlerMock{}
+		jwtMock.CreateTokenFunc = func() (string, error) { return "token", nil }
+		jwtMock.WithClaimsFunc = func(claims auth.Claims) auth.JwtHandler { return jwtMock }
+
+		sessionMock := &repomock.UserSessionRepoMock{}
+		sessionMock.InsertFunc = func(ctx context.Context, tx boil.ContextTransactor, session *entities.UserSession) error { return nil }
+
+		svc := service{
+			repo:           repoMock,
+			sessionRepo:    sessionMock,
+			roleRepo:       newRoleMock(),
+			membershipRepo: newMembershipMock(),
+			authSvc:        authMock,
+			jwtHandler:     jwtMock,
+			attemptRepo:    newAttemptMock(nil),
+			mfaRepo:        newMfaMock(nil),
+			req: LoginRequest{
+				Password: "password",
+				UserName: "test@gmail.com",
+			},
+		}
+
+		resp, err := svc.Login(ctx)
+		require.NoError(t, err)
+		require.NotNil(t, resp)
+		require.Len(t, authMock.HashPasswordCalls(), 1)
+		require.Equal(t, "password", authMock.HashPasswordCalls()[0].Password)
+		require.Empty(t, repoMock.UpdateCalls())
+		require.Len(t, repoMock.ReplacePasswordCalls(), 1)
+		require.Equal(t, 1, repoMock.ReplacePasswordCalls()[0].UserId)
+		require.Equal(t, "old-hash", repoMock.ReplacePasswordCalls()[0].OldHash)
+		require.Equal(t, "new-hash", repoMock.ReplacePasswordCalls()[0].NewHash)
+	}
+	{ // login success, rehash failure does not fail the login
+		repoMock := &repomock.UserAccountRepoMock{}
+		repoMock.GetActiveUserAccountByNameFunc = func(ctx context.Context, tx boil.ContextTransactor, userName string) (*entities.UserAccount, error) {
+			return &entities.UserAccount{
+				ID: 1,
+			}, nil
+		}
+		repoMock.ReplacePasswordFunc = func(ctx context.Context, tx boil.ContextTransactor, userId int, oldHash string, newHash string) (bool, error) {
+			return false, errors.New("failed update")
+		}
+
+		authMock := &pkgmock.AuthServiceMock{}
+		authMock.ComparePasswordAndHashFunc = func(password, encodedHash string) (bool, error) { return true, nil }
+		authMock.NeedsRehashFunc = func(encodedHash string) bool { return true }
+		authMock.HashPasswordFunc = func(password string) (string, error) { return "new-hash", nil }
+
+		jwtMock := &pkgmock.JwtHandlerMock{}
+		jwtMock.CreateTokenFunc = func() (string, error) { return "token", nil }
+		jwtMock.WithClaimsFunc = func(claims auth.Claims) auth.JwtHandler { return jwtMock }
+
+		sessionMock := &repomock.UserSessionRepoMock{}
+		sessionMock.InsertFunc = func(ctx context.Context, tx boil.ContextTransactor, session *entities.UserSession) error { return nil }
+
+		svc := service{
+			repo:           repoMock,
+			sessionRepo:    sessionMock,
+			roleRepo:       newRoleMock(),
+			membershipRepo: newMembershipMock(),
+			authSvc:        authMock,
+			jwtHandler:     jwtMock,
+			attemptRepo:    newAttemptMock(nil),
+			mfaRepo:        newMfaMock(nil),
+			req: LoginRequest{
+				Password: "password",
+				UserName: "test@gmail.com",
+			},
+		}
+
+		resp, err := svc.Login(ctx)
+		require.NoError(t, err)
+		require.NotNil(t, resp)
+	}
+	{ // login failed, account locked
+		repoMock := &repomock.UserAccountRepoMock{}
+		repoMock.GetActiveUserAccountByNameFunc = func(ctx context.Context, tx boil.ContextTransactor, userName string) (*entities.UserAccount, error) {
+			return &entities.UserAccount{
+				ID: 1,
+			}, nil
+		}
+
+		authMock := &pkgmock.AuthServiceMock{}
+
+		attemptMock := newAttemptMock(nil)
+		attemptMock.GetLoginAttemptByKeyFunc = func(ctx context.Context, tx boil.ContextTransactor, attemptKey string) (*entities.LoginAttempt, error) {
+			if attemptKey == "account:1" {
+				return &entities.LoginAttempt{FailedCount: 10, LastFailedAt: time.Now()}, nil
+			}
+			return nil, nil
+		}
+
+		svc := service{
+			repo:          repoMock,
+			authSvc:       authMock,
+			attemptRepo:   attemptMock,
+			accountPolicy: policy,
+			ipPolicy:      policy,
+			req: LoginRequest{
+				Password: "password",
+				UserName: "test@gmail.com",
+				ClientIp: "127.0.0.1",
+			},
+		}
+
+		resp, err := svc.Login(ctx)
+		require.Error(t, err)
+		require.Nil(t, resp)
+
+		var errResp httputil.ErrResponse
+		require.ErrorAs(t, err, &errResp)
+		require.Equal(t, httputil.ErrAccountLocked.AppCode, errResp.AppCode)
+		require.Greater(t, errResp.RetryAfter, 14*time.Minute)
+		// the password is not even checked while locked
+		require.Empty(t, authMock.ComparePasswordAndHashCalls())
+	}
+	{ // login failed, ip backing off
+		repoMock := &repomock.UserAccountRepoMock{}
+
+		attemptMock := newAttemptMock(&entities.LoginAttempt{FailedCount: 4, LastFailedAt: time.Now()})
+
+		svc := service{
+			repo:          repoMock,
+			attemptRepo:   attemptMock,
+			accountPolicy: policy,
+			ipPolicy:      policy,
+			req: LoginRequest{
+				Password: "password",
+				UserName: "test@gmail.com",
+				ClientIp: "127.0.0.1",
+			},
+		}
+
+		resp, err := svc.Login(ctx)
+		require.Error(t, err)
+		require.Nil(t, resp)
+
+		var errResp httputil.ErrResponse
+		require.ErrorAs(t, err, &errResp)
+		require.Equal(t, httputil.ErrLoginDelayed.AppCode, errResp.AppCode)
+		require.Greater(t, errResp.RetryAfter, time.Duration(0))
+		require.Equal(t, "ip:127.0.0.1", attemptMock.GetLoginAttemptByKeyCalls()[0].AttemptKey)
+		require.Empty(t, repoMock.GetActiveUserAccountByNameCalls())
+	}
+	{ // login failed, wrong password counted for account and ip
+		repoMock := &repomock.UserAccountRepoMock{}
+		repoMock.GetActiveUserAccountByNameFunc = func(ctx context.Context, tx boil.ContextTransactor, userName string) (*entities.UserAccount, error) {
+			return &entities.UserAccount{
+				ID: 1,
+			}, nil
+		}
+
+		authMock := &pkgmock.AuthServiceMock{}
+		authMock.ComparePasswordAndHashFunc = func(password, encodedHash string) (bool, error) { return false, nil }
+
+		attemptMock := newAttemptMock(nil)
+
+		svc := service{
+			repo:          repoMock,
+			authSvc:       authMock,
+			attemptRepo:   attemptMock,
+			accountPolicy: policy,
+			ipPolicy:      policy,
+			req: LoginRequest{
+				Password: "password",
+				UserName: "test@gmail.com",
+				ClientIp: "127.0.0.1",
+			},
+		}
+
+		resp, err := svc.Login(ctx)
+		require.ErrorIs(t, err, httputil.ErrUnauthorize)
+		require.Nil(t, resp)
+
+		var keys []string
+		for _, call := range attemptMock.RecordFailureCalls() {
+			keys = append(keys, call.AttemptKey)
+		}
+		require.ElementsMatch(t, []string{"account:1", "ip:127.0.0.1"}, keys)
+		require.Empty(t, attemptMock.DeleteByKeyCalls())
+	}
+	{ // login failed, unknown user counted for ip only
+		repoMock := &repomock.UserAccountRepoMock{}
+		repoMock.GetActiveUserAccountByNameFunc = func(ctx context.Context, tx boil.ContextTransactor, userName string) (*entities.UserAccount, error) {
+			return nil, nil
+		}
+
+		attemptMock := newAttemptMock(nil)
+
+		svc := service{
+			repo:          repoMock,
+			attemptRepo:   attemptMock,
+			accountPolicy: policy,
+			ipPolicy:      policy,
+			req: LoginRequest{
+				Password: "password",
+				UserName: "test@gmail.com",
+				ClientIp: "127.0.0.1",
+			},
+		}
+
+		resp, err := svc.Login(ctx)
+		require.ErrorIs(t, err, httputil.ErrUnauthorize)
+		require.Nil(t, resp)
+		require.Len(t, attemptMock.RecordFailureCalls(), 1)
+		require.Equal(t, "ip:127.0.0.1", attemptMock.RecordFailureCalls()[0].AttemptKey)
+	}
 	{ // login failed, request body wrong
 		repoMock := &repomock.UserAccountRepoMock{}
 		repoMock.GetActiveUserAccountByNameFunc = func(ctx context.Context, tx boil.ContextTransactor, userName string) (*entities.UserAccount, error) {
@@ -221,13 +772,15 @@ func TestLogin(t *testing.T) {
 
 		authMock := &pkgmock.AuthServiceMock{}
 		authMock.ComparePasswordAndHashFunc = func(password, encodedHash string) (bool, error) { return true, nil }
+		authMock.NeedsRehashFunc = func(encodedHash string) bool { return false }
 
 		jwtMock := &pkgmock.JwtHandlerMock{}
 
 		svc := service{
-			repo:       repoMock,
-			authSvc:    authMock,
-			jwtHandler: jwtMock,
+			repo:        repoMock,
+			authSvc:     authMock,
+			jwtHandler:  jwtMock,
+			attemptRepo: newAttemptMock(nil),
 			req: LoginRequest{
 				Password: "",
 				UserName: "test",
@@ -0,0 +1,197 @@
+package internal
+
+import (
+	"context"
+	"fmt"
+	"mysite/dtos"
+	"mysite/entities"
+	"mysite/pkgs/auth"
+	"mysite/pkgs/database"
+	"mysite/pkgs/env"
+	"mysite/pkgs/mailer"
+	"mysite/pkgs/validate"
+	"mysite/repositories/magiclinkrepo"
+	"mysite/repositories/membershiprepo"
+	"mysite/repositories/rolerepo"
+	"mysite/repositories/useraccountrepo"
+	"mysite/repositories/usermfarepo"
+	"mysite/repositories/usersessionrepo"
+	"mysite/utils/httputil"
+	"net/url"
+	"strconv"
+	"time"
+
+	"github.com/friendsofgo/errors"
+	"github.com/mitchellh/mapstructure"
+	"github.com/volatiletech/null/v8"
+	"github.com/volatiletech/sqlboiler/v4/boil"
+)
+
+type magicLinkService struct {
+	repo           useraccountrepo.UserAccountRepo
+	magicLinkRepo  magiclinkrepo.MagicLinkRepo
+	sessionRepo    usersessionrepo.UserSessionRepo
+	mfaRepo        usermfarepo.UserMfaRepo
+	roleRepo       rolerepo.RoleRepo
+	membershipRepo membershiprepo.MembershipRepo
+	jwtHandler     auth.JwtHandler
+	mailer         mailer.Mailer
+}
+
+type MagicLinkRequest struct {
+	// UserName email
+	UserName string `validate:"email,required"`
+}
+
+type MagicLinkVerifyRequest struct {
+	// Token token of the magic link
+	Token string `validate:"required"`
+}
+
+func NewMagicLinkService() *magicLinkService {
+	return &magicLinkService{
+		repo:           useraccountrepo.NewRepo(),
+		magicLinkRepo:  magiclinkrepo.NewRepo(),
+		sessionRepo:    usersessionrepo.NewRepo(),
+		mfaRepo:        usermfarepo.NewRepo(),
+		roleRepo:       rolerepo.NewRepo(),
+		membershipRepo: membershiprepo.NewRepo(),
+		jwtHandler:     auth.NewJwtHandler(),
+		mailer:         mailer.NewMailer(),
+	}
+}
+
+func NewMagicLinkParams(req dtos.LoginMagicLinkJSONRequestBody) (*MagicLinkRequest, error) {
+	var result MagicLinkRequest
+	if err := mapstructure.Decode(req, &result); err != nil {
+		return nil, errors.Wrap(err, "failed decode")
+	}
+
+	return &result, nil
+}
+
+func NewMagicLinkVerifyParams(req dtos.LoginMagicLinkVerifyParams) (*MagicLinkVerifyRequest, error) {
+	var result MagicLinkVerifyRequest
+	if err := mapstructure.Decode(req, &result); err != nil {
+		return nil, errors.Wrap(err, "failed decode")
+	}
+
+	return &result, nil
+}
+
+// SendMagicLink mails a signed single-use link, unknown accounts are silently ignored so the caller can not tell them apart
+func (s *magicLinkService) SendMagicLink(ctx context.Context, req MagicLinkRequest) error {
+	if err := validate.ValidateStruct(req); err != nil {
+		return errors.Wrap(httputil.ErrInvalidRequest, err.Error())
+	}
+
+	claims := auth.NewCustomClaims[any]().WithExpireAt(time.Now().Add(magicLinkTtl()))
+	claims.KeyType = auth.MagicLinkKey
+
+	var found bool
+	if err := database.NewBoilerTransaction(ctx, func(ctx context.Context, tx boil.ContextTransactor) error {
+		user, err := s.repo.GetActiveUserAccountByName(ctx, tx, req.UserName)
+		if err != nil {
+			return errors.Wrap(err, "failed get userAccount")
+		}
+		if user == nil {
+			return nil
+		}
+		found = true
+		claims.Subject = strconv.Itoa(user.ID)
+
+		// the jti is stored so that the link signs in once
+		return s.magicLinkRepo.Insert(ctx, tx, &entities.MagicLinkToken{
+			UserAccountID: user.ID,
+			Jti:           claims.ID,
+			ExpiresAt:     claims.ExpiresAt.Time,
+		})
+	}); err != nil {
+		return errors.Wrap(err, "failed create magic link")
+	}
+
+	if !found {
+		return nil
+	}
+
+	token, err := s.jwtHandler.WithClaims(claims).CreateToken()
+	if err != nil {
+		return errors.Wrap(err, "failed create magicLinkKey")
+	}
+
+	// sent in the background, the latency of the response must not tell whether a mail was sent
+	mailer.SendInBackground(ctx, s.mailer, newMagicLinkMessage(req.UserName, token))
+	return nil
+}
+
+// VerifyMagicLink consumes the link and issues the same tokens as Login
+func (s *magicLinkService) VerifyMagicLink(ctx context.Context, req MagicLinkVerifyRequest) (*LoginResponse, error) {
+	if err := validate.ValidateStruct(req); err != nil {
+		return nil, errors.Wrap(httputil.ErrInvalidRequest, err.Error())
+	}
+
+	var claims auth.CustomClaims[any]
+	if err := s.jwtHandler.ParseToken(req.Token, &claims); err != nil {
+		return nil, errors.Wrapf(httputil.ErrUnauthorize, "failed to parse token: %s", err.Error())
+	}
+	if claims.GetKeyType() != auth.MagicLinkKey {
+		return nil, errors.Wrap(httputil.ErrUnauthorize, "not a magic link token")
+	}
+
+	userId, err := strconv.Atoi(claims.Subject)
+	if err != nil {
+		return nil, errors.Wrap(httputil.ErrUnauthorize, "invalid subject")
+	}
+
+	if err := database.NewBoilerTransaction(ctx, func(ctx context.Context, tx boil.ContextTransactor) error {
+		token, err := s.magicLinkRepo.GetMagicLinkTokenByJti(ctx, tx, claims.ID)
+		if err != nil {
+			return errors.Wrap(err, "failed get magic link")
+		}
+		if token == nil || token.UserAccountID != userId || token.UsedAt.Valid || time.Now().After(token.ExpiresAt) {
+			return errors.Wrap(httputil.ErrUnauthorize, "invalid or used magic link")
+		}
+
+		user, err := s.repo.GetActiveUserAccountById(ctx, tx, userId)
+		if err != nil || user == nil {
+			return errors.Wrap(httputil.ErrUnauthorize, "login failed at step 1")
+		}
+
+		// the link was opened from the mailbox, the address is proven
+		if !user.EmailVerifiedAt.Valid {
+			user.EmailVerifiedAt = null.TimeFrom(time.Now())
+			if err := s.repo.Update(ctx, tx, *user); err != nil {
+				return errors.Wrap(err, "failed to verify email")
+			}
+		}
+
+		return s.magicLinkRepo.MarkUsed(ctx, tx, *token)
+	}); err != nil {
+		return nil, errors.Wrap(err, "failed verify magic link")
+	}
+
+	// the link replaces the password only, the second factor is still required
+	return finishLogin(ctx, s.jwtHandler, s.sessionRepo, s.mfaRepo, s.roleRepo, s.membershipRepo, userId)
+}
+
+func magicLinkTtl() time.Duration {
+	minutes := env.GetEnv().MagicLink.ExpireMinutes
+	if minutes <= 0 {
+		minutes = 15
+	}
+	return time.Duration(minutes) * time.Minute
+}
+
+func newMagicLinkMessage(to, token string) mailer.Message {
+	link := token
+	if linkUrl := env.GetEnv().MagicLink.LinkUrl; linkUrl != "" {
+		link = fmt.Sprintf("%s?token=%s", linkUrl, url.QueryEscape(token))
+	}
+
+	return mailer.Message{
+		To:      []string{to},
+		Subject: "Your sign-in link",
+		Body: fmt.Sprintf("Use the link below to sign in to mysite.\n\n%s\n\nThe link expires in %s and works once. If you did not ask for it, you can ignore this mail.",
+			link, magicLinkTtl()),
+	}
+}
@@ -0,0 +1,199 @@
+package internal
+
+import (
+	"context"
+	"mysite/dtos"
+	"mysite/entities"
+	"mysite/pkgs/auth"
+	"mysite/pkgs/database"
+	"mysite/pkgs/mailer"
+	"mysite/testing/dbtest"
+	"mysite/testing/mocking/repomock"
+	"mysite/utils/httputil"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"github.com/volatiletech/null/v8"
+	"github.com/volatiletech/sqlboiler/v4/boil"
+)
+
+func TestMagicLink(t *testing.T) {
+	t.Parallel()
+	require.NoError(t, database.SetupDatabase())
+	ctx := dbtest.SetTestTransactionCtx(context.Background())
+
+	newRepoMock := func() *repomock.UserAccountRepoMock {
+		return &repomock.UserAccountRepoMock{
+			GetActiveUserAccountByNameFunc: func(ctx context.Context, tx boil.ContextTransactor, userName string) (*entities.UserAccount, error) {
+				if userName != "test@example.com" {
+					return nil, nil
+				}
+				return &entities.UserAccount{ID: 1, UserName: userName, IsActive: true}, nil
+			},
+			GetActiveUserAccountByIdFunc: func(ctx context.Context, tx boil.ContextTransactor, userId int) (*entities.UserAccount, error) {
+				return &entities.UserAccount{ID: userId, IsActive: true}, nil
+			},
+			UpdateFunc: func(ctx context.Context, tx boil.ContextTransactor, pgUser entities.UserAccount) error {
+				return nil
+			},
+		}
+	}
+	// the mock stores the inserted tokens by jti like the table does
+	newMagicLinkMock := func() *repomock.MagicLinkRepoMock {
+		tokens := map[string]entities.MagicLinkToken{}
+		return &repomock.MagicLinkRepoMock{
+			InsertFunc: func(ctx context.Context, tx boil.ContextTransactor, token *entities.MagicLinkToken) error {
+				tokens[token.Jti] = *token
+				return nil
+			},
+			GetMagicLinkTokenByJtiFunc: func(ctx context.Context, tx boil.ContextTransactor, jti string) (*entities.MagicLinkToken, error) {
+				token, ok := tokens[jti]
+				if !ok {
+					return nil, nil
+				}
+				return &token, nil
+			},
+			MarkUsedFunc: func(ctx context.Context, tx boil.ContextTransactor, token entities.MagicLinkToken) error {
+				token.UsedAt = null.TimeFrom(time.Now())
+				tokens[token.Jti] = token
+				return nil
+			},
+		}
+	}
+	newSvc := func(magicLinkRepo *repomock.MagicLinkRepoMock, outbox *mailer.OutboxMailer, mfa *entities.UserMfa) *magicLinkService {
+		return &magicLinkService{
+			repo:          newRepoMock(),
+			magicLinkRepo: magicLinkRepo,
+			sessionRepo:   &repomock.UserSessionRepoMock{InsertFunc: func(ctx context.Context, tx boil.ContextTransactor, session *entities.UserSession) error { return nil }},
+			mfaRepo: &repomock.UserMfaRepoMock{GetUserMfaByUserAccountIdFunc: func(ctx context.Context, tx boil.ContextTransactor, userAccountId int) (*entities.UserMfa, error) {
+				return mfa, nil
+			}},
+			roleRepo:       newRoleMock(),
+			membershipRepo: newMembershipMock(),
+			jwtHandler:     auth.NewJwtHandler(),
+			mailer:         outbox,
+		}
+	}
+	// linkToken the token of the last mail, the body carries it alone on its line, the mail is sent in the background
+	linkToken := func(outbox *mailer.OutboxMailer) string {
+		require.Eventually(t, func() bool { return len(outbox.Messages()) > 0 }, time.Second, 10*time.Millisecond)
+		messages := outbox.Messages()
+		return strings.Split(messages[len(messages)-1].Body, "\n\n")[1]
+	}
+
+	{ // link is mailed and signs in once
+		outbox := mailer.NewOutboxMailer("")
+		magicLinkRepo := newMagicLinkMock()
+		svc := newSvc(magicLinkRepo, outbox, nil)
+
+		require.NoError(t, svc.SendMagicLink(ctx, MagicLinkRequest{UserName: "test@example.com"}))
+		require.Len(t, magicLinkRepo.InsertCalls(), 1)
+		require.Equal(t, 1, magicLinkRepo.InsertCalls()[0].Token.UserAccountID)
+
+		token := linkToken(outbox)
+		require.Len(t, outbox.Messages(), 1)
+		require.Equal(t, []string{"test@example.com"}, outbox.Messages()[0].To)
+		var claims auth.CustomClaims[any]
+		require.NoError(t, auth.NewJwtHandler().ParseToken(token, &claims))
+		require.Equal(t, auth.MagicLinkKey, claims.KeyType)
+		require.Equal(t, "1", claims.Subject)
+		require.Equal(t, magicLinkRepo.InsertCalls()[0].Token.Jti, claims.ID)
+		require.WithinDuration(t, time.Now().Add(15*time.Minute), claims.ExpiresAt.Time, time.Minute)
+
+		resp, err := svc.VerifyMagicLink(ctx, MagicLinkVerifyRequest{Token: token})
+		require.NoError(t, err)
+		require.NotEmpty(t, resp.AccessToken)
+		require.NotEmpty(t, resp.RefreshToken)
+		require.Len(t, magicLinkRepo.MarkUsedCalls(), 1)
+
+		// the link proves the address
+		repoMock := svc.repo.(*repomock.UserAccountRepoMock)
+		require.Len(t, repoMock.UpdateCalls(), 1)
+		require.True(t, repoMock.UpdateCalls()[0].PgUser.EmailVerifiedAt.Valid)
+
+		// the stored jti is used now
+		resp, err = svc.VerifyMagicLink(ctx, MagicLinkVerifyRequest{Token: token})
+		require.ErrorIs(t, err, httputil.ErrUnauthorize)
+		require.Nil(t, resp)
+	}
+	{ // verified address is kept as it is
+		outbox := mailer.NewOutboxMailer("")
+		svc := newSvc(newMagicLinkMock(), outbox, nil)
+		repoMock := svc.repo.(*repomock.UserAccountRepoMock)
+		repoMock.GetActiveUserAccountByIdFunc = func(ctx context.Context, tx boil.ContextTransactor, userId int) (*entities.UserAccount, error) {
+			return &entities.UserAccount{ID: userId, IsActive: true, EmailVerifiedAt: null.TimeFrom(time.Now())}, nil
+		}
+		require.NoError(t, svc.SendMagicLink(ctx, MagicLinkRequest{UserName: "test@example.com"}))
+
+		_, err := svc.VerifyMagicLink(ctx, MagicLinkVerifyRequest{Token: linkToken(outbox)})
+		require.NoError(t, err)
+		require.Empty(t, repoMock.UpdateCalls())
+	}
+	{ // unknown account, nothing is mailed
+		outbox := mailer.NewOutboxMailer("")
+		magicLinkRepo := newMagicLinkMock()
+
+		require.NoError(t, newSvc(magicLinkRepo, outbox, nil).SendMagicLink(ctx, MagicLinkRequest{UserName: "unknown@example.com"}))
+		require.Empty(t, outbox.Messages())
+		require.Empty(t, magicLinkRepo.InsertCalls())
+	}
+	{ // invalid userName
+		err := newSvc(newMagicLinkMock(), mailer.NewOutboxMailer(""), nil).SendMagicLink(ctx, MagicLinkRequest{UserName: "test"})
+		require.ErrorIs(t, err, httputil.ErrInvalidRequest)
+	}
+	{ // second factor required
+		outbox := mailer.NewOutboxMailer("")
+		svc := newSvc(newMagicLinkMock(), outbox, &entities.UserMfa{UserAccountID: 1, EnabledAt: null.TimeFrom(time.Now())})
+		require.NoError(t, svc.SendMagicLink(ctx, MagicLinkRequest{UserName: "test@example.com"}))
+
+		resp, err := svc.VerifyMagicLink(ctx, MagicLinkVerifyRequest{Token: linkToken(outbox)})
+		require.NoError(t, err)
+		require.NotEmpty(t, resp.MfaToken)
+		require.Empty(t, resp.AccessToken)
+	}
+	{ // token without stored jti
+		claims := auth.NewCustomClaims[any]().WithExpireAt(time.Now().Add(time.Minute))
+		claims.Subject = "1"
+		claims.KeyType = auth.MagicLinkKey
+		token, err := auth.NewJwtHandler().WithClaims(claims).CreateToken()
+		require.NoError(t, err)
+
+		_, err = newSvc(newMagicLinkMock(), mailer.NewOutboxMailer(""), nil).VerifyMagicLink(ctx, MagicLinkVerifyRequest{Token: token})
+		require.ErrorIs(t, err, httputil.ErrUnauthorize)
+	}
+	{ // token of another key type
+		claims := auth.NewCustomClaims[any]().WithExpireAt(time.Now().Add(time.Minute))
+		claims.Subject = "1"
+		claims.KeyType = auth.VerifyEmailKey
+		token, err := auth.NewJwtHandler().WithClaims(claims).CreateToken()
+		require.NoError(t, err)
+
+		_, err = newSvc(newMagicLinkMock(), mailer.NewOutboxMailer(""), nil).VerifyMagicLink(ctx, MagicLinkVerifyRequest{Token: token})
+		require.ErrorIs(t, err, httputil.ErrUnauthorize)
+	}
+	{ // expired link
+		outbox := mailer.NewOutboxMailer("")
+		magicLinkRepo := newMagicLinkMock()
+		svc := newSvc(magicLinkRepo, outbox, nil)
+		require.NoError(t, svc.SendMagicLink(ctx, MagicLinkRequest{UserName: "test@example.com"}))
+		expired := *magicLinkRepo.InsertCalls()[0].Token
+		expired.ExpiresAt = time.Now().Add(-time.Minute)
+		require.NoError(t, magicLinkRepo.Insert(ctx, nil, &expired))
+
+		_, err := svc.VerifyMagicLink(ctx, MagicLinkVerifyRequest{Token: linkToken(outbox)})
+		require.ErrorIs(t, err, httputil.ErrUnauthorize)
+	}
+}
+
+func TestNewMagicLinkParams(t *testing.T) {
+	t.Parallel()
+	params, err := NewMagicLinkParams(dtos.MagicLinkRequest{UserName: "test@example.com"})
+	require.NoError(t, err)
+	require.Equal(t, MagicLinkRequest{UserName: "test@example.com"}, *params)
+
+	verify, err := NewMagicLinkVerifyParams(dtos.LoginMagicLinkVerifyParams{Token: "token"})
+	require.NoError(t, err)
+	require.Equal(t, MagicLinkVerifyRequest{Token: "token"}, *verify)
+}
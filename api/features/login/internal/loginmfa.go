@@ -0,0 +1,149 @@
+package internal
+
+import (
+	"context"
+	"mysite/dtos"
+	"mysite/pkgs/auth"
+	"mysite/pkgs/database"
+	"mysite/pkgs/lockout"
+	"mysite/pkgs/totp"
+	"mysite/pkgs/validate"
+	"mysite/repositories/loginattemptrepo"
+	"mysite/repositories/membershiprepo"
+	"mysite/repositories/rolerepo"
+	"mysite/repositories/useraccountrepo"
+	"mysite/repositories/usermfarepo"
+	"mysite/repositories/usersessionrepo"
+	"mysite/utils/httputil"
+	"strconv"
+	"time"
+
+	"github.com/friendsofgo/errors"
+	"github.com/mitchellh/mapstructure"
+	"github.com/volatiletech/sqlboiler/v4/boil"
+)
+
+// maxMfaCodeAttempts wrong codes after which the mfa token is revoked, another one needs the password again
+const maxMfaCodeAttempts = 5
+
+type mfaService struct {
+	repo           useraccountrepo.UserAccountRepo
+	sessionRepo    usersessionrepo.UserSessionRepo
+	mfaRepo        usermfarepo.UserMfaRepo
+	roleRepo       rolerepo.RoleRepo
+	membershipRepo membershiprepo.MembershipRepo
+	attemptRepo    loginattemptrepo.LoginAttemptRepo
+	jwtHandler     auth.JwtHandler
+	accountPolicy  lockout.Policy
+	req            LoginMfaRequest
+}
+
+type LoginMfaRequest struct {
+	// MfaToken mfaToken returned by login
+	MfaToken string `validate:"required"`
+
+	// Code TOTP code or recovery code
+	Code string `validate:"required,max=32"`
+}
+
+func NewMfaService(req LoginMfaRequest) *mfaService {
+	return &mfaService{
+		repo:           useraccountrepo.NewRepo(),
+		sessionRepo:    usersessionrepo.NewRepo(),
+		mfaRepo:        usermfarepo.NewRepo(),
+		roleRepo:       rolerepo.NewRepo(),
+		membershipRepo: membershiprepo.NewRepo(),
+		attemptRepo:    loginattemptrepo.NewRepo(),
+		jwtHandler:     auth.NewJwtHandler(),
+		accountPolicy:  lockout.AccountPolicy(),
+		req:            req,
+	}
+}
+
+func NewMfaParams(req dtos.LoginMfaJSONRequestBody) (*LoginMfaRequest, error) {
+	var result LoginMfaRequest
+	if err := mapstructure.Decode(req, &result); err != nil {
+		return nil, errors.Wrap(err, "failed decode")
+	}
+
+	return &result, nil
+}
+
+// LoginMfa swaps the mfa token and a TOTP or recovery code for the token pair
+func (s *mfaService) LoginMfa(ctx context.Context) (*LoginResponse, error) {
+	if err := validate.ValidateStruct(s.req); err != nil {
+		return nil, errors.Wrap(httputil.ErrInvalidRequest, err.Error())
+	}
+
+	var claims auth.CustomClaims[any]
+	if err := s.jwtHandler.ParseToken(s.req.MfaToken, &claims); err != nil {
+		return nil, errors.Wrapf(httputil.ErrUnauthorize, "failed to parse token: %s", err.Error())
+	}
+	if claims.GetKeyType() != auth.MfaPendingKey {
+		return nil, errors.Wrap(httputil.ErrUnauthorize, "not a mfa token")
+	}
+
+	userId, err := strconv.Atoi(claims.Subject)
+	if err != nil {
+		return nil, errors.Wrap(httputil.ErrUnauthorize, "invalid subject")
+	}
+
+	// wrong codes are counted by the account and by the mfa token, the account backs off and locks like the password
+	var wrongCode bool
+	if err := database.NewBoilerTransaction(ctx, func(ctx context.Context, tx boil.ContextTransactor) error {
+		user, err := s.repo.GetActiveUserAccountById(ctx, tx, userId)
+		if err != nil || user == nil {
+			return errors.Wrap(httputil.ErrUnauthorize, "login failed at step 1")
+		}
+
+		if err := checkAttempts(ctx, tx, s.attemptRepo, mfaAttemptKey(userId), s.accountPolicy); err != nil {
+			return err
+		}
+
+		mfa, err := s.mfaRepo.GetUserMfaByUserAccountId(ctx, tx, userId)
+		if err != nil || mfa == nil || !mfa.EnabledAt.Valid {
+			return errors.Wrap(httputil.ErrUnauthorize, "login failed at step 2")
+		}
+
+		// TOTP code first, recovery code otherwise
+		if matched, err := totp.Accept(mfa, s.req.Code, time.Now()); matched {
+			if err != nil {
+				wrongCode = true
+				return errors.Wrap(httputil.ErrUnauthorize, err.Error())
+			}
+			return s.mfaRepo.Update(ctx, tx, *mfa)
+		}
+
+		code, err := s.mfaRepo.GetUnusedRecoveryCode(ctx, tx, userId, totp.HashRecoveryCode(s.req.Code))
+		if err != nil || code == nil {
+			wrongCode = err == nil
+			return errors.Wrap(httputil.ErrUnauthorize, "invalid code")
+		}
+		return s.mfaRepo.MarkRecoveryCodeUsed(ctx, tx, *code)
+	}); err != nil {
+		if wrongCode {
+			s.recordWrongCode(ctx, userId, claims)
+		}
+		return nil, errors.Wrap(err, "failed verify code")
+	}
+	resetFailures(ctx, s.attemptRepo, mfaAttemptKey(userId))
+
+	// the mfa token can not be swapped twice
+	if claims.ExpiresAt != nil {
+		s.jwtHandler.RevokeToken(claims.ID, claims.ExpiresAt.Time)
+	}
+
+	return issueTokens(ctx, s.jwtHandler, s.sessionRepo, s.roleRepo, s.membershipRepo, userId)
+}
+
+// recordWrongCode counts a wrong code, the mfa token is revoked once it has seen too many
+func (s *mfaService) recordWrongCode(ctx context.Context, userId int, claims auth.CustomClaims[any]) {
+	tokenKey := mfaTokenAttemptKey(claims.ID)
+	counts := recordFailures(ctx, s.attemptRepo, map[string]lockout.Policy{
+		mfaAttemptKey(userId): s.accountPolicy,
+		tokenKey:              s.accountPolicy,
+	})
+	if counts[tokenKey] >= maxMfaCodeAttempts && claims.ExpiresAt != nil {
+		s.jwtHandler.RevokeToken(claims.ID, claims.ExpiresAt.Time)
+	}
+}
@@ -0,0 +1,281 @@
+package internal
+
+import (
+	"context"
+	"mysite/dtos"
+	"mysite/entities"
+	"mysite/pkgs/auth"
+	"mysite/pkgs/database"
+	"mysite/pkgs/lockout"
+	"mysite/pkgs/totp"
+	"mysite/testing/dbtest"
+	"mysite/testing/mocking/pkgmock"
+	"mysite/testing/mocking/repomock"
+	"mysite/utils/httputil"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"github.com/volatiletech/null/v8"
+	"github.com/volatiletech/sqlboiler/v4/boil"
+)
+
+func TestLoginMfa(t *testing.T) {
+	t.Parallel()
+	require.NoError(t, database.SetupDatabase())
+	ctx := dbtest.SetTestTransactionCtx(context.Background())
+	secret, err := totp.GenerateSecret()
+	require.NoError(t, err)
+
+	newJwtMock := func(keyType auth.KeyType) *pkgmock.JwtHandlerMock {
+		jwtMock := &pkgmock.JwtHandlerMock{}
+		jwtMock.ParseTokenFunc = func(tokenString string, claims auth.Claims) error {
+			c := claims.(*auth.CustomClaims[any])
+			c.ID = "mfa-token-id"
+			c.Subject = "1"
+			c.KeyType = keyType
+			c.WithExpireAt(time.Now().Add(time.Minute))
+			return nil
+		}
+		jwtMock.RevokeTokenFunc = func(tokenId string, expiresAt time.Time) {}
+		jwtMock.CreateTokenFunc = func() (string, error) { return "token", nil }
+		jwtMock.WithClaimsFunc = func(claims auth.Claims) auth.JwtHandler { return jwtMock }
+		return jwtMock
+	}
+	newRepoMock := func() *repomock.UserAccountRepoMock {
+		return &repomock.UserAccountRepoMock{
+			GetActiveUserAccountByIdFunc: func(ctx context.Context, tx boil.ContextTransactor, userId int) (*entities.UserAccount, error) {
+				return &entities.UserAccount{ID: userId}, nil
+			},
+		}
+	}
+	newMfaMock := func(mfa *entities.UserMfa, code *entities.UserRecoveryCode) *repomock.UserMfaRepoMock {
+		return &repomock.UserMfaRepoMock{
+			GetUserMfaByUserAccountIdFunc: func(ctx context.Context, tx boil.ContextTransactor, userAccountId int) (*entities.UserMfa, error) {
+				return mfa, nil
+			},
+			UpdateFunc: func(ctx context.Context, tx boil.ContextTransactor, mfa entities.UserMfa) error {
+				return nil
+			},
+			GetUnusedRecoveryCodeFunc: func(ctx context.Context, tx boil.ContextTransactor, userAccountId int, codeHash string) (*entities.UserRecoveryCode, error) {
+				return code, nil
+			},
+			MarkRecoveryCodeUsedFunc: func(ctx context.Context, tx boil.ContextTransactor, code entities.UserRecoveryCode) error {
+				return nil
+			},
+		}
+	}
+	newSessionMock := func() *repomock.UserSessionRepoMock {
+		return &repomock.UserSessionRepoMock{
+			InsertFunc: func(ctx context.Context, tx boil.ContextTransactor, session *entities.UserSession) error { return nil },
+		}
+	}
+	newAttemptMock := func(attempt *entities.LoginAttempt, failedCount int) *repomock.LoginAttemptRepoMock {
+		return &repomock.LoginAttemptRepoMock{
+			GetLoginAttemptByKeyFunc: func(ctx context.Context, tx boil.ContextTransactor, attemptKey string) (*entities.LoginAttempt, error) {
+				return attempt, nil
+			},
+			RecordFailureFunc: func(ctx context.Context, tx boil.ContextTransactor, attemptKey string, staleBefore time.Time) (*entities.LoginAttempt, error) {
+				return &entities.LoginAttempt{AttemptKey: attemptKey, FailedCount: failedCount}, nil
+			},
+			DeleteByKeyFunc: func(ctx context.Context, tx boil.ContextTransactor, attemptKey string) error {
+				return nil
+			},
+		}
+	}
+	policy := lockout.Policy{
+		BackoffAfter: 3,
+		MaxAttempts:  10,
+		BaseDelay:    time.Second,
+		MaxDelay:     time.Minute,
+		Lockout:      15 * time.Minute,
+		Window:       15 * time.Minute,
+	}
+	enabledMfa := func() *entities.UserMfa {
+		return &entities.UserMfa{UserAccountID: 1, Secret: secret, EnabledAt: null.TimeFrom(time.Now())}
+	}
+
+	{ // login mfa success, TOTP code
+		code, err := totp.Code(secret, time.Now())
+		require.NoError(t, err)
+		jwtMock := newJwtMock(auth.MfaPendingKey)
+		mfaMock := newMfaMock(enabledMfa(), nil)
+		sessionMock := newSessionMock()
+		svc := mfaService{
+			repo:           newRepoMock(),
+			attemptRepo:    newAttemptMock(nil, 1),
+			accountPolicy:  policy,
+			sessionRepo:    sessionMock,
+			roleRepo:       newRoleMock(),
+			membershipRepo: newMembershipMock(),
+			mfaRepo:        mfaMock,
+			jwtHandler:     jwtMock,
+			req:            LoginMfaRequest{MfaToken: "mfa-token", Code: code},
+		}
+
+		resp, err := svc.LoginMfa(ctx)
+		require.NoError(t, err)
+		require.Equal(t, "token", resp.AccessToken)
+		require.Equal(t, "token", resp.RefreshToken)
+		require.Len(t, mfaMock.UpdateCalls(), 1)
+		require.True(t, mfaMock.UpdateCalls()[0].Mfa.LastUsedStep.Valid)
+		require.Len(t, jwtMock.RevokeTokenCalls(), 1)
+		require.Equal(t, "mfa-token-id", jwtMock.RevokeTokenCalls()[0].TokenId)
+		require.Len(t, sessionMock.InsertCalls(), 1)
+		require.Equal(t, "mfa:1", svc.attemptRepo.(*repomock.LoginAttemptRepoMock).DeleteByKeyCalls()[0].AttemptKey)
+	}
+	{ // login mfa failed, TOTP code replayed
+		code, err := totp.Code(secret, time.Now())
+		require.NoError(t, err)
+		step, _ := totp.Validate(secret, code, time.Now())
+		mfa := enabledMfa()
+		mfa.LastUsedStep = null.Int64From(step)
+		svc := mfaService{
+			repo:           newRepoMock(),
+			attemptRepo:    newAttemptMock(nil, 1),
+			accountPolicy:  policy,
+			sessionRepo:    newSessionMock(),
+			roleRepo:       newRoleMock(),
+			membershipRepo: newMembershipMock(),
+			mfaRepo:        newMfaMock(mfa, nil),
+			jwtHandler:     newJwtMock(auth.MfaPendingKey),
+			req:            LoginMfaRequest{MfaToken: "mfa-token", Code: code},
+		}
+
+		resp, err := svc.LoginMfa(ctx)
+		require.ErrorIs(t, err, httputil.ErrUnauthorize)
+		require.Nil(t, resp)
+	}
+	{ // login mfa success, recovery code
+		mfaMock := newMfaMock(enabledMfa(), &entities.UserRecoveryCode{ID: 1, UserAccountID: 1})
+		svc := mfaService{
+			repo:           newRepoMock(),
+			attemptRepo:    newAttemptMock(nil, 1),
+			accountPolicy:  policy,
+			sessionRepo:    newSessionMock(),
+			roleRepo:       newRoleMock(),
+			membershipRepo: newMembershipMock(),
+			mfaRepo:        mfaMock,
+			jwtHandler:     newJwtMock(auth.MfaPendingKey),
+			req:            LoginMfaRequest{MfaToken: "mfa-token", Code: "abcde-fghij"},
+		}
+
+		resp, err := svc.LoginMfa(ctx)
+		require.NoError(t, err)
+		require.NotNil(t, resp)
+		require.Equal(t, totp.HashRecoveryCode("abcde-fghij"), mfaMock.GetUnusedRecoveryCodeCalls()[0].CodeHash)
+		require.Len(t, mfaMock.MarkRecoveryCodeUsedCalls(), 1)
+	}
+	{ // login mfa failed, invalid code
+		sessionMock := newSessionMock()
+		svc := mfaService{
+			repo:           newRepoMock(),
+			attemptRepo:    newAttemptMock(nil, 1),
+			accountPolicy:  policy,
+			sessionRepo:    sessionMock,
+			roleRepo:       newRoleMock(),
+			membershipRepo: newMembershipMock(),
+			mfaRepo:        newMfaMock(enabledMfa(), nil),
+			jwtHandler:     newJwtMock(auth.MfaPendingKey),
+			req:            LoginMfaRequest{MfaToken: "mfa-token", Code: "000000"},
+		}
+
+		resp, err := svc.LoginMfa(ctx)
+		require.ErrorIs(t, err, httputil.ErrUnauthorize)
+		require.Nil(t, resp)
+		require.Empty(t, sessionMock.InsertCalls())
+	}
+	{ // login mfa failed, wrong codes are counted and the mfa token is revoked after too many
+		attemptMock := newAttemptMock(nil, maxMfaCodeAttempts)
+		jwtMock := newJwtMock(auth.MfaPendingKey)
+		svc := mfaService{
+			repo:          newRepoMock(),
+			attemptRepo:   attemptMock,
+			accountPolicy: policy,
+			mfaRepo:       newMfaMock(enabledMfa(), nil),
+			jwtHandler:    jwtMock,
+			req:           LoginMfaRequest{MfaToken: "mfa-token", Code: "000000"},
+		}
+
+		_, err := svc.LoginMfa(ctx)
+		require.ErrorIs(t, err, httputil.ErrUnauthorize)
+		require.Len(t, attemptMock.RecordFailureCalls(), 2)
+		keys := []string{attemptMock.RecordFailureCalls()[0].AttemptKey, attemptMock.RecordFailureCalls()[1].AttemptKey}
+		require.ElementsMatch(t, []string{"mfa:1", "mfaToken:mfa-token-id"}, keys)
+		require.Len(t, jwtMock.RevokeTokenCalls(), 1)
+		require.Equal(t, "mfa-token-id", jwtMock.RevokeTokenCalls()[0].TokenId)
+		require.Empty(t, attemptMock.DeleteByKeyCalls())
+	}
+	{ // login mfa failed, a wrong code below the limit keeps the mfa token
+		jwtMock := newJwtMock(auth.MfaPendingKey)
+		svc := mfaService{
+			repo:          newRepoMock(),
+			attemptRepo:   newAttemptMock(nil, 1),
+			accountPolicy: policy,
+			mfaRepo:       newMfaMock(enabledMfa(), nil),
+			jwtHandler:    jwtMock,
+			req:           LoginMfaRequest{MfaToken: "mfa-token", Code: "000000"},
+		}
+
+		_, err := svc.LoginMfa(ctx)
+		require.ErrorIs(t, err, httputil.ErrUnauthorize)
+		require.Empty(t, jwtMock.RevokeTokenCalls())
+	}
+	{ // login mfa failed, second factors of the account locked
+		code, err := totp.Code(secret, time.Now())
+		require.NoError(t, err)
+		mfaMock := newMfaMock(enabledMfa(), nil)
+		svc := mfaService{
+			repo:          newRepoMock(),
+			attemptRepo:   newAttemptMock(&entities.LoginAttempt{AttemptKey: "mfa:1", FailedCount: 10, LastFailedAt: time.Now()}, 11),
+			accountPolicy: policy,
+			mfaRepo:       mfaMock,
+			jwtHandler:    newJwtMock(auth.MfaPendingKey),
+			req:           LoginMfaRequest{MfaToken: "mfa-token", Code: code},
+		}
+
+		_, err = svc.LoginMfa(ctx)
+		require.ErrorIs(t, err, httputil.ErrAccountLocked)
+		require.Empty(t, mfaMock.GetUserMfaByUserAccountIdCalls())
+	}
+	{ // login mfa failed, access token instead of mfa token
+		svc := mfaService{
+			jwtHandler: newJwtMock(auth.AccessKey),
+			req:        LoginMfaRequest{MfaToken: "access-token", Code: "123456"},
+		}
+
+		resp, err := svc.LoginMfa(ctx)
+		require.ErrorIs(t, err, httputil.ErrUnauthorize)
+		require.Nil(t, resp)
+	}
+	{ // login mfa failed, 2FA not enabled
+		svc := mfaService{
+			repo:          newRepoMock(),
+			attemptRepo:   newAttemptMock(nil, 1),
+			accountPolicy: policy,
+			mfaRepo:       newMfaMock(nil, nil),
+			jwtHandler:    newJwtMock(auth.MfaPendingKey),
+			req:           LoginMfaRequest{MfaToken: "mfa-token", Code: "123456"},
+		}
+
+		resp, err := svc.LoginMfa(ctx)
+		require.ErrorIs(t, err, httputil.ErrUnauthorize)
+		require.Nil(t, resp)
+	}
+	{ // login mfa failed, request body wrong
+		svc := mfaService{req: LoginMfaRequest{}}
+
+		resp, err := svc.LoginMfa(ctx)
+		require.ErrorIs(t, err, httputil.ErrInvalidRequest)
+		require.Nil(t, resp)
+	}
+}
+
+func TestNewMfaParams(t *testing.T) {
+	{ // success
+		result, err := NewMfaParams(dtos.LoginMfaRequest{MfaToken: "mfa-token", Code: "123456"})
+		require.NoError(t, err)
+		require.Equal(t, "mfa-token", result.MfaToken)
+		require.Equal(t, "123456", result.Code)
+	}
+}
@@ -0,0 +1,274 @@
+package internal
+
+import (
+	"context"
+	"crypto/subtle"
+	"log/slog"
+	"mysite/constants"
+	"mysite/dtos"
+	"mysite/entities"
+	"mysite/pkgs/auth"
+	"mysite/pkgs/database"
+	"mysite/pkgs/env"
+	"mysite/pkgs/logger"
+	"mysite/pkgs/oidc"
+	"mysite/pkgs/validate"
+	"mysite/repositories/membershiprepo"
+	"mysite/repositories/rolerepo"
+	"mysite/repositories/useraccountrepo"
+	"mysite/repositories/useridentityrepo"
+	"mysite/repositories/usermfarepo"
+	"mysite/repositories/usersessionrepo"
+	"mysite/utils/httputil"
+	"time"
+
+	"github.com/friendsofgo/errors"
+	"github.com/mitchellh/mapstructure"
+	"github.com/volatiletech/null/v8"
+	"github.com/volatiletech/sqlboiler/v4/boil"
+)
+
+type oidcService struct {
+	repo           useraccountrepo.UserAccountRepo
+	identityRepo   useridentityrepo.UserIdentityRepo
+	sessionRepo    usersessionrepo.UserSessionRepo
+	mfaRepo        usermfarepo.UserMfaRepo
+	roleRepo       rolerepo.RoleRepo
+	membershipRepo membershiprepo.MembershipRepo
+	authSvc        auth.AuthService
+	jwtHandler     auth.JwtHandler
+	newProvider    func(name string) (oidc.Provider, error)
+}
+
+// OidcState what the login keeps in the state cookie until the provider redirects back
+type OidcState struct {
+	Provider     string `json:"provider"`
+	State        string `json:"state"`
+	Nonce        string `json:"nonce"`
+	CodeVerifier string `json:"codeVerifier"`
+}
+
+type LoginOidcResponse struct {
+	// Location authorization endpoint of the provider
+	Location string
+
+	// StateToken signed OidcState, set as cookie for the callback
+	StateToken string
+
+	// StateExpiresIn lifetime of the state token
+	StateExpiresIn time.Duration
+}
+
+type LoginOidcCallbackRequest struct {
+	// Provider set by the handler from the path
+	Provider string `mapstructure:"-" validate:"required"`
+
+	// Code authorization code, missing when the provider returns an error
+	Code string
+
+	// State must match the state of the cookie
+	State string
+
+	// Error set by the provider when the user denied the login
+	Error string
+
+	// StateToken set by the handler from the state cookie
+	StateToken string `mapstructure:"-"`
+}
+
+func NewOidcService() *oidcService {
+	return &oidcService{
+		repo:           useraccountrepo.NewRepo(),
+		identityRepo:   useridentityrepo.NewRepo(),
+		sessionRepo:    usersessionrepo.NewRepo(),
+		mfaRepo:        usermfarepo.NewRepo(),
+		roleRepo:       rolerepo.NewRepo(),
+		membershipRepo: membershiprepo.NewRepo(),
+		authSvc:        auth.NewAuthService(),
+		jwtHandler:     auth.NewJwtHandler(),
+		newProvider:    oidc.NewProvider,
+	}
+}
+
+func NewOidcCallbackParams(req dtos.LoginOidcCallbackParams) (*LoginOidcCallbackRequest, error) {
+	var result LoginOidcCallbackRequest
+	if err := mapstructure.Decode(req, &result); err != nil {
+		return nil, errors.Wrap(err, "failed decode")
+	}
+
+	return &result, nil
+}
+
+// LoginOidc starts the code flow at the provider, state, nonce and the PKCE verifier are bound to the browser by the state token
+func (s *oidcService) LoginOidc(ctx context.Context, providerName string) (*LoginOidcResponse, error) {
+	provider, err := s.newProvider(providerName)
+	if err != nil {
+		return nil, errors.Wrap(httputil.ErrNotFound, err.Error())
+	}
+
+	state := OidcState{Provider: providerName}
+	for _, value := range []*string{&state.State, &state.Nonce, &state.CodeVerifier} {
+		if *value, _, err = auth.GenerateOpaqueToken(); err != nil {
+			return nil, errors.Wrap(err, "failed generate oidc state")
+		}
+	}
+
+	location, err := provider.AuthCodeURL(ctx, state.State, state.Nonce, oidc.CodeChallenge(state.CodeVerifier))
+	if err != nil {
+		return nil, errors.Wrap(err, "failed create authorization url")
+	}
+
+	ttl := stateTtl()
+	claims := auth.NewCustomClaims[OidcState]().WithExpireAt(time.Now().Add(ttl))
+	claims.KeyType = auth.OidcStateKey
+	claims.MetaData = state
+	stateToken, err := s.jwtHandler.WithClaims(claims).CreateToken()
+	if err != nil {
+		return nil, errors.Wrap(err, "failed create oidcStateKey")
+	}
+
+	return &LoginOidcResponse{Location: location, StateToken: stateToken, StateExpiresIn: ttl}, nil
+}
+
+// LoginOidcCallback redeems the code, signs in the user linked to the identity and issues the same tokens as Login
+func (s *oidcService) LoginOidcCallback(ctx context.Context, req LoginOidcCallbackRequest) (*LoginResponse, error) {
+	if err := validate.ValidateStruct(req); err != nil {
+		return nil, errors.Wrap(httputil.ErrInvalidRequest, err.Error())
+	}
+	if req.Error != "" {
+		return nil, errors.Wrapf(httputil.ErrUnauthorize, "provider returned %s", req.Error)
+	}
+	if req.Code == "" || req.State == "" {
+		return nil, errors.Wrap(httputil.ErrInvalidRequest, "code and state are required")
+	}
+
+	var claims auth.CustomClaims[OidcState]
+	if err := s.jwtHandler.ParseToken(req.StateToken, &claims); err != nil {
+		return nil, errors.Wrapf(httputil.ErrUnauthorize, "failed to parse state token: %s", err.Error())
+	}
+	if claims.GetKeyType() != auth.OidcStateKey {
+		return nil, errors.Wrap(httputil.ErrUnauthorize, "not an oidc state token")
+	}
+	// the state binds the redirect to the browser which started the login
+	if claims.MetaData.Provider != req.Provider || subtle.ConstantTimeCompare([]byte(claims.MetaData.State), []byte(req.State)) != 1 {
+		return nil, errors.Wrap(httputil.ErrUnauthorize, "state does not match")
+	}
+
+	provider, err := s.newProvider(req.Provider)
+	if err != nil {
+		return nil, errors.Wrap(httputil.ErrNotFound, err.Error())
+	}
+	idToken, err := provider.Exchange(ctx, req.Code, claims.MetaData.CodeVerifier, claims.MetaData.Nonce)
+	if err != nil {
+		slog.Error("failed exchange oidc code", logger.AttrError(err))
+		return nil, errors.Wrap(httputil.ErrUnauthorize, "login failed at step 1")
+	}
+
+	// the state token can not be used for a second code
+	if claims.ExpiresAt != nil {
+		s.jwtHandler.RevokeToken(claims.ID, claims.ExpiresAt.Time)
+	}
+
+	var userId int
+	if err := database.NewBoilerTransaction(ctx, func(ctx context.Context, tx boil.ContextTransactor) error {
+		var err error
+		userId, err = s.linkedUser(ctx, tx, req.Provider, *idToken)
+		return err
+	}); err != nil {
+		return nil, errors.Wrap(err, "failed link identity")
+	}
+
+	// the provider replaces the password only, the second factor is still required
+	return finishLogin(ctx, s.jwtHandler, s.sessionRepo, s.mfaRepo, s.roleRepo, s.membershipRepo, userId)
+}
+
+// linkedUser the account of the identity, on the first login the identity is linked by its verified email
+// to the account of that user name, or to a new account
+func (s *oidcService) linkedUser(ctx context.Context, tx boil.ContextTransactor, providerName string, idToken oidc.IDToken) (int, error) {
+	identity, err := s.identityRepo.GetUserIdentityBySubject(ctx, tx, idToken.Issuer, idToken.Subject)
+	if err != nil {
+		return 0, errors.Wrap(err, "failed get identity")
+	}
+	if identity != nil {
+		user, err := s.repo.GetActiveUserAccountById(ctx, tx, identity.UserAccountID)
+		if err != nil || user == nil {
+			return 0, errors.Wrap(httputil.ErrUnauthorize, "login failed at step 2")
+		}
+		return user.ID, nil
+	}
+
+	// an unverified email of the provider could name the account of someone else
+	if idToken.Email == "" || !idToken.EmailVerified {
+		return 0, errors.Wrap(httputil.ErrUnauthorize, "email not verified by the provider")
+	}
+
+	user, err := s.repo.GetUserAccountByUserName(ctx, tx, idToken.Email)
+	if err != nil {
+		return 0, errors.Wrap(err, "failed get user")
+	}
+	switch {
+	case user == nil:
+		if user, err = s.createUser(ctx, tx, idToken.Email); err != nil {
+			return 0, err
+		}
+	case !user.IsActive, user.IsDeleted:
+		return 0, errors.Wrap(httputil.ErrUnauthorize, "login failed at step 2")
+	case !user.EmailVerifiedAt.Valid:
+		// anyone may have registered the address without owning it, linking would hand them the provider login
+		return 0, errors.Wrap(httputil.ErrUnauthorize, "account email not verified")
+	}
+
+	if err := s.identityRepo.Insert(ctx, tx, &entities.UserIdentity{
+		UserAccountID: user.ID,
+		Provider:      providerName,
+		Issuer:        idToken.Issuer,
+		Subject:       idToken.Subject,
+		Email:         null.StringFrom(idToken.Email),
+	}); err != nil {
+		return 0, errors.Wrap(err, "failed insert identity")
+	}
+	return user.ID, nil
+}
+
+// createUser registers the user of the provider, the random password is unknown until it is reset
+func (s *oidcService) createUser(ctx context.Context, tx boil.ContextTransactor, userName string) (*entities.UserAccount, error) {
+	password, _, err := auth.GenerateOpaqueToken()
+	if err != nil {
+		return nil, errors.Wrap(err, "failed generate password")
+	}
+	hash, err := s.authSvc.HashPassword(password)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to hash password")
+	}
+
+	user := &entities.UserAccount{
+		UserName:        userName,
+		Password:        hash,
+		IsActive:        true,
+		EmailVerifiedAt: null.TimeFrom(time.Now()),
+	}
+	if err := s.repo.Insert(ctx, tx, user); err != nil {
+		return nil, errors.Wrap(err, "failed to insert user")
+	}
+
+	// every new user starts as a member
+	role, err := s.roleRepo.GetRoleByName(ctx, tx, constants.RoleMember)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to get member role")
+	}
+	if role == nil {
+		return nil, errors.New("member role not found")
+	}
+	if err := s.roleRepo.AssignRole(ctx, tx, user.ID, role.ID); err != nil {
+		return nil, errors.Wrap(err, "failed to assign member role")
+	}
+	return user, nil
+}
+
+func stateTtl() time.Duration {
+	minutes := env.GetEnv().Oidc.StateExpireMinutes
+	if minutes <= 0 {
+		minutes = 10
+	}
+	return time.Duration(minutes) * time.Minute
+}
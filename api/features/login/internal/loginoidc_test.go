@@ -0,0 +1,261 @@
+package internal
+
+import (
+	"context"
+	"mysite/constants"
+	"mysite/dtos"
+	"mysite/entities"
+	"mysite/pkgs/auth"
+	"mysite/pkgs/database"
+	"mysite/pkgs/env"
+	"mysite/pkgs/oidc"
+	"mysite/testing/dbtest"
+	"mysite/testing/mocking/pkgmock"
+	"mysite/testing/mocking/repomock"
+	"mysite/testing/oidctest"
+	"mysite/utils/httputil"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"github.com/volatiletech/null/v8"
+	"github.com/volatiletech/sqlboiler/v4/boil"
+)
+
+// TestLoginOidc drives the code flow against the mock provider, the accounts are mocked
+func TestLoginOidc(t *testing.T) {
+	server, err := oidctest.NewServer("mysite", "secret")
+	require.NoError(t, err)
+	t.Cleanup(server.Close)
+
+	origin := env.GetEnv()
+	require.NoError(t, env.ReadEnv(func(appEnv *env.AppEnv) {
+		appEnv.Oidc.Providers = map[string]env.OidcProvider{
+			"test": server.Provider("https://example.com/api/v1/login/oidc/test/callback"),
+		}
+	}))
+	t.Cleanup(func() {
+		_ = env.ReadEnv(func(appEnv *env.AppEnv) { *appEnv = origin })
+	})
+	require.NoError(t, database.SetupDatabase())
+	ctx := dbtest.SetTestTransactionCtx(context.Background())
+
+	// the mock keeps the claims of the state token and hands them back on parse
+	newJwtMock := func() *pkgmock.JwtHandlerMock {
+		jwtMock := &pkgmock.JwtHandlerMock{}
+		var state *auth.CustomClaims[OidcState]
+		jwtMock.WithClaimsFunc = func(claims auth.Claims) auth.JwtHandler {
+			if c, ok := claims.(*auth.CustomClaims[OidcState]); ok {
+				state = c
+			}
+			return jwtMock
+		}
+		jwtMock.CreateTokenFunc = func() (string, error) { return "token", nil }
+		jwtMock.ParseTokenFunc = func(tokenString string, claims auth.Claims) error {
+			if tokenString != "token" || state == nil {
+				return httputil.ErrUnauthorize
+			}
+			*claims.(*auth.CustomClaims[OidcState]) = *state
+			return nil
+		}
+		jwtMock.RevokeTokenFunc = func(tokenId string, expiresAt time.Time) {}
+		return jwtMock
+	}
+	newRepoMock := func(existing *entities.UserAccount) *repomock.UserAccountRepoMock {
+		return &repomock.UserAccountRepoMock{
+			GetUserAccountByUserNameFunc: func(ctx context.Context, tx boil.ContextTransactor, userName string) (*entities.UserAccount, error) {
+				return existing, nil
+			},
+			GetActiveUserAccountByIdFunc: func(ctx context.Context, tx boil.ContextTransactor, userId int) (*entities.UserAccount, error) {
+				return &entities.UserAccount{ID: userId, IsActive: true}, nil
+			},
+			InsertFunc: func(ctx context.Context, tx boil.ContextTransactor, user *entities.UserAccount) error {
+				user.ID = 2
+				return nil
+			},
+		}
+	}
+	newIdentityMock := func(existing *entities.UserIdentity) *repomock.UserIdentityRepoMock {
+		return &repomock.UserIdentityRepoMock{
+			GetUserIdentityBySubjectFunc: func(ctx context.Context, tx boil.ContextTransactor, issuer string, subject string) (*entities.UserIdentity, error) {
+				return existing, nil
+			},
+			InsertFunc: func(ctx context.Context, tx boil.ContextTransactor, identity *entities.UserIdentity) error {
+				return nil
+			},
+		}
+	}
+	newMfaMock := func(mfa *entities.UserMfa) *repomock.UserMfaRepoMock {
+		return &repomock.UserMfaRepoMock{
+			GetUserMfaByUserAccountIdFunc: func(ctx context.Context, tx boil.ContextTransactor, userAccountId int) (*entities.UserMfa, error) {
+				return mfa, nil
+			},
+		}
+	}
+	newOidcRoleMock := func() *repomock.RoleRepoMock {
+		roleMock := newRoleMock()
+		roleMock.GetRoleByNameFunc = func(ctx context.Context, tx boil.ContextTransactor, name string) (*entities.Role, error) {
+			return &entities.Role{ID: 3, Name: name}, nil
+		}
+		roleMock.AssignRoleFunc = func(ctx context.Context, tx boil.ContextTransactor, userAccountId int, roleId int) error {
+			return nil
+		}
+		return roleMock
+	}
+	newSvc := func(repo *repomock.UserAccountRepoMock, identityRepo *repomock.UserIdentityRepoMock, mfa *entities.UserMfa) *oidcService {
+		return &oidcService{
+			repo:           repo,
+			identityRepo:   identityRepo,
+			sessionRepo:    &repomock.UserSessionRepoMock{InsertFunc: func(ctx context.Context, tx boil.ContextTransactor, session *entities.UserSession) error { return nil }},
+			mfaRepo:        newMfaMock(mfa),
+			roleRepo:       newOidcRoleMock(),
+			membershipRepo: newMembershipMock(),
+			authSvc:        &pkgmock.AuthServiceMock{HashPasswordFunc: func(password string) (string, error) { return "hash", nil }},
+			jwtHandler:     newJwtMock(),
+			newProvider:    oidc.NewProvider,
+		}
+	}
+	// login starts the flow and lets the identity sign in at the provider
+	login := func(svc *oidcService, identity oidctest.Identity) (*LoginResponse, error) {
+		started, err := svc.LoginOidc(ctx, "test")
+		require.NoError(t, err)
+		code, state, err := server.Authorize(started.Location, identity)
+		require.NoError(t, err)
+		return svc.LoginOidcCallback(ctx, LoginOidcCallbackRequest{Provider: "test", Code: code, State: state, StateToken: started.StateToken})
+	}
+	identity := oidctest.Identity{Subject: "subject", Email: "user@example.com", EmailVerified: true}
+
+	{ // unknown provider
+		_, err := newSvc(newRepoMock(nil), newIdentityMock(nil), nil).LoginOidc(ctx, "unknown")
+		require.ErrorIs(t, err, httputil.ErrNotFound)
+	}
+	{ // first login, a new account is created and linked
+		repo := newRepoMock(nil)
+		identityRepo := newIdentityMock(nil)
+		svc := newSvc(repo, identityRepo, nil)
+
+		resp, err := login(svc, identity)
+		require.NoError(t, err)
+		require.Equal(t, "token", resp.AccessToken)
+		require.Equal(t, "token", resp.RefreshToken)
+		require.Len(t, repo.InsertCalls(), 1)
+		require.Equal(t, "user@example.com", repo.InsertCalls()[0].User.UserName)
+		require.Equal(t, "hash", repo.InsertCalls()[0].User.Password)
+		require.True(t, repo.InsertCalls()[0].User.EmailVerifiedAt.Valid)
+		require.Equal(t, constants.RoleMember, svc.roleRepo.(*repomock.RoleRepoMock).GetRoleByNameCalls()[0].Name)
+		require.Len(t, identityRepo.InsertCalls(), 1)
+		require.Equal(t, entities.UserIdentity{
+			UserAccountID: 2,
+			Provider:      "test",
+			Issuer:        server.URL,
+			Subject:       "subject",
+			Email:         null.StringFrom("user@example.com"),
+		}, *identityRepo.InsertCalls()[0].Identity)
+		require.Len(t, svc.jwtHandler.(*pkgmock.JwtHandlerMock).RevokeTokenCalls(), 1)
+	}
+	{ // first login fails without the member role, nothing is linked
+		repo := newRepoMock(nil)
+		identityRepo := newIdentityMock(nil)
+		svc := newSvc(repo, identityRepo, nil)
+		svc.roleRepo.(*repomock.RoleRepoMock).GetRoleByNameFunc = func(ctx context.Context, tx boil.ContextTransactor, name string) (*entities.Role, error) {
+			return nil, nil
+		}
+
+		_, err := login(svc, identity)
+		require.Error(t, err)
+		require.Empty(t, svc.roleRepo.(*repomock.RoleRepoMock).AssignRoleCalls())
+		require.Empty(t, identityRepo.InsertCalls())
+	}
+	{ // linked identity signs in its account
+		repo := newRepoMock(nil)
+		identityRepo := newIdentityMock(&entities.UserIdentity{UserAccountID: 1, Issuer: server.URL, Subject: "subject"})
+
+		resp, err := login(newSvc(repo, identityRepo, nil), identity)
+		require.NoError(t, err)
+		require.Equal(t, "token", resp.AccessToken)
+		require.Equal(t, 1, repo.GetActiveUserAccountByIdCalls()[0].UserId)
+		require.Empty(t, repo.InsertCalls())
+		require.Empty(t, identityRepo.InsertCalls())
+	}
+	{ // existing verified account is linked
+		repo := newRepoMock(&entities.UserAccount{ID: 1, UserName: "user@example.com", IsActive: true, EmailVerifiedAt: null.TimeFrom(time.Now())})
+		identityRepo := newIdentityMock(nil)
+
+		_, err := login(newSvc(repo, identityRepo, nil), identity)
+		require.NoError(t, err)
+		require.Empty(t, repo.InsertCalls())
+		require.Equal(t, 1, identityRepo.InsertCalls()[0].Identity.UserAccountID)
+	}
+	{ // existing unverified account is not linked
+		repo := newRepoMock(&entities.UserAccount{ID: 1, UserName: "user@example.com", IsActive: true})
+		identityRepo := newIdentityMock(nil)
+
+		_, err := login(newSvc(repo, identityRepo, nil), identity)
+		require.ErrorIs(t, err, httputil.ErrUnauthorize)
+		require.Empty(t, identityRepo.InsertCalls())
+	}
+	{ // deleted account is not linked
+		repo := newRepoMock(&entities.UserAccount{ID: 1, UserName: "user@example.com", IsDeleted: true, EmailVerifiedAt: null.TimeFrom(time.Now())})
+		identityRepo := newIdentityMock(nil)
+
+		_, err := login(newSvc(repo, identityRepo, nil), identity)
+		require.ErrorIs(t, err, httputil.ErrUnauthorize)
+		require.Empty(t, identityRepo.InsertCalls())
+	}
+	{ // email not verified by the provider
+		repo := newRepoMock(nil)
+		identityRepo := newIdentityMock(nil)
+
+		_, err := login(newSvc(repo, identityRepo, nil), oidctest.Identity{Subject: "subject", Email: "user@example.com"})
+		require.ErrorIs(t, err, httputil.ErrUnauthorize)
+		require.Empty(t, repo.InsertCalls())
+		require.Empty(t, identityRepo.InsertCalls())
+	}
+	{ // second factor required
+		mfa := &entities.UserMfa{UserAccountID: 1, EnabledAt: null.TimeFrom(time.Now())}
+		identityRepo := newIdentityMock(&entities.UserIdentity{UserAccountID: 1})
+
+		resp, err := login(newSvc(newRepoMock(nil), identityRepo, mfa), identity)
+		require.NoError(t, err)
+		require.Equal(t, "token", resp.MfaToken)
+		require.Empty(t, resp.AccessToken)
+	}
+	{ // state of another login
+		svc := newSvc(newRepoMock(nil), newIdentityMock(nil), nil)
+		started, err := svc.LoginOidc(ctx, "test")
+		require.NoError(t, err)
+		code, _, err := server.Authorize(started.Location, identity)
+		require.NoError(t, err)
+
+		_, err = svc.LoginOidcCallback(ctx, LoginOidcCallbackRequest{Provider: "test", Code: code, State: "another", StateToken: started.StateToken})
+		require.ErrorIs(t, err, httputil.ErrUnauthorize)
+	}
+	{ // missing state cookie
+		svc := newSvc(newRepoMock(nil), newIdentityMock(nil), nil)
+		_, err := svc.LoginOidcCallback(ctx, LoginOidcCallbackRequest{Provider: "test", Code: "code", State: "state"})
+		require.ErrorIs(t, err, httputil.ErrUnauthorize)
+	}
+	{ // code of another client is rejected by the provider
+		svc := newSvc(newRepoMock(nil), newIdentityMock(nil), nil)
+		started, err := svc.LoginOidc(ctx, "test")
+		require.NoError(t, err)
+		_, state, err := server.Authorize(started.Location, identity)
+		require.NoError(t, err)
+
+		_, err = svc.LoginOidcCallback(ctx, LoginOidcCallbackRequest{Provider: "test", Code: "unknown", State: state, StateToken: started.StateToken})
+		require.ErrorIs(t, err, httputil.ErrUnauthorize)
+	}
+	{ // login denied at the provider
+		svc := newSvc(newRepoMock(nil), newIdentityMock(nil), nil)
+		_, err := svc.LoginOidcCallback(ctx, LoginOidcCallbackRequest{Provider: "test", Error: "access_denied"})
+		require.ErrorIs(t, err, httputil.ErrUnauthorize)
+	}
+}
+
+func TestNewOidcCallbackParams(t *testing.T) {
+	t.Parallel()
+	code, state := "code", "state"
+	params, err := NewOidcCallbackParams(dtos.LoginOidcCallbackParams{Code: &code, State: &state})
+	require.NoError(t, err)
+	require.Equal(t, LoginOidcCallbackRequest{Code: "code", State: "state"}, *params)
+}
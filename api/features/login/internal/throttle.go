@@ -0,0 +1,94 @@
+package internal
+
+import (
+	"context"
+	"log/slog"
+	"mysite/pkgs/database"
+	"mysite/pkgs/lockout"
+	"mysite/pkgs/logger"
+	"mysite/repositories/loginattemptrepo"
+	"mysite/utils/httputil"
+	"strconv"
+	"time"
+
+	"github.com/friendsofgo/errors"
+	"github.com/volatiletech/sqlboiler/v4/boil"
+)
+
+func accountAttemptKey(userId int) string {
+	return "account:" + strconv.Itoa(userId)
+}
+
+// mfaAttemptKey counts wrong second factors apart from the passwords, a correct password does not reset it
+func mfaAttemptKey(userId int) string {
+	return "mfa:" + strconv.Itoa(userId)
+}
+
+func mfaTokenAttemptKey(tokenId string) string {
+	return "mfaToken:" + tokenId
+}
+
+func ipAttemptKey(clientIp string) string {
+	if clientIp == "" {
+		return ""
+	}
+	return "ip:" + clientIp
+}
+
+// checkAttempts rejects the login while the key is backing off or locked
+func checkAttempts(ctx context.Context, tx boil.ContextTransactor, attemptRepo loginattemptrepo.LoginAttemptRepo, attemptKey string, policy lockout.Policy) error {
+	if attemptKey == "" {
+		return nil
+	}
+
+	attempt, err := attemptRepo.GetLoginAttemptByKey(ctx, tx, attemptKey)
+	if err != nil {
+		return errors.Wrap(err, "failed get loginAttempt")
+	}
+	if attempt == nil {
+		return nil
+	}
+
+	wait, locked := policy.Check(attempt.FailedCount, attempt.LastFailedAt, time.Now())
+	switch {
+	case wait <= 0:
+		return nil
+	case locked:
+		return errors.Wrapf(httputil.ErrAccountLocked.WithRetryAfter(wait), "%s locked", attemptKey)
+	default:
+		return errors.Wrapf(httputil.ErrLoginDelayed.WithRetryAfter(wait), "%s backing off", attemptKey)
+	}
+}
+
+// recordFailures counts a failed login and returns the counts by key, an error here must not change the response
+// and leaves the counts empty
+func recordFailures(ctx context.Context, attemptRepo loginattemptrepo.LoginAttemptRepo, attempts map[string]lockout.Policy) map[string]int {
+	now := time.Now()
+	counts := make(map[string]int, len(attempts))
+	if err := database.NewBoilerTransaction(ctx, func(ctx context.Context, tx boil.ContextTransactor) error {
+		for attemptKey, policy := range attempts {
+			if attemptKey == "" {
+				continue
+			}
+			attempt, err := attemptRepo.RecordFailure(ctx, tx, attemptKey, policy.StaleBefore(now))
+			if err != nil {
+				return errors.Wrapf(err, "failed record failure of %s", attemptKey)
+			}
+			counts[attemptKey] = attempt.FailedCount
+		}
+		return nil
+	}); err != nil {
+		slog.Error("failed record login failure", logger.AttrError(err))
+		return map[string]int{}
+	}
+	return counts
+}
+
+// resetFailures forgets the failures of the key, the ip keeps its count
+func resetFailures(ctx context.Context, attemptRepo loginattemptrepo.LoginAttemptRepo, attemptKey string) {
+	if err := database.NewBoilerTransaction(ctx, func(ctx context.Context, tx boil.ContextTransactor) error {
+		return attemptRepo.DeleteByKey(ctx, tx, attemptKey)
+	}); err != nil {
+		slog.Error("failed reset login failures", logger.AttrError(err))
+	}
+}
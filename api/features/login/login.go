@@ -3,6 +3,7 @@ package login
 import (
 	"context"
 	"log/slog"
+	"mysite/constants"
 	"mysite/dtos"
 	"mysite/features/login/internal"
 	"mysite/pkgs/logger"
@@ -20,10 +21,36 @@ type service interface {
 	Login(ctx context.Context) (*internal.LoginResponse, error)
 }
 
+type mfaService interface {
+	LoginMfa(ctx context.Context) (*internal.LoginResponse, error)
+}
+
+type oidcService interface {
+	LoginOidc(ctx context.Context, provider string) (*internal.LoginOidcResponse, error)
+	LoginOidcCallback(ctx context.Context, req internal.LoginOidcCallbackRequest) (*internal.LoginResponse, error)
+}
+
+type magicLinkService interface {
+	SendMagicLink(ctx context.Context, req internal.MagicLinkRequest) error
+	VerifyMagicLink(ctx context.Context, req internal.MagicLinkVerifyRequest) (*internal.LoginResponse, error)
+}
+
 var newService = func(req internal.LoginRequest) service {
 	return internal.NewService(req)
 }
 
+var newMfaService = func(req internal.LoginMfaRequest) mfaService {
+	return internal.NewMfaService(req)
+}
+
+var newOidcService = func() oidcService {
+	return internal.NewOidcService()
+}
+
+var newMagicLinkService = func() magicLinkService {
+	return internal.NewMagicLinkService()
+}
+
 func NewHandler() *api {
 	return &api{}
 }
@@ -46,6 +73,7 @@ func (a api) Login(w http.ResponseWriter, r *http.Request) {
 		}
 		return
 	}
+	params.ClientIp = httputil.ClientIp(r)
 
 	resp, err := newService(*params).Login(r.Context())
 	if err != nil {
@@ -55,6 +83,147 @@ func (a api) Login(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
-	http.SetCookie(w, httputil.SetCookie("accessToken", resp.AccessToken))
-	http.SetCookie(w, httputil.SetCookie("refreshToken", resp.RefreshToken))
+	// the second factor is still missing, no cookie until LoginMfa
+	if resp.MfaToken != "" {
+		render.JSON(w, r, dtos.LoginResponse{MfaRequired: true, MfaToken: &resp.MfaToken})
+		return
+	}
+
+	http.SetCookie(w, httputil.SetCookie(constants.AccessTokenCookie, resp.AccessToken))
+	http.SetCookie(w, httputil.SetCookie(constants.RefreshTokenCookie, resp.RefreshToken))
+	render.JSON(w, r, dtos.LoginResponse{MfaRequired: false})
+}
+
+func (a api) LoginMfa(w http.ResponseWriter, r *http.Request) {
+	var body dtos.LoginMfaJSONRequestBody
+	if err := httputil.ParseBody(r, &body); err != nil {
+		if err := render.Render(w, r, httputil.NewFailureRender(errors.Wrap(err, "failed to parse body"))); err != nil {
+			slog.Error("failed to render", logger.AttrError(err))
+		}
+		return
+	}
+
+	params, err := internal.NewMfaParams(body)
+	if err != nil {
+		if err := render.Render(w, r, httputil.NewFailureRender(errors.Wrap(err, "failed to create params"))); err != nil {
+			slog.Error("failed to render", logger.AttrError(err))
+		}
+		return
+	}
+
+	resp, err := newMfaService(*params).LoginMfa(r.Context())
+	if err != nil {
+		if err := render.Render(w, r, httputil.NewFailureRender(errors.Wrap(err, "failed to login with second factor"))); err != nil {
+			slog.Error("failed to render", logger.AttrError(err))
+		}
+		return
+	}
+
+	http.SetCookie(w, httputil.SetCookie(constants.AccessTokenCookie, resp.AccessToken))
+	http.SetCookie(w, httputil.SetCookie(constants.RefreshTokenCookie, resp.RefreshToken))
+}
+
+// LoginOidc redirects to the provider, the state cookie binds the callback to this browser
+func (a api) LoginOidc(w http.ResponseWriter, r *http.Request, provider string) {
+	resp, err := newOidcService().LoginOidc(r.Context(), provider)
+	if err != nil {
+		if err := render.Render(w, r, httputil.NewFailureRender(errors.Wrap(err, "failed to start oidc login"))); err != nil {
+			slog.Error("failed to render", logger.AttrError(err))
+		}
+		return
+	}
+
+	cookie := httputil.SetCookie(constants.OidcStateCookie, resp.StateToken)
+	cookie.MaxAge = int(resp.StateExpiresIn.Seconds())
+	// sent along with the top level redirect back from the provider
+	cookie.SameSite = http.SameSiteLaxMode
+	http.SetCookie(w, cookie)
+	http.Redirect(w, r, resp.Location, http.StatusFound)
+}
+
+func (a api) LoginOidcCallback(w http.ResponseWriter, r *http.Request, provider string, params LoginOidcCallbackParams) {
+	req, err := internal.NewOidcCallbackParams(params)
+	if err != nil {
+		if err := render.Render(w, r, httputil.NewFailureRender(errors.Wrap(err, "failed to create params"))); err != nil {
+			slog.Error("failed to render", logger.AttrError(err))
+		}
+		return
+	}
+	req.Provider = provider
+	if cookie, err := r.Cookie(constants.OidcStateCookie); err == nil {
+		req.StateToken = cookie.Value
+	}
+	// the state is used once, whatever the outcome
+	http.SetCookie(w, httputil.ClearCookie(constants.OidcStateCookie))
+
+	resp, err := newOidcService().LoginOidcCallback(r.Context(), *req)
+	if err != nil {
+		if err := render.Render(w, r, httputil.NewFailureRender(errors.Wrap(err, "failed to login with oidc"))); err != nil {
+			slog.Error("failed to render", logger.AttrError(err))
+		}
+		return
+	}
+
+	if resp.MfaToken != "" {
+		render.JSON(w, r, dtos.LoginResponse{MfaRequired: true, MfaToken: &resp.MfaToken})
+		return
+	}
+
+	http.SetCookie(w, httputil.SetCookie(constants.AccessTokenCookie, resp.AccessToken))
+	http.SetCookie(w, httputil.SetCookie(constants.RefreshTokenCookie, resp.RefreshToken))
+	render.JSON(w, r, dtos.LoginResponse{MfaRequired: false})
+}
+
+func (a api) LoginMagicLink(w http.ResponseWriter, r *http.Request) {
+	var body dtos.LoginMagicLinkJSONRequestBody
+	if err := httputil.ParseBody(r, &body); err != nil {
+		if err := render.Render(w, r, httputil.NewFailureRender(errors.Wrap(err, "failed to parse body"))); err != nil {
+			slog.Error("failed to render", logger.AttrError(err))
+		}
+		return
+	}
+
+	params, err := internal.NewMagicLinkParams(body)
+	if err != nil {
+		if err := render.Render(w, r, httputil.NewFailureRender(errors.Wrap(err, "failed to create params"))); err != nil {
+			slog.Error("failed to render", logger.AttrError(err))
+		}
+		return
+	}
+
+	if err := newMagicLinkService().SendMagicLink(r.Context(), *params); err != nil {
+		if err := render.Render(w, r, httputil.NewFailureRender(errors.Wrap(err, "failed to send magic link"))); err != nil {
+			slog.Error("failed to render", logger.AttrError(err))
+		}
+		return
+	}
+
+	w.WriteHeader(http.StatusAccepted)
+}
+
+func (a api) LoginMagicLinkVerify(w http.ResponseWriter, r *http.Request, params LoginMagicLinkVerifyParams) {
+	req, err := internal.NewMagicLinkVerifyParams(params)
+	if err != nil {
+		if err := render.Render(w, r, httputil.NewFailureRender(errors.Wrap(err, "failed to create params"))); err != nil {
+			slog.Error("failed to render", logger.AttrError(err))
+		}
+		return
+	}
+
+	resp, err := newMagicLinkService().VerifyMagicLink(r.Context(), *req)
+	if err != nil {
+		if err := render.Render(w, r, httputil.NewFailureRender(errors.Wrap(err, "failed to login with magic link"))); err != nil {
+			slog.Error("failed to render", logger.AttrError(err))
+		}
+		return
+	}
+
+	if resp.MfaToken != "" {
+		render.JSON(w, r, dtos.LoginResponse{MfaRequired: true, MfaToken: &resp.MfaToken})
+		return
+	}
+
+	http.SetCookie(w, httputil.SetCookie(constants.AccessTokenCookie, resp.AccessToken))
+	http.SetCookie(w, httputil.SetCookie(constants.RefreshTokenCookie, resp.RefreshToken))
+	render.JSON(w, r, dtos.LoginResponse{MfaRequired: false})
 }
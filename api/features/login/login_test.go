@@ -4,6 +4,7 @@ import (
 	"bytes"
 	"context"
 	"encoding/json"
+	"mysite/constants"
 	"mysite/dtos"
 	"mysite/features/login/internal"
 	"mysite/utils/httputil"
@@ -12,6 +13,7 @@ import (
 	"net/url"
 	"slices"
 	"testing"
+	"time"
 
 	"github.com/go-chi/chi/v5"
 	"github.com/pkg/errors"
@@ -27,6 +29,40 @@ func (m mockService) Login(ctx context.Context) (*internal.LoginResponse, error)
 	return m.LoginFunc()
 }
 
+type mockMfaService struct {
+	LoginMfaFunc func() (*internal.LoginResponse, error)
+}
+
+func (m mockMfaService) LoginMfa(ctx context.Context) (*internal.LoginResponse, error) {
+	return m.LoginMfaFunc()
+}
+
+type mockOidcService struct {
+	LoginOidcFunc         func(provider string) (*internal.LoginOidcResponse, error)
+	LoginOidcCallbackFunc func(req internal.LoginOidcCallbackRequest) (*internal.LoginResponse, error)
+}
+
+func (m mockOidcService) LoginOidc(ctx context.Context, provider string) (*internal.LoginOidcResponse, error) {
+	return m.LoginOidcFunc(provider)
+}
+
+func (m mockOidcService) LoginOidcCallback(ctx context.Context, req internal.LoginOidcCallbackRequest) (*internal.LoginResponse, error) {
+	return m.LoginOidcCallbackFunc(req)
+}
+
+type mockMagicLinkService struct {
+	SendMagicLinkFunc   func(req internal.MagicLinkRequest) error
+	VerifyMagicLinkFunc func(req internal.MagicLinkVerifyRequest) (*internal.LoginResponse, error)
+}
+
+func (m mockMagicLinkService) SendMagicLink(ctx context.Context, req internal.MagicLinkRequest) error {
+	return m.SendMagicLinkFunc(req)
+}
+
+func (m mockMagicLinkService) VerifyMagicLink(ctx context.Context, req internal.MagicLinkVerifyRequest) (*internal.LoginResponse, error) {
+	return m.VerifyMagicLinkFunc(req)
+}
+
 func newTestRouter() *chi.Mux {
 	router := chi.NewRouter()
 	router.Route("/api/v1", func(subr chi.Router) {
@@ -107,6 +143,34 @@ func TestDashboardGetStores(t *testing.T) {
 				return mockService{LoginFunc: func() (*internal.LoginResponse, error) { return nil, httputil.ErrInternal }}
 			},
 		},
+		{
+			name: "429 - account locked",
+			req: func(ctx context.Context) (*http.Request, error) {
+				_url, err := url.Parse("http://example.com/api/v1/login")
+				require.NoError(t, err)
+				var buf bytes.Buffer
+				if err := json.NewEncoder(&buf).Encode(dtos.RegisterRequest{Password: "secret", UserName: "test@gmail.com"}); err != nil {
+					return nil, errors.Wrap(err, "failed encode body")
+				}
+
+				req, err := http.NewRequest(http.MethodPost, _url.String(), &buf)
+				if err != nil {
+					return nil, err
+				}
+				req.RemoteAddr = "192.0.2.1:1234"
+				return req, nil
+			},
+			assert: func(w *httptest.ResponseRecorder, r *http.Request) {
+				assert.Equal(t, http.StatusTooManyRequests, w.Result().StatusCode)
+				assert.Equal(t, "90", w.Result().Header.Get("Retry-After"))
+			},
+			newService: func(req internal.LoginRequest) service {
+				assert.Equal(t, "192.0.2.1", req.ClientIp)
+				return mockService{LoginFunc: func() (*internal.LoginResponse, error) {
+					return nil, errors.Wrap(httputil.ErrAccountLocked.WithRetryAfter(90*time.Second), "locked")
+				}}
+			},
+		},
 		{
 			name: "200 - success",
 			req: func(ctx context.Context) (*http.Request, error) {
@@ -143,6 +207,33 @@ func TestDashboardGetStores(t *testing.T) {
 				}}
 			},
 		},
+		{
+			name: "200 - mfa required",
+			req: func(ctx context.Context) (*http.Request, error) {
+				_url, err := url.Parse("http://example.com/api/v1/login")
+				require.NoError(t, err)
+				var buf bytes.Buffer
+				if err := json.NewEncoder(&buf).Encode(dtos.LoginRequest{Password: "secret", UserName: "test@gmail.com"}); err != nil {
+					return nil, errors.Wrap(err, "failed encode body")
+				}
+
+				return http.NewRequest(http.MethodPost, _url.String(), &buf)
+			},
+			assert: func(w *httptest.ResponseRecorder, r *http.Request) {
+				assert.Equal(t, http.StatusOK, w.Result().StatusCode)
+				assert.Empty(t, w.Result().Cookies())
+
+				var resp dtos.LoginResponse
+				require.NoError(t, json.NewDecoder(w.Body).Decode(&resp))
+				require.True(t, resp.MfaRequired)
+				require.Equal(t, "mfa-token", *resp.MfaToken)
+			},
+			newService: func(req internal.LoginRequest) service {
+				return mockService{LoginFunc: func() (*internal.LoginResponse, error) {
+					return &internal.LoginResponse{MfaToken: "mfa-token"}, nil
+				}}
+			},
+		},
 	}
 
 	for _, tt := range tests {
@@ -162,3 +253,366 @@ func TestDashboardGetStores(t *testing.T) {
 		})
 	}
 }
+
+func TestLoginMfa(t *testing.T) {
+	t.Parallel()
+	router := newTestRouter()
+	newRequest := func(body interface{}) (*http.Request, error) {
+		var buf bytes.Buffer
+		if err := json.NewEncoder(&buf).Encode(body); err != nil {
+			return nil, errors.Wrap(err, "failed encode body")
+		}
+		return http.NewRequest(http.MethodPost, "http://example.com/api/v1/login/mfa", &buf)
+	}
+
+	tests := []struct {
+		name          string
+		req           func(context.Context) (*http.Request, error)
+		assert        func(*httptest.ResponseRecorder, *http.Request)
+		newMfaService func(req internal.LoginMfaRequest) mfaService
+	}{
+		{
+			name: "400 - Invalid request, empty body",
+			req: func(ctx context.Context) (*http.Request, error) {
+				return http.NewRequest(http.MethodPost, "http://example.com/api/v1/login/mfa", nil)
+			},
+			assert: func(w *httptest.ResponseRecorder, r *http.Request) {
+				assert.Equal(t, http.StatusBadRequest, w.Result().StatusCode)
+			},
+		},
+		{
+			name: "401 - invalid code",
+			req: func(ctx context.Context) (*http.Request, error) {
+				return newRequest(dtos.LoginMfaRequest{MfaToken: "mfa-token", Code: "000000"})
+			},
+			assert: func(w *httptest.ResponseRecorder, r *http.Request) {
+				assert.Equal(t, http.StatusUnauthorized, w.Result().StatusCode)
+				assert.Empty(t, w.Result().Cookies())
+			},
+			newMfaService: func(req internal.LoginMfaRequest) mfaService {
+				return mockMfaService{LoginMfaFunc: func() (*internal.LoginResponse, error) { return nil, httputil.ErrUnauthorize }}
+			},
+		},
+		{
+			name: "200 - success",
+			req: func(ctx context.Context) (*http.Request, error) {
+				return newRequest(dtos.LoginMfaRequest{MfaToken: "mfa-token", Code: "123456"})
+			},
+			assert: func(w *httptest.ResponseRecorder, r *http.Request) {
+				assert.Equal(t, http.StatusOK, w.Result().StatusCode)
+				assert.Len(t, w.Result().Cookies(), 2)
+			},
+			newMfaService: func(req internal.LoginMfaRequest) mfaService {
+				return mockMfaService{LoginMfaFunc: func() (*internal.LoginResponse, error) {
+					if req.MfaToken != "mfa-token" || req.Code != "123456" {
+						return nil, httputil.ErrUnauthorize
+					}
+					return &internal.LoginResponse{AccessToken: "token", RefreshToken: "token"}, nil
+				}}
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+
+		t.Run(tt.name, func(t *testing.T) {
+			newMfaService = tt.newMfaService
+			ctx := context.Background()
+			var err error
+
+			w := httptest.NewRecorder()
+			r, err := tt.req(ctx)
+			if assert.NoError(t, err) {
+				router.ServeHTTP(w, r)
+				tt.assert(w, r)
+			}
+		})
+	}
+}
+
+func TestLoginOidc(t *testing.T) {
+	t.Parallel()
+	router := newTestRouter()
+	cookie := func(w *httptest.ResponseRecorder, name string) *http.Cookie {
+		for _, c := range w.Result().Cookies() {
+			if c.Name == name {
+				return c
+			}
+		}
+		return nil
+	}
+	newCallbackRequest := func(query string) (*http.Request, error) {
+		r, err := http.NewRequest(http.MethodGet, "http://example.com/api/v1/login/oidc/google/callback?"+query, nil)
+		if err != nil {
+			return nil, err
+		}
+		r.AddCookie(&http.Cookie{Name: constants.OidcStateCookie, Value: "state-token"})
+		return r, nil
+	}
+
+	tests := []struct {
+		name           string
+		req            func(context.Context) (*http.Request, error)
+		assert         func(*httptest.ResponseRecorder, *http.Request)
+		newOidcService func() oidcService
+	}{
+		{
+			name: "404 - unknown provider",
+			req: func(ctx context.Context) (*http.Request, error) {
+				return http.NewRequest(http.MethodGet, "http://example.com/api/v1/login/oidc/unknown", nil)
+			},
+			assert: func(w *httptest.ResponseRecorder, r *http.Request) {
+				assert.Equal(t, http.StatusNotFound, w.Result().StatusCode)
+				assert.Nil(t, cookie(w, constants.OidcStateCookie))
+			},
+			newOidcService: func() oidcService {
+				return mockOidcService{LoginOidcFunc: func(provider string) (*internal.LoginOidcResponse, error) {
+					return nil, httputil.ErrNotFound
+				}}
+			},
+		},
+		{
+			name: "302 - redirect to the provider",
+			req: func(ctx context.Context) (*http.Request, error) {
+				return http.NewRequest(http.MethodGet, "http://example.com/api/v1/login/oidc/google", nil)
+			},
+			assert: func(w *httptest.ResponseRecorder, r *http.Request) {
+				assert.Equal(t, http.StatusFound, w.Result().StatusCode)
+				assert.Equal(t, "https://accounts.example.com/authorize?state=state", w.Result().Header.Get("Location"))
+
+				state := cookie(w, constants.OidcStateCookie)
+				require.NotNil(t, state)
+				assert.Equal(t, "state-token", state.Value)
+				assert.Equal(t, 600, state.MaxAge)
+				assert.True(t, state.HttpOnly)
+				assert.Equal(t, http.SameSiteLaxMode, state.SameSite)
+			},
+			newOidcService: func() oidcService {
+				return mockOidcService{LoginOidcFunc: func(provider string) (*internal.LoginOidcResponse, error) {
+					if provider != "google" {
+						return nil, httputil.ErrNotFound
+					}
+					return &internal.LoginOidcResponse{
+						Location:       "https://accounts.example.com/authorize?state=state",
+						StateToken:     "state-token",
+						StateExpiresIn: 10 * time.Minute,
+					}, nil
+				}}
+			},
+		},
+		{
+			name: "401 - callback rejected",
+			req: func(ctx context.Context) (*http.Request, error) {
+				return newCallbackRequest("error=access_denied&state=state")
+			},
+			assert: func(w *httptest.ResponseRecorder, r *http.Request) {
+				assert.Equal(t, http.StatusUnauthorized, w.Result().StatusCode)
+				assert.Nil(t, cookie(w, constants.AccessTokenCookie))
+				// the state is dropped on failure too
+				require.NotNil(t, cookie(w, constants.OidcStateCookie))
+				assert.Equal(t, -1, cookie(w, constants.OidcStateCookie).MaxAge)
+			},
+			newOidcService: func() oidcService {
+				return mockOidcService{LoginOidcCallbackFunc: func(req internal.LoginOidcCallbackRequest) (*internal.LoginResponse, error) {
+					if req.Error != "access_denied" {
+						return nil, httputil.ErrInvalidRequest
+					}
+					return nil, httputil.ErrUnauthorize
+				}}
+			},
+		},
+		{
+			name: "200 - callback success",
+			req: func(ctx context.Context) (*http.Request, error) {
+				return newCallbackRequest("code=code&state=state")
+			},
+			assert: func(w *httptest.ResponseRecorder, r *http.Request) {
+				assert.Equal(t, http.StatusOK, w.Result().StatusCode)
+				require.NotNil(t, cookie(w, constants.AccessTokenCookie))
+				require.NotNil(t, cookie(w, constants.RefreshTokenCookie))
+
+				var resp dtos.LoginResponse
+				require.NoError(t, json.NewDecoder(w.Body).Decode(&resp))
+				require.False(t, resp.MfaRequired)
+			},
+			newOidcService: func() oidcService {
+				return mockOidcService{LoginOidcCallbackFunc: func(req internal.LoginOidcCallbackRequest) (*internal.LoginResponse, error) {
+					if req.Provider != "google" || req.Code != "code" || req.State != "state" || req.StateToken != "state-token" {
+						return nil, httputil.ErrUnauthorize
+					}
+					return &internal.LoginResponse{AccessToken: "token", RefreshToken: "token"}, nil
+				}}
+			},
+		},
+		{
+			name: "200 - callback mfa required",
+			req: func(ctx context.Context) (*http.Request, error) {
+				return newCallbackRequest("code=code&state=state")
+			},
+			assert: func(w *httptest.ResponseRecorder, r *http.Request) {
+				assert.Equal(t, http.StatusOK, w.Result().StatusCode)
+				assert.Nil(t, cookie(w, constants.AccessTokenCookie))
+
+				var resp dtos.LoginResponse
+				require.NoError(t, json.NewDecoder(w.Body).Decode(&resp))
+				require.True(t, resp.MfaRequired)
+				require.Equal(t, "mfa-token", *resp.MfaToken)
+			},
+			newOidcService: func() oidcService {
+				return mockOidcService{LoginOidcCallbackFunc: func(req internal.LoginOidcCallbackRequest) (*internal.LoginResponse, error) {
+					return &internal.LoginResponse{MfaToken: "mfa-token"}, nil
+				}}
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+
+		t.Run(tt.name, func(t *testing.T) {
+			newOidcService = tt.newOidcService
+			ctx := context.Background()
+			var err error
+
+			w := httptest.NewRecorder()
+			r, err := tt.req(ctx)
+			if assert.NoError(t, err) {
+				router.ServeHTTP(w, r)
+				tt.assert(w, r)
+			}
+		})
+	}
+}
+
+func TestLoginMagicLink(t *testing.T) {
+	t.Parallel()
+	router := newTestRouter()
+	newRequest := func(body interface{}) (*http.Request, error) {
+		var buf bytes.Buffer
+		if err := json.NewEncoder(&buf).Encode(body); err != nil {
+			return nil, errors.Wrap(err, "failed encode body")
+		}
+		return http.NewRequest(http.MethodPost, "http://example.com/api/v1/login/magic-link", &buf)
+	}
+
+	tests := []struct {
+		name                string
+		req                 func(context.Context) (*http.Request, error)
+		assert              func(*httptest.ResponseRecorder, *http.Request)
+		newMagicLinkService func() magicLinkService
+	}{
+		{
+			name: "400 - Invalid request, empty body",
+			req: func(ctx context.Context) (*http.Request, error) {
+				return http.NewRequest(http.MethodPost, "http://example.com/api/v1/login/magic-link", nil)
+			},
+			assert: func(w *httptest.ResponseRecorder, r *http.Request) {
+				assert.Equal(t, http.StatusBadRequest, w.Result().StatusCode)
+			},
+		},
+		{
+			name: "202 - accepted",
+			req: func(ctx context.Context) (*http.Request, error) {
+				return newRequest(dtos.MagicLinkRequest{UserName: "test@gmail.com"})
+			},
+			assert: func(w *httptest.ResponseRecorder, r *http.Request) {
+				assert.Equal(t, http.StatusAccepted, w.Result().StatusCode)
+			},
+			newMagicLinkService: func() magicLinkService {
+				return mockMagicLinkService{SendMagicLinkFunc: func(req internal.MagicLinkRequest) error {
+					if req.UserName != "test@gmail.com" {
+						return httputil.ErrInvalidRequest
+					}
+					return nil
+				}}
+			},
+		},
+		{
+			name: "400 - verify without token",
+			req: func(ctx context.Context) (*http.Request, error) {
+				return http.NewRequest(http.MethodGet, "http://example.com/api/v1/login/magic-link/verify", nil)
+			},
+			assert: func(w *httptest.ResponseRecorder, r *http.Request) {
+				assert.Equal(t, http.StatusBadRequest, w.Result().StatusCode)
+			},
+		},
+		{
+			name: "401 - verify used link",
+			req: func(ctx context.Context) (*http.Request, error) {
+				return http.NewRequest(http.MethodGet, "http://example.com/api/v1/login/magic-link/verify?token=used", nil)
+			},
+			assert: func(w *httptest.ResponseRecorder, r *http.Request) {
+				assert.Equal(t, http.StatusUnauthorized, w.Result().StatusCode)
+				assert.Empty(t, w.Result().Cookies())
+			},
+			newMagicLinkService: func() magicLinkService {
+				return mockMagicLinkService{VerifyMagicLinkFunc: func(req internal.MagicLinkVerifyRequest) (*internal.LoginResponse, error) {
+					return nil, httputil.ErrUnauthorize
+				}}
+			},
+		},
+		{
+			name: "200 - verify success",
+			req: func(ctx context.Context) (*http.Request, error) {
+				return http.NewRequest(http.MethodGet, "http://example.com/api/v1/login/magic-link/verify?token=token", nil)
+			},
+			assert: func(w *httptest.ResponseRecorder, r *http.Request) {
+				assert.Equal(t, http.StatusOK, w.Result().StatusCode)
+				cookies := w.Result().Cookies()
+				require.Len(t, cookies, 2)
+				assert.Equal(t, "accessToken", cookies[0].Name)
+				assert.Equal(t, "refreshToken", cookies[1].Name)
+
+				var resp dtos.LoginResponse
+				require.NoError(t, json.NewDecoder(w.Body).Decode(&resp))
+				require.False(t, resp.MfaRequired)
+			},
+			newMagicLinkService: func() magicLinkService {
+				return mockMagicLinkService{VerifyMagicLinkFunc: func(req internal.MagicLinkVerifyRequest) (*internal.LoginResponse, error) {
+					if req.Token != "token" {
+						return nil, httputil.ErrUnauthorize
+					}
+					return &internal.LoginResponse{AccessToken: "token", RefreshToken: "token"}, nil
+				}}
+			},
+		},
+		{
+			name: "200 - verify mfa required",
+			req: func(ctx context.Context) (*http.Request, error) {
+				return http.NewRequest(http.MethodGet, "http://example.com/api/v1/login/magic-link/verify?token=token", nil)
+			},
+			assert: func(w *httptest.ResponseRecorder, r *http.Request) {
+				assert.Equal(t, http.StatusOK, w.Result().StatusCode)
+				assert.Empty(t, w.Result().Cookies())
+
+				var resp dtos.LoginResponse
+				require.NoError(t, json.NewDecoder(w.Body).Decode(&resp))
+				require.True(t, resp.MfaRequired)
+				require.Equal(t, "mfa-token", *resp.MfaToken)
+			},
+			newMagicLinkService: func() magicLinkService {
+				return mockMagicLinkService{VerifyMagicLinkFunc: func(req internal.MagicLinkVerifyRequest) (*internal.LoginResponse, error) {
+					return &internal.LoginResponse{MfaToken: "mfa-token"}, nil
+				}}
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+
+		t.Run(tt.name, func(t *testing.T) {
+			newMagicLinkService = tt.newMagicLinkService
+			ctx := context.Background()
+			var err error
+
+			w := httptest.NewRecorder()
+			r, err := tt.req(ctx)
+			if assert.NoError(t, err) {
+				router.ServeHTTP(w, r)
+				tt.assert(w, r)
+			}
+		})
+	}
+}
@@ -0,0 +1,9 @@
+package login
+
+import "mysite/dtos"
+
+// the chi-server generation refers to the query params types of the package, which are generated into dtos
+type (
+	LoginOidcCallbackParams    = dtos.LoginOidcCallbackParams
+	LoginMagicLinkVerifyParams = dtos.LoginMagicLinkVerifyParams
+)
@@ -0,0 +1,80 @@
+package internal
+
+import (
+	"context"
+	"mysite/pkgs/auth"
+	"mysite/pkgs/database"
+	"mysite/repositories/usersessionrepo"
+	"mysite/utils/httputil"
+	"strconv"
+	"time"
+
+	"github.com/pkg/errors"
+	"github.com/volatiletech/sqlboiler/v4/boil"
+)
+
+type service struct {
+	sessionRepo usersessionrepo.UserSessionRepo
+	jwtHandler  auth.JwtHandler
+}
+
+type LogoutRequest struct {
+	Principal auth.Principal
+
+	// RefreshToken optional, the session of this token is revoked
+	RefreshToken string
+}
+
+func NewService() service {
+	return service{
+		sessionRepo: usersessionrepo.NewRepo(),
+		jwtHandler:  auth.NewJwtHandler(),
+	}
+}
+
+// Logout denylists the current access token and revokes the session of the refresh token
+func (s service) Logout(ctx context.Context, req LogoutRequest) error {
+	s.jwtHandler.RevokeToken(req.Principal.TokenID, req.Principal.ExpiresAt)
+
+	if req.RefreshToken == "" {
+		return nil
+	}
+
+	// an unparsable or expired refresh token has nothing left to revoke
+	var claims auth.CustomClaims[any]
+	if err := s.jwtHandler.ParseToken(req.RefreshToken, &claims); err != nil {
+		return nil
+	}
+	if claims.GetKeyType() != auth.RefreshKey || claims.Subject != strconv.Itoa(req.Principal.UserID) {
+		return errors.Wrap(httputil.ErrUnauthorize, "refresh token does not belong to user")
+	}
+
+	if err := database.NewBoilerTransaction(ctx, func(ctx context.Context, tx boil.ContextTransactor) error {
+		session, err := s.sessionRepo.GetUserSessionByTokenId(ctx, tx, claims.ID)
+		if err != nil {
+			return errors.Wrap(err, "failed get userSession")
+		}
+		if session == nil {
+			return nil
+		}
+
+		return s.sessionRepo.RevokeFamily(ctx, tx, session.FamilyID)
+	}); err != nil {
+		return errors.Wrap(err, "failed revoke userSession")
+	}
+
+	return nil
+}
+
+// LogoutAll denylists every access token of the user issued so far and revokes every session of the user
+func (s service) LogoutAll(ctx context.Context, principal auth.Principal) error {
+	s.jwtHandler.RevokeSubject(strconv.Itoa(principal.UserID), time.Now().Add(auth.MaxAccessTokenTtl()))
+
+	if err := database.NewBoilerTransaction(ctx, func(ctx context.Context, tx boil.ContextTransactor) error {
+		return s.sessionRepo.RevokeAllByUserAccountId(ctx, tx, principal.UserID)
+	}); err != nil {
+		return errors.Wrap(err, "failed revoke userSessions")
+	}
+
+	return nil
+}
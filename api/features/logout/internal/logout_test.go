@@ -0,0 +1,171 @@
+package internal
+
+import (
+	"context"
+	"fmt"
+	"mysite/entities"
+	"mysite/pkgs/auth"
+	"mysite/pkgs/database"
+	"mysite/testing/dbtest"
+	"mysite/testing/mocking/pkgmock"
+	"mysite/testing/mocking/repomock"
+	"mysite/utils/httputil"
+	"testing"
+	"time"
+
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/require"
+	"github.com/volatiletech/sqlboiler/v4/boil"
+)
+
+func TestMain(m *testing.M) {
+	pool, resource, err := dbtest.SetupDatabaseForTesting()
+	if err != nil {
+		return
+	}
+
+	defer func() {
+		database.Close()
+		if err := dbtest.PurgeResource(pool, resource); err != nil {
+			fmt.Println("failed to purge resource")
+		}
+	}()
+	m.Run()
+}
+
+func TestLogout(t *testing.T) {
+	t.Parallel()
+	require.NoError(t, database.SetupDatabase())
+	ctx := dbtest.SetTestTransactionCtx(context.Background())
+	principal := auth.Principal{
+		UserID:    1,
+		TokenID:   "access-token-id",
+		ExpiresAt: time.Now().Add(time.Minute),
+	}
+	newJwtMock := func(keyType auth.KeyType, subject string) *pkgmock.JwtHandlerMock {
+		return &pkgmock.JwtHandlerMock{
+			ParseTokenFunc: func(tokenString string, claims auth.Claims) error {
+				c := claims.(*auth.CustomClaims[any])
+				c.ID = "refresh-token-id"
+				c.Subject = subject
+				c.KeyType = keyType
+				return nil
+			},
+			RevokeTokenFunc: func(tokenId string, expiresAt time.Time) {},
+		}
+	}
+
+	{ // logout success, session family revoked
+		jwtMock := newJwtMock(auth.RefreshKey, "1")
+		sessionMock := &repomock.UserSessionRepoMock{}
+		sessionMock.GetUserSessionByTokenIdFunc = func(ctx context.Context, tx boil.ContextTransactor, tokenId string) (*entities.UserSession, error) {
+			return &entities.UserSession{
+				UserAccountID: 1,
+				FamilyID:      "family-id",
+				TokenID:       tokenId,
+			}, nil
+		}
+		sessionMock.RevokeFamilyFunc = func(ctx context.Context, tx boil.ContextTransactor, familyId string) error {
+			return nil
+		}
+
+		svc := service{
+			sessionRepo: sessionMock,
+			jwtHandler:  jwtMock,
+		}
+
+		err := svc.Logout(ctx, LogoutRequest{Principal: principal, RefreshToken: "refresh-token"})
+		require.NoError(t, err)
+		require.Len(t, jwtMock.RevokeTokenCalls(), 1)
+		require.Equal(t, "access-token-id", jwtMock.RevokeTokenCalls()[0].TokenId)
+		require.Len(t, sessionMock.RevokeFamilyCalls(), 1)
+		require.Equal(t, "family-id", sessionMock.RevokeFamilyCalls()[0].FamilyId)
+	}
+	{ // logout success, without refresh token
+		jwtMock := newJwtMock(auth.RefreshKey, "1")
+		sessionMock := &repomock.UserSessionRepoMock{}
+
+		svc := service{
+			sessionRepo: sessionMock,
+			jwtHandler:  jwtMock,
+		}
+
+		err := svc.Logout(ctx, LogoutRequest{Principal: principal})
+		require.NoError(t, err)
+		require.Len(t, jwtMock.RevokeTokenCalls(), 1)
+		require.Empty(t, sessionMock.RevokeFamilyCalls())
+	}
+	{ // logout failed, refresh token of another user
+		jwtMock := newJwtMock(auth.RefreshKey, "2")
+
+		svc := service{
+			sessionRepo: &repomock.UserSessionRepoMock{},
+			jwtHandler:  jwtMock,
+		}
+
+		err := svc.Logout(ctx, LogoutRequest{Principal: principal, RefreshToken: "refresh-token"})
+		require.ErrorIs(t, err, httputil.ErrUnauthorize)
+	}
+	{ // logout failed, revoke session failed
+		jwtMock := newJwtMock(auth.RefreshKey, "1")
+		sessionMock := &repomock.UserSessionRepoMock{}
+		sessionMock.GetUserSessionByTokenIdFunc = func(ctx context.Context, tx boil.ContextTransactor, tokenId string) (*entities.UserSession, error) {
+			return nil, errors.New("get session failed")
+		}
+
+		svc := service{
+			sessionRepo: sessionMock,
+			jwtHandler:  jwtMock,
+		}
+
+		err := svc.Logout(ctx, LogoutRequest{Principal: principal, RefreshToken: "refresh-token"})
+		require.Error(t, err)
+	}
+}
+
+func TestLogoutAll(t *testing.T) {
+	t.Parallel()
+	require.NoError(t, database.SetupDatabase())
+	ctx := dbtest.SetTestTransactionCtx(context.Background())
+	principal := auth.Principal{
+		UserID:    1,
+		TokenID:   "access-token-id",
+		ExpiresAt: time.Now().Add(time.Minute),
+	}
+
+	{ // logout all success, every access token of the user denied
+		jwtMock := &pkgmock.JwtHandlerMock{RevokeSubjectFunc: func(subject string, expiresAt time.Time) {}}
+		sessionMock := &repomock.UserSessionRepoMock{}
+		sessionMock.RevokeAllByUserAccountIdFunc = func(ctx context.Context, tx boil.ContextTransactor, userAccountId int) error {
+			return nil
+		}
+
+		svc := service{
+			sessionRepo: sessionMock,
+			jwtHandler:  jwtMock,
+		}
+
+		err := svc.LogoutAll(ctx, principal)
+		require.NoError(t, err)
+		require.Len(t, jwtMock.RevokeSubjectCalls(), 1)
+		require.Equal(t, "1", jwtMock.RevokeSubjectCalls()[0].Subject)
+		require.True(t, jwtMock.RevokeSubjectCalls()[0].ExpiresAt.After(time.Now().Add(14*time.Minute)))
+		require.Len(t, sessionMock.RevokeAllByUserAccountIdCalls(), 1)
+		require.Equal(t, 1, sessionMock.RevokeAllByUserAccountIdCalls()[0].UserAccountId)
+	}
+	{ // logout all failed, revoke sessions failed
+		jwtMock := &pkgmock.JwtHandlerMock{RevokeSubjectFunc: func(subject string, expiresAt time.Time) {}}
+		sessionMock := &repomock.UserSessionRepoMock{}
+		sessionMock.RevokeAllByUserAccountIdFunc = func(ctx context.Context, tx boil.ContextTransactor, userAccountId int) error {
+			return errors.New("revoke failed")
+		}
+
+		svc := service{
+			sessionRepo: sessionMock,
+			jwtHandler:  jwtMock,
+		}
+
+		err := svc.LogoutAll(ctx, principal)
+		require.Error(t, err)
+	}
+}
@@ -0,0 +1,79 @@
+package logout
+
+import (
+	"context"
+	"log/slog"
+	"mysite/constants"
+	"mysite/features/logout/internal"
+	"mysite/pkgs/auth"
+	"mysite/pkgs/logger"
+	"mysite/utils/httputil"
+	"net/http"
+
+	"github.com/go-chi/render"
+	"github.com/pkg/errors"
+)
+
+type api struct {
+}
+
+type service interface {
+	Logout(ctx context.Context, req internal.LogoutRequest) error
+	LogoutAll(ctx context.Context, principal auth.Principal) error
+}
+
+var newService = func() service {
+	return internal.NewService()
+}
+
+func NewHandler() *api {
+	return &api{}
+}
+
+func (a api) Logout(w http.ResponseWriter, r *http.Request) {
+	principal, found := auth.PrincipalFromContext(r.Context())
+	if !found {
+		if err := render.Render(w, r, httputil.NewFailureRender(errors.Wrap(httputil.ErrUnauthorize, "missing principal"))); err != nil {
+			slog.Error("failed to render", logger.AttrError(err))
+		}
+		return
+	}
+
+	req := internal.LogoutRequest{Principal: principal}
+	if cookie, err := r.Cookie(constants.RefreshTokenCookie); err == nil {
+		req.RefreshToken = cookie.Value
+	}
+
+	if err := newService().Logout(r.Context(), req); err != nil {
+		if err := render.Render(w, r, httputil.NewFailureRender(errors.Wrap(err, "failed to logout"))); err != nil {
+			slog.Error("failed to render", logger.AttrError(err))
+		}
+		return
+	}
+
+	clearCookies(w)
+}
+
+func (a api) LogoutAll(w http.ResponseWriter, r *http.Request) {
+	principal, found := auth.PrincipalFromContext(r.Context())
+	if !found {
+		if err := render.Render(w, r, httputil.NewFailureRender(errors.Wrap(httputil.ErrUnauthorize, "missing principal"))); err != nil {
+			slog.Error("failed to render", logger.AttrError(err))
+		}
+		return
+	}
+
+	if err := newService().LogoutAll(r.Context(), principal); err != nil {
+		if err := render.Render(w, r, httputil.NewFailureRender(errors.Wrap(err, "failed to logout all sessions"))); err != nil {
+			slog.Error("failed to render", logger.AttrError(err))
+		}
+		return
+	}
+
+	clearCookies(w)
+}
+
+func clearCookies(w http.ResponseWriter) {
+	http.SetCookie(w, httputil.ClearCookie(constants.AccessTokenCookie))
+	http.SetCookie(w, httputil.ClearCookie(constants.RefreshTokenCookie))
+}
@@ -0,0 +1,195 @@
+package logout
+
+import (
+	"context"
+	"mysite/features/logout/internal"
+	"mysite/pkgs/auth"
+	"mysite/utils/httputil"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"slices"
+	"testing"
+
+	"github.com/go-chi/chi/v5"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type mockService struct {
+	LogoutFunc    func(req internal.LogoutRequest) error
+	LogoutAllFunc func(principal auth.Principal) error
+}
+
+func (m mockService) Logout(ctx context.Context, req internal.LogoutRequest) error {
+	return m.LogoutFunc(req)
+}
+
+func (m mockService) LogoutAll(ctx context.Context, principal auth.Principal) error {
+	return m.LogoutAllFunc(principal)
+}
+
+func withPrincipal(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Header.Get("Authorization") == "" {
+			next.ServeHTTP(w, r)
+			return
+		}
+		next.ServeHTTP(w, r.WithContext(auth.WithPrincipal(r.Context(), auth.Principal{UserID: 1, TokenID: "token-id"})))
+	})
+}
+
+func newTestRouter() *chi.Mux {
+	router := chi.NewRouter()
+	router.Route("/api/v1", func(subr chi.Router) {
+		subr.Use(withPrincipal)
+		HandlerFromMux(NewHandler(), subr)
+	})
+	return router
+}
+
+func assertCookiesCleared(t *testing.T, w *httptest.ResponseRecorder) {
+	cookies := w.Result().Cookies()
+	for _, name := range []string{"accessToken", "refreshToken"} {
+		found := slices.ContainsFunc(cookies, func(c *http.Cookie) bool {
+			return c.Name == name && c.Value == "" && c.MaxAge < 0
+		})
+		require.True(t, found, name)
+	}
+}
+
+func TestDashboardGetStores(t *testing.T) {
+	t.Parallel()
+	router := newTestRouter()
+
+	tests := []struct {
+		name       string
+		req        func(context.Context) (*http.Request, error)
+		assert     func(*httptest.ResponseRecorder, *http.Request)
+		newService func() service
+	}{
+		{
+			name: "401 - logout without principal",
+			req: func(ctx context.Context) (*http.Request, error) {
+				_url, err := url.Parse("http://example.com/api/v1/logout")
+				require.NoError(t, err)
+				return http.NewRequest(http.MethodPost, _url.String(), nil)
+			},
+			assert: func(w *httptest.ResponseRecorder, r *http.Request) {
+				assert.Equal(t, http.StatusUnauthorized, w.Result().StatusCode)
+			},
+		},
+		{
+			name: "500 - logout internal error",
+			req: func(ctx context.Context) (*http.Request, error) {
+				_url, err := url.Parse("http://example.com/api/v1/logout")
+				require.NoError(t, err)
+				r, err := http.NewRequest(http.MethodPost, _url.String(), nil)
+				if err != nil {
+					return nil, err
+				}
+				r.Header.Set("Authorization", "Bearer token")
+				return r, nil
+			},
+			assert: func(w *httptest.ResponseRecorder, r *http.Request) {
+				assert.Equal(t, http.StatusInternalServerError, w.Result().StatusCode)
+			},
+			newService: func() service {
+				return mockService{LogoutFunc: func(req internal.LogoutRequest) error { return httputil.ErrInternal }}
+			},
+		},
+		{
+			name: "200 - logout success",
+			req: func(ctx context.Context) (*http.Request, error) {
+				_url, err := url.Parse("http://example.com/api/v1/logout")
+				require.NoError(t, err)
+				r, err := http.NewRequest(http.MethodPost, _url.String(), nil)
+				if err != nil {
+					return nil, err
+				}
+				r.Header.Set("Authorization", "Bearer token")
+				r.AddCookie(&http.Cookie{Name: "refreshToken", Value: "refresh-token"})
+				return r, nil
+			},
+			assert: func(w *httptest.ResponseRecorder, r *http.Request) {
+				assert.Equal(t, http.StatusOK, w.Result().StatusCode)
+				assertCookiesCleared(t, w)
+			},
+			newService: func() service {
+				return mockService{LogoutFunc: func(req internal.LogoutRequest) error {
+					if req.RefreshToken != "refresh-token" || req.Principal.TokenID != "token-id" {
+						return httputil.ErrInvalidRequest
+					}
+					return nil
+				}}
+			},
+		},
+		{
+			name: "401 - logout all without principal",
+			req: func(ctx context.Context) (*http.Request, error) {
+				_url, err := url.Parse("http://example.com/api/v1/logout/all")
+				require.NoError(t, err)
+				return http.NewRequest(http.MethodPost, _url.String(), nil)
+			},
+			assert: func(w *httptest.ResponseRecorder, r *http.Request) {
+				assert.Equal(t, http.StatusUnauthorized, w.Result().StatusCode)
+			},
+		},
+		{
+			name: "500 - logout all internal error",
+			req: func(ctx context.Context) (*http.Request, error) {
+				_url, err := url.Parse("http://example.com/api/v1/logout/all")
+				require.NoError(t, err)
+				r, err := http.NewRequest(http.MethodPost, _url.String(), nil)
+				if err != nil {
+					return nil, err
+				}
+				r.Header.Set("Authorization", "Bearer token")
+				return r, nil
+			},
+			assert: func(w *httptest.ResponseRecorder, r *http.Request) {
+				assert.Equal(t, http.StatusInternalServerError, w.Result().StatusCode)
+			},
+			newService: func() service {
+				return mockService{LogoutAllFunc: func(principal auth.Principal) error { return httputil.ErrInternal }}
+			},
+		},
+		{
+			name: "200 - logout all success",
+			req: func(ctx context.Context) (*http.Request, error) {
+				_url, err := url.Parse("http://example.com/api/v1/logout/all")
+				require.NoError(t, err)
+				r, err := http.NewRequest(http.MethodPost, _url.String(), nil)
+				if err != nil {
+					return nil, err
+				}
+				r.Header.Set("Authorization", "Bearer token")
+				return r, nil
+			},
+			assert: func(w *httptest.ResponseRecorder, r *http.Request) {
+				assert.Equal(t, http.StatusOK, w.Result().StatusCode)
+				assertCookiesCleared(t, w)
+			},
+			newService: func() service {
+				return mockService{LogoutAllFunc: func(principal auth.Principal) error { return nil }}
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+
+		t.Run(tt.name, func(t *testing.T) {
+			newService = tt.newService
+			ctx := context.Background()
+			var err error
+
+			w := httptest.NewRecorder()
+			r, err := tt.req(ctx)
+			if assert.NoError(t, err) {
+				router.ServeHTTP(w, r)
+				tt.assert(w, r)
+			}
+		})
+	}
+}
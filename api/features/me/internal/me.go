@@ -0,0 +1,155 @@
+package internal
+
+import (
+	"context"
+	"mysite/constants"
+	"mysite/dtos"
+	"mysite/entities"
+	"mysite/pkgs/database"
+	"mysite/pkgs/validate"
+	"mysite/repositories/userinforepo"
+	"mysite/utils/httputil"
+
+	"github.com/mitchellh/mapstructure"
+	"github.com/pkg/errors"
+	"github.com/volatiletech/null/v8"
+	"github.com/volatiletech/sqlboiler/v4/boil"
+)
+
+type service struct {
+	repo userinforepo.UserInfoRepo
+}
+
+// UpdateMeRequest nil fields are kept, validate tags are the same as register.RegisterRequest
+type UpdateMeRequest struct {
+	// Email email of user
+	Email *string `json:"email,omitempty" validate:"omitempty,email"`
+
+	// gender of user
+	Gender *string `json:"gender,omitempty" validate:"omitempty,oneof=male female other"`
+
+	// Name name of user
+	Name *string `json:"name,omitempty"`
+
+	// Phone phone number of user
+	Phone *string `json:"phone,omitempty" validate:"omitempty,number"`
+}
+
+func NewService() service {
+	return service{
+		repo: userinforepo.NewRepo(),
+	}
+}
+
+func NewParams(req dtos.UpdateMeJSONRequestBody) (*UpdateMeRequest, error) {
+	var result UpdateMeRequest
+	if err := mapstructure.Decode(req, &result); err != nil {
+		return nil, errors.Wrap(err, "failed mapping struct")
+	}
+	return &result, nil
+}
+
+func (s service) GetMe(ctx context.Context, userId int) (*dtos.MeResponse, error) {
+	var resp *dtos.MeResponse
+	if err := database.NewBoilerTransaction(ctx, func(ctx context.Context, tx boil.ContextTransactor) error {
+		pgUserAccount, err := s.getUserAccount(ctx, tx, userId)
+		if err != nil {
+			return errors.Wrap(err, "failed get userAccount")
+		}
+
+		resp = newMeResponse(*pgUserAccount, firstUserInfo(*pgUserAccount))
+		return nil
+	}); err != nil {
+		return nil, errors.Wrap(err, "failed get current user")
+	}
+
+	return resp, nil
+}
+
+func (s service) UpdateMe(ctx context.Context, userId int, req UpdateMeRequest) (*dtos.MeResponse, error) {
+	// validate data
+	if err := validate.ValidateStruct(req); err != nil {
+		return nil, errors.Wrap(httputil.ErrInvalidRequest, err.Error())
+	}
+
+	var resp *dtos.MeResponse
+	if err := database.NewBoilerTransaction(ctx, func(ctx context.Context, tx boil.ContextTransactor) error {
+		pgUserAccount, err := s.getUserAccount(ctx, tx, userId)
+		if err != nil {
+			return errors.Wrap(err, "failed get userAccount")
+		}
+
+		userInfo := firstUserInfo(*pgUserAccount)
+		if userInfo == nil {
+			userInfo = &entities.UserInfo{
+				UserAccountID: pgUserAccount.ID,
+				MembershipID:  null.IntFrom(constants.Bronze),
+			}
+			req.apply(userInfo)
+			if err := s.repo.Insert(ctx, tx, userInfo); err != nil {
+				return errors.Wrap(err, "failed insert userInfo")
+			}
+		} else {
+			req.apply(userInfo)
+			if err := s.repo.Update(ctx, tx, *userInfo); err != nil {
+				return errors.Wrap(err, "failed update userInfo")
+			}
+		}
+
+		resp = newMeResponse(*pgUserAccount, userInfo)
+		return nil
+	}); err != nil {
+		return nil, errors.Wrap(err, "failed update current user")
+	}
+
+	return resp, nil
+}
+
+func (s service) getUserAccount(ctx context.Context, tx boil.ContextTransactor, userId int) (*entities.UserAccount, error) {
+	pgUserAccount, err := s.repo.GetActiveUserAccountWithUserInfo(ctx, tx, userId)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed get userAccount with userInfo")
+	}
+	if pgUserAccount == nil {
+		return nil, errors.Wrap(httputil.ErrNotFound, "user not found")
+	}
+	return pgUserAccount, nil
+}
+
+// apply copies the fields which are set in the request
+func (req UpdateMeRequest) apply(userInfo *entities.UserInfo) {
+	if req.Name != nil {
+		userInfo.Name = null.StringFromPtr(req.Name)
+	}
+	if req.Phone != nil {
+		userInfo.Phone = null.StringFromPtr(req.Phone)
+	}
+	if req.Email != nil {
+		userInfo.Email = null.StringFromPtr(req.Email)
+	}
+	if req.Gender != nil {
+		userInfo.Gender = null.StringFromPtr(req.Gender)
+	}
+}
+
+func firstUserInfo(pgUserAccount entities.UserAccount) *entities.UserInfo {
+	if pgUserAccount.R == nil || len(pgUserAccount.R.UserInfos) == 0 {
+		return nil
+	}
+	return pgUserAccount.R.UserInfos[0]
+}
+
+func newMeResponse(pgUserAccount entities.UserAccount, userInfo *entities.UserInfo) *dtos.MeResponse {
+	resp := dtos.MeResponse{
+		Id:        pgUserAccount.ID,
+		UserName:  pgUserAccount.UserName,
+		CreatedAt: pgUserAccount.CreatedAt,
+	}
+	if userInfo != nil {
+		resp.Name = userInfo.Name.Ptr()
+		resp.Phone = userInfo.Phone.Ptr()
+		resp.Email = userInfo.Email.Ptr()
+		resp.Gender = userInfo.Gender.Ptr()
+	}
+	return &resp
+}
@@ -0,0 +1,176 @@
+package internal
+
+import (
+	"context"
+	"fmt"
+	"mysite/dtos"
+	"mysite/entities"
+	"mysite/pkgs/database"
+	"mysite/testing/dbtest"
+	"mysite/testing/mocking/repomock"
+	"mysite/utils/httputil"
+	"mysite/utils/ptrconv"
+	"testing"
+
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/require"
+	"github.com/volatiletech/null/v8"
+	"github.com/volatiletech/sqlboiler/v4/boil"
+)
+
+func TestMain(m *testing.M) {
+	pool, resource, err := dbtest.SetupDatabaseForTesting()
+	if err != nil {
+		return
+	}
+
+	defer func() {
+		database.Close()
+		if err := dbtest.PurgeResource(pool, resource); err != nil {
+			fmt.Println("failed to purge resource")
+		}
+	}()
+	m.Run()
+}
+
+func newUserAccount(userInfos ...*entities.UserInfo) *entities.UserAccount {
+	pgUserAccount := &entities.UserAccount{
+		ID:       1,
+		UserName: "user@example.com",
+	}
+	pgUserAccount.R = pgUserAccount.R.NewStruct()
+	pgUserAccount.R.UserInfos = userInfos
+	return pgUserAccount
+}
+
+func TestGetMe(t *testing.T) {
+	t.Parallel()
+	require.NoError(t, database.SetupDatabase())
+	ctx := dbtest.SetTestTransactionCtx(context.Background())
+
+	{ // get me success
+		repoMock := &repomock.UserInfoRepoMock{}
+		repoMock.GetActiveUserAccountWithUserInfoFunc = func(ctx context.Context, tx boil.ContextTransactor, userAccountId int) (*entities.UserAccount, error) {
+			return newUserAccount(&entities.UserInfo{Name: null.StringFrom("name")}), nil
+		}
+
+		svc := service{repo: repoMock}
+		resp, err := svc.GetMe(ctx, 1)
+		require.NoError(t, err)
+		require.Equal(t, 1, resp.Id)
+		require.Equal(t, "user@example.com", resp.UserName)
+		require.Equal(t, "name", *resp.Name)
+		require.Nil(t, resp.Phone)
+	}
+	{ // get me success, without userInfo
+		repoMock := &repomock.UserInfoRepoMock{}
+		repoMock.GetActiveUserAccountWithUserInfoFunc = func(ctx context.Context, tx boil.ContextTransactor, userAccountId int) (*entities.UserAccount, error) {
+			return newUserAccount(), nil
+		}
+
+		svc := service{repo: repoMock}
+		resp, err := svc.GetMe(ctx, 1)
+		require.NoError(t, err)
+		require.Nil(t, resp.Name)
+	}
+	{ // get me failed, user not found
+		repoMock := &repomock.UserInfoRepoMock{}
+		repoMock.GetActiveUserAccountWithUserInfoFunc = func(ctx context.Context, tx boil.ContextTransactor, userAccountId int) (*entities.UserAccount, error) {
+			return nil, nil
+		}
+
+		svc := service{repo: repoMock}
+		resp, err := svc.GetMe(ctx, 1)
+		require.ErrorIs(t, err, httputil.ErrNotFound)
+		require.Nil(t, resp)
+	}
+	{ // get me failed, get user failed
+		repoMock := &repomock.UserInfoRepoMock{}
+		repoMock.GetActiveUserAccountWithUserInfoFunc = func(ctx context.Context, tx boil.ContextTransactor, userAccountId int) (*entities.UserAccount, error) {
+			return nil, errors.New("get user failed")
+		}
+
+		svc := service{repo: repoMock}
+		resp, err := svc.GetMe(ctx, 1)
+		require.Error(t, err)
+		require.Nil(t, resp)
+	}
+}
+
+func TestUpdateMe(t *testing.T) {
+	t.Parallel()
+	require.NoError(t, database.SetupDatabase())
+	ctx := dbtest.SetTestTransactionCtx(context.Background())
+
+	{ // update success, omitted fields are kept
+		repoMock := &repomock.UserInfoRepoMock{}
+		repoMock.GetActiveUserAccountWithUserInfoFunc = func(ctx context.Context, tx boil.ContextTransactor, userAccountId int) (*entities.UserAccount, error) {
+			return newUserAccount(&entities.UserInfo{Name: null.StringFrom("name"), Phone: null.StringFrom("0123")}), nil
+		}
+		repoMock.UpdateFunc = func(ctx context.Context, tx boil.ContextTransactor, userInfo entities.UserInfo) error {
+			return nil
+		}
+
+		svc := service{repo: repoMock}
+		resp, err := svc.UpdateMe(ctx, 1, UpdateMeRequest{Name: ptrconv.String("new name")})
+		require.NoError(t, err)
+		require.Equal(t, "new name", *resp.Name)
+		require.Equal(t, "0123", *resp.Phone)
+		require.Len(t, repoMock.UpdateCalls(), 1)
+		require.Equal(t, "0123", repoMock.UpdateCalls()[0].UserInfo.Phone.String)
+	}
+	{ // update success, userInfo is created
+		repoMock := &repomock.UserInfoRepoMock{}
+		repoMock.GetActiveUserAccountWithUserInfoFunc = func(ctx context.Context, tx boil.ContextTransactor, userAccountId int) (*entities.UserAccount, error) {
+			return newUserAccount(), nil
+		}
+		repoMock.InsertFunc = func(ctx context.Context, tx boil.ContextTransactor, userInfo *entities.UserInfo) error {
+			return nil
+		}
+
+		svc := service{repo: repoMock}
+		resp, err := svc.UpdateMe(ctx, 1, UpdateMeRequest{Gender: ptrconv.String("other")})
+		require.NoError(t, err)
+		require.Equal(t, "other", *resp.Gender)
+		require.Len(t, repoMock.InsertCalls(), 1)
+		require.Equal(t, 1, repoMock.InsertCalls()[0].UserInfo.UserAccountID)
+	}
+	{ // update failed, validate failed
+		svc := service{repo: &repomock.UserInfoRepoMock{}}
+		resp, err := svc.UpdateMe(ctx, 1, UpdateMeRequest{Email: ptrconv.String("not an email")})
+		require.ErrorIs(t, err, httputil.ErrInvalidRequest)
+		require.Nil(t, resp)
+
+		resp, err = svc.UpdateMe(ctx, 1, UpdateMeRequest{Gender: ptrconv.String("unknown")})
+		require.ErrorIs(t, err, httputil.ErrInvalidRequest)
+		require.Nil(t, resp)
+	}
+	{ // update failed, update userInfo failed
+		repoMock := &repomock.UserInfoRepoMock{}
+		repoMock.GetActiveUserAccountWithUserInfoFunc = func(ctx context.Context, tx boil.ContextTransactor, userAccountId int) (*entities.UserAccount, error) {
+			return newUserAccount(&entities.UserInfo{}), nil
+		}
+		repoMock.UpdateFunc = func(ctx context.Context, tx boil.ContextTransactor, userInfo entities.UserInfo) error {
+			return errors.New("update failed")
+		}
+
+		svc := service{repo: repoMock}
+		resp, err := svc.UpdateMe(ctx, 1, UpdateMeRequest{Name: ptrconv.String("new name")})
+		require.Error(t, err)
+		require.Nil(t, resp)
+	}
+}
+
+func TestNewParams(t *testing.T) {
+	{ // create params success
+		testReq := dtos.UpdateMeJSONRequestBody{
+			Name:  ptrconv.String("name"),
+			Phone: ptrconv.String("0123"),
+		}
+		req, err := NewParams(testReq)
+		require.NoError(t, err)
+		require.Equal(t, "name", *req.Name)
+		require.Equal(t, "0123", *req.Phone)
+		require.Nil(t, req.Email)
+	}
+}
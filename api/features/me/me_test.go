@@ -0,0 +1,184 @@
+package me
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"mysite/dtos"
+	"mysite/features/me/internal"
+	"mysite/pkgs/auth"
+	"mysite/utils/httputil"
+	"mysite/utils/ptrconv"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/go-chi/chi/v5"
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type mockService struct {
+	GetMeFunc    func(userId int) (*dtos.MeResponse, error)
+	UpdateMeFunc func(userId int, req internal.UpdateMeRequest) (*dtos.MeResponse, error)
+}
+
+func (m mockService) GetMe(ctx context.Context, userId int) (*dtos.MeResponse, error) {
+	return m.GetMeFunc(userId)
+}
+
+func (m mockService) UpdateMe(ctx context.Context, userId int, req internal.UpdateMeRequest) (*dtos.MeResponse, error) {
+	return m.UpdateMeFunc(userId, req)
+}
+
+func withPrincipal(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Header.Get("Authorization") == "" {
+			next.ServeHTTP(w, r)
+			return
+		}
+		next.ServeHTTP(w, r.WithContext(auth.WithPrincipal(r.Context(), auth.Principal{UserID: 1})))
+	})
+}
+
+func newTestRouter() *chi.Mux {
+	router := chi.NewRouter()
+	router.Route("/api/v1", func(subr chi.Router) {
+		subr.Use(withPrincipal)
+		HandlerFromMux(NewHandler(), subr)
+	})
+	return router
+}
+
+func newRequest(method string, body interface{}) (*http.Request, error) {
+	var buf bytes.Buffer
+	if body != nil {
+		if err := json.NewEncoder(&buf).Encode(body); err != nil {
+			return nil, errors.Wrap(err, "failed encode body")
+		}
+	}
+
+	r, err := http.NewRequest(method, "http://example.com/api/v1/me", &buf)
+	if err != nil {
+		return nil, err
+	}
+	r.Header.Set("Authorization", "Bearer token")
+	return r, nil
+}
+
+func TestDashboardGetStores(t *testing.T) {
+	t.Parallel()
+	router := newTestRouter()
+
+	tests := []struct {
+		name       string
+		req        func(context.Context) (*http.Request, error)
+		assert     func(*httptest.ResponseRecorder, *http.Request)
+		newService func() service
+	}{
+		{
+			name: "401 - get me without principal",
+			req: func(ctx context.Context) (*http.Request, error) {
+				return http.NewRequest(http.MethodGet, "http://example.com/api/v1/me", nil)
+			},
+			assert: func(w *httptest.ResponseRecorder, r *http.Request) {
+				assert.Equal(t, http.StatusUnauthorized, w.Result().StatusCode)
+			},
+		},
+		{
+			name: "404 - get me user not found",
+			req: func(ctx context.Context) (*http.Request, error) {
+				return newRequest(http.MethodGet, nil)
+			},
+			assert: func(w *httptest.ResponseRecorder, r *http.Request) {
+				assert.Equal(t, http.StatusNotFound, w.Result().StatusCode)
+			},
+			newService: func() service {
+				return mockService{GetMeFunc: func(userId int) (*dtos.MeResponse, error) { return nil, httputil.ErrNotFound }}
+			},
+		},
+		{
+			name: "200 - get me success",
+			req: func(ctx context.Context) (*http.Request, error) {
+				return newRequest(http.MethodGet, nil)
+			},
+			assert: func(w *httptest.ResponseRecorder, r *http.Request) {
+				assert.Equal(t, http.StatusOK, w.Result().StatusCode)
+
+				var resp dtos.MeResponse
+				require.NoError(t, json.NewDecoder(w.Body).Decode(&resp))
+				require.Equal(t, 1, resp.Id)
+				require.Equal(t, "name", *resp.Name)
+			},
+			newService: func() service {
+				return mockService{GetMeFunc: func(userId int) (*dtos.MeResponse, error) {
+					return &dtos.MeResponse{Id: userId, UserName: "user@example.com", Name: ptrconv.String("name")}, nil
+				}}
+			},
+		},
+		{
+			name: "400 - update me empty body",
+			req: func(ctx context.Context) (*http.Request, error) {
+				r, err := http.NewRequest(http.MethodPatch, "http://example.com/api/v1/me", nil)
+				if err != nil {
+					return nil, err
+				}
+				r.Header.Set("Authorization", "Bearer token")
+				return r, nil
+			},
+			assert: func(w *httptest.ResponseRecorder, r *http.Request) {
+				assert.Equal(t, http.StatusBadRequest, w.Result().StatusCode)
+			},
+		},
+		{
+			name: "400 - update me invalid request",
+			req: func(ctx context.Context) (*http.Request, error) {
+				return newRequest(http.MethodPatch, dtos.UpdateMeRequest{Email: ptrconv.String("invalid")})
+			},
+			assert: func(w *httptest.ResponseRecorder, r *http.Request) {
+				assert.Equal(t, http.StatusBadRequest, w.Result().StatusCode)
+			},
+			newService: func() service {
+				return mockService{UpdateMeFunc: func(userId int, req internal.UpdateMeRequest) (*dtos.MeResponse, error) {
+					return nil, httputil.ErrInvalidRequest
+				}}
+			},
+		},
+		{
+			name: "200 - update me success",
+			req: func(ctx context.Context) (*http.Request, error) {
+				return newRequest(http.MethodPatch, dtos.UpdateMeRequest{Name: ptrconv.String("new name")})
+			},
+			assert: func(w *httptest.ResponseRecorder, r *http.Request) {
+				assert.Equal(t, http.StatusOK, w.Result().StatusCode)
+
+				var resp dtos.MeResponse
+				require.NoError(t, json.NewDecoder(w.Body).Decode(&resp))
+				require.Equal(t, "new name", *resp.Name)
+			},
+			newService: func() service {
+				return mockService{UpdateMeFunc: func(userId int, req internal.UpdateMeRequest) (*dtos.MeResponse, error) {
+					return &dtos.MeResponse{Id: userId, UserName: "user@example.com", Name: req.Name}, nil
+				}}
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+
+		t.Run(tt.name, func(t *testing.T) {
+			newService = tt.newService
+			ctx := context.Background()
+			var err error
+
+			w := httptest.NewRecorder()
+			r, err := tt.req(ctx)
+			if assert.NoError(t, err) {
+				router.ServeHTTP(w, r)
+				tt.assert(w, r)
+			}
+		})
+	}
+}
@@ -0,0 +1,69 @@
+package internal
+
+import (
+	"context"
+	"mysite/dtos"
+	"mysite/entities"
+	"mysite/pkgs/database"
+	"mysite/pkgs/tier"
+	"mysite/repositories/membershiprepo"
+	"mysite/repositories/userinforepo"
+	"mysite/utils/httputil"
+
+	"github.com/pkg/errors"
+	"github.com/volatiletech/sqlboiler/v4/boil"
+)
+
+type service struct {
+	repo           userinforepo.UserInfoRepo
+	membershipRepo membershiprepo.MembershipRepo
+}
+
+func NewService() service {
+	return service{
+		repo:           userinforepo.NewRepo(),
+		membershipRepo: membershiprepo.NewRepo(),
+	}
+}
+
+// GetMembership returns the tier of the user, bronze when the user has no userInfo or no tier yet
+func (s service) GetMembership(ctx context.Context, userId int) (*dtos.MembershipResponse, error) {
+	var resp *dtos.MembershipResponse
+	if err := database.NewBoilerTransaction(ctx, func(ctx context.Context, tx boil.ContextTransactor) error {
+		pgUserAccount, err := s.repo.GetActiveUserAccountWithUserInfo(ctx, tx, userId)
+		if err != nil {
+			return errors.Wrap(err, "failed get userAccount with userInfo")
+		}
+		if pgUserAccount == nil {
+			return errors.Wrap(httputil.ErrNotFound, "user not found")
+		}
+
+		membershipId := tier.CurrentMembershipId(pgUserAccount)
+		membership, err := s.membershipRepo.GetMembershipById(ctx, tx, membershipId)
+		if err != nil {
+			return errors.Wrap(err, "failed get membership")
+		}
+		if membership == nil {
+			return errors.Errorf("membership %d not found", membershipId)
+		}
+
+		resp = newMembershipResponse(*membership)
+		return nil
+	}); err != nil {
+		return nil, errors.Wrap(err, "failed get current membership")
+	}
+
+	return resp, nil
+}
+
+func newMembershipResponse(membership entities.Membership) *dtos.MembershipResponse {
+	benefits := []string(membership.Benefits)
+	if benefits == nil {
+		benefits = []string{}
+	}
+	return &dtos.MembershipResponse{
+		Name:     membership.Name,
+		Rank:     membership.Rank,
+		Benefits: benefits,
+	}
+}
@@ -0,0 +1,90 @@
+package internal
+
+import (
+	"context"
+	"fmt"
+	"mysite/constants"
+	"mysite/entities"
+	"mysite/pkgs/database"
+	"mysite/testing/dbtest"
+	"mysite/testing/mocking/repomock"
+	"mysite/utils/httputil"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"github.com/volatiletech/null/v8"
+	"github.com/volatiletech/sqlboiler/v4/boil"
+	"github.com/volatiletech/sqlboiler/v4/types"
+)
+
+func TestMain(m *testing.M) {
+	pool, resource, err := dbtest.SetupDatabaseForTesting()
+	if err != nil {
+		return
+	}
+
+	defer func() {
+		database.Close()
+		if err := dbtest.PurgeResource(pool, resource); err != nil {
+			fmt.Println("failed to purge resource")
+		}
+	}()
+	m.Run()
+}
+
+func newUserAccount(userInfos ...*entities.UserInfo) *entities.UserAccount {
+	pgUserAccount := &entities.UserAccount{ID: 1}
+	pgUserAccount.R = pgUserAccount.R.NewStruct()
+	pgUserAccount.R.UserInfos = userInfos
+	return pgUserAccount
+}
+
+func TestGetMembership(t *testing.T) {
+	t.Parallel()
+	require.NoError(t, database.SetupDatabase())
+	ctx := dbtest.SetTestTransactionCtx(context.Background())
+	newMembershipMock := func() *repomock.MembershipRepoMock {
+		return &repomock.MembershipRepoMock{
+			GetMembershipByIdFunc: func(ctx context.Context, tx boil.ContextTransactor, id int) (*entities.Membership, error) {
+				return &entities.Membership{ID: id, Name: fmt.Sprintf("tier%d", id), Rank: id, Benefits: types.StringArray{"benefit"}}, nil
+			},
+		}
+	}
+
+	{ // get membership success
+		repoMock := &repomock.UserInfoRepoMock{
+			GetActiveUserAccountWithUserInfoFunc: func(ctx context.Context, tx boil.ContextTransactor, userAccountId int) (*entities.UserAccount, error) {
+				return newUserAccount(&entities.UserInfo{MembershipID: null.IntFrom(constants.Gold)}), nil
+			},
+		}
+
+		svc := service{repo: repoMock, membershipRepo: newMembershipMock()}
+		resp, err := svc.GetMembership(ctx, 1)
+		require.NoError(t, err)
+		require.Equal(t, constants.Gold, resp.Rank)
+		require.Equal(t, []string{"benefit"}, resp.Benefits)
+	}
+	{ // get membership success, bronze without userInfo
+		repoMock := &repomock.UserInfoRepoMock{
+			GetActiveUserAccountWithUserInfoFunc: func(ctx context.Context, tx boil.ContextTransactor, userAccountId int) (*entities.UserAccount, error) {
+				return newUserAccount(), nil
+			},
+		}
+
+		svc := service{repo: repoMock, membershipRepo: newMembershipMock()}
+		resp, err := svc.GetMembership(ctx, 1)
+		require.NoError(t, err)
+		require.Equal(t, constants.Bronze, resp.Rank)
+	}
+	{ // get membership failed, user not found
+		repoMock := &repomock.UserInfoRepoMock{
+			GetActiveUserAccountWithUserInfoFunc: func(ctx context.Context, tx boil.ContextTransactor, userAccountId int) (*entities.UserAccount, error) {
+				return nil, nil
+			},
+		}
+
+		svc := service{repo: repoMock, membershipRepo: newMembershipMock()}
+		_, err := svc.GetMembership(ctx, 1)
+		require.ErrorIs(t, err, httputil.ErrNotFound)
+	}
+}
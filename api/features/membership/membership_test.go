@@ -0,0 +1,107 @@
+package membership
+
+import (
+	"context"
+	"encoding/json"
+	"mysite/dtos"
+	"mysite/pkgs/auth"
+	"mysite/utils/httputil"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/go-chi/chi/v5"
+	"github.com/stretchr/testify/assert"
+)
+
+type mockService struct {
+	GetMembershipFunc func(userId int) (*dtos.MembershipResponse, error)
+}
+
+func (m mockService) GetMembership(ctx context.Context, userId int) (*dtos.MembershipResponse, error) {
+	return m.GetMembershipFunc(userId)
+}
+
+func withPrincipal(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Header.Get("Authorization") == "" {
+			next.ServeHTTP(w, r)
+			return
+		}
+		next.ServeHTTP(w, r.WithContext(auth.WithPrincipal(r.Context(), auth.Principal{UserID: 1})))
+	})
+}
+
+func newTestRouter() *chi.Mux {
+	router := chi.NewRouter()
+	router.Route("/api/v1", func(subr chi.Router) {
+		subr.Use(withPrincipal)
+		HandlerFromMux(NewHandler(), subr)
+	})
+	return router
+}
+
+func TestGetMembership(t *testing.T) {
+	t.Parallel()
+	router := newTestRouter()
+
+	tests := []struct {
+		name       string
+		req        func(context.Context) (*http.Request, error)
+		assert     func(*httptest.ResponseRecorder, *http.Request)
+		newService func() service
+	}{
+		{
+			name: "200",
+			req: func(ctx context.Context) (*http.Request, error) {
+				r, err := http.NewRequest(http.MethodGet, "http://example.com/api/v1/me/membership", nil)
+				if err != nil {
+					return nil, err
+				}
+				r.Header.Set("Authorization", "Bearer token")
+				return r, nil
+			},
+			assert: func(w *httptest.ResponseRecorder, r *http.Request) {
+				assert.Equal(t, http.StatusOK, w.Result().StatusCode)
+				var resp dtos.MembershipResponse
+				if assert.NoError(t, json.NewDecoder(w.Body).Decode(&resp)) {
+					assert.Equal(t, "gold", resp.Name)
+				}
+			},
+			newService: func() service {
+				return mockService{GetMembershipFunc: func(userId int) (*dtos.MembershipResponse, error) {
+					if userId != 1 {
+						return nil, httputil.ErrNotFound
+					}
+					return &dtos.MembershipResponse{Name: "gold", Rank: 3, Benefits: []string{}}, nil
+				}}
+			},
+		},
+		{
+			name: "401 - without principal",
+			req: func(ctx context.Context) (*http.Request, error) {
+				return http.NewRequest(http.MethodGet, "http://example.com/api/v1/me/membership", nil)
+			},
+			assert: func(w *httptest.ResponseRecorder, r *http.Request) {
+				assert.Equal(t, http.StatusUnauthorized, w.Result().StatusCode)
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+
+		t.Run(tt.name, func(t *testing.T) {
+			newService = tt.newService
+			ctx := context.Background()
+			var err error
+
+			w := httptest.NewRecorder()
+			r, err := tt.req(ctx)
+			if assert.NoError(t, err) {
+				router.ServeHTTP(w, r)
+				tt.assert(w, r)
+			}
+		})
+	}
+}
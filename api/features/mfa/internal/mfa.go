@@ -0,0 +1,162 @@
+package internal
+
+import (
+	"context"
+	"mysite/dtos"
+	"mysite/entities"
+	"mysite/pkgs/database"
+	"mysite/pkgs/env"
+	"mysite/pkgs/totp"
+	"mysite/pkgs/validate"
+	"mysite/repositories/useraccountrepo"
+	"mysite/repositories/usermfarepo"
+	"mysite/utils/httputil"
+	"time"
+
+	"github.com/mitchellh/mapstructure"
+	"github.com/pkg/errors"
+	"github.com/volatiletech/null/v8"
+	"github.com/volatiletech/sqlboiler/v4/boil"
+)
+
+type service struct {
+	repo    useraccountrepo.UserAccountRepo
+	mfaRepo usermfarepo.UserMfaRepo
+}
+
+type MfaCodeRequest struct {
+	// Code TOTP code, or a recovery code where accepted
+	Code string `validate:"required,max=32"`
+}
+
+func NewService() service {
+	return service{
+		repo:    useraccountrepo.NewRepo(),
+		mfaRepo: usermfarepo.NewRepo(),
+	}
+}
+
+func NewParams(req dtos.MfaCodeRequest) (*MfaCodeRequest, error) {
+	var result MfaCodeRequest
+	if err := mapstructure.Decode(req, &result); err != nil {
+		return nil, errors.Wrap(err, "failed decode")
+	}
+
+	return &result, nil
+}
+
+// EnrollMfa creates a new secret, a pending enrolment is replaced
+func (s service) EnrollMfa(ctx context.Context, userId int) (*dtos.MfaEnrollResponse, error) {
+	secret, err := totp.GenerateSecret()
+	if err != nil {
+		return nil, errors.Wrap(err, "failed generate secret")
+	}
+
+	var userName string
+	if err := database.NewBoilerTransaction(ctx, func(ctx context.Context, tx boil.ContextTransactor) error {
+		user, err := s.repo.GetActiveUserAccountById(ctx, tx, userId)
+		if err != nil || user == nil {
+			return errors.Wrap(httputil.ErrNotFound, "user not found")
+		}
+		userName = user.UserName
+
+		mfa, err := s.mfaRepo.GetUserMfaByUserAccountId(ctx, tx, userId)
+		if err != nil {
+			return errors.Wrap(err, "failed get userMfa")
+		}
+		if mfa == nil {
+			return s.mfaRepo.Insert(ctx, tx, &entities.UserMfa{
+				UserAccountID: userId,
+				Secret:        secret,
+			})
+		}
+		if mfa.EnabledAt.Valid {
+			return errors.Wrap(httputil.ErrInvalidRequest, "2fa already enabled")
+		}
+
+		mfa.Secret = secret
+		mfa.LastUsedStep = null.Int64{}
+		return s.mfaRepo.Update(ctx, tx, *mfa)
+	}); err != nil {
+		return nil, errors.Wrap(err, "failed enroll mfa")
+	}
+
+	return &dtos.MfaEnrollResponse{
+		Secret:     secret,
+		OtpauthUri: totp.URI(env.GetEnv().Jwt.Issuer, userName, secret),
+	}, nil
+}
+
+// ConfirmMfa turns 2FA on once the user proves the secret is in the app
+func (s service) ConfirmMfa(ctx context.Context, userId int, req MfaCodeRequest) (*dtos.MfaRecoveryCodesResponse, error) {
+	if err := validate.ValidateStruct(req); err != nil {
+		return nil, errors.Wrap(httputil.ErrInvalidRequest, err.Error())
+	}
+
+	codes, hashes, err := totp.NewRecoveryCodes()
+	if err != nil {
+		return nil, errors.Wrap(err, "failed generate recovery codes")
+	}
+
+	if err := database.NewBoilerTransaction(ctx, func(ctx context.Context, tx boil.ContextTransactor) error {
+		mfa, err := s.mfaRepo.GetUserMfaByUserAccountId(ctx, tx, userId)
+		if err != nil {
+			return errors.Wrap(err, "failed get userMfa")
+		}
+		if mfa == nil {
+			return errors.Wrap(httputil.ErrInvalidRequest, "2fa not enrolled")
+		}
+		if mfa.EnabledAt.Valid {
+			return errors.Wrap(httputil.ErrInvalidRequest, "2fa already enabled")
+		}
+
+		if matched, err := totp.Accept(mfa, req.Code, time.Now()); !matched || err != nil {
+			return errors.Wrap(httputil.ErrInvalidRequest, "invalid code")
+		}
+
+		mfa.EnabledAt = null.TimeFrom(time.Now())
+		if err := s.mfaRepo.Update(ctx, tx, *mfa); err != nil {
+			return errors.Wrap(err, "failed update userMfa")
+		}
+
+		return s.mfaRepo.ReplaceRecoveryCodes(ctx, tx, userId, hashes)
+	}); err != nil {
+		return nil, errors.Wrap(err, "failed confirm mfa")
+	}
+
+	return &dtos.MfaRecoveryCodesResponse{RecoveryCodes: codes}, nil
+}
+
+// DisableMfa turns 2FA off with a TOTP code or a recovery code
+func (s service) DisableMfa(ctx context.Context, userId int, req MfaCodeRequest) error {
+	if err := validate.ValidateStruct(req); err != nil {
+		return errors.Wrap(httputil.ErrInvalidRequest, err.Error())
+	}
+
+	return database.NewBoilerTransaction(ctx, func(ctx context.Context, tx boil.ContextTransactor) error {
+		mfa, err := s.mfaRepo.GetUserMfaByUserAccountId(ctx, tx, userId)
+		if err != nil {
+			return errors.Wrap(err, "failed get userMfa")
+		}
+		if mfa == nil || !mfa.EnabledAt.Valid {
+			return errors.Wrap(httputil.ErrInvalidRequest, "2fa not enabled")
+		}
+
+		// a replayed code is rejected like at login, it is not tried as a recovery code either
+		matched, err := totp.Accept(mfa, req.Code, time.Now())
+		if err != nil {
+			return errors.Wrap(httputil.ErrInvalidRequest, err.Error())
+		}
+		if !matched {
+			code, err := s.mfaRepo.GetUnusedRecoveryCode(ctx, tx, userId, totp.HashRecoveryCode(req.Code))
+			if err != nil {
+				return errors.Wrap(err, "failed get recovery code")
+			}
+			if code == nil {
+				return errors.Wrap(httputil.ErrInvalidRequest, "invalid code")
+			}
+		}
+
+		return s.mfaRepo.DeleteByUserAccountId(ctx, tx, userId)
+	})
+}
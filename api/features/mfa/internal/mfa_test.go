@@ -0,0 +1,199 @@
+package internal
+
+import (
+	"context"
+	"fmt"
+	"mysite/dtos"
+	"mysite/entities"
+	"mysite/pkgs/database"
+	"mysite/pkgs/totp"
+	"mysite/testing/dbtest"
+	"mysite/testing/mocking/repomock"
+	"mysite/utils/httputil"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"github.com/volatiletech/null/v8"
+	"github.com/volatiletech/sqlboiler/v4/boil"
+)
+
+func TestMain(m *testing.M) {
+	pool, resource, err := dbtest.SetupDatabaseForTesting()
+	if err != nil {
+		return
+	}
+
+	defer func() {
+		database.Close()
+		if err := dbtest.PurgeResource(pool, resource); err != nil {
+			fmt.Println("failed to purge resource")
+		}
+	}()
+	m.Run()
+}
+
+func newMfaMock(mfa *entities.UserMfa, code *entities.UserRecoveryCode) *repomock.UserMfaRepoMock {
+	return &repomock.UserMfaRepoMock{
+		GetUserMfaByUserAccountIdFunc: func(ctx context.Context, tx boil.ContextTransactor, userAccountId int) (*entities.UserMfa, error) {
+			return mfa, nil
+		},
+		InsertFunc: func(ctx context.Context, tx boil.ContextTransactor, mfa *entities.UserMfa) error {
+			return nil
+		},
+		UpdateFunc: func(ctx context.Context, tx boil.ContextTransactor, mfa entities.UserMfa) error {
+			return nil
+		},
+		ReplaceRecoveryCodesFunc: func(ctx context.Context, tx boil.ContextTransactor, userAccountId int, codeHashes []string) error {
+			return nil
+		},
+		GetUnusedRecoveryCodeFunc: func(ctx context.Context, tx boil.ContextTransactor, userAccountId int, codeHash string) (*entities.UserRecoveryCode, error) {
+			return code, nil
+		},
+		DeleteByUserAccountIdFunc: func(ctx context.Context, tx boil.ContextTransactor, userAccountId int) error {
+			return nil
+		},
+	}
+}
+
+func TestEnrollMfa(t *testing.T) {
+	t.Parallel()
+	require.NoError(t, database.SetupDatabase())
+	ctx := dbtest.SetTestTransactionCtx(context.Background())
+	repoMock := &repomock.UserAccountRepoMock{
+		GetActiveUserAccountByIdFunc: func(ctx context.Context, tx boil.ContextTransactor, userId int) (*entities.UserAccount, error) {
+			return &entities.UserAccount{ID: userId, UserName: "test@example.com"}, nil
+		},
+	}
+
+	{ // enroll success, new secret
+		mfaMock := newMfaMock(nil, nil)
+		svc := service{repo: repoMock, mfaRepo: mfaMock}
+
+		resp, err := svc.EnrollMfa(ctx, 1)
+		require.NoError(t, err)
+		require.NotEmpty(t, resp.Secret)
+		require.True(t, strings.HasPrefix(resp.OtpauthUri, "otpauth://totp/"))
+		require.Contains(t, resp.OtpauthUri, "test@example.com")
+		require.Len(t, mfaMock.InsertCalls(), 1)
+		require.Equal(t, resp.Secret, mfaMock.InsertCalls()[0].Mfa.Secret)
+	}
+	{ // enroll success, pending secret replaced
+		mfaMock := newMfaMock(&entities.UserMfa{UserAccountID: 1, Secret: "OLD"}, nil)
+		svc := service{repo: repoMock, mfaRepo: mfaMock}
+
+		resp, err := svc.EnrollMfa(ctx, 1)
+		require.NoError(t, err)
+		require.Len(t, mfaMock.UpdateCalls(), 1)
+		require.Equal(t, resp.Secret, mfaMock.UpdateCalls()[0].Mfa.Secret)
+	}
+	{ // enroll failed, already enabled
+		mfaMock := newMfaMock(&entities.UserMfa{UserAccountID: 1, Secret: "OLD", EnabledAt: null.TimeFrom(time.Now())}, nil)
+		svc := service{repo: repoMock, mfaRepo: mfaMock}
+
+		_, err := svc.EnrollMfa(ctx, 1)
+		require.ErrorIs(t, err, httputil.ErrInvalidRequest)
+		require.Empty(t, mfaMock.UpdateCalls())
+	}
+}
+
+func TestConfirmMfa(t *testing.T) {
+	t.Parallel()
+	require.NoError(t, database.SetupDatabase())
+	ctx := dbtest.SetTestTransactionCtx(context.Background())
+	secret, err := totp.GenerateSecret()
+	require.NoError(t, err)
+
+	{ // confirm success, recovery codes stored hashed
+		code, err := totp.Code(secret, time.Now())
+		require.NoError(t, err)
+		mfaMock := newMfaMock(&entities.UserMfa{UserAccountID: 1, Secret: secret}, nil)
+		svc := service{mfaRepo: mfaMock}
+
+		resp, err := svc.ConfirmMfa(ctx, 1, MfaCodeRequest{Code: code})
+		require.NoError(t, err)
+		require.Len(t, resp.RecoveryCodes, totp.RecoveryCodeCount)
+		require.Len(t, mfaMock.UpdateCalls(), 1)
+		require.True(t, mfaMock.UpdateCalls()[0].Mfa.EnabledAt.Valid)
+		require.Len(t, mfaMock.ReplaceRecoveryCodesCalls(), 1)
+		hashes := mfaMock.ReplaceRecoveryCodesCalls()[0].CodeHashes
+		require.Equal(t, totp.HashRecoveryCode(resp.RecoveryCodes[0]), hashes[0])
+		require.NotContains(t, hashes, resp.RecoveryCodes[0])
+	}
+	{ // confirm failed, invalid code
+		mfaMock := newMfaMock(&entities.UserMfa{UserAccountID: 1, Secret: secret}, nil)
+		svc := service{mfaRepo: mfaMock}
+
+		_, err := svc.ConfirmMfa(ctx, 1, MfaCodeRequest{Code: "abcdef"})
+		require.ErrorIs(t, err, httputil.ErrInvalidRequest)
+		require.Empty(t, mfaMock.UpdateCalls())
+	}
+	{ // confirm failed, not enrolled
+		svc := service{mfaRepo: newMfaMock(nil, nil)}
+
+		_, err := svc.ConfirmMfa(ctx, 1, MfaCodeRequest{Code: "123456"})
+		require.ErrorIs(t, err, httputil.ErrInvalidRequest)
+	}
+}
+
+func TestDisableMfa(t *testing.T) {
+	t.Parallel()
+	require.NoError(t, database.SetupDatabase())
+	ctx := dbtest.SetTestTransactionCtx(context.Background())
+	secret, err := totp.GenerateSecret()
+	require.NoError(t, err)
+	enabled := &entities.UserMfa{UserAccountID: 1, Secret: secret, EnabledAt: null.TimeFrom(time.Now())}
+
+	{ // disable success, TOTP code
+		code, err := totp.Code(secret, time.Now())
+		require.NoError(t, err)
+		mfaMock := newMfaMock(enabled, nil)
+		svc := service{mfaRepo: mfaMock}
+
+		require.NoError(t, svc.DisableMfa(ctx, 1, MfaCodeRequest{Code: code}))
+		require.Len(t, mfaMock.DeleteByUserAccountIdCalls(), 1)
+	}
+	{ // disable success, recovery code
+		mfaMock := newMfaMock(enabled, &entities.UserRecoveryCode{ID: 1, UserAccountID: 1})
+		svc := service{mfaRepo: mfaMock}
+
+		require.NoError(t, svc.DisableMfa(ctx, 1, MfaCodeRequest{Code: "abcde-fghij"}))
+		require.Len(t, mfaMock.DeleteByUserAccountIdCalls(), 1)
+	}
+	{ // disable failed, invalid code
+		mfaMock := newMfaMock(enabled, nil)
+		svc := service{mfaRepo: mfaMock}
+
+		err := svc.DisableMfa(ctx, 1, MfaCodeRequest{Code: "000000"})
+		require.ErrorIs(t, err, httputil.ErrInvalidRequest)
+		require.Empty(t, mfaMock.DeleteByUserAccountIdCalls())
+	}
+	{ // disable failed, TOTP code replayed
+		code, err := totp.Code(secret, time.Now())
+		require.NoError(t, err)
+		step, _ := totp.Validate(secret, code, time.Now())
+		mfa := *enabled
+		mfa.LastUsedStep = null.Int64From(step)
+		mfaMock := newMfaMock(&mfa, nil)
+		svc := service{mfaRepo: mfaMock}
+
+		err = svc.DisableMfa(ctx, 1, MfaCodeRequest{Code: code})
+		require.ErrorIs(t, err, httputil.ErrInvalidRequest)
+		require.Empty(t, mfaMock.DeleteByUserAccountIdCalls())
+	}
+	{ // disable failed, not enabled
+		svc := service{mfaRepo: newMfaMock(nil, nil)}
+
+		err := svc.DisableMfa(ctx, 1, MfaCodeRequest{Code: "123456"})
+		require.ErrorIs(t, err, httputil.ErrInvalidRequest)
+	}
+}
+
+func TestNewParams(t *testing.T) {
+	{ // create params success
+		req, err := NewParams(dtos.MfaCodeRequest{Code: "123456"})
+		require.NoError(t, err)
+		require.Equal(t, "123456", req.Code)
+	}
+}
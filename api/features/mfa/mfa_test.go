@@ -0,0 +1,185 @@
+package mfa
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"mysite/dtos"
+	"mysite/features/mfa/internal"
+	"mysite/pkgs/auth"
+	"mysite/utils/httputil"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/go-chi/chi/v5"
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type mockService struct {
+	EnrollMfaFunc  func(userId int) (*dtos.MfaEnrollResponse, error)
+	ConfirmMfaFunc func(userId int, req internal.MfaCodeRequest) (*dtos.MfaRecoveryCodesResponse, error)
+	DisableMfaFunc func(userId int, req internal.MfaCodeRequest) error
+}
+
+func (m mockService) EnrollMfa(ctx context.Context, userId int) (*dtos.MfaEnrollResponse, error) {
+	return m.EnrollMfaFunc(userId)
+}
+
+func (m mockService) ConfirmMfa(ctx context.Context, userId int, req internal.MfaCodeRequest) (*dtos.MfaRecoveryCodesResponse, error) {
+	return m.ConfirmMfaFunc(userId, req)
+}
+
+func (m mockService) DisableMfa(ctx context.Context, userId int, req internal.MfaCodeRequest) error {
+	return m.DisableMfaFunc(userId, req)
+}
+
+func withPrincipal(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Header.Get("Authorization") == "" {
+			next.ServeHTTP(w, r)
+			return
+		}
+		next.ServeHTTP(w, r.WithContext(auth.WithPrincipal(r.Context(), auth.Principal{UserID: 1})))
+	})
+}
+
+func newTestRouter() *chi.Mux {
+	router := chi.NewRouter()
+	router.Route("/api/v1", func(subr chi.Router) {
+		subr.Use(withPrincipal)
+		HandlerFromMux(NewHandler(), subr)
+	})
+	return router
+}
+
+func newRequest(path string, body interface{}) (*http.Request, error) {
+	var buf bytes.Buffer
+	if body != nil {
+		if err := json.NewEncoder(&buf).Encode(body); err != nil {
+			return nil, errors.Wrap(err, "failed encode body")
+		}
+	}
+
+	r, err := http.NewRequest(http.MethodPost, "http://example.com/api/v1"+path, &buf)
+	if err != nil {
+		return nil, err
+	}
+	r.Header.Set("Authorization", "Bearer token")
+	return r, nil
+}
+
+func TestDashboardGetStores(t *testing.T) {
+	t.Parallel()
+	router := newTestRouter()
+
+	tests := []struct {
+		name       string
+		req        func(context.Context) (*http.Request, error)
+		assert     func(*httptest.ResponseRecorder, *http.Request)
+		newService func() service
+	}{
+		{
+			name: "401 - enroll without principal",
+			req: func(ctx context.Context) (*http.Request, error) {
+				return http.NewRequest(http.MethodPost, "http://example.com/api/v1/me/mfa/enroll", nil)
+			},
+			assert: func(w *httptest.ResponseRecorder, r *http.Request) {
+				assert.Equal(t, http.StatusUnauthorized, w.Result().StatusCode)
+			},
+		},
+		{
+			name: "200 - enroll",
+			req: func(ctx context.Context) (*http.Request, error) {
+				return newRequest("/me/mfa/enroll", nil)
+			},
+			assert: func(w *httptest.ResponseRecorder, r *http.Request) {
+				assert.Equal(t, http.StatusOK, w.Result().StatusCode)
+
+				var resp dtos.MfaEnrollResponse
+				require.NoError(t, json.NewDecoder(w.Body).Decode(&resp))
+				require.Equal(t, "SECRET", resp.Secret)
+			},
+			newService: func() service {
+				return mockService{EnrollMfaFunc: func(userId int) (*dtos.MfaEnrollResponse, error) {
+					return &dtos.MfaEnrollResponse{Secret: "SECRET", OtpauthUri: "otpauth://totp/mysite:test?secret=SECRET"}, nil
+				}}
+			},
+		},
+		{
+			name: "400 - confirm, empty body",
+			req: func(ctx context.Context) (*http.Request, error) {
+				return newRequest("/me/mfa/confirm", nil)
+			},
+			assert: func(w *httptest.ResponseRecorder, r *http.Request) {
+				assert.Equal(t, http.StatusBadRequest, w.Result().StatusCode)
+			},
+		},
+		{
+			name: "200 - confirm",
+			req: func(ctx context.Context) (*http.Request, error) {
+				return newRequest("/me/mfa/confirm", dtos.MfaCodeRequest{Code: "123456"})
+			},
+			assert: func(w *httptest.ResponseRecorder, r *http.Request) {
+				assert.Equal(t, http.StatusOK, w.Result().StatusCode)
+
+				var resp dtos.MfaRecoveryCodesResponse
+				require.NoError(t, json.NewDecoder(w.Body).Decode(&resp))
+				require.Len(t, resp.RecoveryCodes, 1)
+			},
+			newService: func() service {
+				return mockService{ConfirmMfaFunc: func(userId int, req internal.MfaCodeRequest) (*dtos.MfaRecoveryCodesResponse, error) {
+					if userId != 1 || req.Code != "123456" {
+						return nil, httputil.ErrInvalidRequest
+					}
+					return &dtos.MfaRecoveryCodesResponse{RecoveryCodes: []string{"abcde-fghij"}}, nil
+				}}
+			},
+		},
+		{
+			name: "400 - disable, invalid code",
+			req: func(ctx context.Context) (*http.Request, error) {
+				return newRequest("/me/mfa/disable", dtos.MfaCodeRequest{Code: "000000"})
+			},
+			assert: func(w *httptest.ResponseRecorder, r *http.Request) {
+				assert.Equal(t, http.StatusBadRequest, w.Result().StatusCode)
+			},
+			newService: func() service {
+				return mockService{DisableMfaFunc: func(userId int, req internal.MfaCodeRequest) error {
+					return httputil.ErrInvalidRequest
+				}}
+			},
+		},
+		{
+			name: "204 - disable",
+			req: func(ctx context.Context) (*http.Request, error) {
+				return newRequest("/me/mfa/disable", dtos.MfaCodeRequest{Code: "123456"})
+			},
+			assert: func(w *httptest.ResponseRecorder, r *http.Request) {
+				assert.Equal(t, http.StatusNoContent, w.Result().StatusCode)
+			},
+			newService: func() service {
+				return mockService{DisableMfaFunc: func(userId int, req internal.MfaCodeRequest) error { return nil }}
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+
+		t.Run(tt.name, func(t *testing.T) {
+			newService = tt.newService
+			ctx := context.Background()
+			var err error
+
+			w := httptest.NewRecorder()
+			r, err := tt.req(ctx)
+			if assert.NoError(t, err) {
+				router.ServeHTTP(w, r)
+				tt.assert(w, r)
+			}
+		})
+	}
+}
@@ -0,0 +1,348 @@
+package internal
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/base64"
+	"mysite/constants"
+	"mysite/dtos"
+	"mysite/entities"
+	"mysite/pkgs/auth"
+	"mysite/pkgs/database"
+	"mysite/pkgs/env"
+	"mysite/pkgs/oauth"
+	"mysite/testing/dbtest"
+	"mysite/testing/mocking/repomock"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"github.com/volatiletech/null/v8"
+	"github.com/volatiletech/sqlboiler/v4/boil"
+	"github.com/volatiletech/sqlboiler/v4/types"
+)
+
+// memoryStore keeps the codes and sessions the flow writes, so that each step reads what the previous one stored
+type memoryStore struct {
+	codes    map[string]*entities.OauthAuthorizationCode
+	sessions map[string]*entities.UserSession
+}
+
+func newMemoryStore() *memoryStore {
+	return &memoryStore{
+		codes:    map[string]*entities.OauthAuthorizationCode{},
+		sessions: map[string]*entities.UserSession{},
+	}
+}
+
+func (m *memoryStore) codeRepo() *repomock.OauthCodeRepoMock {
+	return &repomock.OauthCodeRepoMock{
+		GetOauthAuthorizationCodeByHashFunc: func(ctx context.Context, tx boil.ContextTransactor, codeHash string) (*entities.OauthAuthorizationCode, error) {
+			code, ok := m.codes[codeHash]
+			if !ok {
+				return nil, nil
+			}
+			copied := *code
+			return &copied, nil
+		},
+		MarkUsedFunc: func(ctx context.Context, tx boil.ContextTransactor, codeId int, familyId string) (bool, error) {
+			for _, code := range m.codes {
+				if code.ID == codeId && !code.UsedAt.Valid {
+					code.UsedAt = null.TimeFrom(time.Now())
+					code.FamilyID = null.StringFrom(familyId)
+					return true, nil
+				}
+			}
+			return false, nil
+		},
+	}
+}
+
+func (m *memoryStore) sessionRepo() *repomock.UserSessionRepoMock {
+	return &repomock.UserSessionRepoMock{
+		GetUserSessionByTokenIdFunc: func(ctx context.Context, tx boil.ContextTransactor, tokenId string) (*entities.UserSession, error) {
+			session, ok := m.sessions[tokenId]
+			if !ok {
+				return nil, nil
+			}
+			copied := *session
+			return &copied, nil
+		},
+		InsertFunc: func(ctx context.Context, tx boil.ContextTransactor, session *entities.UserSession) error {
+			session.ID = len(m.sessions) + 1
+			copied := *session
+			m.sessions[session.TokenID] = &copied
+			return nil
+		},
+		MarkUsedFunc: func(ctx context.Context, tx boil.ContextTransactor, session entities.UserSession) error {
+			m.sessions[session.TokenID].UsedAt = null.TimeFrom(time.Now())
+			return nil
+		},
+		RevokeFamilyFunc: func(ctx context.Context, tx boil.ContextTransactor, familyId string) error {
+			for _, session := range m.sessions {
+				if session.FamilyID == familyId && !session.RevokedAt.Valid {
+					session.RevokedAt = null.TimeFrom(time.Now())
+				}
+			}
+			return nil
+		},
+	}
+}
+
+// authorize stores a code the way GET /oauth/authorize does for the user after consent, when the request names the redirect uri
+func (m *memoryStore) authorize(client entities.OauthClient, userId int, redirectURI string, scopes []string, challenge string) string {
+	code, codeHash, _ := auth.GenerateOpaqueToken()
+	m.codes[codeHash] = &entities.OauthAuthorizationCode{
+		ID:                  len(m.codes) + 1,
+		CodeHash:            codeHash,
+		OauthClientID:       client.ID,
+		UserAccountID:       userId,
+		RedirectURI:         redirectURI,
+		RedirectURIExplicit: true,
+		Scopes:              types.StringArray(scopes),
+		CodeChallenge:       challenge,
+		ExpiresAt:           time.Now().Add(time.Minute),
+	}
+	return code
+}
+
+func codeChallenge(verifier string) string {
+	sum := sha256.Sum256([]byte(verifier))
+	return base64.RawURLEncoding.EncodeToString(sum[:])
+}
+
+// TestOauthFlow drives code exchange with PKCE, refresh rotation, introspection and revocation in process
+func TestOauthFlow(t *testing.T) {
+	origin := env.GetEnv()
+	require.NoError(t, env.ReadEnv(func(appEnv *env.AppEnv) {
+		appEnv.OAuth.AccessExpireMinutes = 15
+		appEnv.OAuth.RefreshExpireHours = 72
+	}))
+	t.Cleanup(func() {
+		_ = env.ReadEnv(func(appEnv *env.AppEnv) { *appEnv = origin })
+	})
+	require.NoError(t, database.SetupDatabase())
+	ctx := dbtest.SetTestTransactionCtx(context.Background())
+
+	const (
+		redirectURI   = "https://partner.example.com/callback"
+		partnerSecret = "partner-secret"
+		serviceSecret = "service-secret"
+		verifier      = "dBjftJeZ4CVP-mB92K27uhbUJU1p1r_wW1gFWFOEjXk"
+	)
+	partner := entities.OauthClient{
+		ID:           1,
+		ClientID:     "partner",
+		SecretHash:   null.StringFrom(auth.HashOpaqueToken(partnerSecret)),
+		RedirectUris: types.StringArray{redirectURI},
+		GrantTypes:   types.StringArray{constants.GrantAuthorizationCode, constants.GrantRefreshToken},
+		Scopes:       types.StringArray{"users:read", "users:write"},
+	}
+	batch := entities.OauthClient{
+		ID:         2,
+		ClientID:   "batch",
+		SecretHash: null.StringFrom(auth.HashOpaqueToken(serviceSecret)),
+		GrantTypes: types.StringArray{constants.GrantClientCredentials},
+		Scopes:     types.StringArray{"users:read"},
+	}
+
+	store := newMemoryStore()
+	svc := service{
+		repo: &repomock.UserAccountRepoMock{
+			GetActiveUserAccountByIdFunc: func(ctx context.Context, tx boil.ContextTransactor, userId int) (*entities.UserAccount, error) {
+				return &entities.UserAccount{ID: userId}, nil
+			},
+		},
+		clientRepo:  newClientMock(partner, batch),
+		codeRepo:    store.codeRepo(),
+		sessionRepo: store.sessionRepo(),
+		roleRepo: &repomock.RoleRepoMock{
+			GetPermissionNamesByUserAccountIdFunc: func(ctx context.Context, tx boil.ContextTransactor, userAccountId int) ([]string, error) {
+				// the user lost users:write after authorizing, the access token must not carry it
+				return []string{"users:read", "roles:write"}, nil
+			},
+		},
+		membershipRepo: &repomock.MembershipRepoMock{
+			GetCurrentMembershipByUserAccountIdFunc: func(ctx context.Context, tx boil.ContextTransactor, userAccountId int) (*entities.Membership, error) {
+				return &entities.Membership{Name: "gold"}, nil
+			},
+		},
+		jwtHandler: auth.NewJwtHandler(),
+	}
+	introspect := func(token string) *dtos.OauthIntrospectResponse {
+		resp, err := svc.Introspect(ctx, TokenHintRequest{Token: token, ClientId: "partner", ClientSecret: partnerSecret})
+		require.NoError(t, err)
+		return resp
+	}
+
+	code := store.authorize(partner, 1, redirectURI, partner.Scopes, codeChallenge(verifier))
+	exchange := TokenRequest{
+		GrantType:    constants.GrantAuthorizationCode,
+		ClientId:     "partner",
+		ClientSecret: partnerSecret,
+		Code:         &code,
+		CodeVerifier: ptr(verifier),
+		RedirectUri:  ptr(redirectURI),
+	}
+
+	{ // the verifier of another challenge does not redeem the code
+		req := exchange
+		req.CodeVerifier = ptr("wrong-verifier-wrong-verifier-wrong-verifier")
+		resp, err := svc.Token(ctx, req)
+		require.ErrorIs(t, err, oauth.ErrInvalidGrant)
+		require.Nil(t, resp)
+	}
+	{ // another redirect uri does not redeem the code
+		req := exchange
+		req.RedirectUri = ptr("https://partner.example.com/other")
+		resp, err := svc.Token(ctx, req)
+		require.ErrorIs(t, err, oauth.ErrInvalidGrant)
+		require.Nil(t, resp)
+	}
+	{ // the redirect uri named by the authorize request is required
+		req := exchange
+		req.RedirectUri = nil
+		resp, err := svc.Token(ctx, req)
+		require.ErrorIs(t, err, oauth.ErrInvalidRequest)
+		require.Nil(t, resp)
+	}
+
+	// exchange the code
+	first, err := svc.Token(ctx, exchange)
+	require.NoError(t, err)
+	require.Equal(t, "Bearer", first.TokenType)
+	require.Equal(t, "users:read", first.Scope)
+	require.NotNil(t, first.RefreshToken)
+
+	var accessClaims auth.CustomClaims[auth.AccessMetaData]
+	require.NoError(t, auth.NewJwtHandler().ParseToken(first.AccessToken, &accessClaims))
+	require.Equal(t, "1", accessClaims.Subject)
+	require.Equal(t, "partner", accessClaims.MetaData.ClientID)
+	require.Equal(t, []string{"users:read"}, accessClaims.MetaData.Permissions)
+	require.Empty(t, accessClaims.MetaData.Roles)
+	require.Equal(t, "gold", accessClaims.MetaData.Membership)
+
+	{ // introspect the access token
+		resp := introspect(first.AccessToken)
+		require.True(t, resp.Active)
+		require.Equal(t, "partner", *resp.ClientId)
+		require.Equal(t, "1", *resp.Sub)
+		require.Equal(t, "users:read", *resp.Scope)
+		require.Equal(t, dtos.OauthIntrospectResponseTokenTypeAccessToken, *resp.TokenType)
+	}
+	{ // the refresh token keeps the authorized scopes
+		resp := introspect(*first.RefreshToken)
+		require.True(t, resp.Active)
+		require.Equal(t, "users:read users:write", *resp.Scope)
+		require.Equal(t, dtos.OauthIntrospectResponseTokenTypeRefreshToken, *resp.TokenType)
+	}
+	{ // public clients may not introspect, and garbage is inactive
+		resp, err := svc.Introspect(ctx, TokenHintRequest{Token: first.AccessToken, ClientId: "partner"})
+		require.ErrorIs(t, err, oauth.ErrInvalidClient)
+		require.Nil(t, resp)
+		require.False(t, introspect("garbage").Active)
+	}
+
+	// refresh, narrowing the scope of the new access token
+	refresh := TokenRequest{
+		GrantType:    constants.GrantRefreshToken,
+		ClientId:     "partner",
+		ClientSecret: partnerSecret,
+		RefreshToken: first.RefreshToken,
+	}
+	{ // a scope which was not authorized
+		req := refresh
+		req.Scope = ptr("roles:write")
+		resp, err := svc.Token(ctx, req)
+		require.ErrorIs(t, err, oauth.ErrInvalidScope)
+		require.Nil(t, resp)
+	}
+	{ // a client without the refresh_token grant
+		req := refresh
+		req.ClientId = "batch"
+		req.ClientSecret = serviceSecret
+		resp, err := svc.Token(ctx, req)
+		require.ErrorIs(t, err, oauth.ErrUnauthorizedClient)
+		require.Nil(t, resp)
+	}
+	req := refresh
+	req.Scope = ptr("users:read")
+	second, err := svc.Token(ctx, req)
+	require.NoError(t, err)
+	require.NotNil(t, second.RefreshToken)
+	require.NotEqual(t, *first.RefreshToken, *second.RefreshToken)
+	require.False(t, introspect(*first.RefreshToken).Active)
+	require.True(t, introspect(*second.RefreshToken).Active)
+
+	// revoke the access token of the first exchange
+	require.NoError(t, svc.Revoke(ctx, TokenHintRequest{Token: first.AccessToken, ClientId: "partner", ClientSecret: partnerSecret}))
+	require.False(t, introspect(first.AccessToken).Active)
+	require.True(t, introspect(second.AccessToken).Active)
+
+	// a reused refresh token revokes the family
+	resp, err := svc.Token(ctx, refresh)
+	require.ErrorIs(t, err, oauth.ErrInvalidGrant)
+	require.Nil(t, resp)
+	require.False(t, introspect(*second.RefreshToken).Active)
+
+	// a replayed code revokes the tokens issued for it
+	code = store.authorize(partner, 1, redirectURI, []string{"users:read"}, codeChallenge(verifier))
+	exchange.Code = &code
+	third, err := svc.Token(ctx, exchange)
+	require.NoError(t, err)
+	require.True(t, introspect(*third.RefreshToken).Active)
+	resp, err = svc.Token(ctx, exchange)
+	require.ErrorIs(t, err, oauth.ErrInvalidGrant)
+	require.Nil(t, resp)
+	require.False(t, introspect(*third.RefreshToken).Active)
+
+	{ // revoking the refresh token ends the session, unknown tokens are not an error
+		code = store.authorize(partner, 1, redirectURI, []string{"users:read"}, codeChallenge(verifier))
+		exchange.Code = &code
+		fourth, err := svc.Token(ctx, exchange)
+		require.NoError(t, err)
+		require.NoError(t, svc.Revoke(ctx, TokenHintRequest{Token: *fourth.RefreshToken, ClientId: "partner", ClientSecret: partnerSecret}))
+		require.False(t, introspect(*fourth.RefreshToken).Active)
+		require.NoError(t, svc.Revoke(ctx, TokenHintRequest{Token: "garbage", ClientId: "partner", ClientSecret: partnerSecret}))
+	}
+	{ // a code authorized without redirect uri, for the single uri of the client, is redeemed without it
+		code = store.authorize(partner, 1, redirectURI, []string{"users:read"}, codeChallenge(verifier))
+		store.codes[auth.HashOpaqueToken(code)].RedirectURIExplicit = false
+		req := exchange
+		req.Code = &code
+		req.RedirectUri = nil
+		fifth, err := svc.Token(ctx, req)
+		require.NoError(t, err)
+		require.True(t, introspect(fifth.AccessToken).Active)
+	}
+	{ // a redirect uri sent anyway still has to match
+		code = store.authorize(partner, 1, redirectURI, []string{"users:read"}, codeChallenge(verifier))
+		store.codes[auth.HashOpaqueToken(code)].RedirectURIExplicit = false
+		req := exchange
+		req.Code = &code
+		req.RedirectUri = ptr("https://partner.example.com/other")
+		resp, err := svc.Token(ctx, req)
+		require.ErrorIs(t, err, oauth.ErrInvalidGrant)
+		require.Nil(t, resp)
+	}
+
+	// client credentials act as the client, without refresh token
+	batchToken, err := svc.Token(ctx, TokenRequest{
+		GrantType:    constants.GrantClientCredentials,
+		ClientId:     "batch",
+		ClientSecret: serviceSecret,
+	})
+	require.NoError(t, err)
+	require.Nil(t, batchToken.RefreshToken)
+	require.Equal(t, "users:read", batchToken.Scope)
+	{
+		resp := introspect(batchToken.AccessToken)
+		require.True(t, resp.Active)
+		require.Equal(t, "batch", *resp.Sub)
+		require.Equal(t, "batch", *resp.ClientId)
+	}
+	{ // another client does not revoke the token of the batch client
+		require.NoError(t, svc.Revoke(ctx, TokenHintRequest{Token: batchToken.AccessToken, ClientId: "partner", ClientSecret: partnerSecret}))
+		require.True(t, introspect(batchToken.AccessToken).Active)
+	}
+}
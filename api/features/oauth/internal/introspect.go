@@ -0,0 +1,125 @@
+package internal
+
+import (
+	"context"
+	"mysite/dtos"
+	"mysite/entities"
+	"mysite/pkgs/auth"
+	"mysite/pkgs/database"
+	"mysite/pkgs/oauth"
+	"time"
+
+	"github.com/pkg/errors"
+	"github.com/volatiletech/sqlboiler/v4/boil"
+)
+
+// Introspect answers RFC 7662 for confidential clients, a token which is not active only gets active false.
+// The token_type_hint is not needed, both token types are told apart by their key type
+func (s service) Introspect(ctx context.Context, req TokenHintRequest) (*dtos.OauthIntrospectResponse, error) {
+	resp := dtos.OauthIntrospectResponse{Active: false}
+	if err := database.NewBoilerTransaction(ctx, func(ctx context.Context, tx boil.ContextTransactor) error {
+		client, err := s.authenticateClient(ctx, tx, req.ClientId, req.ClientSecret)
+		if err != nil {
+			return err
+		}
+		if !oauth.IsConfidential(*client) {
+			return oauth.ErrUnauthorizedClient.WithDescription("only confidential clients may introspect")
+		}
+
+		var claims auth.CustomClaims[auth.AccessMetaData]
+		if err := s.jwtHandler.ParseToken(req.Token, &claims); err != nil {
+			return nil
+		}
+
+		switch claims.GetKeyType() {
+		case auth.AccessKey:
+			resp = newIntrospectResponse(claims, dtos.OauthIntrospectResponseTokenTypeAccessToken, claims.MetaData.Permissions)
+			if claims.MetaData.ClientID != "" {
+				resp.ClientId = &claims.MetaData.ClientID
+			}
+		case auth.RefreshKey:
+			session, err := s.sessionRepo.GetUserSessionByTokenId(ctx, tx, claims.ID)
+			if err != nil {
+				return errors.Wrap(err, "failed get userSession")
+			}
+			if !activeSession(session) {
+				return nil
+			}
+			resp = newIntrospectResponse(claims, dtos.OauthIntrospectResponseTokenTypeRefreshToken, session.Scopes)
+			if session.OauthClientID.Valid {
+				sessionClient, err := s.clientRepo.GetOauthClientById(ctx, tx, session.OauthClientID.Int)
+				if err != nil {
+					return errors.Wrap(err, "failed get oauthClient")
+				}
+				if sessionClient != nil {
+					resp.ClientId = &sessionClient.ClientID
+				}
+			}
+		}
+		return nil
+	}); err != nil {
+		return nil, errors.Wrap(err, "failed introspect token")
+	}
+
+	return &resp, nil
+}
+
+// Revoke answers RFC 7009, a client only revokes its own tokens and an unknown token is not an error.
+// Revoking a refresh token revokes its whole family, the access tokens expire on their own
+func (s service) Revoke(ctx context.Context, req TokenHintRequest) error {
+	return database.NewBoilerTransaction(ctx, func(ctx context.Context, tx boil.ContextTransactor) error {
+		client, err := s.authenticateClient(ctx, tx, req.ClientId, req.ClientSecret)
+		if err != nil {
+			return err
+		}
+
+		var claims auth.CustomClaims[auth.AccessMetaData]
+		if err := s.jwtHandler.ParseToken(req.Token, &claims); err != nil {
+			return nil
+		}
+
+		switch claims.GetKeyType() {
+		case auth.AccessKey:
+			if claims.MetaData.ClientID == client.ClientID && claims.ExpiresAt != nil {
+				s.jwtHandler.RevokeToken(claims.ID, claims.ExpiresAt.Time)
+			}
+		case auth.RefreshKey:
+			session, err := s.sessionRepo.GetUserSessionByTokenId(ctx, tx, claims.ID)
+			if err != nil {
+				return errors.Wrap(err, "failed get userSession")
+			}
+			if session == nil || !session.OauthClientID.Valid || session.OauthClientID.Int != client.ID {
+				return nil
+			}
+			if err := s.sessionRepo.RevokeFamily(ctx, tx, session.FamilyID); err != nil {
+				return errors.Wrap(err, "failed revoke userSession family")
+			}
+		}
+		return nil
+	})
+}
+
+func activeSession(session *entities.UserSession) bool {
+	return session != nil && !session.RevokedAt.Valid && !session.UsedAt.Valid && session.ExpiresAt.After(time.Now())
+}
+
+func newIntrospectResponse(claims auth.CustomClaims[auth.AccessMetaData], tokenType dtos.OauthIntrospectResponseTokenType, scopes []string) dtos.OauthIntrospectResponse {
+	scope := oauth.FormatScope(scopes)
+	resp := dtos.OauthIntrospectResponse{
+		Active:    true,
+		Scope:     &scope,
+		Sub:       &claims.Subject,
+		TokenType: &tokenType,
+		Iss:       &claims.Issuer,
+		Jti:       &claims.ID,
+	}
+	if claims.ExpiresAt != nil {
+		exp := int(claims.ExpiresAt.Unix())
+		resp.Exp = &exp
+	}
+	if claims.IssuedAt != nil {
+		iat := int(claims.IssuedAt.Unix())
+		resp.Iat = &iat
+	}
+	return resp
+}
@@ -0,0 +1,100 @@
+package internal
+
+import (
+	"context"
+	"mysite/dtos"
+	"mysite/entities"
+	"mysite/pkgs/auth"
+	"mysite/pkgs/oauth"
+	"mysite/repositories/membershiprepo"
+	"mysite/repositories/oauthclientrepo"
+	"mysite/repositories/oauthcoderepo"
+	"mysite/repositories/rolerepo"
+	"mysite/repositories/useraccountrepo"
+	"mysite/repositories/usersessionrepo"
+
+	"github.com/mitchellh/mapstructure"
+	"github.com/pkg/errors"
+	"github.com/volatiletech/sqlboiler/v4/boil"
+)
+
+type service struct {
+	repo           useraccountrepo.UserAccountRepo
+	clientRepo     oauthclientrepo.OauthClientRepo
+	codeRepo       oauthcoderepo.OauthCodeRepo
+	sessionRepo    usersessionrepo.UserSessionRepo
+	roleRepo       rolerepo.RoleRepo
+	membershipRepo membershiprepo.MembershipRepo
+	jwtHandler     auth.JwtHandler
+}
+
+// TokenRequest the form of the token endpoint, the client credentials are set by the handler from HTTP Basic or the form
+type TokenRequest struct {
+	GrantType    string
+	ClientId     string `mapstructure:"-"`
+	ClientSecret string `mapstructure:"-"`
+	Code         *string
+	CodeVerifier *string
+	RedirectUri  *string
+	RefreshToken *string
+	Scope        *string
+}
+
+// TokenHintRequest the form of the introspection and revocation endpoints
+type TokenHintRequest struct {
+	Token         string
+	TokenTypeHint *string
+	ClientId      string `mapstructure:"-"`
+	ClientSecret  string `mapstructure:"-"`
+}
+
+func NewService() service {
+	return service{
+		repo:           useraccountrepo.NewRepo(),
+		clientRepo:     oauthclientrepo.NewRepo(),
+		codeRepo:       oauthcoderepo.NewRepo(),
+		sessionRepo:    usersessionrepo.NewRepo(),
+		roleRepo:       rolerepo.NewRepo(),
+		membershipRepo: membershiprepo.NewRepo(),
+		jwtHandler:     auth.NewJwtHandler(),
+	}
+}
+
+func NewTokenParams(req dtos.OauthTokenFormdataRequestBody) (*TokenRequest, error) {
+	var result TokenRequest
+	if err := mapstructure.Decode(req, &result); err != nil {
+		return nil, errors.Wrap(err, "failed decode")
+	}
+
+	return &result, nil
+}
+
+func NewTokenHintParams(req dtos.OauthTokenHintRequest) (*TokenHintRequest, error) {
+	var result TokenHintRequest
+	if err := mapstructure.Decode(req, &result); err != nil {
+		return nil, errors.Wrap(err, "failed decode")
+	}
+
+	return &result, nil
+}
+
+// authenticateClient confidential clients must send their secret, public clients must not send one
+func (s service) authenticateClient(ctx context.Context, tx boil.ContextTransactor, clientId string, clientSecret string) (*entities.OauthClient, error) {
+	if clientId == "" {
+		return nil, oauth.ErrInvalidClient.WithDescription("missing client_id")
+	}
+
+	client, err := s.clientRepo.GetOauthClientByClientId(ctx, tx, clientId)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed get oauthClient")
+	}
+	switch {
+	case client == nil:
+		return nil, oauth.ErrInvalidClient.WithDescription("unknown client")
+	case oauth.IsConfidential(*client) && !oauth.VerifyClientSecret(*client, clientSecret):
+		return nil, oauth.ErrInvalidClient.WithDescription("invalid client_secret")
+	case !oauth.IsConfidential(*client) && clientSecret != "":
+		return nil, oauth.ErrInvalidClient.WithDescription("public clients have no client_secret")
+	}
+	return client, nil
+}
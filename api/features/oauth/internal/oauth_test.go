@@ -0,0 +1,125 @@
+package internal
+
+import (
+	"context"
+	"fmt"
+	"mysite/entities"
+	"mysite/pkgs/auth"
+	"mysite/pkgs/database"
+	"mysite/pkgs/oauth"
+	"mysite/testing/dbtest"
+	"mysite/testing/mocking/repomock"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"github.com/volatiletech/null/v8"
+	"github.com/volatiletech/sqlboiler/v4/boil"
+	"github.com/volatiletech/sqlboiler/v4/types"
+)
+
+func TestMain(m *testing.M) {
+	pool, resource, err := dbtest.SetupDatabaseForTesting()
+	if err != nil {
+		return
+	}
+
+	defer func() {
+		database.Close()
+		if err := dbtest.PurgeResource(pool, resource); err != nil {
+			fmt.Println("failed to purge resource")
+		}
+	}()
+	m.Run()
+}
+
+func newClientMock(clients ...entities.OauthClient) *repomock.OauthClientRepoMock {
+	return &repomock.OauthClientRepoMock{
+		GetOauthClientByClientIdFunc: func(ctx context.Context, tx boil.ContextTransactor, clientId string) (*entities.OauthClient, error) {
+			for _, client := range clients {
+				if client.ClientID == clientId {
+					return &client, nil
+				}
+			}
+			return nil, nil
+		},
+		GetOauthClientByIdFunc: func(ctx context.Context, tx boil.ContextTransactor, oauthClientId int) (*entities.OauthClient, error) {
+			for _, client := range clients {
+				if client.ID == oauthClientId {
+					return &client, nil
+				}
+			}
+			return nil, nil
+		},
+	}
+}
+
+func TestAuthenticateClient(t *testing.T) {
+	t.Parallel()
+	require.NoError(t, database.SetupDatabase())
+	ctx := dbtest.SetTestTransactionCtx(context.Background())
+
+	confidential := entities.OauthClient{ID: 1, ClientID: "confidential", SecretHash: null.StringFrom(auth.HashOpaqueToken("secret"))}
+	public := entities.OauthClient{ID: 2, ClientID: "public"}
+	svc := service{clientRepo: newClientMock(confidential, public)}
+
+	tests := []struct {
+		name         string
+		clientId     string
+		clientSecret string
+		ok           bool
+	}{
+		{name: "confidential client", clientId: "confidential", clientSecret: "secret", ok: true},
+		{name: "public client", clientId: "public", ok: true},
+		{name: "missing client_id", clientSecret: "secret"},
+		{name: "unknown client", clientId: "unknown", clientSecret: "secret"},
+		{name: "wrong secret", clientId: "confidential", clientSecret: "other"},
+		{name: "confidential client without secret", clientId: "confidential"},
+		{name: "public client with secret", clientId: "public", clientSecret: "secret"},
+	}
+	for _, tt := range tests {
+		client, err := svc.authenticateClient(ctx, nil, tt.clientId, tt.clientSecret)
+		if tt.ok {
+			require.NoError(t, err, tt.name)
+			require.Equal(t, tt.clientId, client.ClientID, tt.name)
+			continue
+		}
+		require.ErrorIs(t, err, oauth.ErrInvalidClient, tt.name)
+		require.Nil(t, client, tt.name)
+	}
+}
+
+func TestTokenRejected(t *testing.T) {
+	t.Parallel()
+	require.NoError(t, database.SetupDatabase())
+	ctx := dbtest.SetTestTransactionCtx(context.Background())
+
+	public := entities.OauthClient{
+		ID:           1,
+		ClientID:     "public",
+		RedirectUris: types.StringArray{"https://example.com/callback"},
+		GrantTypes:   types.StringArray{"authorization_code"},
+		Scopes:       types.StringArray{"users:read"},
+	}
+	svc := service{clientRepo: newClientMock(public), jwtHandler: auth.NewJwtHandler()}
+
+	tests := []struct {
+		name string
+		req  TokenRequest
+		err  error
+	}{
+		{name: "missing grant_type", req: TokenRequest{ClientId: "public"}, err: oauth.ErrInvalidRequest},
+		{name: "unsupported grant_type", req: TokenRequest{GrantType: "password", ClientId: "public"}, err: oauth.ErrUnsupportedGrantType},
+		{name: "grant the client is not registered for", req: TokenRequest{GrantType: "refresh_token", ClientId: "public"}, err: oauth.ErrUnauthorizedClient},
+		{name: "client_credentials of a public client", req: TokenRequest{GrantType: "client_credentials", ClientId: "public"}, err: oauth.ErrUnauthorizedClient},
+		{name: "code without verifier", req: TokenRequest{GrantType: "authorization_code", ClientId: "public", Code: ptr("code"), RedirectUri: ptr("https://example.com/callback")}, err: oauth.ErrInvalidRequest},
+	}
+	for _, tt := range tests {
+		resp, err := svc.Token(ctx, tt.req)
+		require.ErrorIs(t, err, tt.err, tt.name)
+		require.Nil(t, resp, tt.name)
+	}
+}
+
+func ptr(s string) *string {
+	return &s
+}
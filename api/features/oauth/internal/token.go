@@ -0,0 +1,266 @@
+package internal
+
+import (
+	"context"
+	"mysite/constants"
+	"mysite/dtos"
+	"mysite/entities"
+	"mysite/pkgs/auth"
+	"mysite/pkgs/database"
+	"mysite/pkgs/env"
+	"mysite/pkgs/oauth"
+	"slices"
+	"strconv"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/pkg/errors"
+	"github.com/volatiletech/null/v8"
+	"github.com/volatiletech/sqlboiler/v4/boil"
+	"github.com/volatiletech/sqlboiler/v4/types"
+)
+
+// Token issues tokens for the grant of the request.
+// A rejected request still commits, so that the family revoked on a reused code or refresh token stays revoked
+func (s service) Token(ctx context.Context, req TokenRequest) (*dtos.OauthTokenResponse, error) {
+	if req.GrantType == "" {
+		return nil, oauth.ErrInvalidRequest.WithDescription("missing grant_type")
+	}
+
+	var (
+		resp     *dtos.OauthTokenResponse
+		rejected error
+	)
+	if err := database.NewBoilerTransaction(ctx, func(ctx context.Context, tx boil.ContextTransactor) error {
+		client, err := s.authenticateClient(ctx, tx, req.ClientId, req.ClientSecret)
+		if err == nil {
+			switch req.GrantType {
+			case constants.GrantAuthorizationCode:
+				resp, err = s.exchangeCode(ctx, tx, *client, req)
+			case constants.GrantRefreshToken:
+				resp, err = s.refreshToken(ctx, tx, *client, req)
+			case constants.GrantClientCredentials:
+				resp, err = s.clientCredentials(*client, req)
+			default:
+				err = oauth.ErrUnsupportedGrantType
+			}
+		}
+
+		var oauthErr oauth.Error
+		if errors.As(err, &oauthErr) {
+			rejected = err
+			return nil
+		}
+		return err
+	}); err != nil {
+		return nil, errors.Wrap(err, "failed issue token")
+	}
+	if rejected != nil {
+		return nil, rejected
+	}
+
+	return resp, nil
+}
+
+// exchangeCode the authorization_code grant, the code is redeemed once and only with the verifier of its challenge
+func (s service) exchangeCode(ctx context.Context, tx boil.ContextTransactor, client entities.OauthClient, req TokenRequest) (*dtos.OauthTokenResponse, error) {
+	if !oauth.AllowsGrant(client, constants.GrantAuthorizationCode) {
+		return nil, oauth.ErrUnauthorizedClient.WithDescription("client is not registered for authorization_code")
+	}
+	if req.Code == nil || req.CodeVerifier == nil {
+		return nil, oauth.ErrInvalidRequest.WithDescription("code and code_verifier are required")
+	}
+
+	code, err := s.codeRepo.GetOauthAuthorizationCodeByHash(ctx, tx, auth.HashOpaqueToken(*req.Code))
+	if err != nil {
+		return nil, errors.Wrap(err, "failed get oauthAuthorizationCode")
+	}
+	switch {
+	case code == nil, code.OauthClientID != client.ID:
+		return nil, oauth.ErrInvalidGrant.WithDescription("unknown code")
+	case code.UsedAt.Valid:
+		// RFC 6749 4.1.2, the tokens issued for a code which is used twice are revoked
+		if code.FamilyID.Valid {
+			if err := s.sessionRepo.RevokeFamily(ctx, tx, code.FamilyID.String); err != nil {
+				return nil, errors.Wrap(err, "failed revoke userSession family")
+			}
+		}
+		return nil, oauth.ErrInvalidGrant.WithDescription("code already used")
+	case !code.ExpiresAt.After(time.Now()):
+		return nil, oauth.ErrInvalidGrant.WithDescription("code expired")
+	case req.RedirectUri == nil && code.RedirectURIExplicit:
+		// RFC 6749 4.1.3, required when the authorize request included it
+		return nil, oauth.ErrInvalidRequest.WithDescription("missing redirect_uri")
+	case req.RedirectUri != nil && code.RedirectURI != *req.RedirectUri:
+		return nil, oauth.ErrInvalidGrant.WithDescription("redirect_uri does not match")
+	case !oauth.VerifyCodeChallenge(*req.CodeVerifier, code.CodeChallenge):
+		return nil, oauth.ErrInvalidGrant.WithDescription("code_verifier does not match")
+	}
+
+	familyId := uuid.NewString()
+	marked, err := s.codeRepo.MarkUsed(ctx, tx, code.ID, familyId)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed mark oauthAuthorizationCode used")
+	}
+	if !marked {
+		return nil, oauth.ErrInvalidGrant.WithDescription("code already used")
+	}
+
+	return s.issueUserTokens(ctx, tx, client, code.UserAccountID, code.Scopes, code.Scopes, familyId)
+}
+
+// refreshToken the refresh_token grant, rotating the session like the first-party refresh does
+func (s service) refreshToken(ctx context.Context, tx boil.ContextTransactor, client entities.OauthClient, req TokenRequest) (*dtos.OauthTokenResponse, error) {
+	if !oauth.AllowsGrant(client, constants.GrantRefreshToken) {
+		return nil, oauth.ErrUnauthorizedClient.WithDescription("client is not registered for refresh_token")
+	}
+	if req.RefreshToken == nil {
+		return nil, oauth.ErrInvalidRequest.WithDescription("missing refresh_token")
+	}
+
+	var claims auth.CustomClaims[any]
+	if err := s.jwtHandler.ParseToken(*req.RefreshToken, &claims); err != nil || claims.GetKeyType() != auth.RefreshKey {
+		return nil, oauth.ErrInvalidGrant.WithDescription("invalid refresh_token")
+	}
+
+	session, err := s.sessionRepo.GetUserSessionByTokenId(ctx, tx, claims.ID)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed get userSession")
+	}
+	switch {
+	case session == nil, !session.OauthClientID.Valid, session.OauthClientID.Int != client.ID, strconv.Itoa(session.UserAccountID) != claims.Subject:
+		return nil, oauth.ErrInvalidGrant.WithDescription("unknown refresh_token")
+	case session.RevokedAt.Valid:
+		return nil, oauth.ErrInvalidGrant.WithDescription("refresh_token revoked")
+	case session.UsedAt.Valid:
+		if err := s.sessionRepo.RevokeFamily(ctx, tx, session.FamilyID); err != nil {
+			return nil, errors.Wrap(err, "failed revoke userSession family")
+		}
+		return nil, oauth.ErrInvalidGrant.WithDescription("refresh_token reused, session revoked")
+	}
+
+	// a narrower scope only applies to the new access token, the session keeps the scopes of the authorization
+	accessScopes, ok := oauth.ResolveScopes(requestedScopes(req.Scope), session.Scopes)
+	if !ok {
+		return nil, oauth.ErrInvalidScope.WithDescription("scope exceeds the authorized scopes")
+	}
+
+	if err := s.sessionRepo.MarkUsed(ctx, tx, *session); err != nil {
+		return nil, errors.Wrap(err, "failed mark userSession used")
+	}
+
+	return s.issueUserTokens(ctx, tx, client, session.UserAccountID, session.Scopes, accessScopes, session.FamilyID)
+}
+
+// clientCredentials the client_credentials grant, the token acts as the client itself and is never refreshed.
+// Its subject is the client_id, so it is meant for resource servers introspecting it, not for the user endpoints
+func (s service) clientCredentials(client entities.OauthClient, req TokenRequest) (*dtos.OauthTokenResponse, error) {
+	if !oauth.IsConfidential(client) || !oauth.AllowsGrant(client, constants.GrantClientCredentials) {
+		return nil, oauth.ErrUnauthorizedClient.WithDescription("client is not registered for client_credentials")
+	}
+
+	scopes, ok := oauth.ResolveScopes(requestedScopes(req.Scope), client.Scopes)
+	if !ok {
+		return nil, oauth.ErrInvalidScope.WithDescription("scope is not allowed for the client")
+	}
+
+	accessToken, err := s.createAccessToken(client.ClientID, auth.AccessMetaData{
+		Permissions: scopes,
+		ClientID:    client.ClientID,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return newTokenResponse(accessToken, nil, scopes), nil
+}
+
+// issueUserTokens the access token carries the scopes the user still has permission for, and no roles,
+// so that the client is limited to what was authorized. The refresh token keeps the authorized scopes
+func (s service) issueUserTokens(ctx context.Context, tx boil.ContextTransactor, client entities.OauthClient, userId int, authorizedScopes types.StringArray, accessScopes []string, familyId string) (*dtos.OauthTokenResponse, error) {
+	pgUserAccount, err := s.repo.GetActiveUserAccountById(ctx, tx, userId)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed get userAccount")
+	}
+	if pgUserAccount == nil {
+		return nil, oauth.ErrInvalidGrant.WithDescription("user is not active")
+	}
+
+	permissions, err := s.roleRepo.GetPermissionNamesByUserAccountId(ctx, tx, userId)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed get permissions")
+	}
+	metaData := auth.AccessMetaData{
+		Permissions: []string{},
+		ClientID:    client.ClientID,
+	}
+	for _, scope := range accessScopes {
+		if slices.Contains(permissions, scope) {
+			metaData.Permissions = append(metaData.Permissions, scope)
+		}
+	}
+	membership, err := s.membershipRepo.GetCurrentMembershipByUserAccountId(ctx, tx, userId)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed get membership")
+	}
+	if membership != nil {
+		metaData.Membership = membership.Name
+	}
+
+	accessToken, err := s.createAccessToken(strconv.Itoa(userId), metaData)
+	if err != nil {
+		return nil, err
+	}
+	if !oauth.AllowsGrant(client, constants.GrantRefreshToken) {
+		return newTokenResponse(accessToken, nil, metaData.Permissions), nil
+	}
+
+	refreshClaims := auth.NewCustomClaims[any]().WithExpireAt(time.Now().Add(time.Duration(env.GetEnv().OAuth.RefreshExpireHours) * time.Hour))
+	refreshClaims.Subject = strconv.Itoa(userId)
+	refreshClaims.KeyType = auth.RefreshKey
+	refreshToken, err := s.jwtHandler.WithClaims(refreshClaims).CreateToken()
+	if err != nil {
+		return nil, errors.Wrap(err, "failed create refresh token")
+	}
+
+	if err := s.sessionRepo.Insert(ctx, tx, &entities.UserSession{
+		UserAccountID: userId,
+		FamilyID:      familyId,
+		TokenID:       refreshClaims.ID,
+		ExpiresAt:     refreshClaims.ExpiresAt.Time,
+		OauthClientID: null.IntFrom(client.ID),
+		Scopes:        authorizedScopes,
+	}); err != nil {
+		return nil, errors.Wrap(err, "failed insert userSession")
+	}
+
+	return newTokenResponse(accessToken, &refreshToken, metaData.Permissions), nil
+}
+
+func (s service) createAccessToken(subject string, metaData auth.AccessMetaData) (string, error) {
+	claims := auth.NewCustomClaims[any]().WithExpireAt(time.Now().Add(time.Duration(env.GetEnv().OAuth.AccessExpireMinutes) * time.Minute))
+	claims.Subject = subject
+	claims.KeyType = auth.AccessKey
+	claims.MetaData = metaData
+	accessToken, err := s.jwtHandler.WithClaims(claims).CreateToken()
+	if err != nil {
+		return "", errors.Wrap(err, "failed create access token")
+	}
+	return accessToken, nil
+}
+
+func requestedScopes(scope *string) []string {
+	if scope == nil {
+		return nil
+	}
+	return oauth.ParseScope(*scope)
+}
+
+func newTokenResponse(accessToken string, refreshToken *string, scopes []string) *dtos.OauthTokenResponse {
+	return &dtos.OauthTokenResponse{
+		AccessToken:  accessToken,
+		TokenType:    "Bearer",
+		ExpiresIn:    env.GetEnv().OAuth.AccessExpireMinutes * 60,
+		RefreshToken: refreshToken,
+		Scope:        oauth.FormatScope(scopes),
+	}
+}
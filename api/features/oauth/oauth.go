@@ -0,0 +1,137 @@
+package oauth
+
+import (
+	"context"
+	"log/slog"
+	"mysite/dtos"
+	"mysite/features/oauth/internal"
+	"mysite/pkgs/logger"
+	"mysite/pkgs/oauth"
+	"net/http"
+
+	"github.com/go-chi/render"
+	"github.com/oapi-codegen/runtime"
+	"github.com/pkg/errors"
+)
+
+type api struct {
+}
+
+type service interface {
+	Token(ctx context.Context, req internal.TokenRequest) (*dtos.OauthTokenResponse, error)
+	Introspect(ctx context.Context, req internal.TokenHintRequest) (*dtos.OauthIntrospectResponse, error)
+	Revoke(ctx context.Context, req internal.TokenHintRequest) error
+}
+
+var newService = func() service {
+	return internal.NewService()
+}
+
+func NewHandler() *api {
+	return &api{}
+}
+
+// OauthToken errors are the json of RFC 6749 5.2 rather than httputil.ErrResponse
+func (a api) OauthToken(w http.ResponseWriter, r *http.Request) {
+	noStore(w)
+
+	var body dtos.OauthTokenFormdataRequestBody
+	if err := parseForm(r, &body); err != nil {
+		renderFailure(w, r, err)
+		return
+	}
+
+	params, err := internal.NewTokenParams(body)
+	if err != nil {
+		renderFailure(w, r, errors.Wrap(err, "failed to parse params"))
+		return
+	}
+	if params.ClientId, params.ClientSecret, err = oauth.ClientCredentials(r, body.ClientId, body.ClientSecret); err != nil {
+		renderFailure(w, r, err)
+		return
+	}
+
+	resp, err := newService().Token(r.Context(), *params)
+	if err != nil {
+		renderFailure(w, r, errors.Wrap(err, "failed to issue token"))
+		return
+	}
+
+	render.JSON(w, r, resp)
+}
+
+func (a api) OauthIntrospect(w http.ResponseWriter, r *http.Request) {
+	noStore(w)
+
+	params, err := tokenHintParams(r)
+	if err != nil {
+		renderFailure(w, r, err)
+		return
+	}
+
+	resp, err := newService().Introspect(r.Context(), *params)
+	if err != nil {
+		renderFailure(w, r, errors.Wrap(err, "failed to introspect token"))
+		return
+	}
+
+	render.JSON(w, r, resp)
+}
+
+// OauthRevoke answers 200 with no body, also for tokens which are unknown or of another client
+func (a api) OauthRevoke(w http.ResponseWriter, r *http.Request) {
+	params, err := tokenHintParams(r)
+	if err != nil {
+		renderFailure(w, r, err)
+		return
+	}
+
+	if err := newService().Revoke(r.Context(), *params); err != nil {
+		renderFailure(w, r, errors.Wrap(err, "failed to revoke token"))
+		return
+	}
+
+	w.WriteHeader(http.StatusOK)
+}
+
+func tokenHintParams(r *http.Request) (*internal.TokenHintRequest, error) {
+	var body dtos.OauthTokenHintRequest
+	if err := parseForm(r, &body); err != nil {
+		return nil, err
+	}
+	if body.Token == "" {
+		return nil, oauth.ErrInvalidRequest.WithDescription("missing token")
+	}
+
+	params, err := internal.NewTokenHintParams(body)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to parse params")
+	}
+	if params.ClientId, params.ClientSecret, err = oauth.ClientCredentials(r, body.ClientId, body.ClientSecret); err != nil {
+		return nil, err
+	}
+	return params, nil
+}
+
+// parseForm binds the urlencoded body, the query is not read as RFC 6749 sends credentials in the body only
+func parseForm(r *http.Request, body any) error {
+	if err := r.ParseForm(); err != nil {
+		return oauth.ErrInvalidRequest.WithDescription("malformed form body")
+	}
+	if err := runtime.BindForm(body, r.PostForm, nil, nil); err != nil {
+		return oauth.ErrInvalidRequest.WithDescription("malformed form body")
+	}
+	return nil
+}
+
+// noStore responses with tokens must not be cached, RFC 6749 5.1
+func noStore(w http.ResponseWriter) {
+	w.Header().Set("Cache-Control", "no-store")
+	w.Header().Set("Pragma", "no-cache")
+}
+
+func renderFailure(w http.ResponseWriter, r *http.Request, err error) {
+	if err := render.Render(w, r, oauth.NewFailureRender(err)); err != nil {
+		slog.Error("failed to render", logger.AttrError(err))
+	}
+}
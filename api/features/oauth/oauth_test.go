@@ -0,0 +1,229 @@
+package oauth
+
+import (
+	"context"
+	"encoding/json"
+	"mysite/dtos"
+	"mysite/features/oauth/internal"
+	"mysite/pkgs/oauth"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"strings"
+	"testing"
+
+	"github.com/go-chi/chi/v5"
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type mockService struct {
+	TokenFunc      func(req internal.TokenRequest) (*dtos.OauthTokenResponse, error)
+	IntrospectFunc func(req internal.TokenHintRequest) (*dtos.OauthIntrospectResponse, error)
+	RevokeFunc     func(req internal.TokenHintRequest) error
+}
+
+func (m mockService) Token(ctx context.Context, req internal.TokenRequest) (*dtos.OauthTokenResponse, error) {
+	return m.TokenFunc(req)
+}
+
+func (m mockService) Introspect(ctx context.Context, req internal.TokenHintRequest) (*dtos.OauthIntrospectResponse, error) {
+	return m.IntrospectFunc(req)
+}
+
+func (m mockService) Revoke(ctx context.Context, req internal.TokenHintRequest) error {
+	return m.RevokeFunc(req)
+}
+
+func newTestRouter() *chi.Mux {
+	router := chi.NewRouter()
+	router.Route("/api/v1", func(subr chi.Router) {
+		HandlerFromMux(NewHandler(), subr)
+	})
+	return router
+}
+
+func newFormRequest(path string, form url.Values) (*http.Request, error) {
+	r, err := http.NewRequest(http.MethodPost, "http://example.com/api/v1"+path, strings.NewReader(form.Encode()))
+	if err != nil {
+		return nil, err
+	}
+	r.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+	return r, nil
+}
+
+func decodeError(t *testing.T, w *httptest.ResponseRecorder) oauth.Error {
+	var resp oauth.Error
+	require.NoError(t, json.NewDecoder(w.Body).Decode(&resp))
+	return resp
+}
+
+func TestOauth(t *testing.T) {
+	t.Parallel()
+	router := newTestRouter()
+
+	tests := []struct {
+		name       string
+		req        func(context.Context) (*http.Request, error)
+		assert     func(*httptest.ResponseRecorder, *http.Request)
+		newService func() service
+	}{
+		{
+			name: "400 - token missing grant_type",
+			req: func(ctx context.Context) (*http.Request, error) {
+				return newFormRequest("/oauth/token", url.Values{"client_id": {"client"}})
+			},
+			assert: func(w *httptest.ResponseRecorder, r *http.Request) {
+				assert.Equal(t, http.StatusBadRequest, w.Result().StatusCode)
+				assert.Equal(t, "invalid_request", decodeError(t, w).Code)
+			},
+			newService: func() service {
+				return mockService{TokenFunc: func(req internal.TokenRequest) (*dtos.OauthTokenResponse, error) {
+					return nil, oauth.ErrInvalidRequest.WithDescription("missing grant_type")
+				}}
+			},
+		},
+		{
+			name: "400 - token with basic and body secret",
+			req: func(ctx context.Context) (*http.Request, error) {
+				r, err := newFormRequest("/oauth/token", url.Values{"grant_type": {"client_credentials"}, "client_secret": {"secret"}})
+				if err != nil {
+					return nil, err
+				}
+				r.SetBasicAuth("client", "secret")
+				return r, nil
+			},
+			assert: func(w *httptest.ResponseRecorder, r *http.Request) {
+				assert.Equal(t, http.StatusBadRequest, w.Result().StatusCode)
+				assert.Equal(t, "invalid_request", decodeError(t, w).Code)
+			},
+		},
+		{
+			name: "401 - token invalid client",
+			req: func(ctx context.Context) (*http.Request, error) {
+				return newFormRequest("/oauth/token", url.Values{"grant_type": {"client_credentials"}, "client_id": {"unknown"}})
+			},
+			assert: func(w *httptest.ResponseRecorder, r *http.Request) {
+				assert.Equal(t, http.StatusUnauthorized, w.Result().StatusCode)
+				assert.NotEmpty(t, w.Result().Header.Get("WWW-Authenticate"))
+				assert.Equal(t, "invalid_client", decodeError(t, w).Code)
+			},
+			newService: func() service {
+				return mockService{TokenFunc: func(req internal.TokenRequest) (*dtos.OauthTokenResponse, error) {
+					return nil, errors.Wrap(oauth.ErrInvalidClient, "unknown client")
+				}}
+			},
+		},
+		{
+			name: "500 - token server error hides the cause",
+			req: func(ctx context.Context) (*http.Request, error) {
+				return newFormRequest("/oauth/token", url.Values{"grant_type": {"client_credentials"}, "client_id": {"client"}})
+			},
+			assert: func(w *httptest.ResponseRecorder, r *http.Request) {
+				assert.Equal(t, http.StatusInternalServerError, w.Result().StatusCode)
+				resp := decodeError(t, w)
+				assert.Equal(t, "server_error", resp.Code)
+				assert.Empty(t, resp.Description)
+			},
+			newService: func() service {
+				return mockService{TokenFunc: func(req internal.TokenRequest) (*dtos.OauthTokenResponse, error) {
+					return nil, errors.New("connection refused")
+				}}
+			},
+		},
+		{
+			name: "200 - token success with basic credentials",
+			req: func(ctx context.Context) (*http.Request, error) {
+				r, err := newFormRequest("/oauth/token", url.Values{"grant_type": {"authorization_code"}, "code": {"code"}, "code_verifier": {"verifier"}})
+				if err != nil {
+					return nil, err
+				}
+				r.SetBasicAuth("client", "se%2Bcret")
+				return r, nil
+			},
+			assert: func(w *httptest.ResponseRecorder, r *http.Request) {
+				assert.Equal(t, http.StatusOK, w.Result().StatusCode)
+				assert.Equal(t, "no-store", w.Result().Header.Get("Cache-Control"))
+
+				var resp dtos.OauthTokenResponse
+				require.NoError(t, json.NewDecoder(w.Body).Decode(&resp))
+				assert.Equal(t, "access", resp.AccessToken)
+				assert.Equal(t, "Bearer", resp.TokenType)
+			},
+			newService: func() service {
+				return mockService{TokenFunc: func(req internal.TokenRequest) (*dtos.OauthTokenResponse, error) {
+					if req.GrantType != "authorization_code" || req.ClientId != "client" || req.ClientSecret != "se+cret" || *req.Code != "code" || *req.CodeVerifier != "verifier" {
+						return nil, oauth.ErrInvalidRequest
+					}
+					return &dtos.OauthTokenResponse{AccessToken: "access", TokenType: "Bearer", ExpiresIn: 900}, nil
+				}}
+			},
+		},
+		{
+			name: "400 - introspect missing token",
+			req: func(ctx context.Context) (*http.Request, error) {
+				return newFormRequest("/oauth/introspect", url.Values{"client_id": {"client"}})
+			},
+			assert: func(w *httptest.ResponseRecorder, r *http.Request) {
+				assert.Equal(t, http.StatusBadRequest, w.Result().StatusCode)
+				assert.Equal(t, "invalid_request", decodeError(t, w).Code)
+			},
+		},
+		{
+			name: "200 - introspect success",
+			req: func(ctx context.Context) (*http.Request, error) {
+				return newFormRequest("/oauth/introspect", url.Values{"token": {"token"}, "client_id": {"client"}, "client_secret": {"secret"}})
+			},
+			assert: func(w *httptest.ResponseRecorder, r *http.Request) {
+				assert.Equal(t, http.StatusOK, w.Result().StatusCode)
+
+				var resp dtos.OauthIntrospectResponse
+				require.NoError(t, json.NewDecoder(w.Body).Decode(&resp))
+				assert.False(t, resp.Active)
+			},
+			newService: func() service {
+				return mockService{IntrospectFunc: func(req internal.TokenHintRequest) (*dtos.OauthIntrospectResponse, error) {
+					if req.Token != "token" || req.ClientId != "client" || req.ClientSecret != "secret" {
+						return nil, oauth.ErrInvalidRequest
+					}
+					return &dtos.OauthIntrospectResponse{Active: false}, nil
+				}}
+			},
+		},
+		{
+			name: "200 - revoke success",
+			req: func(ctx context.Context) (*http.Request, error) {
+				return newFormRequest("/oauth/revoke", url.Values{"token": {"token"}, "token_type_hint": {"refresh_token"}, "client_id": {"client"}})
+			},
+			assert: func(w *httptest.ResponseRecorder, r *http.Request) {
+				assert.Equal(t, http.StatusOK, w.Result().StatusCode)
+			},
+			newService: func() service {
+				return mockService{RevokeFunc: func(req internal.TokenHintRequest) error {
+					if req.Token != "token" || *req.TokenTypeHint != "refresh_token" {
+						return oauth.ErrInvalidRequest
+					}
+					return nil
+				}}
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+
+		t.Run(tt.name, func(t *testing.T) {
+			newService = tt.newService
+			ctx := context.Background()
+			var err error
+
+			w := httptest.NewRecorder()
+			r, err := tt.req(ctx)
+			if assert.NoError(t, err) {
+				router.ServeHTTP(w, r)
+				tt.assert(w, r)
+			}
+		})
+	}
+}
@@ -0,0 +1,175 @@
+package internal
+
+import (
+	"context"
+	"mysite/constants"
+	"mysite/dtos"
+	"mysite/entities"
+	"mysite/pkgs/auth"
+	"mysite/pkgs/database"
+	"mysite/pkgs/env"
+	"mysite/pkgs/oauth"
+	"mysite/repositories/oauthclientrepo"
+	"mysite/repositories/oauthcoderepo"
+	"mysite/utils/httputil"
+	"net/url"
+	"time"
+
+	"github.com/mitchellh/mapstructure"
+	"github.com/pkg/errors"
+	"github.com/volatiletech/sqlboiler/v4/boil"
+	"github.com/volatiletech/sqlboiler/v4/types"
+)
+
+type service struct {
+	clientRepo oauthclientrepo.OauthClientRepo
+	codeRepo   oauthcoderepo.OauthCodeRepo
+}
+
+// AuthorizeRequest the query of the authorize request, validated by Authorize so that errors can be redirected
+type AuthorizeRequest struct {
+	ResponseType        *string
+	ClientId            string
+	RedirectUri         *string
+	Scope               *string
+	State               *string
+	CodeChallenge       *string
+	CodeChallengeMethod *string
+}
+
+func NewService() service {
+	return service{
+		clientRepo: oauthclientrepo.NewRepo(),
+		codeRepo:   oauthcoderepo.NewRepo(),
+	}
+}
+
+func NewParams(req dtos.OauthAuthorizeParams) (*AuthorizeRequest, error) {
+	var result AuthorizeRequest
+	if err := mapstructure.Decode(req, &result); err != nil {
+		return nil, errors.Wrap(err, "failed decode")
+	}
+
+	return &result, nil
+}
+
+// Authorize returns where to redirect the user, with a code or with the error of RFC 6749 4.1.2.1.
+// An unknown client or redirect uri fails instead, redirecting to an unverified uri would make an open redirector
+func (s service) Authorize(ctx context.Context, userId int, req AuthorizeRequest) (string, error) {
+	var location string
+	if err := database.NewBoilerTransaction(ctx, func(ctx context.Context, tx boil.ContextTransactor) error {
+		client, err := s.clientRepo.GetOauthClientByClientId(ctx, tx, req.ClientId)
+		if err != nil {
+			return errors.Wrap(err, "failed get oauthClient")
+		}
+		if client == nil {
+			return errors.Wrap(httputil.ErrInvalidRequest, "unknown client")
+		}
+
+		redirectURI, err := resolveRedirectURI(*client, req.RedirectUri)
+		if err != nil {
+			return err
+		}
+
+		scopes, err := validateParams(*client, req)
+		if err != nil {
+			location = redirectLocation(redirectURI, req.State, errorQuery(err))
+			return nil
+		}
+
+		code, codeHash, err := auth.GenerateOpaqueToken()
+		if err != nil {
+			return errors.Wrap(err, "failed generate code")
+		}
+		if err := s.codeRepo.Insert(ctx, tx, &entities.OauthAuthorizationCode{
+			CodeHash:      codeHash,
+			OauthClientID: client.ID,
+			UserAccountID: userId,
+			RedirectURI:   redirectURI,
+			// the token request has to repeat the uri only when it was named here
+			RedirectURIExplicit: req.RedirectUri != nil,
+			Scopes:              types.StringArray(scopes),
+			CodeChallenge:       *req.CodeChallenge,
+			ExpiresAt:           time.Now().Add(time.Duration(env.GetEnv().OAuth.CodeExpireSeconds) * time.Second),
+		}); err != nil {
+			return errors.Wrap(err, "failed insert oauthAuthorizationCode")
+		}
+
+		location = redirectLocation(redirectURI, req.State, url.Values{"code": {code}})
+		return nil
+	}); err != nil {
+		return "", errors.Wrap(err, "failed authorize")
+	}
+
+	return location, nil
+}
+
+// resolveRedirectURI the registered uri the request names, the only one of the client when it names none
+func resolveRedirectURI(client entities.OauthClient, redirectURI *string) (string, error) {
+	if redirectURI == nil {
+		if len(client.RedirectUris) != 1 {
+			return "", errors.Wrap(httputil.ErrInvalidRequest, "missing redirect_uri")
+		}
+		return client.RedirectUris[0], nil
+	}
+	if !oauth.AllowsRedirectURI(client, *redirectURI) {
+		return "", errors.Wrap(httputil.ErrInvalidRequest, "redirect_uri is not registered")
+	}
+	return *redirectURI, nil
+}
+
+// validateParams returns the scopes of the code
+func validateParams(client entities.OauthClient, req AuthorizeRequest) ([]string, error) {
+	if req.ResponseType == nil || *req.ResponseType != "code" {
+		return nil, oauth.ErrUnsupportedResponse.WithDescription("response_type must be code")
+	}
+	if !oauth.AllowsGrant(client, constants.GrantAuthorizationCode) {
+		return nil, oauth.ErrUnauthorizedClient.WithDescription("client is not registered for authorization_code")
+	}
+	if req.CodeChallenge == nil || len(*req.CodeChallenge) < 43 || len(*req.CodeChallenge) > 128 {
+		return nil, oauth.ErrInvalidRequest.WithDescription("code_challenge is required")
+	}
+	if req.CodeChallengeMethod == nil || *req.CodeChallengeMethod != oauth.CodeChallengeS256 {
+		return nil, oauth.ErrInvalidRequest.WithDescription("code_challenge_method must be S256")
+	}
+
+	var requested []string
+	if req.Scope != nil {
+		requested = oauth.ParseScope(*req.Scope)
+	}
+	scopes, ok := oauth.ResolveScopes(requested, client.Scopes)
+	if !ok {
+		return nil, oauth.ErrInvalidScope.WithDescription("scope is not allowed for the client")
+	}
+	return scopes, nil
+}
+
+func errorQuery(err error) url.Values {
+	oauthErr := oauth.ErrServerError
+	errors.As(err, &oauthErr)
+
+	query := url.Values{"error": {oauthErr.Code}}
+	if oauthErr.Description != "" {
+		query.Set("error_description", oauthErr.Description)
+	}
+	return query
+}
+
+// redirectLocation adds the params to the query the redirect uri already has
+func redirectLocation(redirectURI string, state *string, params url.Values) string {
+	u, err := url.Parse(redirectURI)
+	if err != nil {
+		// registered uris were parsed when the client was registered
+		return redirectURI
+	}
+
+	query := u.Query()
+	for key, values := range params {
+		query[key] = values
+	}
+	if state != nil {
+		query.Set("state", *state)
+	}
+	u.RawQuery = query.Encode()
+	return u.String()
+}
@@ -0,0 +1,163 @@
+package internal
+
+import (
+	"context"
+	"fmt"
+	"mysite/entities"
+	"mysite/pkgs/auth"
+	"mysite/pkgs/database"
+	"mysite/testing/dbtest"
+	"mysite/testing/mocking/repomock"
+	"mysite/utils/httputil"
+	"net/url"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"github.com/volatiletech/null/v8"
+	"github.com/volatiletech/sqlboiler/v4/boil"
+	"github.com/volatiletech/sqlboiler/v4/types"
+)
+
+func TestMain(m *testing.M) {
+	pool, resource, err := dbtest.SetupDatabaseForTesting()
+	if err != nil {
+		return
+	}
+
+	defer func() {
+		database.Close()
+		if err := dbtest.PurgeResource(pool, resource); err != nil {
+			fmt.Println("failed to purge resource")
+		}
+	}()
+	m.Run()
+}
+
+const challenge = "E9Melhoa2OwvFrEMTJguCHaoeK1t8URWbuGJSstw-cM"
+
+func ptr(s string) *string {
+	return &s
+}
+
+func newAuthorizeRequest() AuthorizeRequest {
+	return AuthorizeRequest{
+		ResponseType:        ptr("code"),
+		ClientId:            "client",
+		RedirectUri:         ptr("https://example.com/callback?app=1"),
+		Scope:               ptr("users:read"),
+		State:               ptr("xyz"),
+		CodeChallenge:       ptr(challenge),
+		CodeChallengeMethod: ptr("S256"),
+	}
+}
+
+func newClientMock() *repomock.OauthClientRepoMock {
+	return &repomock.OauthClientRepoMock{
+		GetOauthClientByClientIdFunc: func(ctx context.Context, tx boil.ContextTransactor, clientId string) (*entities.OauthClient, error) {
+			if clientId != "client" {
+				return nil, nil
+			}
+			return &entities.OauthClient{
+				ID:           1,
+				ClientID:     "client",
+				SecretHash:   null.StringFrom("hash"),
+				RedirectUris: types.StringArray{"https://example.com/callback?app=1"},
+				GrantTypes:   types.StringArray{"authorization_code", "refresh_token"},
+				Scopes:       types.StringArray{"users:read", "users:write"},
+			}, nil
+		},
+	}
+}
+
+func newCodeMock() *repomock.OauthCodeRepoMock {
+	return &repomock.OauthCodeRepoMock{
+		InsertFunc: func(ctx context.Context, tx boil.ContextTransactor, code *entities.OauthAuthorizationCode) error {
+			return nil
+		},
+	}
+}
+
+func TestAuthorize(t *testing.T) {
+	t.Parallel()
+	require.NoError(t, database.SetupDatabase())
+	ctx := dbtest.SetTestTransactionCtx(context.Background())
+
+	{ // authorize success, the code is stored hashed and appended to the redirect uri
+		codeMock := newCodeMock()
+		svc := service{clientRepo: newClientMock(), codeRepo: codeMock}
+		location, err := svc.Authorize(ctx, 1, newAuthorizeRequest())
+		require.NoError(t, err)
+
+		u, err := url.Parse(location)
+		require.NoError(t, err)
+		require.Equal(t, "example.com", u.Host)
+		require.Equal(t, "1", u.Query().Get("app"))
+		require.Equal(t, "xyz", u.Query().Get("state"))
+
+		inserted := codeMock.InsertCalls()[0].Code
+		require.Equal(t, auth.HashOpaqueToken(u.Query().Get("code")), inserted.CodeHash)
+		require.Equal(t, 1, inserted.UserAccountID)
+		require.Equal(t, types.StringArray{"users:read"}, inserted.Scopes)
+		require.Equal(t, challenge, inserted.CodeChallenge)
+		require.True(t, inserted.RedirectURIExplicit)
+	}
+	{ // authorize success, omitted redirect_uri and scope are the ones of the client
+		codeMock := newCodeMock()
+		svc := service{clientRepo: newClientMock(), codeRepo: codeMock}
+		req := newAuthorizeRequest()
+		req.RedirectUri = nil
+		req.Scope = nil
+		_, err := svc.Authorize(ctx, 1, req)
+		require.NoError(t, err)
+
+		inserted := codeMock.InsertCalls()[0].Code
+		require.Equal(t, "https://example.com/callback?app=1", inserted.RedirectURI)
+		require.False(t, inserted.RedirectURIExplicit, "the token request may omit it too")
+		require.Equal(t, types.StringArray{"users:read", "users:write"}, inserted.Scopes)
+	}
+
+	failures := []struct {
+		name   string
+		modify func(*AuthorizeRequest)
+		err    error
+	}{
+		{name: "unknown client", modify: func(req *AuthorizeRequest) { req.ClientId = "unknown" }, err: httputil.ErrInvalidRequest},
+		{name: "unregistered redirect uri", modify: func(req *AuthorizeRequest) { req.RedirectUri = ptr("https://evil.example.com/callback") }, err: httputil.ErrInvalidRequest},
+	}
+	for _, tt := range failures {
+		codeMock := newCodeMock()
+		svc := service{clientRepo: newClientMock(), codeRepo: codeMock}
+		req := newAuthorizeRequest()
+		tt.modify(&req)
+		location, err := svc.Authorize(ctx, 1, req)
+		require.ErrorIs(t, err, tt.err, tt.name)
+		require.Empty(t, location, tt.name)
+		require.Empty(t, codeMock.InsertCalls(), tt.name)
+	}
+
+	redirected := []struct {
+		name   string
+		modify func(*AuthorizeRequest)
+		code   string
+	}{
+		{name: "response type token", modify: func(req *AuthorizeRequest) { req.ResponseType = ptr("token") }, code: "unsupported_response_type"},
+		{name: "missing code challenge", modify: func(req *AuthorizeRequest) { req.CodeChallenge = nil }, code: "invalid_request"},
+		{name: "plain code challenge", modify: func(req *AuthorizeRequest) { req.CodeChallengeMethod = ptr("plain") }, code: "invalid_request"},
+		{name: "scope of another client", modify: func(req *AuthorizeRequest) { req.Scope = ptr("roles:write") }, code: "invalid_scope"},
+	}
+	for _, tt := range redirected {
+		codeMock := newCodeMock()
+		svc := service{clientRepo: newClientMock(), codeRepo: codeMock}
+		req := newAuthorizeRequest()
+		tt.modify(&req)
+		location, err := svc.Authorize(ctx, 1, req)
+		require.NoError(t, err, tt.name)
+
+		u, err := url.Parse(location)
+		require.NoError(t, err, tt.name)
+		require.Equal(t, tt.code, u.Query().Get("error"), tt.name)
+		require.Equal(t, "xyz", u.Query().Get("state"), tt.name)
+		require.Empty(t, u.Query().Get("code"), tt.name)
+		require.Empty(t, codeMock.InsertCalls(), tt.name)
+	}
+}
@@ -0,0 +1,65 @@
+package oauthauthorize
+
+import (
+	"context"
+	"log/slog"
+	"mysite/features/oauthauthorize/internal"
+	"mysite/pkgs/auth"
+	"mysite/pkgs/logger"
+	"mysite/utils/httputil"
+	"net/http"
+
+	"github.com/go-chi/render"
+	"github.com/pkg/errors"
+)
+
+type api struct {
+}
+
+type service interface {
+	Authorize(ctx context.Context, userId int, req internal.AuthorizeRequest) (string, error)
+}
+
+var newService = func() service {
+	return internal.NewService()
+}
+
+func NewHandler() *api {
+	return &api{}
+}
+
+// OauthAuthorize only a first-party login may authorize, an api key or a token of another client can not
+func (a api) OauthAuthorize(w http.ResponseWriter, r *http.Request, params OauthAuthorizeParams) {
+	principal, found := auth.PrincipalFromContext(r.Context())
+	var err error
+	switch {
+	case !found:
+		err = errors.Wrap(httputil.ErrUnauthorize, "missing principal")
+	case principal.ApiKeyID != 0, principal.ClientID != "":
+		err = errors.Wrap(httputil.ErrForbidden, "only a login can authorize oauth clients")
+	}
+	if err != nil {
+		if err := render.Render(w, r, httputil.NewFailureRender(err)); err != nil {
+			slog.Error("failed to render", logger.AttrError(err))
+		}
+		return
+	}
+
+	req, err := internal.NewParams(params)
+	if err != nil {
+		if err := render.Render(w, r, httputil.NewFailureRender(errors.Wrap(err, "failed to parse params"))); err != nil {
+			slog.Error("failed to render", logger.AttrError(err))
+		}
+		return
+	}
+
+	location, err := newService().Authorize(r.Context(), principal.UserID, *req)
+	if err != nil {
+		if err := render.Render(w, r, httputil.NewFailureRender(errors.Wrap(err, "failed to authorize"))); err != nil {
+			slog.Error("failed to render", logger.AttrError(err))
+		}
+		return
+	}
+
+	http.Redirect(w, r, location, http.StatusFound)
+}
@@ -0,0 +1,146 @@
+package oauthauthorize
+
+import (
+	"context"
+	"mysite/features/oauthauthorize/internal"
+	"mysite/pkgs/auth"
+	"mysite/utils/httputil"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/go-chi/chi/v5"
+	"github.com/stretchr/testify/assert"
+)
+
+type mockService struct {
+	AuthorizeFunc func(userId int, req internal.AuthorizeRequest) (string, error)
+}
+
+func (m mockService) Authorize(ctx context.Context, userId int, req internal.AuthorizeRequest) (string, error) {
+	return m.AuthorizeFunc(userId, req)
+}
+
+func withPrincipal(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		header := r.Header.Get("Authorization")
+		switch {
+		case header == "":
+			next.ServeHTTP(w, r)
+		case strings.HasPrefix(header, "ApiKey "):
+			next.ServeHTTP(w, r.WithContext(auth.WithPrincipal(r.Context(), auth.Principal{UserID: 1, ApiKeyID: 2})))
+		default:
+			next.ServeHTTP(w, r.WithContext(auth.WithPrincipal(r.Context(), auth.Principal{UserID: 1})))
+		}
+	})
+}
+
+func newTestRouter() *chi.Mux {
+	router := chi.NewRouter()
+	router.Route("/api/v1", func(subr chi.Router) {
+		subr.Use(withPrincipal)
+		HandlerFromMux(NewHandler(), subr)
+	})
+	return router
+}
+
+func newRequest(query string, authorization string) (*http.Request, error) {
+	r, err := http.NewRequest(http.MethodGet, "http://example.com/api/v1/oauth/authorize?"+query, nil)
+	if err != nil {
+		return nil, err
+	}
+	if authorization != "" {
+		r.Header.Set("Authorization", authorization)
+	}
+	return r, nil
+}
+
+func TestOauthAuthorize(t *testing.T) {
+	t.Parallel()
+	router := newTestRouter()
+
+	tests := []struct {
+		name       string
+		req        func(context.Context) (*http.Request, error)
+		assert     func(*httptest.ResponseRecorder, *http.Request)
+		newService func() service
+	}{
+		{
+			name: "401 - authorize without principal",
+			req: func(ctx context.Context) (*http.Request, error) {
+				return newRequest("client_id=client", "")
+			},
+			assert: func(w *httptest.ResponseRecorder, r *http.Request) {
+				assert.Equal(t, http.StatusUnauthorized, w.Result().StatusCode)
+			},
+		},
+		{
+			name: "403 - authorize with an api key",
+			req: func(ctx context.Context) (*http.Request, error) {
+				return newRequest("client_id=client", "ApiKey key")
+			},
+			assert: func(w *httptest.ResponseRecorder, r *http.Request) {
+				assert.Equal(t, http.StatusForbidden, w.Result().StatusCode)
+			},
+		},
+		{
+			name: "400 - authorize missing client_id",
+			req: func(ctx context.Context) (*http.Request, error) {
+				return newRequest("response_type=code", "Bearer token")
+			},
+			assert: func(w *httptest.ResponseRecorder, r *http.Request) {
+				assert.Equal(t, http.StatusBadRequest, w.Result().StatusCode)
+			},
+		},
+		{
+			name: "400 - authorize unknown client",
+			req: func(ctx context.Context) (*http.Request, error) {
+				return newRequest("client_id=unknown", "Bearer token")
+			},
+			assert: func(w *httptest.ResponseRecorder, r *http.Request) {
+				assert.Equal(t, http.StatusBadRequest, w.Result().StatusCode)
+			},
+			newService: func() service {
+				return mockService{AuthorizeFunc: func(userId int, req internal.AuthorizeRequest) (string, error) {
+					return "", httputil.ErrInvalidRequest
+				}}
+			},
+		},
+		{
+			name: "302 - authorize success",
+			req: func(ctx context.Context) (*http.Request, error) {
+				return newRequest("client_id=client&response_type=code&state=xyz", "Bearer token")
+			},
+			assert: func(w *httptest.ResponseRecorder, r *http.Request) {
+				assert.Equal(t, http.StatusFound, w.Result().StatusCode)
+				assert.Equal(t, "https://example.com/callback?code=code&state=xyz", w.Result().Header.Get("Location"))
+			},
+			newService: func() service {
+				return mockService{AuthorizeFunc: func(userId int, req internal.AuthorizeRequest) (string, error) {
+					if userId != 1 || req.ClientId != "client" || *req.ResponseType != "code" {
+						return "", httputil.ErrInvalidRequest
+					}
+					return "https://example.com/callback?code=code&state=" + *req.State, nil
+				}}
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+
+		t.Run(tt.name, func(t *testing.T) {
+			newService = tt.newService
+			ctx := context.Background()
+			var err error
+
+			w := httptest.NewRecorder()
+			r, err := tt.req(ctx)
+			if assert.NoError(t, err) {
+				router.ServeHTTP(w, r)
+				tt.assert(w, r)
+			}
+		})
+	}
+}
@@ -0,0 +1,6 @@
+package oauthauthorize
+
+import "mysite/dtos"
+
+// OauthAuthorizeParams the chi-server generation refers to the query params type of the package, which is generated into dtos
+type OauthAuthorizeParams = dtos.OauthAuthorizeParams
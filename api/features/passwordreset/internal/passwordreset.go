@@ -0,0 +1,183 @@
+package internal
+
+import (
+	"context"
+	"fmt"
+	"mysite/dtos"
+	"mysite/entities"
+	"mysite/pkgs/auth"
+	"mysite/pkgs/database"
+	"mysite/pkgs/env"
+	"mysite/pkgs/mailer"
+	"mysite/pkgs/validate"
+	"mysite/repositories/passwordresetrepo"
+	"mysite/repositories/useraccountrepo"
+	"mysite/repositories/usersessionrepo"
+	"mysite/utils/httputil"
+	"net/url"
+	"time"
+
+	"github.com/mitchellh/mapstructure"
+	"github.com/pkg/errors"
+	"github.com/volatiletech/sqlboiler/v4/boil"
+)
+
+type service struct {
+	repo        useraccountrepo.UserAccountRepo
+	resetRepo   passwordresetrepo.PasswordResetRepo
+	sessionRepo usersessionrepo.UserSessionRepo
+	authSvc     auth.AuthService
+	mailer      mailer.Mailer
+}
+
+type ForgotPasswordRequest struct {
+	// UserName email
+	UserName string `validate:"email,required"`
+}
+
+type ResetPasswordRequest struct {
+	// Token token of the reset link
+	Token string `validate:"required"`
+
+	// NewPassword new password
+	NewPassword string `validate:"required,max=128"`
+}
+
+func NewService() service {
+	return service{
+		repo:        useraccountrepo.NewRepo(),
+		resetRepo:   passwordresetrepo.NewRepo(),
+		sessionRepo: usersessionrepo.NewRepo(),
+		authSvc:     auth.NewAuthService(),
+		mailer:      mailer.NewMailer(),
+	}
+}
+
+func NewForgotParams(req dtos.ForgotPasswordJSONRequestBody) (*ForgotPasswordRequest, error) {
+	var result ForgotPasswordRequest
+	if err := mapstructure.Decode(req, &result); err != nil {
+		return nil, errors.Wrap(err, "failed decode")
+	}
+
+	return &result, nil
+}
+
+func NewResetParams(req dtos.ResetPasswordJSONRequestBody) (*ResetPasswordRequest, error) {
+	var result ResetPasswordRequest
+	if err := mapstructure.Decode(req, &result); err != nil {
+		return nil, errors.Wrap(err, "failed decode")
+	}
+
+	return &result, nil
+}
+
+// ForgotPassword mails a reset link, unknown accounts are silently ignored so the caller can not tell them apart
+func (s service) ForgotPassword(ctx context.Context, req ForgotPasswordRequest) error {
+	if err := validate.ValidateStruct(req); err != nil {
+		return errors.Wrap(httputil.ErrInvalidRequest, err.Error())
+	}
+
+	token, tokenHash, err := auth.GenerateOpaqueToken()
+	if err != nil {
+		return errors.Wrap(err, "failed generate reset token")
+	}
+
+	var found bool
+	if err := database.NewBoilerTransaction(ctx, func(ctx context.Context, tx boil.ContextTransactor) error {
+		user, err := s.repo.GetUserAccountByUserName(ctx, tx, req.UserName)
+		if err != nil {
+			return errors.Wrap(err, "failed get userAccount")
+		}
+		if user == nil || !user.IsActive || user.IsDeleted {
+			return nil
+		}
+		found = true
+
+		// only the latest link stays valid
+		if err := s.resetRepo.InvalidateByUserAccountId(ctx, tx, user.ID); err != nil {
+			return errors.Wrap(err, "failed invalidate previous tokens")
+		}
+
+		return s.resetRepo.Insert(ctx, tx, &entities.PasswordResetToken{
+			UserAccountID: user.ID,
+			TokenHash:     tokenHash,
+			ExpiresAt:     time.Now().Add(resetTokenTtl()),
+		})
+	}); err != nil {
+		return errors.Wrap(err, "failed create reset token")
+	}
+
+	// sent in the background, the latency of the response must not tell whether a mail was sent
+	if found {
+		mailer.SendInBackground(ctx, s.mailer, newResetMessage(req.UserName, token))
+	}
+	return nil
+}
+
+// ResetPassword consumes the reset token, sets the new password and revokes every session of the user
+func (s service) ResetPassword(ctx context.Context, req ResetPasswordRequest) error {
+	if err := validate.ValidateStruct(req); err != nil {
+		return errors.Wrap(httputil.ErrInvalidRequest, err.Error())
+	}
+
+	return database.NewBoilerTransaction(ctx, func(ctx context.Context, tx boil.ContextTransactor) error {
+		resetToken, err := s.resetRepo.GetPasswordResetTokenByHash(ctx, tx, auth.HashOpaqueToken(req.Token))
+		if err != nil {
+			return errors.Wrap(err, "failed get reset token")
+		}
+		if resetToken == nil || resetToken.UsedAt.Valid || time.Now().After(resetToken.ExpiresAt) {
+			return errors.Wrap(httputil.ErrInvalidRequest, "invalid or expired reset token")
+		}
+
+		user, err := s.repo.GetActiveUserAccountById(ctx, tx, resetToken.UserAccountID)
+		if err != nil || user == nil {
+			return errors.Wrap(httputil.ErrInvalidRequest, "invalid or expired reset token")
+		}
+
+		// checked once the user is known, the password must not contain the user name
+		if err := validate.ValidatePassword(req.NewPassword, user.UserName); err != nil {
+			return errors.Wrap(httputil.ErrWeakPassword.WithFailedRules(validate.FailedRules(err)), err.Error())
+		}
+
+		hash, err := s.authSvc.HashPassword(req.NewPassword)
+		if err != nil {
+			return errors.Wrap(err, "failed to hash password")
+		}
+
+		user.Password = hash
+		if err := s.repo.Update(ctx, tx, *user); err != nil {
+			return errors.Wrap(err, "failed to update password")
+		}
+
+		if err := s.resetRepo.MarkUsed(ctx, tx, *resetToken); err != nil {
+			return errors.Wrap(err, "failed to mark reset token used")
+		}
+
+		if err := s.sessionRepo.RevokeAllByUserAccountId(ctx, tx, user.ID); err != nil {
+			return errors.Wrap(err, "failed to revoke sessions")
+		}
+		return nil
+	})
+}
+
+func resetTokenTtl() time.Duration {
+	minutes := env.GetEnv().PasswordReset.ExpireMinutes
+	if minutes <= 0 {
+		minutes = 30
+	}
+	return time.Duration(minutes) * time.Minute
+}
+
+func newResetMessage(to, token string) mailer.Message {
+	link := token
+	if linkUrl := env.GetEnv().PasswordReset.LinkUrl; linkUrl != "" {
+		link = fmt.Sprintf("%s?token=%s", linkUrl, url.QueryEscape(token))
+	}
+
+	return mailer.Message{
+		To:      []string{to},
+		Subject: "Reset your password",
+		Body: fmt.Sprintf("We received a request to reset your password.\n\n%s\n\nThe link expires in %s. If you did not ask for it, you can ignore this mail.",
+			link, resetTokenTtl()),
+	}
+}
@@ -0,0 +1,274 @@
+package internal
+
+import (
+	"context"
+	"fmt"
+	"mysite/dtos"
+	"mysite/entities"
+	"mysite/pkgs/database"
+	"mysite/pkgs/mailer"
+	"mysite/pkgs/validate"
+	"mysite/testing/dbtest"
+	"mysite/testing/mocking/pkgmock"
+	"mysite/testing/mocking/repomock"
+	"mysite/utils/httputil"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/require"
+	"github.com/volatiletech/null/v8"
+	"github.com/volatiletech/sqlboiler/v4/boil"
+)
+
+func TestMain(m *testing.M) {
+	pool, resource, err := dbtest.SetupDatabaseForTesting()
+	if err != nil {
+		return
+	}
+
+	defer func() {
+		database.Close()
+		if err := dbtest.PurgeResource(pool, resource); err != nil {
+			fmt.Println("failed to purge resource")
+		}
+	}()
+	m.Run()
+}
+
+func TestForgotPassword(t *testing.T) {
+	t.Parallel()
+	require.NoError(t, database.SetupDatabase())
+	ctx := dbtest.SetTestTransactionCtx(context.Background())
+	req := ForgotPasswordRequest{UserName: "test@example.com"}
+	newResetMock := func() *repomock.PasswordResetRepoMock {
+		return &repomock.PasswordResetRepoMock{
+			InvalidateByUserAccountIdFunc: func(ctx context.Context, tx boil.ContextTransactor, userAccountId int) error {
+				return nil
+			},
+			InsertFunc: func(ctx context.Context, tx boil.ContextTransactor, token *entities.PasswordResetToken) error {
+				return nil
+			},
+		}
+	}
+	newRepoMock := func(user *entities.UserAccount) *repomock.UserAccountRepoMock {
+		return &repomock.UserAccountRepoMock{
+			GetUserAccountByUserNameFunc: func(ctx context.Context, tx boil.ContextTransactor, userName string) (*entities.UserAccount, error) {
+				return user, nil
+			},
+		}
+	}
+
+	{ // forgot password success, token stored and mailed
+		resetMock := newResetMock()
+		outbox := mailer.MemoryOutbox()
+		svc := service{
+			repo:      newRepoMock(&entities.UserAccount{ID: 1, UserName: req.UserName, IsActive: true}),
+			resetRepo: resetMock,
+			mailer:    outbox,
+		}
+
+		err := svc.ForgotPassword(ctx, req)
+		require.NoError(t, err)
+		require.Len(t, resetMock.InvalidateByUserAccountIdCalls(), 1)
+		require.Len(t, resetMock.InsertCalls(), 1)
+		stored := resetMock.InsertCalls()[0].Token
+		require.Equal(t, 1, stored.UserAccountID)
+		require.Len(t, stored.TokenHash, 64)
+		require.True(t, stored.ExpiresAt.After(time.Now()))
+
+		require.Eventually(t, func() bool { return len(outbox.Messages()) == 1 }, time.Second, 10*time.Millisecond)
+		messages := outbox.Messages()
+		require.Equal(t, []string{req.UserName}, messages[0].To)
+		// the mail carries the raw token, never the stored hash
+		require.False(t, strings.Contains(messages[0].Body, stored.TokenHash))
+	}
+	{ // forgot password success, unknown account is not revealed
+		resetMock := newResetMock()
+		mailerMock := &pkgmock.MailerMock{}
+		svc := service{
+			repo:      newRepoMock(nil),
+			resetRepo: resetMock,
+			mailer:    mailerMock,
+		}
+
+		err := svc.ForgotPassword(ctx, req)
+		require.NoError(t, err)
+		require.Empty(t, resetMock.InsertCalls())
+		require.Empty(t, mailerMock.SendCalls())
+	}
+	{ // forgot password success, inactive account is not revealed
+		resetMock := newResetMock()
+		svc := service{
+			repo:      newRepoMock(&entities.UserAccount{ID: 1, UserName: req.UserName, IsActive: false}),
+			resetRepo: resetMock,
+			mailer:    &pkgmock.MailerMock{},
+		}
+
+		err := svc.ForgotPassword(ctx, req)
+		require.NoError(t, err)
+		require.Empty(t, resetMock.InsertCalls())
+	}
+	{ // forgot password success, mail failure is not revealed
+		mailerMock := &pkgmock.MailerMock{
+			SendFunc: func(ctx context.Context, msg mailer.Message) error {
+				return errors.New("smtp down")
+			},
+		}
+		svc := service{
+			repo:      newRepoMock(&entities.UserAccount{ID: 1, UserName: req.UserName, IsActive: true}),
+			resetRepo: newResetMock(),
+			mailer:    mailerMock,
+		}
+
+		err := svc.ForgotPassword(ctx, req)
+		require.NoError(t, err)
+		require.Eventually(t, func() bool { return len(mailerMock.SendCalls()) == 1 }, time.Second, 10*time.Millisecond)
+	}
+	{ // forgot password failed, invalid email
+		svc := service{}
+
+		err := svc.ForgotPassword(ctx, ForgotPasswordRequest{UserName: "invalid"})
+		require.ErrorIs(t, err, httputil.ErrInvalidRequest)
+	}
+}
+
+func TestResetPassword(t *testing.T) {
+	t.Parallel()
+	require.NoError(t, database.SetupDatabase())
+	ctx := dbtest.SetTestTransactionCtx(context.Background())
+	req := ResetPasswordRequest{Token: "reset-token", NewPassword: "newPassword1"}
+	newResetMock := func(token *entities.PasswordResetToken) *repomock.PasswordResetRepoMock {
+		return &repomock.PasswordResetRepoMock{
+			GetPasswordResetTokenByHashFunc: func(ctx context.Context, tx boil.ContextTransactor, tokenHash string) (*entities.PasswordResetToken, error) {
+				return token, nil
+			},
+			MarkUsedFunc: func(ctx context.Context, tx boil.ContextTransactor, token entities.PasswordResetToken) error {
+				return nil
+			},
+		}
+	}
+	newRepoMock := func() *repomock.UserAccountRepoMock {
+		return &repomock.UserAccountRepoMock{
+			GetActiveUserAccountByIdFunc: func(ctx context.Context, tx boil.ContextTransactor, userId int) (*entities.UserAccount, error) {
+				return &entities.UserAccount{ID: userId, Password: "oldHash"}, nil
+			},
+			UpdateFunc: func(ctx context.Context, tx boil.ContextTransactor, pgUser entities.UserAccount) error {
+				return nil
+			},
+		}
+	}
+	newSessionMock := func() *repomock.UserSessionRepoMock {
+		return &repomock.UserSessionRepoMock{
+			RevokeAllByUserAccountIdFunc: func(ctx context.Context, tx boil.ContextTransactor, userAccountId int) error {
+				return nil
+			},
+		}
+	}
+	authMock := &pkgmock.AuthServiceMock{
+		HashPasswordFunc: func(password string) (string, error) { return "newHash", nil },
+	}
+	validToken := &entities.PasswordResetToken{ID: 1, UserAccountID: 1, ExpiresAt: time.Now().Add(time.Minute)}
+
+	{ // reset password success, token consumed and sessions revoked
+		resetMock := newResetMock(validToken)
+		repoMock := newRepoMock()
+		sessionMock := newSessionMock()
+		svc := service{
+			repo:        repoMock,
+			resetRepo:   resetMock,
+			sessionRepo: sessionMock,
+			authSvc:     authMock,
+		}
+
+		err := svc.ResetPassword(ctx, req)
+		require.NoError(t, err)
+		require.NotEqual(t, req.Token, resetMock.GetPasswordResetTokenByHashCalls()[0].TokenHash)
+		require.Len(t, repoMock.UpdateCalls(), 1)
+		require.Equal(t, "newHash", repoMock.UpdateCalls()[0].PgUser.Password)
+		require.Len(t, resetMock.MarkUsedCalls(), 1)
+		require.Len(t, sessionMock.RevokeAllByUserAccountIdCalls(), 1)
+	}
+	{ // reset password failed, unknown, used or expired token
+		for _, token := range []*entities.PasswordResetToken{
+			nil,
+			{ID: 1, UserAccountID: 1, ExpiresAt: time.Now().Add(time.Minute), UsedAt: null.TimeFrom(time.Now())},
+			{ID: 1, UserAccountID: 1, ExpiresAt: time.Now().Add(-time.Minute)},
+		} {
+			repoMock := newRepoMock()
+			svc := service{
+				repo:        repoMock,
+				resetRepo:   newResetMock(token),
+				sessionRepo: newSessionMock(),
+				authSvc:     authMock,
+			}
+
+			err := svc.ResetPassword(ctx, req)
+			require.ErrorIs(t, err, httputil.ErrInvalidRequest)
+			require.Empty(t, repoMock.UpdateCalls())
+		}
+	}
+	{ // reset password failed, password policy, the token is not consumed
+		for newPassword, rule := range map[string]string{
+			"short1":          validate.RuleMinLength,
+			"onlyletters":     validate.RuleDigit,
+			"1234567890":      validate.RuleLetter,
+			"password123":     validate.RuleBreached,
+			"alice-secret-42": validate.RuleUserName,
+		} {
+			req := req
+			req.NewPassword = newPassword
+			repoMock := newRepoMock()
+			repoMock.GetActiveUserAccountByIdFunc = func(ctx context.Context, tx boil.ContextTransactor, userId int) (*entities.UserAccount, error) {
+				return &entities.UserAccount{ID: userId, UserName: "alice@example.com"}, nil
+			}
+			resetMock := newResetMock(validToken)
+			svc := service{
+				repo:        repoMock,
+				resetRepo:   resetMock,
+				sessionRepo: newSessionMock(),
+				authSvc:     authMock,
+			}
+
+			err := svc.ResetPassword(ctx, req)
+			require.ErrorIs(t, err, httputil.ErrWeakPassword, newPassword)
+			var errResp httputil.ErrResponse
+			require.ErrorAs(t, err, &errResp)
+			require.Contains(t, *errResp.FailedRules, rule, newPassword)
+			require.Empty(t, repoMock.UpdateCalls())
+			require.Empty(t, resetMock.MarkUsedCalls())
+		}
+	}
+	{ // reset password failed, update failed
+		repoMock := newRepoMock()
+		repoMock.UpdateFunc = func(ctx context.Context, tx boil.ContextTransactor, pgUser entities.UserAccount) error {
+			return errors.New("update failed")
+		}
+		resetMock := newResetMock(validToken)
+		svc := service{
+			repo:        repoMock,
+			resetRepo:   resetMock,
+			sessionRepo: newSessionMock(),
+			authSvc:     authMock,
+		}
+
+		err := svc.ResetPassword(ctx, req)
+		require.Error(t, err)
+		require.Empty(t, resetMock.MarkUsedCalls())
+	}
+}
+
+func TestNewParams(t *testing.T) {
+	{ // create forgot params success
+		req, err := NewForgotParams(dtos.ForgotPasswordJSONRequestBody{UserName: "test@example.com"})
+		require.NoError(t, err)
+		require.Equal(t, "test@example.com", req.UserName)
+	}
+	{ // create reset params success
+		req, err := NewResetParams(dtos.ResetPasswordJSONRequestBody{Token: "reset-token", NewPassword: "newPassword1"})
+		require.NoError(t, err)
+		require.Equal(t, "reset-token", req.Token)
+		require.Equal(t, "newPassword1", req.NewPassword)
+	}
+}
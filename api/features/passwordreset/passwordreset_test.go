@@ -0,0 +1,134 @@
+package passwordreset
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"mysite/dtos"
+	"mysite/features/passwordreset/internal"
+	"mysite/utils/httputil"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/go-chi/chi/v5"
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/assert"
+)
+
+type mockService struct {
+	ForgotPasswordFunc func(req internal.ForgotPasswordRequest) error
+	ResetPasswordFunc  func(req internal.ResetPasswordRequest) error
+}
+
+func (m mockService) ForgotPassword(ctx context.Context, req internal.ForgotPasswordRequest) error {
+	return m.ForgotPasswordFunc(req)
+}
+
+func (m mockService) ResetPassword(ctx context.Context, req internal.ResetPasswordRequest) error {
+	return m.ResetPasswordFunc(req)
+}
+
+func newTestRouter() *chi.Mux {
+	router := chi.NewRouter()
+	router.Route("/api/v1", func(subr chi.Router) {
+		HandlerFromMux(NewHandler(), subr)
+	})
+	return router
+}
+
+func newRequest(path string, body interface{}) (*http.Request, error) {
+	var buf bytes.Buffer
+	if err := json.NewEncoder(&buf).Encode(body); err != nil {
+		return nil, errors.Wrap(err, "failed encode body")
+	}
+
+	return http.NewRequest(http.MethodPost, "http://example.com/api/v1"+path, &buf)
+}
+
+func TestDashboardGetStores(t *testing.T) {
+	t.Parallel()
+	router := newTestRouter()
+
+	tests := []struct {
+		name       string
+		req        func(context.Context) (*http.Request, error)
+		assert     func(*httptest.ResponseRecorder, *http.Request)
+		newService func() service
+	}{
+		{
+			name: "400 - forgot password, empty body",
+			req: func(ctx context.Context) (*http.Request, error) {
+				return http.NewRequest(http.MethodPost, "http://example.com/api/v1/password/forgot", nil)
+			},
+			assert: func(w *httptest.ResponseRecorder, r *http.Request) {
+				assert.Equal(t, http.StatusBadRequest, w.Result().StatusCode)
+			},
+		},
+		{
+			name: "202 - forgot password",
+			req: func(ctx context.Context) (*http.Request, error) {
+				return newRequest("/password/forgot", dtos.ForgotPasswordRequest{UserName: "test@example.com"})
+			},
+			assert: func(w *httptest.ResponseRecorder, r *http.Request) {
+				assert.Equal(t, http.StatusAccepted, w.Result().StatusCode)
+			},
+			newService: func() service {
+				return mockService{ForgotPasswordFunc: func(req internal.ForgotPasswordRequest) error {
+					if req.UserName != "test@example.com" {
+						return httputil.ErrInvalidRequest
+					}
+					return nil
+				}}
+			},
+		},
+		{
+			name: "400 - reset password, invalid token",
+			req: func(ctx context.Context) (*http.Request, error) {
+				return newRequest("/password/reset", dtos.ResetPasswordRequest{Token: "reset-token", NewPassword: "newPassword1"})
+			},
+			assert: func(w *httptest.ResponseRecorder, r *http.Request) {
+				assert.Equal(t, http.StatusBadRequest, w.Result().StatusCode)
+			},
+			newService: func() service {
+				return mockService{ResetPasswordFunc: func(req internal.ResetPasswordRequest) error {
+					return httputil.ErrInvalidRequest
+				}}
+			},
+		},
+		{
+			name: "204 - reset password",
+			req: func(ctx context.Context) (*http.Request, error) {
+				return newRequest("/password/reset", dtos.ResetPasswordRequest{Token: "reset-token", NewPassword: "newPassword1"})
+			},
+			assert: func(w *httptest.ResponseRecorder, r *http.Request) {
+				assert.Equal(t, http.StatusNoContent, w.Result().StatusCode)
+			},
+			newService: func() service {
+				return mockService{ResetPasswordFunc: func(req internal.ResetPasswordRequest) error {
+					if req.Token != "reset-token" || req.NewPassword != "newPassword1" {
+						return httputil.ErrInvalidRequest
+					}
+					return nil
+				}}
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+
+		t.Run(tt.name, func(t *testing.T) {
+			newService = tt.newService
+			ctx := context.Background()
+			var err error
+
+			w := httptest.NewRecorder()
+			r, err := tt.req(ctx)
+			if assert.NoError(t, err) {
+				router.ServeHTTP(w, r)
+				tt.assert(w, r)
+			}
+		})
+	}
+}
@@ -3,10 +3,14 @@ package internal
 import (
 	"context"
 	"mysite/dtos"
+	"mysite/entities"
 	"mysite/pkgs/auth"
 	"mysite/pkgs/database"
 	"mysite/pkgs/validate"
+	"mysite/repositories/membershiprepo"
+	"mysite/repositories/rolerepo"
 	"mysite/repositories/useraccountrepo"
+	"mysite/repositories/usersessionrepo"
 	"mysite/utils/httputil"
 	"strconv"
 	"time"
@@ -17,8 +21,11 @@ import (
 )
 
 type service struct {
-	repo       useraccountrepo.UserAccountRepo
-	jwtHandler auth.JwtHandler
+	repo           useraccountrepo.UserAccountRepo
+	sessionRepo    usersessionrepo.UserSessionRepo
+	roleRepo       rolerepo.RoleRepo
+	membershipRepo membershiprepo.MembershipRepo
+	jwtHandler     auth.JwtHandler
 }
 
 type RefreshRequest struct {
@@ -28,12 +35,18 @@ type RefreshRequest struct {
 type RefreshResponse struct {
 	// AccessToken access token
 	AccessToken string
+
+	// RefreshToken rotated refresh token
+	RefreshToken string
 }
 
 func NewService() service {
 	return service{
-		repo:       useraccountrepo.NewRepo(),
-		jwtHandler: auth.NewJwtHandler(),
+		repo:           useraccountrepo.NewRepo(),
+		sessionRepo:    usersessionrepo.NewRepo(),
+		roleRepo:       rolerepo.NewRepo(),
+		membershipRepo: membershiprepo.NewRepo(),
+		jwtHandler:     auth.NewJwtHandler(),
 	}
 }
 
@@ -53,12 +66,13 @@ func (s service) RefreshToken(ctx context.Context, req RefreshRequest) (*Refresh
 
 	// parse  token
 	var claims auth.CustomClaims[any]
-	claims.KeyType = auth.AccessKey
-
 	err := s.jwtHandler.ParseToken(req.RefreshToken, &claims)
 	if err != nil {
 		return nil, errors.Wrapf(httputil.ErrUnauthorize, "failed to parse token: %s", err.Error())
 	}
+	if claims.GetKeyType() != auth.RefreshKey {
+		return nil, errors.Wrap(httputil.ErrUnauthorize, "not a refresh token")
+	}
 
 	// get userId from claims
 	userId, err := strconv.Atoi(claims.Subject)
@@ -66,9 +80,37 @@ func (s service) RefreshToken(ctx context.Context, req RefreshRequest) (*Refresh
 		return nil, errors.Wrap(err, "failed to get user Id")
 	}
 
-	// get user by user id
+	// roles and membership are read again, so that their changes reach the access token on refresh
+	var metaData auth.AccessMetaData
 	if err := database.NewBoilerTransaction(ctx, func(ctx context.Context, tx boil.ContextTransactor) error {
 		var err error
+		if metaData.Roles, err = s.roleRepo.GetRoleNamesByUserAccountId(ctx, tx, userId); err != nil {
+			return errors.Wrap(err, "failed get roles")
+		}
+		if metaData.Permissions, err = s.roleRepo.GetPermissionNamesByUserAccountId(ctx, tx, userId); err != nil {
+			return errors.Wrap(err, "failed get permissions")
+		}
+		membership, err := s.membershipRepo.GetCurrentMembershipByUserAccountId(ctx, tx, userId)
+		if err != nil {
+			return errors.Wrap(err, "failed get membership")
+		}
+		if membership != nil {
+			metaData.Membership = membership.Name
+		}
+		return nil
+	}); err != nil {
+		return nil, errors.Wrap(err, "failed get access metadata")
+	}
+
+	// generate access token and rotated refresh token
+	accessToken, refreshToken, refreshClaims, err := s.generateToken(claims.Subject, metaData)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed generate token")
+	}
+
+	// rotate session, revoke the whole family when a used refresh token comes back
+	var reused bool
+	if err := database.NewBoilerTransaction(ctx, func(ctx context.Context, tx boil.ContextTransactor) error {
 		pgUserAccount, err := s.repo.GetActiveUserAccountById(ctx, tx, userId)
 		if err != nil {
 			return errors.Wrap(err, "failed get userAccount")
@@ -76,21 +118,44 @@ func (s service) RefreshToken(ctx context.Context, req RefreshRequest) (*Refresh
 		if pgUserAccount == nil {
 			return errors.New("failed get userAccount")
 		}
-		return nil
+
+		session, err := s.sessionRepo.GetUserSessionByTokenId(ctx, tx, claims.ID)
+		if err != nil {
+			return errors.Wrap(err, "failed get userSession")
+		}
+		switch {
+		case session == nil, session.UserAccountID != userId:
+			return errors.New("session not found")
+		case session.OauthClientID.Valid:
+			// oauth refresh tokens are scoped, they are only refreshed by the token endpoint of their client
+			return errors.New("session of an oauth client")
+		case session.RevokedAt.Valid:
+			return errors.New("session revoked")
+		case session.UsedAt.Valid:
+			reused = true
+			return s.sessionRepo.RevokeFamily(ctx, tx, session.FamilyID)
+		}
+
+		if err := s.sessionRepo.MarkUsed(ctx, tx, *session); err != nil {
+			return errors.Wrap(err, "failed mark userSession used")
+		}
+
+		return s.sessionRepo.Insert(ctx, tx, &entities.UserSession{
+			UserAccountID: userId,
+			FamilyID:      session.FamilyID,
+			TokenID:       refreshClaims.ID,
+			ExpiresAt:     refreshClaims.ExpiresAt.Time,
+		})
 	}); err != nil {
 		return nil, errors.Wrap(httputil.ErrUnauthorize, err.Error())
 	}
-
-	// generate access token
-	accessClaims := claims.Clone().WithExpireAt(time.Now().Add(time.Minute * 15))
-	claims.KeyType = auth.AccessKey
-	accessToken, err := s.jwtHandler.WithClaims(accessClaims).CreateToken()
-	if err != nil {
-		return nil, errors.Wrap(err, "failed create access token")
+	if reused {
+		return nil, errors.Wrap(httputil.ErrUnauthorize, "refresh token reused, session revoked")
 	}
 
 	return &RefreshResponse{
-		AccessToken: accessToken,
+		AccessToken:  accessToken,
+		RefreshToken: refreshToken,
 	}, nil
 }
 
@@ -100,3 +165,24 @@ func validateParams(params RefreshRequest) error {
 	}
 	return nil
 }
+
+func (s service) generateToken(subject string, metaData auth.AccessMetaData) (accessToken string, refreshToken string, refreshClaims *auth.CustomClaims[any], err error) {
+	accessClaims := auth.NewCustomClaims[any]().WithExpireAt(time.Now().Add(time.Minute * 15))
+	accessClaims.Subject = subject
+	accessClaims.KeyType = auth.AccessKey
+	accessClaims.MetaData = metaData
+	accessToken, err = s.jwtHandler.WithClaims(accessClaims).CreateToken()
+	if err != nil {
+		return "", "", nil, errors.Wrap(err, "failed create access token")
+	}
+
+	refreshClaims = auth.NewCustomClaims[any]().WithExpireAt(time.Now().Add(72 * time.Hour))
+	refreshClaims.Subject = subject
+	refreshClaims.KeyType = auth.RefreshKey
+	refreshToken, err = s.jwtHandler.WithClaims(refreshClaims).CreateToken()
+	if err != nil {
+		return "", "", nil, errors.Wrap(err, "failed create refresh token")
+	}
+
+	return
+}
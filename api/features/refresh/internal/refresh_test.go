@@ -3,6 +3,7 @@ package internal
 import (
 	"context"
 	"fmt"
+	"mysite/constants"
 	"mysite/dtos"
 	"mysite/entities"
 	"mysite/pkgs/auth"
@@ -10,10 +11,13 @@ import (
 	"mysite/testing/dbtest"
 	"mysite/testing/mocking/pkgmock"
 	"mysite/testing/mocking/repomock"
+	"mysite/utils/httputil"
 	"testing"
+	"time"
 
 	"github.com/pkg/errors"
 	"github.com/stretchr/testify/require"
+	"github.com/volatiletech/null/v8"
 	"github.com/volatiletech/sqlboiler/v4/boil"
 )
 
@@ -39,32 +43,87 @@ func TestRefreshToken(t *testing.T) {
 	req := RefreshRequest{
 		RefreshToken: "refresh-token",
 	}
+	parseRefreshToken := func(tokenString string, claims auth.Claims) error {
+		c := claims.(*auth.CustomClaims[any])
+		c.Subject = "1"
+		c.ID = "token-id"
+		c.KeyType = auth.RefreshKey
+		return nil
+	}
+	newRepoMock := func() *repomock.UserAccountRepoMock {
+		return &repomock.UserAccountRepoMock{
+			GetActiveUserAccountByIdFunc: func(ctx context.Context, tx boil.ContextTransactor, userId int) (*entities.UserAccount, error) {
+				return &entities.UserAccount{
+					ID: 1,
+				}, nil
+			},
+		}
+	}
+	newRoleMock := func() *repomock.RoleRepoMock {
+		return &repomock.RoleRepoMock{
+			GetRoleNamesByUserAccountIdFunc: func(ctx context.Context, tx boil.ContextTransactor, userAccountId int) ([]string, error) {
+				return []string{constants.RoleMember}, nil
+			},
+			GetPermissionNamesByUserAccountIdFunc: func(ctx context.Context, tx boil.ContextTransactor, userAccountId int) ([]string, error) {
+				return []string{}, nil
+			},
+		}
+	}
+	newMembershipMock := func() *repomock.MembershipRepoMock {
+		return &repomock.MembershipRepoMock{
+			GetCurrentMembershipByUserAccountIdFunc: func(ctx context.Context, tx boil.ContextTransactor, userAccountId int) (*entities.Membership, error) {
+				return &entities.Membership{ID: constants.Gold, Name: "gold"}, nil
+			},
+		}
+	}
+	newJwtMock := func() *pkgmock.JwtHandlerMock {
+		jwtMock := &pkgmock.JwtHandlerMock{}
+		jwtMock.ParseTokenFunc = parseRefreshToken
+		jwtMock.CreateTokenFunc = func() (string, error) { return "token", nil }
+		jwtMock.WithClaimsFunc = func(claims auth.Claims) auth.JwtHandler { return jwtMock }
+		return jwtMock
+	}
 
 	{ // refresh success
-		repoMock := &repomock.UserAccountRepoMock{}
-		repoMock.GetActiveUserAccountByIdFunc = func(ctx context.Context, tx boil.ContextTransactor, userId int) (*entities.UserAccount, error) {
-			return &entities.UserAccount{
-				ID: 1,
+		jwtMock := newJwtMock()
+		sessionMock := &repomock.UserSessionRepoMock{}
+		sessionMock.GetUserSessionByTokenIdFunc = func(ctx context.Context, tx boil.ContextTransactor, tokenId string) (*entities.UserSession, error) {
+			return &entities.UserSession{
+				ID:            1,
+				UserAccountID: 1,
+				FamilyID:      "family-id",
+				TokenID:       tokenId,
+				ExpiresAt:     time.Now().Add(time.Hour),
 			}, nil
 		}
-
-		jwtMock := &pkgmock.JwtHandlerMock{}
-		jwtMock.ParseTokenFunc = func(tokenString string, claims auth.Claims) error {
-			claims.(*auth.CustomClaims[any]).Subject = "1"
+		sessionMock.MarkUsedFunc = func(ctx context.Context, tx boil.ContextTransactor, session entities.UserSession) error {
+			return nil
+		}
+		sessionMock.InsertFunc = func(ctx context.Context, tx boil.ContextTransactor, session *entities.UserSession) error {
 			return nil
 		}
-		jwtMock.CreateTokenFunc = func() (string, error) { return "token", nil }
-		jwtMock.WithClaimsFunc = func(claims auth.Claims) auth.JwtHandler { return jwtMock }
 
 		svc := service{
-			repo:       repoMock,
-			jwtHandler: jwtMock,
+			repo:           newRepoMock(),
+			sessionRepo:    sessionMock,
+			jwtHandler:     jwtMock,
+			roleRepo:       newRoleMock(),
+			membershipRepo: newMembershipMock(),
 		}
 
 		resp, err := svc.RefreshToken(ctx, req)
 		require.NoError(t, err)
 		require.NotNil(t, resp)
 		require.Equal(t, "token", resp.AccessToken)
+		require.Equal(t, "token", resp.RefreshToken)
+		require.Len(t, sessionMock.MarkUsedCalls(), 1)
+		require.Len(t, sessionMock.InsertCalls(), 1)
+		require.Equal(t, "family-id", sessionMock.InsertCalls()[0].Session.FamilyID)
+		require.NotEqual(t, "token-id", sessionMock.InsertCalls()[0].Session.TokenID)
+
+		accessClaims := jwtMock.WithClaimsCalls()[0].Claims.(*auth.CustomClaims[any])
+		require.Equal(t, []string{constants.RoleMember}, accessClaims.MetaData.(auth.AccessMetaData).Roles)
+		require.Equal(t, "gold", accessClaims.MetaData.(auth.AccessMetaData).Membership)
 	}
 	{ // refresh failed, validate failed
 
@@ -78,25 +137,40 @@ func TestRefreshToken(t *testing.T) {
 		require.Nil(t, resp)
 	}
 	{ // refresh failed, parse token failed
-		repoMock := &repomock.UserAccountRepoMock{}
-		repoMock.GetActiveUserAccountByIdFunc = func(ctx context.Context, tx boil.ContextTransactor, userId int) (*entities.UserAccount, error) {
-			return &entities.UserAccount{
-				ID: 1,
-			}, nil
+		jwtMock := &pkgmock.JwtHandlerMock{}
+		jwtMock.ParseTokenFunc = func(tokenString string, claims auth.Claims) error {
+			return errors.New("parse token failed")
 		}
 
+		svc := service{
+			repo:           newRepoMock(),
+			jwtHandler:     jwtMock,
+			roleRepo:       newRoleMock(),
+			membershipRepo: newMembershipMock(),
+		}
+
+		resp, err := svc.RefreshToken(ctx, req)
+		require.ErrorIs(t, err, httputil.ErrUnauthorize)
+		require.Nil(t, resp)
+	}
+	{ // refresh failed, not a refresh token
 		jwtMock := &pkgmock.JwtHandlerMock{}
 		jwtMock.ParseTokenFunc = func(tokenString string, claims auth.Claims) error {
-			return errors.New("parse token failed")
+			c := claims.(*auth.CustomClaims[any])
+			c.Subject = "1"
+			c.KeyType = auth.AccessKey
+			return nil
 		}
 
 		svc := service{
-			repo:       repoMock,
-			jwtHandler: jwtMock,
+			repo:           newRepoMock(),
+			jwtHandler:     jwtMock,
+			roleRepo:       newRoleMock(),
+			membershipRepo: newMembershipMock(),
 		}
 
 		resp, err := svc.RefreshToken(ctx, req)
-		require.Error(t, err)
+		require.ErrorIs(t, err, httputil.ErrUnauthorize)
 		require.Nil(t, resp)
 	}
 	{ // refresh failed, get user failed
@@ -105,47 +179,127 @@ func TestRefreshToken(t *testing.T) {
 			return nil, errors.New("get user account failed")
 		}
 
-		jwtMock := &pkgmock.JwtHandlerMock{}
-		jwtMock.ParseTokenFunc = func(tokenString string, claims auth.Claims) error {
-			return nil
+		svc := service{
+			repo:           repoMock,
+			jwtHandler:     newJwtMock(),
+			roleRepo:       newRoleMock(),
+			membershipRepo: newMembershipMock(),
+		}
+
+		resp, err := svc.RefreshToken(ctx, req)
+		require.Error(t, err)
+		require.Nil(t, resp)
+	}
+	{ // refresh failed, createToken failed
+		jwtMock := newJwtMock()
+		jwtMock.CreateTokenFunc = func() (string, error) {
+			return "", errors.New("create token failed")
 		}
 
 		svc := service{
-			repo:       repoMock,
-			jwtHandler: jwtMock,
+			repo:           newRepoMock(),
+			jwtHandler:     jwtMock,
+			roleRepo:       newRoleMock(),
+			membershipRepo: newMembershipMock(),
 		}
 
 		resp, err := svc.RefreshToken(ctx, req)
 		require.Error(t, err)
 		require.Nil(t, resp)
 	}
-	{ // refresh failed, createToken failed
-		repoMock := &repomock.UserAccountRepoMock{}
-		repoMock.GetActiveUserAccountByIdFunc = func(ctx context.Context, tx boil.ContextTransactor, userId int) (*entities.UserAccount, error) {
-			return &entities.UserAccount{
-				ID: 1,
+	{ // refresh failed, session not found
+		sessionMock := &repomock.UserSessionRepoMock{}
+		sessionMock.GetUserSessionByTokenIdFunc = func(ctx context.Context, tx boil.ContextTransactor, tokenId string) (*entities.UserSession, error) {
+			return nil, nil
+		}
+
+		svc := service{
+			repo:           newRepoMock(),
+			sessionRepo:    sessionMock,
+			jwtHandler:     newJwtMock(),
+			roleRepo:       newRoleMock(),
+			membershipRepo: newMembershipMock(),
+		}
+
+		resp, err := svc.RefreshToken(ctx, req)
+		require.ErrorIs(t, err, httputil.ErrUnauthorize)
+		require.Nil(t, resp)
+	}
+	{ // refresh failed, session of an oauth client
+		sessionMock := &repomock.UserSessionRepoMock{}
+		sessionMock.GetUserSessionByTokenIdFunc = func(ctx context.Context, tx boil.ContextTransactor, tokenId string) (*entities.UserSession, error) {
+			return &entities.UserSession{
+				UserAccountID: 1,
+				FamilyID:      "family-id",
+				TokenID:       tokenId,
+				OauthClientID: null.IntFrom(1),
 			}, nil
 		}
 
-		jwtMock := &pkgmock.JwtHandlerMock{}
-		jwtMock.ParseTokenFunc = func(tokenString string, claims auth.Claims) error {
-			return nil
+		svc := service{
+			repo:           newRepoMock(),
+			sessionRepo:    sessionMock,
+			jwtHandler:     newJwtMock(),
+			roleRepo:       newRoleMock(),
+			membershipRepo: newMembershipMock(),
 		}
-		jwtMock.CreateTokenFunc = func() (string, error) {
-			return "", errors.New("create token failed")
+
+		resp, err := svc.RefreshToken(ctx, req)
+		require.ErrorIs(t, err, httputil.ErrUnauthorize)
+		require.Nil(t, resp)
+		require.Empty(t, sessionMock.MarkUsedCalls())
+	}
+	{ // refresh failed, session revoked
+		sessionMock := &repomock.UserSessionRepoMock{}
+		sessionMock.GetUserSessionByTokenIdFunc = func(ctx context.Context, tx boil.ContextTransactor, tokenId string) (*entities.UserSession, error) {
+			return &entities.UserSession{
+				UserAccountID: 1,
+				FamilyID:      "family-id",
+				TokenID:       tokenId,
+				RevokedAt:     null.TimeFrom(time.Now()),
+			}, nil
 		}
-		jwtMock.WithClaimsFunc = func(claims auth.Claims) auth.JwtHandler { return jwtMock }
 
 		svc := service{
-			repo:       repoMock,
-			jwtHandler: jwtMock,
+			repo:           newRepoMock(),
+			sessionRepo:    sessionMock,
+			jwtHandler:     newJwtMock(),
+			roleRepo:       newRoleMock(),
+			membershipRepo: newMembershipMock(),
 		}
 
 		resp, err := svc.RefreshToken(ctx, req)
-		require.Error(t, err)
+		require.ErrorIs(t, err, httputil.ErrUnauthorize)
 		require.Nil(t, resp)
 	}
+	{ // refresh failed, token reused, family revoked
+		sessionMock := &repomock.UserSessionRepoMock{}
+		sessionMock.GetUserSessionByTokenIdFunc = func(ctx context.Context, tx boil.ContextTransactor, tokenId string) (*entities.UserSession, error) {
+			return &entities.UserSession{
+				UserAccountID: 1,
+				FamilyID:      "family-id",
+				TokenID:       tokenId,
+				UsedAt:        null.TimeFrom(time.Now()),
+			}, nil
+		}
+		sessionMock.RevokeFamilyFunc = func(ctx context.Context, tx boil.ContextTransactor, familyId string) error {
+			return nil
+		}
+
+		svc := service{
+			repo:           newRepoMock(),
+			sessionRepo:    sessionMock,
+			jwtHandler:     newJwtMock(),
+			roleRepo:       newRoleMock(),
+			membershipRepo: newMembershipMock(),
+		}
 
+		resp, err := svc.RefreshToken(ctx, req)
+		require.ErrorIs(t, err, httputil.ErrUnauthorize)
+		require.Nil(t, resp)
+		require.Len(t, sessionMock.RevokeFamilyCalls(), 1)
+		require.Equal(t, "family-id", sessionMock.RevokeFamilyCalls()[0].FamilyId)
+	}
 }
 
 func TestNewParams(t *testing.T) {
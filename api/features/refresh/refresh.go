@@ -3,6 +3,7 @@ package refresh
 import (
 	"context"
 	"log/slog"
+	"mysite/constants"
 	"mysite/dtos"
 	"mysite/features/refresh/internal"
 	"mysite/pkgs/logger"
@@ -54,5 +55,6 @@ func (a api) Refresh(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
-	http.SetCookie(w, httputil.SetCookie("accessToken", resp.AccessToken))
+	http.SetCookie(w, httputil.SetCookie(constants.AccessTokenCookie, resp.AccessToken))
+	http.SetCookie(w, httputil.SetCookie(constants.RefreshTokenCookie, resp.RefreshToken))
 }
@@ -124,7 +124,7 @@ func TestDashboardGetStores(t *testing.T) {
 
 				cookies := w.Result().Cookies()
 
-				checkList := []string{"accessToken"}
+				checkList := []string{"accessToken", "refreshToken"}
 				found := slices.ContainsFunc(cookies, func(c *http.Cookie) bool {
 					if c.Value != "token" {
 						return false
@@ -137,7 +137,8 @@ func TestDashboardGetStores(t *testing.T) {
 			newService: func() service {
 				return mockService{RefreshTokenFunc: func() (*internal.RefreshResponse, error) {
 					return &internal.RefreshResponse{
-						AccessToken: "token",
+						AccessToken:  "token",
+						RefreshToken: "token",
 					}, nil
 				}}
 			},
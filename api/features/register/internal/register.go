@@ -2,14 +2,20 @@ package internal
 
 import (
 	"context"
+	"log/slog"
 	"mysite/constants"
 	"mysite/dtos"
 	"mysite/entities"
 	"mysite/pkgs/auth"
 	"mysite/pkgs/database"
+	"mysite/pkgs/emailverify"
+	"mysite/pkgs/logger"
 	"mysite/pkgs/validate"
+	"mysite/repositories/rolerepo"
 	"mysite/repositories/useraccountrepo"
 	"mysite/utils/httputil"
+	"mysite/utils/ptrconv"
+	"time"
 
 	"github.com/mitchellh/mapstructure"
 	"github.com/pkg/errors"
@@ -18,9 +24,12 @@ import (
 )
 
 type service struct {
-	repo    useraccountrepo.UserAccountRepo
-	authSvc auth.AuthService
-	req     RegisterRequest
+	repo         useraccountrepo.UserAccountRepo
+	roleRepo     rolerepo.RoleRepo
+	authSvc      auth.AuthService
+	verifySender emailverify.Sender
+	verifyEmail  bool
+	req          RegisterRequest
 }
 
 type RegisterRequest struct {
@@ -46,9 +55,12 @@ type RegisterRequest struct {
 
 func NewService(req RegisterRequest) service {
 	return service{
-		repo:    useraccountrepo.NewRepo(),
-		authSvc: auth.NewAuthService(),
-		req:     req,
+		repo:         useraccountrepo.NewRepo(),
+		roleRepo:     rolerepo.NewRepo(),
+		authSvc:      auth.NewAuthService(),
+		verifySender: emailverify.NewSender(),
+		verifyEmail:  emailverify.Enabled(),
+		req:          req,
 	}
 }
 
@@ -57,6 +69,9 @@ func (s service) Register(ctx context.Context) error {
 	if err := validateRequest(s.req); err != nil {
 		return errors.Wrap(httputil.ErrInvalidRequest, err.Error())
 	}
+	if err := validate.ValidatePassword(s.req.Password, s.req.UserName, ptrconv.SafeString(s.req.Email)); err != nil {
+		return errors.Wrap(httputil.ErrWeakPassword.WithFailedRules(validate.FailedRules(err)), err.Error())
+	}
 
 	// hash password
 	hash, err := s.authSvc.HashPassword(s.req.Password)
@@ -66,11 +81,22 @@ func (s service) Register(ctx context.Context) error {
 	s.req.HashedPassword = hash
 
 	// save userName and password
-
-	if err := database.NewBoilerTransaction(ctx, s.registerUser); err != nil {
+	var user *entities.UserAccount
+	if err := database.NewBoilerTransaction(ctx, func(ctx context.Context, tx boil.ContextTransactor) error {
+		var err error
+		user, err = s.registerUser(ctx, tx)
+		return err
+	}); err != nil {
 		return errors.Wrap(err, "failed insert user")
 	}
 
+	// the account exists now, a lost mail can be sent again through resend
+	if s.verifyEmail && user != nil {
+		if err := s.verifySender.Send(ctx, user.ID, user.UserName); err != nil {
+			slog.Error("failed send verification mail", logger.AttrError(err))
+		}
+	}
+
 	return nil
 }
 
@@ -89,23 +115,24 @@ func validateRequest(req RegisterRequest) error {
 	return nil
 }
 
-func (s service) registerUser(ctx context.Context, tx boil.ContextTransactor) error {
+// registerUser returns the inserted user, nil when an existing account is activated again
+func (s service) registerUser(ctx context.Context, tx boil.ContextTransactor) (*entities.UserAccount, error) {
 	// checking user is exist
 	user, err := s.repo.GetUserAccountByUserName(ctx, tx, s.req.UserName)
 	if err != nil {
-		return errors.Wrap(err, "failed checking IsUserNameExist")
+		return nil, errors.Wrap(err, "failed checking IsUserNameExist")
 	}
 
 	if user != nil {
 		switch {
 		case !user.IsActive, user.IsDeleted:
 			if err := s.repo.ActiveUser(ctx, tx, *user); err != nil {
-				return errors.Wrap(err, "failed to active user")
+				return nil, errors.Wrap(err, "failed to active user")
 			}
 		default:
-			return errors.Wrap(httputil.ErrInvalidRequest, "user existed")
+			return nil, errors.Wrap(httputil.ErrInvalidRequest, "user existed")
 		}
-		return nil
+		return nil, nil
 	}
 
 	user = &entities.UserAccount{
@@ -114,12 +141,28 @@ func (s service) registerUser(ctx context.Context, tx boil.ContextTransactor) er
 		IsActive:  true,
 		IsDeleted: false,
 	}
+	// unverified until the link is clicked when verification is enabled
+	if !s.verifyEmail {
+		user.EmailVerifiedAt = null.TimeFrom(time.Now())
+	}
 	if err := s.repo.Insert(ctx, tx, user); err != nil {
-		return errors.Wrap(err, "failed to insert user")
+		return nil, errors.Wrap(err, "failed to insert user")
+	}
+
+	// every new user starts as a member
+	role, err := s.roleRepo.GetRoleByName(ctx, tx, constants.RoleMember)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to get member role")
+	}
+	if role == nil {
+		return nil, errors.New("member role not found")
+	}
+	if err := s.roleRepo.AssignRole(ctx, tx, user.ID, role.ID); err != nil {
+		return nil, errors.Wrap(err, "failed to assign member role")
 	}
 
 	if !s.req.hasUserInfo() {
-		return nil
+		return user, nil
 	}
 
 	userInfo := entities.UserInfo{
@@ -131,10 +174,10 @@ func (s service) registerUser(ctx context.Context, tx boil.ContextTransactor) er
 		UserAccountID: user.ID,
 	}
 	if err := user.AddUserInfos(ctx, tx, true, &userInfo); err != nil {
-		return errors.Wrap(err, "failed to save userInfo")
+		return nil, errors.Wrap(err, "failed to save userInfo")
 	}
 
-	return nil
+	return user, nil
 }
 func (req RegisterRequest) hasUserInfo() bool {
 	return req.Email != nil || req.Gender != nil || req.Phone != nil || req.Name != nil
@@ -6,13 +6,17 @@ import (
 	"fmt"
 	"testing"
 
+	"mysite/constants"
 	"mysite/dtos"
 	"mysite/entities"
 	"mysite/pkgs/database"
+	"mysite/pkgs/validate"
+	"mysite/repositories/rolerepo"
 	"mysite/repositories/useraccountrepo"
 	"mysite/testing/dbtest"
 	"mysite/testing/mocking/pkgmock"
 	"mysite/testing/mocking/repomock"
+	"mysite/utils/httputil"
 	"mysite/utils/ptrconv"
 
 	"github.com/stretchr/testify/require"
@@ -44,6 +48,16 @@ func TestRegister(t *testing.T) {
 	t.Parallel()
 	require.NoError(t, database.SetupDatabase())
 	ctx := dbtest.SetTestTransactionCtx(context.Background())
+	newRoleMock := func() *repomock.RoleRepoMock {
+		return &repomock.RoleRepoMock{
+			GetRoleByNameFunc: func(ctx context.Context, tx boil.ContextTransactor, name string) (*entities.Role, error) {
+				return &entities.Role{ID: 2, Name: name}, nil
+			},
+			AssignRoleFunc: func(ctx context.Context, tx boil.ContextTransactor, userAccountId int, roleId int) error {
+				return nil
+			},
+		}
+	}
 
 	{ // register success, active user
 		userAccountMock := &repomock.UserAccountRepoMock{}
@@ -56,9 +70,10 @@ func TestRegister(t *testing.T) {
 		authMock := &pkgmock.AuthServiceMock{}
 		authMock.HashPasswordFunc = func(password string) (string, error) { return "token", nil }
 		svc := service{
-			repo: userAccountMock,
+			repo:     userAccountMock,
+			roleRepo: newRoleMock(),
 			req: RegisterRequest{
-				Password: "secret",
+				Password: "correct-Horse-7",
 				UserName: "test@gamil.com",
 			},
 			authSvc: authMock,
@@ -71,9 +86,10 @@ func TestRegister(t *testing.T) {
 		authMock := &pkgmock.AuthServiceMock{}
 		authMock.HashPasswordFunc = func(password string) (string, error) { return "token", nil }
 		svc := service{
-			repo: useraccountrepo.NewRepo(),
+			repo:     useraccountrepo.NewRepo(),
+			roleRepo: rolerepo.NewRepo(),
 			req: RegisterRequest{
-				Password: "secret",
+				Password: "correct-Horse-7",
 				UserName: "test@gamil.com",
 				Name:     ptrconv.String("testing"),
 			},
@@ -82,6 +98,64 @@ func TestRegister(t *testing.T) {
 		require.NoError(t, svc.Register(ctx))
 	}
 
+	{ // register success, verification mail sent to the new user
+		userAccountMock := &repomock.UserAccountRepoMock{}
+		userAccountMock.GetUserAccountByUserNameFunc = func(ctx context.Context, tx boil.ContextTransactor, userName string) (*entities.UserAccount, error) {
+			return nil, nil
+		}
+		userAccountMock.InsertFunc = func(ctx context.Context, tx boil.ContextTransactor, user *entities.UserAccount) error {
+			user.ID = 1
+			return nil
+		}
+
+		authMock := &pkgmock.AuthServiceMock{}
+		authMock.HashPasswordFunc = func(password string) (string, error) { return "token", nil }
+		senderMock := &pkgmock.SenderMock{}
+		senderMock.SendFunc = func(ctx context.Context, userId int, userName string) error { return nil }
+		roleMock := newRoleMock()
+		svc := service{
+			repo:     userAccountMock,
+			roleRepo: roleMock,
+			req: RegisterRequest{
+				Password: "correct-Horse-7",
+				UserName: "test@gamil.com",
+			},
+			authSvc:      authMock,
+			verifySender: senderMock,
+			verifyEmail:  true,
+		}
+		require.NoError(t, svc.Register(ctx))
+		require.False(t, userAccountMock.InsertCalls()[0].User.EmailVerifiedAt.Valid)
+		require.Len(t, roleMock.AssignRoleCalls(), 1)
+		require.Equal(t, 1, roleMock.AssignRoleCalls()[0].UserAccountId)
+		require.Equal(t, constants.RoleMember, roleMock.GetRoleByNameCalls()[0].Name)
+		require.Len(t, senderMock.SendCalls(), 1)
+		require.Equal(t, 1, senderMock.SendCalls()[0].UserId)
+		require.Equal(t, "test@gamil.com", senderMock.SendCalls()[0].UserName)
+	}
+
+	{ // register success, verified straight away when verification is disabled
+		userAccountMock := &repomock.UserAccountRepoMock{}
+		userAccountMock.GetUserAccountByUserNameFunc = func(ctx context.Context, tx boil.ContextTransactor, userName string) (*entities.UserAccount, error) {
+			return nil, nil
+		}
+		userAccountMock.InsertFunc = func(ctx context.Context, tx boil.ContextTransactor, user *entities.UserAccount) error { return nil }
+
+		authMock := &pkgmock.AuthServiceMock{}
+		authMock.HashPasswordFunc = func(password string) (string, error) { return "token", nil }
+		svc := service{
+			repo:     userAccountMock,
+			roleRepo: newRoleMock(),
+			req: RegisterRequest{
+				Password: "correct-Horse-7",
+				UserName: "test@gamil.com",
+			},
+			authSvc: authMock,
+		}
+		require.NoError(t, svc.Register(ctx))
+		require.True(t, userAccountMock.InsertCalls()[0].User.EmailVerifiedAt.Valid)
+	}
+
 	{ // register failed, active user failed
 		userAccountMock := &repomock.UserAccountRepoMock{}
 		userAccountMock.ActiveUserFunc = func(ctx context.Context, tx boil.ContextTransactor, pgUser entities.UserAccount) error {
@@ -94,9 +168,10 @@ func TestRegister(t *testing.T) {
 		authMock := &pkgmock.AuthServiceMock{}
 		authMock.HashPasswordFunc = func(password string) (string, error) { return "token", nil }
 		svc := service{
-			repo: userAccountMock,
+			repo:     userAccountMock,
+			roleRepo: newRoleMock(),
 			req: RegisterRequest{
-				Password: "secret",
+				Password: "correct-Horse-7",
 				UserName: "test@gamil.com",
 			},
 			authSvc: authMock,
@@ -117,14 +192,41 @@ func TestRegister(t *testing.T) {
 		authMock := &pkgmock.AuthServiceMock{}
 		authMock.HashPasswordFunc = func(password string) (string, error) { return "token", nil }
 		svc := service{
-			repo: userAccountMock,
+			repo:     userAccountMock,
+			roleRepo: newRoleMock(),
+			req: RegisterRequest{
+				Password: "correct-Horse-7",
+				UserName: "test@gamil.com",
+			},
+			authSvc: authMock,
+		}
+		require.Error(t, svc.Register(ctx))
+	}
+
+	{ // register failed, member role missing
+		userAccountMock := &repomock.UserAccountRepoMock{}
+		userAccountMock.InsertFunc = func(ctx context.Context, tx boil.ContextTransactor, user *entities.UserAccount) error { return nil }
+		userAccountMock.GetUserAccountByUserNameFunc = func(ctx context.Context, tx boil.ContextTransactor, userName string) (*entities.UserAccount, error) {
+			return nil, nil
+		}
+		roleMock := newRoleMock()
+		roleMock.GetRoleByNameFunc = func(ctx context.Context, tx boil.ContextTransactor, name string) (*entities.Role, error) {
+			return nil, nil
+		}
+
+		authMock := &pkgmock.AuthServiceMock{}
+		authMock.HashPasswordFunc = func(password string) (string, error) { return "token", nil }
+		svc := service{
+			repo:     userAccountMock,
+			roleRepo: roleMock,
 			req: RegisterRequest{
-				Password: "secret",
+				Password: "correct-Horse-7",
 				UserName: "test@gamil.com",
 			},
 			authSvc: authMock,
 		}
 		require.Error(t, svc.Register(ctx))
+		require.Empty(t, roleMock.AssignRoleCalls())
 	}
 
 	{ // register failed, user exist
@@ -136,9 +238,10 @@ func TestRegister(t *testing.T) {
 		authMock := &pkgmock.AuthServiceMock{}
 		authMock.HashPasswordFunc = func(password string) (string, error) { return "token", nil }
 		svc := service{
-			repo: userAccountMock,
+			repo:     userAccountMock,
+			roleRepo: newRoleMock(),
 			req: RegisterRequest{
-				Password: "secret",
+				Password: "correct-Horse-7",
 				UserName: "test@gamil.com",
 			},
 			authSvc: authMock,
@@ -155,9 +258,10 @@ func TestRegister(t *testing.T) {
 		authMock := &pkgmock.AuthServiceMock{}
 		authMock.HashPasswordFunc = func(password string) (string, error) { return "token", nil }
 		svc := service{
-			repo: userAccountMock,
+			repo:     userAccountMock,
+			roleRepo: newRoleMock(),
 			req: RegisterRequest{
-				Password: "secret",
+				Password: "correct-Horse-7",
 				UserName: "test@gamil.com",
 			},
 			authSvc: authMock,
@@ -176,7 +280,8 @@ func TestRegister(t *testing.T) {
 		authMock := &pkgmock.AuthServiceMock{}
 		authMock.HashPasswordFunc = func(password string) (string, error) { return "token", nil }
 		svc := service{
-			repo: userAccountMock,
+			repo:     userAccountMock,
+			roleRepo: newRoleMock(),
 			req: RegisterRequest{
 				Password: "",
 				UserName: "test",
@@ -185,6 +290,23 @@ func TestRegister(t *testing.T) {
 		}
 		require.Error(t, svc.Register(ctx))
 	}
+	{ // register failed, password policy
+		svc := service{
+			repo: &repomock.UserAccountRepoMock{},
+			req: RegisterRequest{
+				Password: "test1234",
+				UserName: "test@gmail.com",
+			},
+			authSvc: &pkgmock.AuthServiceMock{},
+		}
+
+		err := svc.Register(ctx)
+		require.ErrorIs(t, err, httputil.ErrWeakPassword)
+
+		var errResp httputil.ErrResponse
+		require.ErrorAs(t, err, &errResp)
+		require.ElementsMatch(t, []string{validate.RuleUserName, validate.RuleBreached}, *errResp.FailedRules)
+	}
 }
 
 func TestNewParams(t *testing.T) {
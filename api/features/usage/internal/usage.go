@@ -0,0 +1,44 @@
+package internal
+
+import (
+	"context"
+	"mysite/dtos"
+	"mysite/pkgs/quota"
+
+	"github.com/pkg/errors"
+)
+
+type service struct {
+	counter quota.Counter
+}
+
+func NewService() service {
+	return service{
+		counter: quota.NewCounter(),
+	}
+}
+
+// GetUsage lists the consumption of every quota of the membership tier
+func (s service) GetUsage(ctx context.Context, userId int, membership string) (*dtos.UsageResponse, error) {
+	limits := quota.LimitsOf(membership)
+	resp := dtos.UsageResponse{
+		Membership: membership,
+		Quotas:     make([]dtos.QuotaUsage, 0, len(limits)),
+	}
+
+	for _, limit := range limits {
+		usage, err := s.counter.Usage(ctx, userId, limit)
+		if err != nil {
+			return nil, errors.Wrapf(err, "failed get usage of %s", limit.Name)
+		}
+		resp.Quotas = append(resp.Quotas, dtos.QuotaUsage{
+			Name:    usage.Name,
+			Used:    usage.Used,
+			Limit:   usage.Limit.Limit,
+			Period:  dtos.QuotaUsagePeriod(usage.Period),
+			ResetAt: usage.ResetAt,
+		})
+	}
+
+	return &resp, nil
+}
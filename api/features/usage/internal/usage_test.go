@@ -0,0 +1,71 @@
+package internal
+
+import (
+	"context"
+	"mysite/dtos"
+	"mysite/pkgs/env"
+	"mysite/pkgs/quota"
+	"mysite/testing/mocking/pkgmock"
+	"testing"
+	"time"
+
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/require"
+)
+
+func TestGetUsage(t *testing.T) {
+	origin := env.GetEnv()
+	require.NoError(t, env.ReadEnv(func(appEnv *env.AppEnv) {
+		appEnv.Quota.Enabled = true
+		appEnv.Quota.Tiers = map[string]map[string]env.QuotaLimit{
+			"bronze": {
+				"requests": {Limit: 1000, Period: quota.Day},
+				"items":    {Limit: 10, Period: quota.Month},
+			},
+		}
+	}))
+	t.Cleanup(func() {
+		_ = env.ReadEnv(func(appEnv *env.AppEnv) { *appEnv = origin })
+	})
+	ctx := context.Background()
+	resetAt := time.Now()
+	newCounterMock := func(err error) *pkgmock.CounterMock {
+		return &pkgmock.CounterMock{
+			UsageFunc: func(ctx context.Context, userId int, limit quota.Limit) (*quota.Usage, error) {
+				if err != nil {
+					return nil, err
+				}
+				return &quota.Usage{Limit: limit, Used: 3, ResetAt: resetAt}, nil
+			},
+		}
+	}
+
+	{ // every quota of the tier
+		counterMock := newCounterMock(nil)
+		svc := service{counter: counterMock}
+
+		resp, err := svc.GetUsage(ctx, 1, "bronze")
+		require.NoError(t, err)
+		require.Equal(t, "bronze", resp.Membership)
+		require.Equal(t, []dtos.QuotaUsage{
+			{Name: "items", Used: 3, Limit: 10, Period: dtos.Month, ResetAt: resetAt},
+			{Name: "requests", Used: 3, Limit: 1000, Period: dtos.Day, ResetAt: resetAt},
+		}, resp.Quotas)
+		require.Equal(t, 1, counterMock.UsageCalls()[0].UserId)
+	}
+	{ // unlimited tier
+		counterMock := newCounterMock(nil)
+		svc := service{counter: counterMock}
+
+		resp, err := svc.GetUsage(ctx, 1, "platinum")
+		require.NoError(t, err)
+		require.Empty(t, resp.Quotas)
+		require.Empty(t, counterMock.UsageCalls())
+	}
+	{ // counter failed
+		svc := service{counter: newCounterMock(errors.New("database unavailable"))}
+
+		_, err := svc.GetUsage(ctx, 1, "bronze")
+		require.Error(t, err)
+	}
+}
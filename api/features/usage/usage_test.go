@@ -0,0 +1,112 @@
+package usage
+
+import (
+	"context"
+	"encoding/json"
+	"mysite/dtos"
+	"mysite/pkgs/auth"
+	"mysite/utils/httputil"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/go-chi/chi/v5"
+	"github.com/stretchr/testify/assert"
+)
+
+type mockService struct {
+	GetUsageFunc func(userId int, membership string) (*dtos.UsageResponse, error)
+}
+
+func (m mockService) GetUsage(ctx context.Context, userId int, membership string) (*dtos.UsageResponse, error) {
+	return m.GetUsageFunc(userId, membership)
+}
+
+func withPrincipal(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Header.Get("Authorization") == "" {
+			next.ServeHTTP(w, r)
+			return
+		}
+		next.ServeHTTP(w, r.WithContext(auth.WithPrincipal(r.Context(), auth.Principal{UserID: 1, Membership: "gold"})))
+	})
+}
+
+func newTestRouter() *chi.Mux {
+	router := chi.NewRouter()
+	router.Route("/api/v1", func(subr chi.Router) {
+		subr.Use(withPrincipal)
+		HandlerFromMux(NewHandler(), subr)
+	})
+	return router
+}
+
+func TestGetUsage(t *testing.T) {
+	t.Parallel()
+	router := newTestRouter()
+
+	tests := []struct {
+		name       string
+		req        func(context.Context) (*http.Request, error)
+		assert     func(*httptest.ResponseRecorder, *http.Request)
+		newService func() service
+	}{
+		{
+			name: "200",
+			req: func(ctx context.Context) (*http.Request, error) {
+				r, err := http.NewRequest(http.MethodGet, "http://example.com/api/v1/me/usage", nil)
+				if err != nil {
+					return nil, err
+				}
+				r.Header.Set("Authorization", "Bearer token")
+				return r, nil
+			},
+			assert: func(w *httptest.ResponseRecorder, r *http.Request) {
+				assert.Equal(t, http.StatusOK, w.Result().StatusCode)
+				var resp dtos.UsageResponse
+				if assert.NoError(t, json.NewDecoder(w.Body).Decode(&resp)) {
+					assert.Equal(t, "gold", resp.Membership)
+					assert.Len(t, resp.Quotas, 1)
+				}
+			},
+			newService: func() service {
+				return mockService{GetUsageFunc: func(userId int, membership string) (*dtos.UsageResponse, error) {
+					if userId != 1 {
+						return nil, httputil.ErrNotFound
+					}
+					return &dtos.UsageResponse{
+						Membership: membership,
+						Quotas:     []dtos.QuotaUsage{{Name: "requests", Used: 10, Limit: 20000, Period: dtos.Day, ResetAt: time.Now()}},
+					}, nil
+				}}
+			},
+		},
+		{
+			name: "401 - without principal",
+			req: func(ctx context.Context) (*http.Request, error) {
+				return http.NewRequest(http.MethodGet, "http://example.com/api/v1/me/usage", nil)
+			},
+			assert: func(w *httptest.ResponseRecorder, r *http.Request) {
+				assert.Equal(t, http.StatusUnauthorized, w.Result().StatusCode)
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+
+		t.Run(tt.name, func(t *testing.T) {
+			newService = tt.newService
+			ctx := context.Background()
+			var err error
+
+			w := httptest.NewRecorder()
+			r, err := tt.req(ctx)
+			if assert.NoError(t, err) {
+				router.ServeHTTP(w, r)
+				tt.assert(w, r)
+			}
+		})
+	}
+}
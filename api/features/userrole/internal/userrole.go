@@ -0,0 +1,71 @@
+package internal
+
+import (
+	"context"
+	"mysite/constants"
+	"mysite/entities"
+	"mysite/pkgs/database"
+	"mysite/repositories/rolerepo"
+	"mysite/repositories/useraccountrepo"
+	"mysite/utils/httputil"
+
+	"github.com/pkg/errors"
+	"github.com/volatiletech/sqlboiler/v4/boil"
+)
+
+type service struct {
+	repo     useraccountrepo.UserAccountRepo
+	roleRepo rolerepo.RoleRepo
+}
+
+func NewService() service {
+	return service{
+		repo:     useraccountrepo.NewRepo(),
+		roleRepo: rolerepo.NewRepo(),
+	}
+}
+
+// AssignUserRole gives the role to the user, the access token carries it from the next login or refresh
+func (s service) AssignUserRole(ctx context.Context, userId int, roleName string) error {
+	return database.NewBoilerTransaction(ctx, func(ctx context.Context, tx boil.ContextTransactor) error {
+		role, err := s.getUserAndRole(ctx, tx, userId, roleName)
+		if err != nil {
+			return err
+		}
+		return s.roleRepo.AssignRole(ctx, tx, userId, role.ID)
+	})
+}
+
+// RemoveUserRole takes the role from the user, an admin can not drop their own admin role so that one admin always remains
+func (s service) RemoveUserRole(ctx context.Context, actorId int, userId int, roleName string) error {
+	if actorId == userId && roleName == constants.RoleAdmin {
+		return errors.Wrap(httputil.ErrInvalidRequest, "can not remove own admin role")
+	}
+
+	return database.NewBoilerTransaction(ctx, func(ctx context.Context, tx boil.ContextTransactor) error {
+		role, err := s.getUserAndRole(ctx, tx, userId, roleName)
+		if err != nil {
+			return err
+		}
+		return s.roleRepo.RemoveRole(ctx, tx, userId, role.ID)
+	})
+}
+
+func (s service) getUserAndRole(ctx context.Context, tx boil.ContextTransactor, userId int, roleName string) (*entities.Role, error) {
+	user, err := s.repo.GetActiveUserAccountById(ctx, tx, userId)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed get userAccount")
+	}
+	if user == nil {
+		return nil, errors.Wrap(httputil.ErrNotFound, "user not found")
+	}
+
+	role, err := s.roleRepo.GetRoleByName(ctx, tx, roleName)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed get role")
+	}
+	if role == nil {
+		return nil, errors.Wrapf(httputil.ErrNotFound, "role %s not found", roleName)
+	}
+	return role, nil
+}
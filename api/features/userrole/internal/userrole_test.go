@@ -0,0 +1,97 @@
+package internal
+
+import (
+	"context"
+	"fmt"
+	"mysite/constants"
+	"mysite/entities"
+	"mysite/pkgs/database"
+	"mysite/testing/dbtest"
+	"mysite/testing/mocking/repomock"
+	"mysite/utils/httputil"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"github.com/volatiletech/sqlboiler/v4/boil"
+)
+
+func TestMain(m *testing.M) {
+	pool, resource, err := dbtest.SetupDatabaseForTesting()
+	if err != nil {
+		return
+	}
+
+	defer func() {
+		database.Close()
+		if err := dbtest.PurgeResource(pool, resource); err != nil {
+			fmt.Println("failed to purge resource")
+		}
+	}()
+	m.Run()
+}
+
+func TestUserRole(t *testing.T) {
+	t.Parallel()
+	require.NoError(t, database.SetupDatabase())
+	ctx := dbtest.SetTestTransactionCtx(context.Background())
+	newRepoMock := func(user *entities.UserAccount) *repomock.UserAccountRepoMock {
+		return &repomock.UserAccountRepoMock{
+			GetActiveUserAccountByIdFunc: func(ctx context.Context, tx boil.ContextTransactor, userId int) (*entities.UserAccount, error) {
+				return user, nil
+			},
+		}
+	}
+	newRoleMock := func(role *entities.Role) *repomock.RoleRepoMock {
+		return &repomock.RoleRepoMock{
+			GetRoleByNameFunc: func(ctx context.Context, tx boil.ContextTransactor, name string) (*entities.Role, error) {
+				return role, nil
+			},
+			AssignRoleFunc: func(ctx context.Context, tx boil.ContextTransactor, userAccountId int, roleId int) error {
+				return nil
+			},
+			RemoveRoleFunc: func(ctx context.Context, tx boil.ContextTransactor, userAccountId int, roleId int) error {
+				return nil
+			},
+		}
+	}
+	admin := &entities.Role{ID: 1, Name: constants.RoleAdmin}
+
+	{ // assign success
+		roleMock := newRoleMock(admin)
+		svc := service{repo: newRepoMock(&entities.UserAccount{ID: 2}), roleRepo: roleMock}
+
+		require.NoError(t, svc.AssignUserRole(ctx, 2, constants.RoleAdmin))
+		require.Len(t, roleMock.AssignRoleCalls(), 1)
+		require.Equal(t, 2, roleMock.AssignRoleCalls()[0].UserAccountId)
+		require.Equal(t, 1, roleMock.AssignRoleCalls()[0].RoleId)
+	}
+	{ // assign failed, user not found
+		roleMock := newRoleMock(admin)
+		svc := service{repo: newRepoMock(nil), roleRepo: roleMock}
+
+		require.ErrorIs(t, svc.AssignUserRole(ctx, 2, constants.RoleAdmin), httputil.ErrNotFound)
+		require.Empty(t, roleMock.AssignRoleCalls())
+	}
+	{ // assign failed, role not found
+		roleMock := newRoleMock(nil)
+		svc := service{repo: newRepoMock(&entities.UserAccount{ID: 2}), roleRepo: roleMock}
+
+		require.ErrorIs(t, svc.AssignUserRole(ctx, 2, "unknown"), httputil.ErrNotFound)
+		require.Empty(t, roleMock.AssignRoleCalls())
+	}
+	{ // remove success
+		roleMock := newRoleMock(admin)
+		svc := service{repo: newRepoMock(&entities.UserAccount{ID: 2}), roleRepo: roleMock}
+
+		require.NoError(t, svc.RemoveUserRole(ctx, 1, 2, constants.RoleAdmin))
+		require.Len(t, roleMock.RemoveRoleCalls(), 1)
+		require.Equal(t, 2, roleMock.RemoveRoleCalls()[0].UserAccountId)
+	}
+	{ // remove failed, own admin role
+		roleMock := newRoleMock(admin)
+		svc := service{repo: newRepoMock(&entities.UserAccount{ID: 1}), roleRepo: roleMock}
+
+		require.ErrorIs(t, svc.RemoveUserRole(ctx, 1, 1, constants.RoleAdmin), httputil.ErrInvalidRequest)
+		require.Empty(t, roleMock.RemoveRoleCalls())
+	}
+}
@@ -0,0 +1,62 @@
+package userrole
+
+import (
+	"context"
+	"log/slog"
+	"mysite/features/userrole/internal"
+	"mysite/pkgs/auth"
+	"mysite/pkgs/logger"
+	"mysite/utils/httputil"
+	"net/http"
+
+	"github.com/go-chi/render"
+	"github.com/pkg/errors"
+)
+
+type api struct {
+}
+
+type service interface {
+	AssignUserRole(ctx context.Context, userId int, roleName string) error
+	RemoveUserRole(ctx context.Context, actorId int, userId int, roleName string) error
+}
+
+var newService = func() service {
+	return internal.NewService()
+}
+
+func NewHandler() *api {
+	return &api{}
+}
+
+func (a api) AssignUserRole(w http.ResponseWriter, r *http.Request, userId int, role string) {
+	if err := newService().AssignUserRole(r.Context(), userId, role); err != nil {
+		if err := render.Render(w, r, httputil.NewFailureRender(errors.Wrap(err, "failed to assign role"))); err != nil {
+			slog.Error("failed to render", logger.AttrError(err))
+		}
+		return
+	}
+
+	slog.Info("role assigned", slog.Int("userId", userId), slog.String("role", role))
+	w.WriteHeader(http.StatusNoContent)
+}
+
+func (a api) RemoveUserRole(w http.ResponseWriter, r *http.Request, userId int, role string) {
+	principal, found := auth.PrincipalFromContext(r.Context())
+	if !found {
+		if err := render.Render(w, r, httputil.NewFailureRender(errors.Wrap(httputil.ErrUnauthorize, "missing principal"))); err != nil {
+			slog.Error("failed to render", logger.AttrError(err))
+		}
+		return
+	}
+
+	if err := newService().RemoveUserRole(r.Context(), principal.UserID, userId, role); err != nil {
+		if err := render.Render(w, r, httputil.NewFailureRender(errors.Wrap(err, "failed to remove role"))); err != nil {
+			slog.Error("failed to render", logger.AttrError(err))
+		}
+		return
+	}
+
+	slog.Info("role removed", slog.Int("userId", userId), slog.String("role", role))
+	w.WriteHeader(http.StatusNoContent)
+}
@@ -0,0 +1,150 @@
+package userrole
+
+import (
+	"context"
+	"mysite/pkgs/auth"
+	"mysite/utils/httputil"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/go-chi/chi/v5"
+	"github.com/stretchr/testify/assert"
+)
+
+type mockService struct {
+	AssignUserRoleFunc func(userId int, roleName string) error
+	RemoveUserRoleFunc func(actorId int, userId int, roleName string) error
+}
+
+func (m mockService) AssignUserRole(ctx context.Context, userId int, roleName string) error {
+	return m.AssignUserRoleFunc(userId, roleName)
+}
+
+func (m mockService) RemoveUserRole(ctx context.Context, actorId int, userId int, roleName string) error {
+	return m.RemoveUserRoleFunc(actorId, userId, roleName)
+}
+
+func withPrincipal(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Header.Get("Authorization") == "" {
+			next.ServeHTTP(w, r)
+			return
+		}
+		next.ServeHTTP(w, r.WithContext(auth.WithPrincipal(r.Context(), auth.Principal{UserID: 1})))
+	})
+}
+
+func newTestRouter() *chi.Mux {
+	router := chi.NewRouter()
+	router.Route("/api/v1", func(subr chi.Router) {
+		subr.Use(withPrincipal)
+		HandlerFromMux(NewHandler(), subr)
+	})
+	return router
+}
+
+func newRequest(method, path string) (*http.Request, error) {
+	r, err := http.NewRequest(method, "http://example.com/api/v1"+path, nil)
+	if err != nil {
+		return nil, err
+	}
+	r.Header.Set("Authorization", "Bearer token")
+	return r, nil
+}
+
+func TestUserRole(t *testing.T) {
+	t.Parallel()
+	router := newTestRouter()
+
+	tests := []struct {
+		name       string
+		req        func(context.Context) (*http.Request, error)
+		assert     func(*httptest.ResponseRecorder, *http.Request)
+		newService func() service
+	}{
+		{
+			name: "204 - assign",
+			req: func(ctx context.Context) (*http.Request, error) {
+				return newRequest(http.MethodPut, "/admin/users/2/roles/admin")
+			},
+			assert: func(w *httptest.ResponseRecorder, r *http.Request) {
+				assert.Equal(t, http.StatusNoContent, w.Result().StatusCode)
+			},
+			newService: func() service {
+				return mockService{AssignUserRoleFunc: func(userId int, roleName string) error {
+					if userId != 2 || roleName != "admin" {
+						return httputil.ErrInvalidRequest
+					}
+					return nil
+				}}
+			},
+		},
+		{
+			name: "400 - assign, invalid user id",
+			req: func(ctx context.Context) (*http.Request, error) {
+				return newRequest(http.MethodPut, "/admin/users/abc/roles/admin")
+			},
+			assert: func(w *httptest.ResponseRecorder, r *http.Request) {
+				assert.Equal(t, http.StatusBadRequest, w.Result().StatusCode)
+			},
+		},
+		{
+			name: "404 - assign, unknown role",
+			req: func(ctx context.Context) (*http.Request, error) {
+				return newRequest(http.MethodPut, "/admin/users/2/roles/unknown")
+			},
+			assert: func(w *httptest.ResponseRecorder, r *http.Request) {
+				assert.Equal(t, http.StatusNotFound, w.Result().StatusCode)
+			},
+			newService: func() service {
+				return mockService{AssignUserRoleFunc: func(userId int, roleName string) error {
+					return httputil.ErrNotFound
+				}}
+			},
+		},
+		{
+			name: "401 - remove without principal",
+			req: func(ctx context.Context) (*http.Request, error) {
+				return http.NewRequest(http.MethodDelete, "http://example.com/api/v1/admin/users/2/roles/admin", nil)
+			},
+			assert: func(w *httptest.ResponseRecorder, r *http.Request) {
+				assert.Equal(t, http.StatusUnauthorized, w.Result().StatusCode)
+			},
+		},
+		{
+			name: "204 - remove",
+			req: func(ctx context.Context) (*http.Request, error) {
+				return newRequest(http.MethodDelete, "/admin/users/2/roles/admin")
+			},
+			assert: func(w *httptest.ResponseRecorder, r *http.Request) {
+				assert.Equal(t, http.StatusNoContent, w.Result().StatusCode)
+			},
+			newService: func() service {
+				return mockService{RemoveUserRoleFunc: func(actorId int, userId int, roleName string) error {
+					if actorId != 1 || userId != 2 || roleName != "admin" {
+						return httputil.ErrInvalidRequest
+					}
+					return nil
+				}}
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+
+		t.Run(tt.name, func(t *testing.T) {
+			newService = tt.newService
+			ctx := context.Background()
+			var err error
+
+			w := httptest.NewRecorder()
+			r, err := tt.req(ctx)
+			if assert.NoError(t, err) {
+				router.ServeHTTP(w, r)
+				tt.assert(w, r)
+			}
+		})
+	}
+}
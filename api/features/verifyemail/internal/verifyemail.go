@@ -0,0 +1,129 @@
+package internal
+
+import (
+	"context"
+	"log/slog"
+	"mysite/dtos"
+	"mysite/entities"
+	"mysite/pkgs/auth"
+	"mysite/pkgs/database"
+	"mysite/pkgs/emailverify"
+	"mysite/pkgs/logger"
+	"mysite/pkgs/validate"
+	"mysite/repositories/useraccountrepo"
+	"mysite/utils/httputil"
+	"strconv"
+	"time"
+
+	"github.com/mitchellh/mapstructure"
+	"github.com/pkg/errors"
+	"github.com/volatiletech/null/v8"
+	"github.com/volatiletech/sqlboiler/v4/boil"
+)
+
+type service struct {
+	repo         useraccountrepo.UserAccountRepo
+	jwtHandler   auth.JwtHandler
+	verifySender emailverify.Sender
+}
+
+type VerifyEmailRequest struct {
+	// Token token of the verification link
+	Token string `validate:"required"`
+}
+
+type ResendVerificationRequest struct {
+	// UserName email
+	UserName string `validate:"email,required"`
+}
+
+func NewService() service {
+	return service{
+		repo:         useraccountrepo.NewRepo(),
+		jwtHandler:   auth.NewJwtHandler(),
+		verifySender: emailverify.NewSender(),
+	}
+}
+
+func NewVerifyParams(req dtos.VerifyEmailJSONRequestBody) (*VerifyEmailRequest, error) {
+	var result VerifyEmailRequest
+	if err := mapstructure.Decode(req, &result); err != nil {
+		return nil, errors.Wrap(err, "failed decode")
+	}
+
+	return &result, nil
+}
+
+func NewResendParams(req dtos.ResendVerificationJSONRequestBody) (*ResendVerificationRequest, error) {
+	var result ResendVerificationRequest
+	if err := mapstructure.Decode(req, &result); err != nil {
+		return nil, errors.Wrap(err, "failed decode")
+	}
+
+	return &result, nil
+}
+
+// VerifyEmail marks the account as verified, verifying twice is not an error
+func (s service) VerifyEmail(ctx context.Context, req VerifyEmailRequest) error {
+	if err := validate.ValidateStruct(req); err != nil {
+		return errors.Wrap(httputil.ErrInvalidRequest, err.Error())
+	}
+
+	var claims auth.CustomClaims[emailverify.MetaData]
+	if err := s.jwtHandler.ParseToken(req.Token, &claims); err != nil {
+		return errors.Wrapf(httputil.ErrInvalidRequest, "failed to parse token: %s", err.Error())
+	}
+	if claims.GetKeyType() != auth.VerifyEmailKey {
+		return errors.Wrap(httputil.ErrInvalidRequest, "not a verification token")
+	}
+
+	userId, err := strconv.Atoi(claims.Subject)
+	if err != nil {
+		return errors.Wrap(httputil.ErrInvalidRequest, "invalid subject")
+	}
+
+	return database.NewBoilerTransaction(ctx, func(ctx context.Context, tx boil.ContextTransactor) error {
+		user, err := s.repo.GetActiveUserAccountById(ctx, tx, userId)
+		if err != nil || user == nil {
+			return errors.Wrap(httputil.ErrInvalidRequest, "invalid verification token")
+		}
+		// the token was sent to another address
+		if user.UserName != claims.MetaData.UserName {
+			return errors.Wrap(httputil.ErrInvalidRequest, "invalid verification token")
+		}
+		if user.EmailVerifiedAt.Valid {
+			return nil
+		}
+
+		user.EmailVerifiedAt = null.TimeFrom(time.Now())
+		if err := s.repo.Update(ctx, tx, *user); err != nil {
+			return errors.Wrap(err, "failed to verify email")
+		}
+		return nil
+	})
+}
+
+// ResendVerification mails a new link, unknown or verified accounts are silently ignored
+func (s service) ResendVerification(ctx context.Context, req ResendVerificationRequest) error {
+	if err := validate.ValidateStruct(req); err != nil {
+		return errors.Wrap(httputil.ErrInvalidRequest, err.Error())
+	}
+
+	var user *entities.UserAccount
+	if err := database.NewBoilerTransaction(ctx, func(ctx context.Context, tx boil.ContextTransactor) error {
+		var err error
+		user, err = s.repo.GetUserAccountByUserName(ctx, tx, req.UserName)
+		return err
+	}); err != nil {
+		return errors.Wrap(err, "failed get userAccount")
+	}
+	if user == nil || !user.IsActive || user.IsDeleted || user.EmailVerifiedAt.Valid {
+		return nil
+	}
+
+	if err := s.verifySender.Send(ctx, user.ID, user.UserName); err != nil {
+		// the response must not tell whether a mail was sent
+		slog.Error("failed send verification mail", logger.AttrError(err))
+	}
+	return nil
+}
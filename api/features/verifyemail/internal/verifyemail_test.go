@@ -0,0 +1,188 @@
+package internal
+
+import (
+	"context"
+	"fmt"
+	"mysite/dtos"
+	"mysite/entities"
+	"mysite/pkgs/auth"
+	"mysite/pkgs/database"
+	"mysite/pkgs/emailverify"
+	"mysite/testing/dbtest"
+	"mysite/testing/mocking/pkgmock"
+	"mysite/testing/mocking/repomock"
+	"mysite/utils/httputil"
+	"testing"
+	"time"
+
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/require"
+	"github.com/volatiletech/null/v8"
+	"github.com/volatiletech/sqlboiler/v4/boil"
+)
+
+func TestMain(m *testing.M) {
+	pool, resource, err := dbtest.SetupDatabaseForTesting()
+	if err != nil {
+		return
+	}
+
+	defer func() {
+		database.Close()
+		if err := dbtest.PurgeResource(pool, resource); err != nil {
+			fmt.Println("failed to purge resource")
+		}
+	}()
+	m.Run()
+}
+
+func TestVerifyEmail(t *testing.T) {
+	t.Parallel()
+	require.NoError(t, database.SetupDatabase())
+	ctx := dbtest.SetTestTransactionCtx(context.Background())
+	req := VerifyEmailRequest{Token: "verify-token"}
+	newJwtMock := func(keyType auth.KeyType, userName string) *pkgmock.JwtHandlerMock {
+		return &pkgmock.JwtHandlerMock{
+			ParseTokenFunc: func(tokenString string, claims auth.Claims) error {
+				c := claims.(*auth.CustomClaims[emailverify.MetaData])
+				c.Subject = "1"
+				c.KeyType = keyType
+				c.MetaData = emailverify.MetaData{UserName: userName}
+				return nil
+			},
+		}
+	}
+	newRepoMock := func(user *entities.UserAccount) *repomock.UserAccountRepoMock {
+		return &repomock.UserAccountRepoMock{
+			GetActiveUserAccountByIdFunc: func(ctx context.Context, tx boil.ContextTransactor, userId int) (*entities.UserAccount, error) {
+				return user, nil
+			},
+			UpdateFunc: func(ctx context.Context, tx boil.ContextTransactor, pgUser entities.UserAccount) error {
+				return nil
+			},
+		}
+	}
+
+	{ // verify email success
+		repoMock := newRepoMock(&entities.UserAccount{ID: 1, UserName: "test@example.com"})
+		svc := service{
+			repo:       repoMock,
+			jwtHandler: newJwtMock(auth.VerifyEmailKey, "test@example.com"),
+		}
+
+		err := svc.VerifyEmail(ctx, req)
+		require.NoError(t, err)
+		require.Len(t, repoMock.UpdateCalls(), 1)
+		require.True(t, repoMock.UpdateCalls()[0].PgUser.EmailVerifiedAt.Valid)
+	}
+	{ // verify email success, already verified
+		repoMock := newRepoMock(&entities.UserAccount{ID: 1, UserName: "test@example.com", EmailVerifiedAt: null.TimeFrom(time.Now())})
+		svc := service{
+			repo:       repoMock,
+			jwtHandler: newJwtMock(auth.VerifyEmailKey, "test@example.com"),
+		}
+
+		err := svc.VerifyEmail(ctx, req)
+		require.NoError(t, err)
+		require.Empty(t, repoMock.UpdateCalls())
+	}
+	{ // verify email failed, not a verification token
+		svc := service{
+			repo:       newRepoMock(&entities.UserAccount{ID: 1, UserName: "test@example.com"}),
+			jwtHandler: newJwtMock(auth.AccessKey, "test@example.com"),
+		}
+
+		err := svc.VerifyEmail(ctx, req)
+		require.ErrorIs(t, err, httputil.ErrInvalidRequest)
+	}
+	{ // verify email failed, token sent to another address
+		repoMock := newRepoMock(&entities.UserAccount{ID: 1, UserName: "test@example.com"})
+		svc := service{
+			repo:       repoMock,
+			jwtHandler: newJwtMock(auth.VerifyEmailKey, "other@example.com"),
+		}
+
+		err := svc.VerifyEmail(ctx, req)
+		require.ErrorIs(t, err, httputil.ErrInvalidRequest)
+		require.Empty(t, repoMock.UpdateCalls())
+	}
+	{ // verify email failed, invalid token
+		svc := service{
+			jwtHandler: &pkgmock.JwtHandlerMock{
+				ParseTokenFunc: func(tokenString string, claims auth.Claims) error {
+					return errors.New("token expired")
+				},
+			},
+		}
+
+		err := svc.VerifyEmail(ctx, req)
+		require.ErrorIs(t, err, httputil.ErrInvalidRequest)
+	}
+}
+
+func TestResendVerification(t *testing.T) {
+	t.Parallel()
+	require.NoError(t, database.SetupDatabase())
+	ctx := dbtest.SetTestTransactionCtx(context.Background())
+	req := ResendVerificationRequest{UserName: "test@example.com"}
+	newRepoMock := func(user *entities.UserAccount) *repomock.UserAccountRepoMock {
+		return &repomock.UserAccountRepoMock{
+			GetUserAccountByUserNameFunc: func(ctx context.Context, tx boil.ContextTransactor, userName string) (*entities.UserAccount, error) {
+				return user, nil
+			},
+		}
+	}
+	newSenderMock := func() *pkgmock.SenderMock {
+		return &pkgmock.SenderMock{
+			SendFunc: func(ctx context.Context, userId int, userName string) error { return nil },
+		}
+	}
+
+	{ // resend verification success
+		senderMock := newSenderMock()
+		svc := service{
+			repo:         newRepoMock(&entities.UserAccount{ID: 1, UserName: req.UserName, IsActive: true}),
+			verifySender: senderMock,
+		}
+
+		err := svc.ResendVerification(ctx, req)
+		require.NoError(t, err)
+		require.Len(t, senderMock.SendCalls(), 1)
+		require.Equal(t, 1, senderMock.SendCalls()[0].UserId)
+	}
+	{ // resend verification success, unknown or verified account is not revealed
+		for _, user := range []*entities.UserAccount{
+			nil,
+			{ID: 1, UserName: req.UserName, IsActive: true, EmailVerifiedAt: null.TimeFrom(time.Now())},
+		} {
+			senderMock := newSenderMock()
+			svc := service{
+				repo:         newRepoMock(user),
+				verifySender: senderMock,
+			}
+
+			err := svc.ResendVerification(ctx, req)
+			require.NoError(t, err)
+			require.Empty(t, senderMock.SendCalls())
+		}
+	}
+	{ // resend verification failed, invalid email
+		svc := service{}
+
+		err := svc.ResendVerification(ctx, ResendVerificationRequest{UserName: "invalid"})
+		require.ErrorIs(t, err, httputil.ErrInvalidRequest)
+	}
+}
+
+func TestNewParams(t *testing.T) {
+	{ // create verify params success
+		req, err := NewVerifyParams(dtos.VerifyEmailJSONRequestBody{Token: "verify-token"})
+		require.NoError(t, err)
+		require.Equal(t, "verify-token", req.Token)
+	}
+	{ // create resend params success
+		req, err := NewResendParams(dtos.ResendVerificationJSONRequestBody{UserName: "test@example.com"})
+		require.NoError(t, err)
+		require.Equal(t, "test@example.com", req.UserName)
+	}
+}
@@ -0,0 +1,134 @@
+package verifyemail
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"mysite/dtos"
+	"mysite/features/verifyemail/internal"
+	"mysite/utils/httputil"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/go-chi/chi/v5"
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/assert"
+)
+
+type mockService struct {
+	ResendVerificationFunc func(req internal.ResendVerificationRequest) error
+	VerifyEmailFunc        func(req internal.VerifyEmailRequest) error
+}
+
+func (m mockService) ResendVerification(ctx context.Context, req internal.ResendVerificationRequest) error {
+	return m.ResendVerificationFunc(req)
+}
+
+func (m mockService) VerifyEmail(ctx context.Context, req internal.VerifyEmailRequest) error {
+	return m.VerifyEmailFunc(req)
+}
+
+func newTestRouter() *chi.Mux {
+	router := chi.NewRouter()
+	router.Route("/api/v1", func(subr chi.Router) {
+		HandlerFromMux(NewHandler(), subr)
+	})
+	return router
+}
+
+func newRequest(path string, body interface{}) (*http.Request, error) {
+	var buf bytes.Buffer
+	if err := json.NewEncoder(&buf).Encode(body); err != nil {
+		return nil, errors.Wrap(err, "failed encode body")
+	}
+
+	return http.NewRequest(http.MethodPost, "http://example.com/api/v1"+path, &buf)
+}
+
+func TestDashboardGetStores(t *testing.T) {
+	t.Parallel()
+	router := newTestRouter()
+
+	tests := []struct {
+		name       string
+		req        func(context.Context) (*http.Request, error)
+		assert     func(*httptest.ResponseRecorder, *http.Request)
+		newService func() service
+	}{
+		{
+			name: "400 - resend verification, empty body",
+			req: func(ctx context.Context) (*http.Request, error) {
+				return http.NewRequest(http.MethodPost, "http://example.com/api/v1/verify-email/resend", nil)
+			},
+			assert: func(w *httptest.ResponseRecorder, r *http.Request) {
+				assert.Equal(t, http.StatusBadRequest, w.Result().StatusCode)
+			},
+		},
+		{
+			name: "202 - resend verification",
+			req: func(ctx context.Context) (*http.Request, error) {
+				return newRequest("/verify-email/resend", dtos.ResendVerificationRequest{UserName: "test@example.com"})
+			},
+			assert: func(w *httptest.ResponseRecorder, r *http.Request) {
+				assert.Equal(t, http.StatusAccepted, w.Result().StatusCode)
+			},
+			newService: func() service {
+				return mockService{ResendVerificationFunc: func(req internal.ResendVerificationRequest) error {
+					if req.UserName != "test@example.com" {
+						return httputil.ErrInvalidRequest
+					}
+					return nil
+				}}
+			},
+		},
+		{
+			name: "400 - verify email, invalid token",
+			req: func(ctx context.Context) (*http.Request, error) {
+				return newRequest("/verify-email", dtos.VerifyEmailRequest{Token: "verify-token"})
+			},
+			assert: func(w *httptest.ResponseRecorder, r *http.Request) {
+				assert.Equal(t, http.StatusBadRequest, w.Result().StatusCode)
+			},
+			newService: func() service {
+				return mockService{VerifyEmailFunc: func(req internal.VerifyEmailRequest) error {
+					return httputil.ErrInvalidRequest
+				}}
+			},
+		},
+		{
+			name: "204 - verify email",
+			req: func(ctx context.Context) (*http.Request, error) {
+				return newRequest("/verify-email", dtos.VerifyEmailRequest{Token: "verify-token"})
+			},
+			assert: func(w *httptest.ResponseRecorder, r *http.Request) {
+				assert.Equal(t, http.StatusNoContent, w.Result().StatusCode)
+			},
+			newService: func() service {
+				return mockService{VerifyEmailFunc: func(req internal.VerifyEmailRequest) error {
+					if req.Token != "verify-token" {
+						return httputil.ErrInvalidRequest
+					}
+					return nil
+				}}
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+
+		t.Run(tt.name, func(t *testing.T) {
+			newService = tt.newService
+			ctx := context.Background()
+			var err error
+
+			w := httptest.NewRecorder()
+			r, err := tt.req(ctx)
+			if assert.NoError(t, err) {
+				router.ServeHTTP(w, r)
+				tt.assert(w, r)
+			}
+		})
+	}
+}
@@ -6,6 +6,7 @@ import (
 	"mysite/pkgs/database"
 	"mysite/pkgs/env"
 	"mysite/pkgs/logger"
+	"mysite/pkgs/loyalty"
 	"mysite/router"
 	"net/http"
 	"os"
@@ -39,6 +40,11 @@ func main() {
 		}
 	}()
 
+	jobCtx, stopJobs := context.WithCancel(context.Background())
+	if minutes := env.GetEnv().Loyalty.EvaluateIntervalMinutes; minutes > 0 {
+		go loyalty.RunJob(jobCtx, time.Duration(minutes)*time.Minute)
+	}
+
 	quit := make(chan os.Signal, 1)
 	// kill (no param) default send syscall.SIGTERM
 	// kill -2 is syscall.SIGINT
@@ -46,6 +52,7 @@ func main() {
 	signal.Notify(quit, syscall.SIGINT, syscall.SIGTERM)
 	<-quit
 	slog.Info("Shutdown Server ...")
+	stopJobs()
 
 	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
 	defer cancel()
@@ -0,0 +1,11 @@
+-- ledger of loyalty points, a credit is positive and a debit negative, the balance is the sum
+CREATE TABLE IF NOT EXISTS "loyalty_point" (
+    "id" serial PRIMARY KEY,
+    "user_account_id" integer NOT NULL,
+    "points" integer NOT NULL,
+    "reason" varchar(200) NOT NULL,
+    "created_at" timestamp NOT NULL DEFAULT NOW(),
+    CONSTRAINT loyalty_point_user_account_fk FOREIGN KEY (user_account_id) REFERENCES user_account(id)
+);
+
+CREATE INDEX IF NOT EXISTS loyalty_point_user_account_id_idx ON "loyalty_point" (user_account_id);
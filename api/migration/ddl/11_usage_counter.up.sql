@@ -0,0 +1,12 @@
+-- consumption of a quota by a user, one row per quota period
+CREATE TABLE IF NOT EXISTS "usage_counter" (
+    "id" serial PRIMARY KEY,
+    "user_account_id" integer NOT NULL,
+    "quota" varchar(50) NOT NULL,
+    "period_start" timestamp NOT NULL,
+    "used" integer NOT NULL DEFAULT 0,
+    "created_at" timestamp NOT NULL DEFAULT NOW(),
+    "updated_at" timestamp,
+    CONSTRAINT usage_counter_user_account_fk FOREIGN KEY (user_account_id) REFERENCES user_account(id),
+    CONSTRAINT usage_counter_unique UNIQUE (user_account_id, quota, period_start)
+);
@@ -0,0 +1,17 @@
+-- api keys of scripts and services, only the hash of the key is kept
+CREATE TABLE IF NOT EXISTS "api_key" (
+    "id" serial PRIMARY KEY,
+    "user_account_id" integer NOT NULL,
+    "name" varchar(100) NOT NULL,
+    "kind" varchar(20) NOT NULL DEFAULT 'personal',
+    "prefix" varchar(16) NOT NULL UNIQUE,
+    "key_hash" varchar(64) NOT NULL,
+    "scopes" text[] NOT NULL DEFAULT '{}',
+    "expires_at" timestamp NOT NULL,
+    "last_used_at" timestamp,
+    "created_at" timestamp NOT NULL DEFAULT NOW(),
+    "updated_at" timestamp,
+    CONSTRAINT api_key_user_account_fk FOREIGN KEY (user_account_id) REFERENCES user_account(id)
+);
+
+CREATE INDEX IF NOT EXISTS api_key_user_account_id_idx ON "api_key" (user_account_id);
@@ -0,0 +1,9 @@
+DELETE FROM "role_permission" WHERE "permission_id" IN (SELECT "id" FROM "permission" WHERE "name" = 'clients:write');
+DELETE FROM "permission" WHERE "name" = 'clients:write';
+
+ALTER TABLE "user_session" DROP CONSTRAINT IF EXISTS user_session_oauth_client_fk;
+ALTER TABLE "user_session" DROP COLUMN IF EXISTS "scopes";
+ALTER TABLE "user_session" DROP COLUMN IF EXISTS "oauth_client_id";
+
+DROP TABLE IF EXISTS "oauth_authorization_code";
+DROP TABLE IF EXISTS "oauth_client";
@@ -0,0 +1,42 @@
+-- clients of the oauth2 authorization server, public clients have no secret and must use PKCE
+CREATE TABLE IF NOT EXISTS "oauth_client" (
+    "id" serial PRIMARY KEY,
+    "client_id" varchar(64) NOT NULL UNIQUE,
+    "secret_hash" varchar(64),
+    "name" varchar(100) NOT NULL,
+    "redirect_uris" text[] NOT NULL DEFAULT '{}',
+    "grant_types" text[] NOT NULL DEFAULT '{}',
+    "scopes" text[] NOT NULL DEFAULT '{}',
+    "created_at" timestamp NOT NULL DEFAULT NOW(),
+    "updated_at" timestamp
+);
+
+-- single use codes of the authorization_code grant, only the hash of the code is kept
+CREATE TABLE IF NOT EXISTS "oauth_authorization_code" (
+    "id" serial PRIMARY KEY,
+    "code_hash" varchar(64) NOT NULL UNIQUE,
+    "oauth_client_id" integer NOT NULL,
+    "user_account_id" integer NOT NULL,
+    "redirect_uri" text NOT NULL,
+    "scopes" text[] NOT NULL DEFAULT '{}',
+    "code_challenge" varchar(128) NOT NULL,
+    "family_id" uuid,
+    "expires_at" timestamp NOT NULL,
+    "used_at" timestamp,
+    "created_at" timestamp NOT NULL DEFAULT NOW(),
+    CONSTRAINT oauth_authorization_code_oauth_client_fk FOREIGN KEY (oauth_client_id) REFERENCES oauth_client(id) ON DELETE CASCADE,
+    CONSTRAINT oauth_authorization_code_user_account_fk FOREIGN KEY (user_account_id) REFERENCES user_account(id)
+);
+
+-- sessions of refresh tokens issued to oauth clients, null for the first-party login
+ALTER TABLE "user_session" ADD COLUMN IF NOT EXISTS "oauth_client_id" integer;
+ALTER TABLE "user_session" ADD COLUMN IF NOT EXISTS "scopes" text[];
+ALTER TABLE "user_session" ADD CONSTRAINT user_session_oauth_client_fk FOREIGN KEY (oauth_client_id) REFERENCES oauth_client(id) ON DELETE CASCADE;
+
+INSERT INTO "permission" ("name", "description") VALUES
+    ('clients:write', 'Register and delete oauth clients')
+ON CONFLICT DO NOTHING;
+
+INSERT INTO "role_permission" ("role_id", "permission_id")
+SELECT r.id, p.id FROM "role" r CROSS JOIN "permission" p WHERE r.name = 'admin' AND p.name = 'clients:write'
+ON CONFLICT DO NOTHING;
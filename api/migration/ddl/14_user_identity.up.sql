@@ -0,0 +1,15 @@
+-- identities of external OpenID Connect providers linked to an account, sub is only unique per issuer
+CREATE TABLE IF NOT EXISTS "user_identity" (
+    "id" serial PRIMARY KEY,
+    "user_account_id" integer NOT NULL,
+    "provider" varchar(50) NOT NULL,
+    "issuer" varchar(255) NOT NULL,
+    "subject" varchar(255) NOT NULL,
+    "email" varchar(200),
+    "created_at" timestamp NOT NULL DEFAULT NOW(),
+    "updated_at" timestamp,
+    CONSTRAINT user_identity_user_account_fk FOREIGN KEY (user_account_id) REFERENCES user_account(id),
+    CONSTRAINT user_identity_issuer_subject_key UNIQUE (issuer, subject)
+);
+
+CREATE INDEX IF NOT EXISTS user_identity_user_account_id_idx ON "user_identity" (user_account_id);
@@ -0,0 +1,11 @@
+-- jti of the magic link tokens which were mailed, a link signs in once
+CREATE TABLE IF NOT EXISTS "magic_link_token" (
+    "id" serial PRIMARY KEY,
+    "user_account_id" integer NOT NULL,
+    "jti" varchar(36) NOT NULL UNIQUE,
+    "expires_at" timestamp NOT NULL,
+    "used_at" timestamp,
+    "created_at" timestamp NOT NULL DEFAULT NOW(),
+    "updated_at" timestamp,
+    CONSTRAINT magic_link_token_user_account_fk FOREIGN KEY (user_account_id) REFERENCES user_account(id)
+);
@@ -0,0 +1,4 @@
+DELETE FROM "role_permission" WHERE "permission_id" IN (SELECT "id" FROM "permission" WHERE "name" = 'users:impersonate');
+DELETE FROM "permission" WHERE "name" = 'users:impersonate';
+
+DROP TABLE IF EXISTS "impersonation_audit";
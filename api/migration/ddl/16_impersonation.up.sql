@@ -0,0 +1,25 @@
+-- what admins did as another user, a row when the token is issued and a row per request made with it
+CREATE TABLE IF NOT EXISTS "impersonation_audit" (
+    "id" serial PRIMARY KEY,
+    "actor_user_account_id" integer NOT NULL,
+    "user_account_id" integer NOT NULL,
+    "token_id" varchar(36) NOT NULL,
+    "action" varchar(20) NOT NULL,
+    "method" varchar(10),
+    "path" text,
+    "request_id" varchar(100),
+    "created_at" timestamp NOT NULL DEFAULT NOW(),
+    CONSTRAINT impersonation_audit_actor_user_account_fk FOREIGN KEY (actor_user_account_id) REFERENCES user_account(id),
+    CONSTRAINT impersonation_audit_user_account_fk FOREIGN KEY (user_account_id) REFERENCES user_account(id)
+);
+
+CREATE INDEX IF NOT EXISTS impersonation_audit_user_account_id_idx ON "impersonation_audit" (user_account_id);
+CREATE INDEX IF NOT EXISTS impersonation_audit_actor_user_account_id_idx ON "impersonation_audit" (actor_user_account_id);
+
+INSERT INTO "permission" ("name", "description") VALUES
+    ('users:impersonate', 'Act as another user with a short-lived access token')
+ON CONFLICT DO NOTHING;
+
+INSERT INTO "role_permission" ("role_id", "permission_id")
+SELECT r.id, p.id FROM "role" r CROSS JOIN "permission" p WHERE r.name = 'admin' AND p.name = 'users:impersonate'
+ON CONFLICT DO NOTHING;
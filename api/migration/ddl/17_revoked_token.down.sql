@@ -0,0 +1,2 @@
+DROP TABLE IF EXISTS "revoked_subject";
+DROP TABLE IF EXISTS "revoked_token";
@@ -0,0 +1,21 @@
+-- tokens revoked before they expire, shared by every instance of the api
+CREATE TABLE IF NOT EXISTS "revoked_token" (
+    "id" serial PRIMARY KEY,
+    "token_id" varchar(100) NOT NULL UNIQUE,
+    "expires_at" timestamp NOT NULL,
+    "created_at" timestamp NOT NULL DEFAULT NOW()
+);
+
+CREATE INDEX IF NOT EXISTS revoked_token_expires_at_idx ON "revoked_token" (expires_at);
+
+-- every token of the subject issued up to issued_before is revoked, by a logout of all devices
+CREATE TABLE IF NOT EXISTS "revoked_subject" (
+    "id" serial PRIMARY KEY,
+    "subject" varchar(100) NOT NULL UNIQUE,
+    "issued_before" timestamp NOT NULL,
+    "expires_at" timestamp NOT NULL,
+    "created_at" timestamp NOT NULL DEFAULT NOW(),
+    "updated_at" timestamp
+);
+
+CREATE INDEX IF NOT EXISTS revoked_subject_expires_at_idx ON "revoked_subject" (expires_at);
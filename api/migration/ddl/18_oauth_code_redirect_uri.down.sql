@@ -0,0 +1 @@
+ALTER TABLE "oauth_authorization_code" DROP COLUMN IF EXISTS "redirect_uri_explicit";
@@ -0,0 +1,2 @@
+-- RFC 6749 4.1.3, the token request repeats the redirect_uri only when the authorize request sent it
+ALTER TABLE "oauth_authorization_code" ADD COLUMN IF NOT EXISTS "redirect_uri_explicit" boolean NOT NULL DEFAULT false;
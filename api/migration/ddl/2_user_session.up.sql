@@ -0,0 +1,14 @@
+CREATE TABLE IF NOT EXISTS "user_session" (
+    "id" serial PRIMARY KEY,
+    "user_account_id" integer NOT NULL,
+    "family_id" uuid NOT NULL,
+    "token_id" uuid NOT NULL UNIQUE,
+    "expires_at" timestamp NOT NULL,
+    "used_at" timestamp,
+    "revoked_at" timestamp,
+    "created_at" timestamp NOT NULL DEFAULT NOW(),
+    "updated_at" timestamp,
+    CONSTRAINT user_session_user_account_fk FOREIGN KEY (user_account_id) REFERENCES user_account(id)
+);
+
+CREATE INDEX IF NOT EXISTS user_session_family_id_idx ON "user_session" (family_id);
@@ -0,0 +1,10 @@
+CREATE TABLE IF NOT EXISTS "password_reset_token" (
+    "id" serial PRIMARY KEY,
+    "user_account_id" integer NOT NULL,
+    "token_hash" varchar(64) NOT NULL UNIQUE,
+    "expires_at" timestamp NOT NULL,
+    "used_at" timestamp,
+    "created_at" timestamp NOT NULL DEFAULT NOW(),
+    "updated_at" timestamp,
+    CONSTRAINT password_reset_token_user_account_fk FOREIGN KEY (user_account_id) REFERENCES user_account(id)
+);
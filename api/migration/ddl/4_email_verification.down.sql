@@ -0,0 +1 @@
+ALTER TABLE "user_account" DROP COLUMN IF EXISTS "email_verified_at";
@@ -0,0 +1,4 @@
+ALTER TABLE "user_account" ADD COLUMN IF NOT EXISTS "email_verified_at" timestamp;
+
+-- accounts created before verification existed are trusted
+UPDATE "user_account" SET "email_verified_at" = "created_at" WHERE "email_verified_at" IS NULL;
@@ -0,0 +1,22 @@
+CREATE TABLE IF NOT EXISTS "user_mfa" (
+    "id" serial PRIMARY KEY,
+    "user_account_id" integer NOT NULL UNIQUE,
+    "secret" varchar(64) NOT NULL,
+    "enabled_at" timestamp,
+    "last_used_step" bigint,
+    "created_at" timestamp NOT NULL DEFAULT NOW(),
+    "updated_at" timestamp,
+    CONSTRAINT user_mfa_user_account_fk FOREIGN KEY (user_account_id) REFERENCES user_account(id)
+);
+
+CREATE TABLE IF NOT EXISTS "user_recovery_code" (
+    "id" serial PRIMARY KEY,
+    "user_account_id" integer NOT NULL,
+    "code_hash" varchar(64) NOT NULL,
+    "used_at" timestamp,
+    "created_at" timestamp NOT NULL DEFAULT NOW(),
+    "updated_at" timestamp,
+    CONSTRAINT user_recovery_code_user_account_fk FOREIGN KEY (user_account_id) REFERENCES user_account(id)
+);
+
+CREATE INDEX IF NOT EXISTS user_recovery_code_user_account_id_idx ON "user_recovery_code" (user_account_id);
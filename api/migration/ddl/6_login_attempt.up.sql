@@ -0,0 +1,8 @@
+CREATE TABLE IF NOT EXISTS "login_attempt" (
+    "id" serial PRIMARY KEY,
+    "attempt_key" varchar(100) NOT NULL UNIQUE,
+    "failed_count" integer NOT NULL DEFAULT 0,
+    "last_failed_at" timestamp NOT NULL,
+    "created_at" timestamp NOT NULL DEFAULT NOW(),
+    "updated_at" timestamp
+);
@@ -0,0 +1,55 @@
+CREATE TABLE IF NOT EXISTS "role" (
+    "id" serial PRIMARY KEY,
+    "name" varchar(50) NOT NULL UNIQUE,
+    "description" varchar(200),
+    "created_at" timestamp NOT NULL DEFAULT NOW(),
+    "updated_at" timestamp
+);
+
+CREATE TABLE IF NOT EXISTS "permission" (
+    "id" serial PRIMARY KEY,
+    "name" varchar(100) NOT NULL UNIQUE,
+    "description" varchar(200),
+    "created_at" timestamp NOT NULL DEFAULT NOW(),
+    "updated_at" timestamp
+);
+
+CREATE TABLE IF NOT EXISTS "role_permission" (
+    "id" serial PRIMARY KEY,
+    "role_id" integer NOT NULL,
+    "permission_id" integer NOT NULL,
+    "created_at" timestamp NOT NULL DEFAULT NOW(),
+    CONSTRAINT role_permission_role_fk FOREIGN KEY (role_id) REFERENCES role(id),
+    CONSTRAINT role_permission_permission_fk FOREIGN KEY (permission_id) REFERENCES permission(id),
+    CONSTRAINT role_permission_unique UNIQUE (role_id, permission_id)
+);
+
+CREATE TABLE IF NOT EXISTS "user_role" (
+    "id" serial PRIMARY KEY,
+    "user_account_id" integer NOT NULL,
+    "role_id" integer NOT NULL,
+    "created_at" timestamp NOT NULL DEFAULT NOW(),
+    CONSTRAINT user_role_user_account_fk FOREIGN KEY (user_account_id) REFERENCES user_account(id),
+    CONSTRAINT user_role_role_fk FOREIGN KEY (role_id) REFERENCES role(id),
+    CONSTRAINT user_role_unique UNIQUE (user_account_id, role_id)
+);
+
+INSERT INTO "role" ("name", "description") VALUES
+    ('admin', 'Manages users and their roles'),
+    ('member', 'Default role of registered users')
+ON CONFLICT DO NOTHING;
+
+INSERT INTO "permission" ("name", "description") VALUES
+    ('users:read', 'Read any user account'),
+    ('users:write', 'Change any user account'),
+    ('roles:write', 'Assign and remove roles of users')
+ON CONFLICT DO NOTHING;
+
+INSERT INTO "role_permission" ("role_id", "permission_id")
+SELECT r.id, p.id FROM "role" r CROSS JOIN "permission" p WHERE r.name = 'admin'
+ON CONFLICT DO NOTHING;
+
+-- users registered before roles existed become members
+INSERT INTO "user_role" ("user_account_id", "role_id")
+SELECT u.id, r.id FROM "user_account" u CROSS JOIN "role" r WHERE r.name = 'member'
+ON CONFLICT DO NOTHING;
@@ -0,0 +1,2 @@
+DROP TABLE IF EXISTS "membership_history";
+DROP TABLE IF EXISTS "membership";
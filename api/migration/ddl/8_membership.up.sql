@@ -0,0 +1,35 @@
+CREATE TABLE IF NOT EXISTS "membership" (
+    "id" serial PRIMARY KEY,
+    "name" varchar(50) NOT NULL UNIQUE,
+    "rank" integer NOT NULL UNIQUE,
+    "benefits" text[] NOT NULL DEFAULT '{}',
+    "created_at" timestamp NOT NULL DEFAULT NOW(),
+    "updated_at" timestamp
+);
+
+-- ids are constants.Bronze through constants.Platinum
+INSERT INTO "membership" ("id", "name", "rank", "benefits") VALUES
+    (1, 'bronze', 1, ARRAY['Member prices']),
+    (2, 'silver', 2, ARRAY['Member prices', 'Free standard shipping']),
+    (3, 'gold', 3, ARRAY['Member prices', 'Free standard shipping', 'Early access to sales']),
+    (4, 'diamond', 4, ARRAY['Member prices', 'Free express shipping', 'Early access to sales', 'Birthday gift']),
+    (5, 'platinum', 5, ARRAY['Member prices', 'Free express shipping', 'Early access to sales', 'Birthday gift', 'Dedicated support'])
+ON CONFLICT DO NOTHING;
+
+SELECT setval('membership_id_seq', (SELECT MAX("id") FROM "membership"));
+
+CREATE TABLE IF NOT EXISTS "membership_history" (
+    "id" serial PRIMARY KEY,
+    "user_account_id" integer NOT NULL,
+    "from_membership_id" integer,
+    "to_membership_id" integer NOT NULL,
+    "changed_by" integer,
+    "reason" varchar(200),
+    "created_at" timestamp NOT NULL DEFAULT NOW(),
+    CONSTRAINT membership_history_user_account_fk FOREIGN KEY (user_account_id) REFERENCES user_account(id),
+    CONSTRAINT membership_history_from_membership_fk FOREIGN KEY (from_membership_id) REFERENCES membership(id),
+    CONSTRAINT membership_history_to_membership_fk FOREIGN KEY (to_membership_id) REFERENCES membership(id),
+    CONSTRAINT membership_history_changed_by_fk FOREIGN KEY (changed_by) REFERENCES user_account(id)
+);
+
+CREATE INDEX IF NOT EXISTS membership_history_user_account_id_idx ON "membership_history" (user_account_id);
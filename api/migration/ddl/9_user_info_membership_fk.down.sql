@@ -0,0 +1,3 @@
+ALTER TABLE "user_info" DROP CONSTRAINT IF EXISTS user_info_membership_fk;
+
+UPDATE "user_info" SET "membership_id" = "membership_id" - 1 WHERE "membership_id" IS NOT NULL;
@@ -0,0 +1,5 @@
+-- membership_id held the iota of constants.Bronze (0) through constants.Platinum (4), the seeded ids start at 1
+UPDATE "user_info" SET "membership_id" = "membership_id" + 1 WHERE "membership_id" BETWEEN 0 AND 4;
+UPDATE "user_info" SET "membership_id" = NULL WHERE "membership_id" NOT IN (SELECT "id" FROM "membership");
+
+ALTER TABLE "user_info" ADD CONSTRAINT user_info_membership_fk FOREIGN KEY (membership_id) REFERENCES membership(id);
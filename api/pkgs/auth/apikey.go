@@ -0,0 +1,46 @@
+package auth
+
+import (
+	"crypto/subtle"
+	"encoding/base64"
+	"encoding/hex"
+	"strings"
+
+	"github.com/pkg/errors"
+)
+
+const (
+	apiKeyScheme       = "msk"
+	apiKeyPrefixLength = 4
+	apiKeySecretLength = 32
+)
+
+// GenerateApiKey returns a key of the form msk_<prefix>_<secret>, the prefix to look the key up by and the hash to store instead of it
+func GenerateApiKey() (key string, prefix string, hash string, err error) {
+	p, err := generateRandomBytes(apiKeyPrefixLength)
+	if err != nil {
+		return "", "", "", errors.Wrap(err, "failed to generate prefix")
+	}
+	s, err := generateRandomBytes(apiKeySecretLength)
+	if err != nil {
+		return "", "", "", errors.Wrap(err, "failed to generate secret")
+	}
+
+	prefix = hex.EncodeToString(p)
+	key = apiKeyScheme + "_" + prefix + "_" + base64.RawURLEncoding.EncodeToString(s)
+	return key, prefix, HashOpaqueToken(key), nil
+}
+
+// ParseApiKeyPrefix returns the prefix of a well formed key
+func ParseApiKeyPrefix(key string) (string, bool) {
+	parts := strings.SplitN(key, "_", 3)
+	if len(parts) != 3 || parts[0] != apiKeyScheme || parts[1] == "" || parts[2] == "" {
+		return "", false
+	}
+	return parts[1], true
+}
+
+// VerifyApiKey compares the key to the stored hash in constant time
+func VerifyApiKey(key string, hash string) bool {
+	return subtle.ConstantTimeCompare([]byte(HashOpaqueToken(key)), []byte(hash)) == 1
+}
@@ -0,0 +1,40 @@
+package auth
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestGenerateApiKey(t *testing.T) {
+	key, prefix, hash, err := GenerateApiKey()
+	require.NoError(t, err)
+	require.True(t, strings.HasPrefix(key, "msk_"+prefix+"_"))
+	require.Len(t, prefix, 8)
+	require.True(t, VerifyApiKey(key, hash))
+	require.False(t, VerifyApiKey(key+"x", hash))
+
+	parsed, ok := ParseApiKeyPrefix(key)
+	require.True(t, ok)
+	require.Equal(t, prefix, parsed)
+
+	otherKey, otherPrefix, _, err := GenerateApiKey()
+	require.NoError(t, err)
+	require.NotEqual(t, key, otherKey)
+	require.NotEqual(t, prefix, otherPrefix)
+}
+
+func TestParseApiKeyPrefix(t *testing.T) {
+	{ // secret may contain the separator
+		prefix, ok := ParseApiKeyPrefix("msk_abcd1234_se_cret")
+		require.True(t, ok)
+		require.Equal(t, "abcd1234", prefix)
+	}
+	{ // malformed keys
+		for _, key := range []string{"", "msk", "msk_abcd1234", "msk__secret", "msk_abcd1234_", "pk_abcd1234_secret"} {
+			_, ok := ParseApiKeyPrefix(key)
+			require.False(t, ok, key)
+		}
+	}
+}
@@ -5,6 +5,7 @@ import (
 	"crypto/subtle"
 	"encoding/base64"
 	"fmt"
+	"mysite/pkgs/env"
 	"strings"
 
 	"github.com/pkg/errors"
@@ -17,6 +18,9 @@ type argonParam struct {
 	parallelism uint8
 	saltLength  uint32
 	keyLength   uint32
+
+	// pepperVersion keyid of the pepper, empty when the hash is not peppered
+	pepperVersion string
 }
 
 var (
@@ -30,25 +34,82 @@ var (
 	}
 )
 
+// argonParamsFromEnv overrides the default params with the configured ones
+func argonParamsFromEnv() argonParam {
+	cfg := env.GetEnv().Argon2
+	params := defaultParams
+	if cfg.Memory > 0 {
+		params.memory = cfg.Memory
+	}
+	if cfg.Iterations > 0 {
+		params.iterations = cfg.Iterations
+	}
+	if cfg.Parallelism > 0 {
+		params.parallelism = cfg.Parallelism
+	}
+	if cfg.SaltLength > 0 {
+		params.saltLength = cfg.SaltLength
+	}
+	if cfg.KeyLength > 0 {
+		params.keyLength = cfg.KeyLength
+	}
+	params.pepperVersion = env.GetEnv().PasswordPepper.ActiveVersion
+	return params
+}
+
+// hashParams are the params of new hashes
+func (a auth) hashParams() argonParam {
+	if a.params == (argonParam{}) {
+		return defaultParams
+	}
+	return a.params
+}
+
+func (a auth) hashVerifiers() []prefixVerifier {
+	if a.verifiers == nil {
+		return newVerifiers(a.peppers)
+	}
+	return a.verifiers
+}
+
 func (a auth) HashPassword(password string) (string, error) {
-	salt, err := generateRandomBytes(defaultParams.saltLength)
+	params := a.hashParams()
+	salt, err := generateRandomBytes(params.saltLength)
 	if err != nil {
 		return "", errors.Wrap(err, "failed to generate salt")
 	}
 
-	hash := argon2.IDKey([]byte(password), salt, defaultParams.iterations, defaultParams.memory, defaultParams.parallelism, defaultParams.keyLength)
+	input, err := a.peppers.apply(params.pepperVersion, password)
+	if err != nil {
+		return "", errors.Wrap(err, "failed to pepper password")
+	}
+	hash := argon2.IDKey(input, salt, params.iterations, params.memory, params.parallelism, params.keyLength)
 
 	// Base64 encode the salt and hashed password.
 	b64Salt := base64.RawStdEncoding.EncodeToString(salt)
 	b64Hash := base64.RawStdEncoding.EncodeToString(hash)
 
 	// Return a string using the standard encoded hash representation.
-	encodedHash := fmt.Sprintf("$argon2id$v=%d$m=%d,t=%d,p=%d$%s$%s", argon2.Version, defaultParams.memory, defaultParams.iterations, defaultParams.parallelism, b64Salt, b64Hash)
+	encodedParams := fmt.Sprintf("m=%d,t=%d,p=%d", params.memory, params.iterations, params.parallelism)
+	if params.pepperVersion != "" {
+		encodedParams += ",keyid=" + params.pepperVersion
+	}
+	encodedHash := fmt.Sprintf("$argon2id$v=%d$%s$%s$%s", argon2.Version, encodedParams, b64Salt, b64Hash)
 
 	return encodedHash, nil
 }
 
+// ComparePasswordAndHash verifies with the scheme of the hash, legacy hashes are accepted until NeedsRehash upgrades them
 func (a auth) ComparePasswordAndHash(password, encodedHash string) (match bool, err error) {
+	verify, err := findVerifier(a.hashVerifiers(), encodedHash)
+	if err != nil {
+		return false, err
+	}
+	return verify(password, encodedHash)
+}
+
+// compareArgon2id picks the pepper by the keyid of the hash, hashes made before the pepper was set have none
+func compareArgon2id(peppers peppers, password, encodedHash string) (match bool, err error) {
 	// Extract the parameters, salt and derived key from the encoded password
 	// hash.
 	p, salt, hash, err := decodeHash(encodedHash)
@@ -56,8 +117,13 @@ func (a auth) ComparePasswordAndHash(password, encodedHash string) (match bool,
 		return false, err
 	}
 
+	input, err := peppers.apply(p.pepperVersion, password)
+	if err != nil {
+		return false, errors.Wrap(err, "failed to pepper password")
+	}
+
 	// Derive the key from the other password using the same parameters.
-	otherHash := argon2.IDKey([]byte(password), salt, p.iterations, p.memory, p.parallelism, p.keyLength)
+	otherHash := argon2.IDKey(input, salt, p.iterations, p.memory, p.parallelism, p.keyLength)
 
 	// Check that the contents of the hashed passwords are identical. Note
 	// that we are using the subtle.ConstantTimeCompare() function for this
@@ -68,6 +134,15 @@ func (a auth) ComparePasswordAndHash(password, encodedHash string) (match bool,
 	return false, nil
 }
 
+func (a auth) NeedsRehash(encodedHash string) bool {
+	p, _, _, err := decodeHash(encodedHash)
+	if err != nil {
+		// legacy scheme or another argon2 version, a new hash can only help
+		return true
+	}
+	return *p != a.hashParams()
+}
+
 func generateRandomBytes(saltLength uint32) ([]byte, error) {
 	b := make([]byte, saltLength)
 	if _, err := rand.Read(b); err != nil {
@@ -93,10 +168,12 @@ func decodeHash(encodedHash string) (p *argonParam, salt, hash []byte, err error
 	}
 
 	p = &argonParam{}
-	_, err = fmt.Sscanf(vals[3], "m=%d,t=%d,p=%d", &p.memory, &p.iterations, &p.parallelism)
+	encodedParams, pepperVersion, _ := strings.Cut(vals[3], ",keyid=")
+	_, err = fmt.Sscanf(encodedParams, "m=%d,t=%d,p=%d", &p.memory, &p.iterations, &p.parallelism)
 	if err != nil {
 		return nil, nil, nil, errors.Wrap(err, "failed to get argon param")
 	}
+	p.pepperVersion = pepperVersion
 
 	salt, err = base64.RawStdEncoding.Strict().DecodeString(vals[4])
 	if err != nil {
@@ -38,3 +38,38 @@ func TestComparePasswordAndHash(t *testing.T) {
 		assert.False(t, match)
 	}
 }
+
+func TestNeedsRehash(t *testing.T) {
+	svc := auth{}
+	{ // hash with current params
+		hash, err := svc.HashPassword("secret")
+		assert.NoError(t, err)
+		assert.False(t, svc.NeedsRehash(hash))
+	}
+	{ // params raised since the hash was made
+		hash, err := svc.HashPassword("secret")
+		assert.NoError(t, err)
+
+		stronger := defaultParams
+		stronger.iterations++
+		assert.True(t, auth{params: stronger}.NeedsRehash(hash))
+
+		// the old hash still matches
+		match, err := auth{params: stronger}.ComparePasswordAndHash("secret", hash)
+		assert.NoError(t, err)
+		assert.True(t, match)
+	}
+	{ // hash with configured params
+		params := defaultParams
+		params.memory = 32 * 1024
+		params.keyLength = 16
+		svc := auth{params: params}
+		hash, err := svc.HashPassword("secret")
+		assert.NoError(t, err)
+		assert.Contains(t, hash, "m=32768,t=3,p=2")
+		assert.False(t, svc.NeedsRehash(hash))
+	}
+	{ // invalid hash
+		assert.True(t, svc.NeedsRehash("invalid"))
+	}
+}
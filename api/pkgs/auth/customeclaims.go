@@ -11,15 +11,37 @@ import (
 type KeyType string
 
 const (
-	CursorKey  KeyType = "cursorKey"
-	AccessKey  KeyType = "accessKey"
-	RefreshKey KeyType = "refreshKey"
+	CursorKey      KeyType = "cursorKey"
+	AccessKey      KeyType = "accessKey"
+	RefreshKey     KeyType = "refreshKey"
+	VerifyEmailKey KeyType = "verifyEmailKey"
+	MfaPendingKey  KeyType = "mfaPendingKey"
+	OidcStateKey   KeyType = "oidcStateKey"
+	MagicLinkKey   KeyType = "magicLinkKey"
 )
 
 type CustomClaims[T any] struct {
 	jwt.RegisteredClaims
 	KeyType  KeyType `json:"key_type"`
 	MetaData T       `json:"meta_data,omitempty"`
+
+	// Act is set when an admin acts as the subject, RFC 8693 4.1
+	Act *Actor `json:"act,omitempty"`
+}
+
+// Actor the party acting on behalf of the subject of the token
+type Actor struct {
+	Subject string `json:"sub"`
+}
+
+// AccessMetaData is the MetaData of access tokens, permissions are resolved from the roles when the token is issued
+type AccessMetaData struct {
+	Roles       []string `json:"roles,omitempty"`
+	Permissions []string `json:"permissions,omitempty"`
+	Membership  string   `json:"membership,omitempty"`
+
+	// ClientID oauth client the token was issued to, empty for the first-party login
+	ClientID string `json:"client_id,omitempty"`
 }
 
 func NewCustomClaims[T any]() *CustomClaims[T] {
@@ -38,8 +60,17 @@ func (c *CustomClaims[T]) GetKeyType() KeyType {
 	return c.KeyType
 }
 
+func (c *CustomClaims[T]) GetID() string {
+	return c.ID
+}
+
 func (c *CustomClaims[T]) isValidKey() bool {
-	return c.KeyType == CursorKey || c.KeyType == AccessKey || c.KeyType == RefreshKey
+	switch c.KeyType {
+	case CursorKey, AccessKey, RefreshKey, VerifyEmailKey, MfaPendingKey, OidcStateKey, MagicLinkKey:
+		return true
+	default:
+		return false
+	}
 }
 
 func (c *CustomClaims[T]) WithExpireAt(expireAt time.Time) *CustomClaims[T] {
@@ -52,7 +83,21 @@ func (c *CustomClaims[T]) Clone() *CustomClaims[T] {
 		RegisteredClaims: c.RegisteredClaims,
 		KeyType:          c.KeyType,
 		MetaData:         c.MetaData,
+		Act:              c.Act,
+	}
+}
+
+// MaxAccessTokenTtl the longest lifetime of an access token, of a login, of an oauth client or of an impersonation
+func MaxAccessTokenTtl() time.Duration {
+	ttl := 15 * time.Minute
+	appEnv := env.GetEnv()
+	if oauthTtl := time.Duration(appEnv.OAuth.AccessExpireMinutes) * time.Minute; oauthTtl > ttl {
+		ttl = oauthTtl
+	}
+	if impersonationTtl := time.Duration(appEnv.Impersonation.ExpireMinutes) * time.Minute; impersonationTtl > ttl {
+		ttl = impersonationTtl
 	}
+	return ttl
 }
 
 func defaultRegisterClaims() jwt.RegisteredClaims {
@@ -0,0 +1,179 @@
+package auth
+
+import (
+	"context"
+	"log/slog"
+	"mysite/pkgs/database"
+	"mysite/pkgs/logger"
+	"mysite/repositories/revokedtokenrepo"
+	"sync"
+	"time"
+
+	"github.com/pkg/errors"
+	"github.com/volatiletech/sqlboiler/v4/boil"
+)
+
+// Denylist keeps revoked token ids until the token would have expired anyway
+type Denylist interface {
+	Add(tokenId string, expiresAt time.Time)
+	Contains(tokenId string) bool
+
+	// AddSubject denies every token of the subject issued up to issuedBefore, kept until expiresAt
+	AddSubject(subject string, issuedBefore time.Time, expiresAt time.Time)
+	ContainsSubject(subject string, issuedAt time.Time) bool
+}
+
+type memoryDenylist struct {
+	mu       sync.RWMutex
+	tokens   map[string]time.Time
+	subjects map[string]subjectCutoff
+}
+
+type subjectCutoff struct {
+	issuedBefore time.Time
+	expiresAt    time.Time
+}
+
+// defaultDenylist is shared by every JwtHandler, stored in the database so that every instance of the api
+// and a restart see the revocations
+var defaultDenylist = NewDbDenylist()
+
+func NewMemoryDenylist() Denylist {
+	return &memoryDenylist{
+		tokens:   make(map[string]time.Time),
+		subjects: make(map[string]subjectCutoff),
+	}
+}
+
+func (d *memoryDenylist) Add(tokenId string, expiresAt time.Time) {
+	if tokenId == "" {
+		return
+	}
+
+	d.mu.Lock()
+	defer d.mu.Unlock()
+
+	d.prune(time.Now())
+	d.tokens[tokenId] = expiresAt
+}
+
+func (d *memoryDenylist) Contains(tokenId string) bool {
+	d.mu.RLock()
+	defer d.mu.RUnlock()
+
+	expiresAt, found := d.tokens[tokenId]
+	return found && time.Now().Before(expiresAt)
+}
+
+func (d *memoryDenylist) AddSubject(subject string, issuedBefore time.Time, expiresAt time.Time) {
+	if subject == "" {
+		return
+	}
+
+	d.mu.Lock()
+	defer d.mu.Unlock()
+
+	d.prune(time.Now())
+
+	// iat has a precision of seconds, a token of the same second counts as issued before
+	cutoff := subjectCutoff{issuedBefore: issuedBefore.Truncate(time.Second), expiresAt: expiresAt}
+	if current, found := d.subjects[subject]; found && current.expiresAt.After(cutoff.expiresAt) {
+		cutoff.expiresAt = current.expiresAt
+	}
+	d.subjects[subject] = cutoff
+}
+
+func (d *memoryDenylist) ContainsSubject(subject string, issuedAt time.Time) bool {
+	d.mu.RLock()
+	defer d.mu.RUnlock()
+
+	cutoff, found := d.subjects[subject]
+	return found && time.Now().Before(cutoff.expiresAt) && !issuedAt.After(cutoff.issuedBefore)
+}
+
+// prune drops tokens which already expired, caller must hold the lock
+func (d *memoryDenylist) prune(now time.Time) {
+	for tokenId, expiresAt := range d.tokens {
+		if !now.Before(expiresAt) {
+			delete(d.tokens, tokenId)
+		}
+	}
+	for subject, cutoff := range d.subjects {
+		if !now.Before(cutoff.expiresAt) {
+			delete(d.subjects, subject)
+		}
+	}
+}
+
+type dbDenylist struct {
+	repo revokedtokenrepo.RevokedTokenRepo
+}
+
+func NewDbDenylist() Denylist {
+	return &dbDenylist{
+		repo: revokedtokenrepo.NewRepo(),
+	}
+}
+
+func (d *dbDenylist) Add(tokenId string, expiresAt time.Time) {
+	if tokenId == "" {
+		return
+	}
+
+	if err := database.NewBoilerTransaction(context.Background(), func(ctx context.Context, tx boil.ContextTransactor) error {
+		if err := d.repo.DeleteExpired(ctx, tx, time.Now()); err != nil {
+			return errors.Wrap(err, "failed prune denylist")
+		}
+		return d.repo.InsertToken(ctx, tx, tokenId, expiresAt)
+	}); err != nil {
+		slog.Error("failed revoke token", logger.AttrError(err))
+	}
+}
+
+// Contains denies the token when the denylist can not be read
+func (d *dbDenylist) Contains(tokenId string) bool {
+	var revoked bool
+	if err := database.NewBoilerTransaction(context.Background(), func(ctx context.Context, tx boil.ContextTransactor) error {
+		var err error
+		revoked, err = d.repo.IsTokenRevoked(ctx, tx, tokenId)
+		return err
+	}); err != nil {
+		slog.Error("failed read denylist", logger.AttrError(err))
+		return true
+	}
+	return revoked
+}
+
+func (d *dbDenylist) AddSubject(subject string, issuedBefore time.Time, expiresAt time.Time) {
+	if subject == "" {
+		return
+	}
+
+	// iat has a precision of seconds, a token of the same second counts as issued before
+	issuedBefore = issuedBefore.Truncate(time.Second)
+	if err := database.NewBoilerTransaction(context.Background(), func(ctx context.Context, tx boil.ContextTransactor) error {
+		if err := d.repo.DeleteExpired(ctx, tx, time.Now()); err != nil {
+			return errors.Wrap(err, "failed prune denylist")
+		}
+		return d.repo.UpsertSubject(ctx, tx, subject, issuedBefore, expiresAt)
+	}); err != nil {
+		slog.Error("failed revoke subject", logger.AttrError(err))
+	}
+}
+
+// ContainsSubject denies the token when the denylist can not be read
+func (d *dbDenylist) ContainsSubject(subject string, issuedAt time.Time) bool {
+	var revoked bool
+	if err := database.NewBoilerTransaction(context.Background(), func(ctx context.Context, tx boil.ContextTransactor) error {
+		cutoff, err := d.repo.GetRevokedSubject(ctx, tx, subject)
+		if err != nil {
+			return err
+		}
+		revoked = cutoff != nil && time.Now().Before(cutoff.ExpiresAt) && !issuedAt.After(cutoff.IssuedBefore)
+		return nil
+	}); err != nil {
+		slog.Error("failed read denylist", logger.AttrError(err))
+		return true
+	}
+	return revoked
+}
@@ -0,0 +1,48 @@
+package auth
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestMemoryDenylist(t *testing.T) {
+	{ // revoked token is denied until it expires
+		denylist := NewMemoryDenylist()
+		denylist.Add("token-id", time.Now().Add(time.Hour))
+		require.True(t, denylist.Contains("token-id"))
+		require.False(t, denylist.Contains("other-token-id"))
+	}
+	{ // expired token is no longer kept
+		denylist := NewMemoryDenylist()
+		denylist.Add("token-id", time.Now().Add(-time.Second))
+		require.False(t, denylist.Contains("token-id"))
+
+		denylist.Add("other-token-id", time.Now().Add(time.Hour))
+		require.NotContains(t, denylist.(*memoryDenylist).tokens, "token-id")
+	}
+	{ // tokens of the subject issued up to the cutoff are denied
+		denylist := NewMemoryDenylist()
+		now := time.Now()
+		denylist.AddSubject("1", now, now.Add(time.Hour))
+		require.True(t, denylist.ContainsSubject("1", now.Add(-time.Minute)))
+		require.True(t, denylist.ContainsSubject("1", now.Truncate(time.Second)))
+		require.True(t, denylist.ContainsSubject("1", time.Time{}))
+		require.False(t, denylist.ContainsSubject("1", now.Truncate(time.Second).Add(time.Second)))
+		require.False(t, denylist.ContainsSubject("2", now.Add(-time.Minute)))
+	}
+	{ // expired subject is no longer kept
+		denylist := NewMemoryDenylist()
+		denylist.AddSubject("1", time.Now(), time.Now().Add(-time.Second))
+		require.False(t, denylist.ContainsSubject("1", time.Now().Add(-time.Minute)))
+
+		denylist.AddSubject("2", time.Now(), time.Now().Add(time.Hour))
+		require.NotContains(t, denylist.(*memoryDenylist).subjects, "1")
+	}
+	{ // empty token id is ignored
+		denylist := NewMemoryDenylist()
+		denylist.Add("", time.Now().Add(time.Hour))
+		require.Empty(t, denylist.(*memoryDenylist).tokens)
+	}
+}
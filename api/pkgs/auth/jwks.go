@@ -0,0 +1,88 @@
+package auth
+
+import (
+	"crypto"
+	"crypto/ecdsa"
+	"crypto/ed25519"
+	"crypto/rsa"
+	"encoding/base64"
+	"math/big"
+
+	"github.com/pkg/errors"
+)
+
+// Jwk public verification key as described by RFC 7517
+type Jwk struct {
+	Kty string `json:"kty"`
+	Kid string `json:"kid"`
+	Use string `json:"use"`
+	Alg string `json:"alg"`
+
+	// RSA
+	N string `json:"n,omitempty"`
+	E string `json:"e,omitempty"`
+
+	// EC and OKP
+	Crv string `json:"crv,omitempty"`
+	X   string `json:"x,omitempty"`
+	Y   string `json:"y,omitempty"`
+}
+
+// PublicJwks returns the active and verify-only keys of every key type signed with an asymmetric algorithm
+func PublicJwks() ([]Jwk, error) {
+	keys := make([]Jwk, 0)
+	seen := map[string]bool{}
+	for _, keyType := range []KeyType{AccessKey, RefreshKey, CursorKey} {
+		keySet, err := getKeySet(keyType)
+		if err != nil {
+			return nil, errors.Wrapf(err, "failed get signing keys of %s", keyType)
+		}
+
+		for _, key := range keySet {
+			if key.state == KeyStateRetired || !key.asymmetric() || seen[key.kid] {
+				continue
+			}
+			seen[key.kid] = true
+
+			jwk, err := newJwk(key.kid, key.method.Alg(), key.verify)
+			if err != nil {
+				return nil, errors.Wrapf(err, "failed create jwk of %s", keyType)
+			}
+			keys = append(keys, *jwk)
+		}
+	}
+	return keys, nil
+}
+
+func newJwk(kid, alg string, publicKey crypto.PublicKey) (*Jwk, error) {
+	jwk := Jwk{
+		Kid: kid,
+		Use: "sig",
+		Alg: alg,
+	}
+
+	switch key := publicKey.(type) {
+	case *rsa.PublicKey:
+		jwk.Kty = "RSA"
+		jwk.N = encodeBase64Url(key.N.Bytes())
+		jwk.E = encodeBase64Url(big.NewInt(int64(key.E)).Bytes())
+	case *ecdsa.PublicKey:
+		size := (key.Curve.Params().BitSize + 7) / 8
+		jwk.Kty = "EC"
+		jwk.Crv = key.Curve.Params().Name
+		jwk.X = encodeBase64Url(key.X.FillBytes(make([]byte, size)))
+		jwk.Y = encodeBase64Url(key.Y.FillBytes(make([]byte, size)))
+	case ed25519.PublicKey:
+		jwk.Kty = "OKP"
+		jwk.Crv = "Ed25519"
+		jwk.X = encodeBase64Url(key)
+	default:
+		return nil, errors.Errorf("unsupported public key %T", publicKey)
+	}
+
+	return &jwk, nil
+}
+
+func encodeBase64Url(data []byte) string {
+	return base64.RawURLEncoding.EncodeToString(data)
+}
@@ -1,20 +1,22 @@
 package auth
 
 import (
-	"mysite/pkgs/env"
+	"time"
 
 	"github.com/golang-jwt/jwt/v5"
 	"github.com/pkg/errors"
 )
 
 type jwtHandler struct {
-	claims Claims
+	claims   Claims
+	denylist Denylist
 }
 
 type Claims interface {
 	jwt.Claims
 	IsValid() bool
 	GetKeyType() KeyType
+	GetID() string
 }
 
 //go:generate moq -pkg pkgmock -out ../../testing/mocking/pkgmock/jwt.mock.go . JwtHandler
@@ -22,10 +24,14 @@ type JwtHandler interface {
 	CreateToken() (string, error)
 	ParseToken(tokenString string, claims Claims) error
 	WithClaims(claims Claims) JwtHandler
+	RevokeToken(tokenId string, expiresAt time.Time)
+	RevokeSubject(subject string, expiresAt time.Time)
 }
 
 func NewJwtHandler() JwtHandler {
-	return &jwtHandler{}
+	return &jwtHandler{
+		denylist: defaultDenylist,
+	}
 }
 
 func (j jwtHandler) WithClaims(claims Claims) JwtHandler {
@@ -39,13 +45,21 @@ func (j *jwtHandler) CreateToken() (string, error) {
 		return "", errors.New("invalid claims")
 	}
 
-	// get jwt key
-	key, err := getJwtKey(j.claims.GetKeyType())
+	// get active signing key
+	keys, err := getKeySet(j.claims.GetKeyType())
+	if err != nil {
+		return "", errors.Wrap(err, "failed get signing keys")
+	}
+	key, err := keys.active()
 	if err != nil {
-		return "", errors.Wrap(err, "failed get jwt key")
+		return "", errors.Wrap(err, "failed get signing key")
 	}
 
-	return jwt.NewWithClaims(jwt.SigningMethodHS256, j.claims).SignedString(key)
+	token := jwt.NewWithClaims(key.method, j.claims)
+	if key.kid != "" {
+		token.Header["kid"] = key.kid
+	}
+	return token.SignedString(key.sign)
 }
 
 func (j *jwtHandler) ParseToken(tokenString string, claims Claims) error {
@@ -57,36 +71,74 @@ func (j *jwtHandler) ParseToken(tokenString string, claims Claims) error {
 	if !token.Valid {
 		return errors.New("invalid token")
 	}
+
+	// the other key types are never revoked, they are not looked up
+	if j.denylist != nil && revocable(claims.GetKeyType()) && j.denylist.Contains(claims.GetID()) {
+		return errors.New("token revoked")
+	}
+
+	// refresh tokens end with their session, other tokens are short-lived and not a login
+	if j.denylist != nil && claims.GetKeyType() == AccessKey {
+		subject, _ := claims.GetSubject()
+		var issuedAt time.Time
+		if iat, _ := claims.GetIssuedAt(); iat != nil {
+			issuedAt = iat.Time
+		}
+		if j.denylist.ContainsSubject(subject, issuedAt) {
+			return errors.New("token revoked")
+		}
+	}
 	return nil
 }
 
-func keyParser(token *jwt.Token) (interface{}, error) {
-	method, ok := token.Method.(*jwt.SigningMethodHMAC)
-	if !ok {
-		return nil, errors.Errorf("Unexpected signing method: %v", token.Header["alg"])
+// revocable key types RevokeToken is used for, access tokens by a logout, mfa pending and oidc state tokens once used
+func revocable(keyType KeyType) bool {
+	switch keyType {
+	case AccessKey, MfaPendingKey, OidcStateKey:
+		return true
+	default:
+		return false
+	}
+}
+
+// RevokeToken denies the token id until expiresAt, ParseToken fails for it afterwards, for revocable key types only
+func (j *jwtHandler) RevokeToken(tokenId string, expiresAt time.Time) {
+	if j.denylist == nil {
+		return
 	}
-	if method.Alg() != jwt.SigningMethodHS256.Name {
-		return nil, errors.New("the alg must be HS256")
+	j.denylist.Add(tokenId, expiresAt)
+}
+
+// RevokeSubject denies every access token of the subject issued until now, expiresAt must cover the longest of them
+func (j *jwtHandler) RevokeSubject(subject string, expiresAt time.Time) {
+	if j.denylist == nil {
+		return
 	}
+	j.denylist.AddSubject(subject, time.Now(), expiresAt)
+}
 
+func keyParser(token *jwt.Token) (interface{}, error) {
 	claims, ok := token.Claims.(Claims)
 	if !ok {
 		return nil, errors.New("invalid claims")
 	}
 
-	return getJwtKey(claims.GetKeyType())
-}
+	keys, err := getKeySet(claims.GetKeyType())
+	if err != nil {
+		return nil, errors.Wrap(err, "failed get signing keys")
+	}
 
-func getJwtKey(keyType KeyType) ([]byte, error) {
-	envObj := env.GetEnv().Jwt
-	switch keyType {
-	case CursorKey:
-		return []byte(envObj.CursorKey), nil
-	case AccessKey:
-		return []byte(envObj.AccessKey), nil
-	case RefreshKey:
-		return []byte(envObj.RefreshKey), nil
-	default:
-		return nil, errors.New("unsupported key type")
+	// pick the key by kid so that tokens of a verify-only key stay valid during rotation
+	kid, _ := token.Header["kid"].(string)
+	key, err := keys.verifier(kid)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed get signing key")
 	}
+
+	// the alg must be the one configured for the key type, never the one picked by the token
+	if token.Method.Alg() != key.method.Alg() {
+		return nil, errors.Errorf("unexpected signing method: %v", token.Header["alg"])
+	}
+
+	return key.verify, nil
 }
@@ -2,12 +2,35 @@ package auth
 
 import (
 	"fmt"
+	"mysite/pkgs/env"
+	"os"
 	"testing"
 	"time"
 
+	"github.com/golang-jwt/jwt/v5"
 	"github.com/stretchr/testify/require"
 )
 
+func TestMain(m *testing.M) {
+	// no database in these tests
+	defaultDenylist = NewMemoryDenylist()
+
+	// HS256 keys are refused without a secret
+	if err := env.ReadEnv(func(appEnv *env.AppEnv) {
+		appEnv.Jwt.AccessKey = "access-secret"
+		appEnv.Jwt.RefreshKey = "refresh-secret"
+		appEnv.Jwt.CursorKey = "cursor-secret"
+		appEnv.Jwt.VerifyEmailKey = "verify-email-secret"
+		appEnv.Jwt.MfaPendingKey = "mfa-pending-secret"
+		appEnv.Jwt.OidcStateKey = "oidc-state-secret"
+		appEnv.Jwt.MagicLinkKey = "magic-link-secret"
+	}); err != nil {
+		fmt.Println("failed to read env")
+		os.Exit(1)
+	}
+	os.Exit(m.Run())
+}
+
 type myClaims struct {
 	Foo string `json:"foo"`
 }
@@ -29,3 +52,55 @@ func TestJwt(t *testing.T) {
 	require.Equal(t, claimsA, &claimsB)
 
 }
+
+func TestJwtActor(t *testing.T) {
+	claimsA := NewCustomClaims[myClaims]().WithExpireAt(time.Now().Add(time.Hour))
+	claimsA.Subject = "1"
+	claimsA.KeyType = AccessKey
+	claimsA.Act = &Actor{Subject: "2"}
+
+	tokenStr, err := NewJwtHandler().WithClaims(claimsA).CreateToken()
+	require.NoError(t, err)
+
+	var claimsB CustomClaims[myClaims]
+	require.NoError(t, NewJwtHandler().ParseToken(tokenStr, &claimsB))
+	require.Equal(t, "2", claimsB.Act.Subject)
+	require.Equal(t, claimsA.Act, claimsB.Clone().Act)
+}
+
+func TestRevokeToken(t *testing.T) {
+	claims := NewCustomClaims[any]().WithExpireAt(time.Now().Add(time.Hour))
+	claims.KeyType = AccessKey
+
+	handler := &jwtHandler{denylist: NewMemoryDenylist()}
+	tokenStr, err := handler.WithClaims(claims).CreateToken()
+	require.NoError(t, err)
+
+	var parsed CustomClaims[any]
+	require.NoError(t, handler.ParseToken(tokenStr, &parsed))
+
+	handler.RevokeToken(claims.ID, claims.ExpiresAt.Time)
+	require.Error(t, handler.ParseToken(tokenStr, &parsed))
+}
+
+func TestRevokeSubject(t *testing.T) {
+	handler := &jwtHandler{denylist: NewMemoryDenylist()}
+	newToken := func(subject string, issuedAt time.Time) string {
+		claims := NewCustomClaims[any]().WithExpireAt(time.Now().Add(time.Hour))
+		claims.KeyType = AccessKey
+		claims.Subject = subject
+		claims.IssuedAt = jwt.NewNumericDate(issuedAt)
+		tokenStr, err := handler.WithClaims(claims).CreateToken()
+		require.NoError(t, err)
+		return tokenStr
+	}
+	before := newToken("1", time.Now().Add(-time.Minute))
+	other := newToken("2", time.Now().Add(-time.Minute))
+
+	handler.RevokeSubject("1", time.Now().Add(time.Hour))
+
+	var parsed CustomClaims[any]
+	require.Error(t, handler.ParseToken(before, &parsed))
+	require.NoError(t, handler.ParseToken(other, &parsed))
+	require.NoError(t, handler.ParseToken(newToken("1", time.Now().Add(2*time.Second)), &parsed))
+}
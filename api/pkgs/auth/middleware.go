@@ -0,0 +1,247 @@
+package auth
+
+import (
+	"context"
+	"log/slog"
+	"mysite/constants"
+	"mysite/entities"
+	"mysite/pkgs/database"
+	"mysite/pkgs/logger"
+	"mysite/repositories/apikeyrepo"
+	"mysite/repositories/membershiprepo"
+	"mysite/repositories/rolerepo"
+	"mysite/repositories/useraccountrepo"
+	"mysite/utils/httputil"
+	"net/http"
+	"slices"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/go-chi/render"
+	"github.com/pkg/errors"
+	"github.com/volatiletech/sqlboiler/v4/boil"
+)
+
+const (
+	bearerPrefix = "Bearer "
+	apiKeyPrefix = "ApiKey "
+
+	// lastUsedInterval limits the writes of last_used_at for busy keys
+	lastUsedInterval = time.Minute
+)
+
+type authenticator struct {
+	repo           useraccountrepo.UserAccountRepo
+	apiKeyRepo     apikeyrepo.ApiKeyRepo
+	roleRepo       rolerepo.RoleRepo
+	membershipRepo membershiprepo.MembershipRepo
+	jwtHandler     JwtHandler
+}
+
+func NewAuthenticator() *authenticator {
+	return &authenticator{
+		repo:           useraccountrepo.NewRepo(),
+		apiKeyRepo:     apikeyrepo.NewRepo(),
+		roleRepo:       rolerepo.NewRepo(),
+		membershipRepo: membershiprepo.NewRepo(),
+		jwtHandler:     NewJwtHandler(),
+	}
+}
+
+// Authenticate rejects requests without a valid access token or api key and puts the Principal on the request context
+func (a authenticator) Authenticate(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		principal, err := a.authenticate(r)
+		if err != nil {
+			if err := render.Render(w, r, httputil.NewFailureRender(errors.Wrap(err, "failed to authenticate"))); err != nil {
+				slog.Error("failed to render", logger.AttrError(err))
+			}
+			return
+		}
+
+		next.ServeHTTP(w, r.WithContext(WithPrincipal(r.Context(), *principal)))
+	})
+}
+
+func (a authenticator) authenticate(r *http.Request) (*Principal, error) {
+	if header := r.Header.Get("Authorization"); strings.HasPrefix(header, apiKeyPrefix) {
+		return a.authenticateApiKey(r.Context(), strings.TrimSpace(strings.TrimPrefix(header, apiKeyPrefix)))
+	}
+
+	tokenString := extractAccessToken(r)
+	if tokenString == "" {
+		return nil, errors.Wrap(httputil.ErrUnauthorize, "missing access token")
+	}
+
+	// parse token
+	var claims CustomClaims[AccessMetaData]
+	if err := a.jwtHandler.ParseToken(tokenString, &claims); err != nil {
+		return nil, errors.Wrapf(httputil.ErrUnauthorize, "failed to parse token: %s", err.Error())
+	}
+	if claims.GetKeyType() != AccessKey {
+		return nil, errors.Wrap(httputil.ErrUnauthorize, "not an access token")
+	}
+
+	// get userId from claims
+	userId, err := strconv.Atoi(claims.Subject)
+	if err != nil {
+		return nil, errors.Wrap(httputil.ErrUnauthorize, "invalid subject")
+	}
+
+	var actorId int
+	if claims.Act != nil {
+		if actorId, err = strconv.Atoi(claims.Act.Subject); err != nil || actorId == 0 {
+			return nil, errors.Wrap(httputil.ErrUnauthorize, "invalid actor")
+		}
+	}
+
+	// get user by user id
+	var user *entities.UserAccount
+	if err := database.NewBoilerTransaction(r.Context(), func(ctx context.Context, tx boil.ContextTransactor) error {
+		var err error
+		user, err = a.repo.GetActiveUserAccountById(ctx, tx, userId)
+		if err != nil {
+			return errors.Wrap(err, "failed get userAccount")
+		}
+		if user == nil {
+			return errors.New("failed get userAccount")
+		}
+
+		// the impersonation ends with the account of the admin
+		if actorId != 0 {
+			actor, err := a.repo.GetActiveUserAccountById(ctx, tx, actorId)
+			if err != nil {
+				return errors.Wrap(err, "failed get actor")
+			}
+			if actor == nil {
+				return errors.New("failed get actor")
+			}
+		}
+		return nil
+	}); err != nil {
+		return nil, errors.Wrap(httputil.ErrUnauthorize, err.Error())
+	}
+
+	principal := Principal{
+		UserID:      user.ID,
+		UserName:    user.UserName,
+		TokenID:     claims.ID,
+		ClientID:    claims.MetaData.ClientID,
+		Roles:       claims.MetaData.Roles,
+		Permissions: claims.MetaData.Permissions,
+		Membership:  claims.MetaData.Membership,
+		ActorID:     actorId,
+	}
+	if claims.ExpiresAt != nil {
+		principal.ExpiresAt = claims.ExpiresAt.Time
+	}
+
+	return &principal, nil
+}
+
+// authenticateApiKey acts as the owner of the key, limited to the scopes the owner still has
+func (a authenticator) authenticateApiKey(ctx context.Context, key string) (*Principal, error) {
+	prefix, ok := ParseApiKeyPrefix(key)
+	if !ok {
+		return nil, errors.Wrap(httputil.ErrUnauthorize, "malformed api key")
+	}
+
+	var (
+		apiKey      *entities.APIKey
+		user        *entities.UserAccount
+		roles       []string
+		permissions []string
+		membership  string
+	)
+	if err := database.NewBoilerTransaction(ctx, func(ctx context.Context, tx boil.ContextTransactor) error {
+		var err error
+		apiKey, err = a.apiKeyRepo.GetApiKeyByPrefix(ctx, tx, prefix)
+		if err != nil {
+			return errors.Wrap(err, "failed get apiKey")
+		}
+		if apiKey == nil || !VerifyApiKey(key, apiKey.KeyHash) {
+			return errors.New("unknown api key")
+		}
+		if !apiKey.ExpiresAt.After(time.Now()) {
+			return errors.New("expired api key")
+		}
+
+		user, err = a.repo.GetActiveUserAccountById(ctx, tx, apiKey.UserAccountID)
+		if err != nil {
+			return errors.Wrap(err, "failed get userAccount")
+		}
+		if user == nil {
+			return errors.New("failed get userAccount")
+		}
+
+		if roles, err = a.roleRepo.GetRoleNamesByUserAccountId(ctx, tx, user.ID); err != nil {
+			return errors.Wrap(err, "failed get roles")
+		}
+		if permissions, err = a.roleRepo.GetPermissionNamesByUserAccountId(ctx, tx, user.ID); err != nil {
+			return errors.Wrap(err, "failed get permissions")
+		}
+		pgMembership, err := a.membershipRepo.GetCurrentMembershipByUserAccountId(ctx, tx, user.ID)
+		if err != nil {
+			return errors.Wrap(err, "failed get membership")
+		}
+		if pgMembership != nil {
+			membership = pgMembership.Name
+		}
+		return nil
+	}); err != nil {
+		return nil, errors.Wrap(httputil.ErrUnauthorize, err.Error())
+	}
+
+	a.markUsed(ctx, *apiKey)
+
+	return &Principal{
+		UserID:      user.ID,
+		UserName:    user.UserName,
+		ApiKeyID:    apiKey.ID,
+		ExpiresAt:   apiKey.ExpiresAt,
+		Roles:       roles,
+		Permissions: scopedPermissions(apiKey.Scopes, permissions),
+		Membership:  membership,
+	}, nil
+}
+
+// markUsed records the use of the key, failing to do so does not reject the request
+func (a authenticator) markUsed(ctx context.Context, apiKey entities.APIKey) {
+	now := time.Now()
+	if apiKey.LastUsedAt.Valid && now.Sub(apiKey.LastUsedAt.Time) < lastUsedInterval {
+		return
+	}
+	if err := database.NewBoilerTransaction(ctx, func(ctx context.Context, tx boil.ContextTransactor) error {
+		return a.apiKeyRepo.MarkUsed(ctx, tx, apiKey.ID, now)
+	}); err != nil {
+		slog.Warn("failed to mark api key used", logger.AttrError(err))
+	}
+}
+
+// scopedPermissions the scopes of a key which its owner still has
+func scopedPermissions(scopes []string, permissions []string) []string {
+	result := []string{}
+	for _, scope := range scopes {
+		if slices.Contains(permissions, scope) {
+			result = append(result, scope)
+		}
+	}
+	return result
+}
+
+// extractAccessToken reads the token from the Authorization header, falling back to the accessToken cookie
+func extractAccessToken(r *http.Request) string {
+	if header := r.Header.Get("Authorization"); header != "" {
+		if !strings.HasPrefix(header, bearerPrefix) {
+			return ""
+		}
+		return strings.TrimSpace(strings.TrimPrefix(header, bearerPrefix))
+	}
+
+	cookie, err := r.Cookie(constants.AccessTokenCookie)
+	if err != nil {
+		return ""
+	}
+	return cookie.Value
+}
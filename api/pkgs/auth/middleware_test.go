@@ -0,0 +1,103 @@
+package auth
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestExtractAccessToken(t *testing.T) {
+	{ // bearer header
+		r := httptest.NewRequest(http.MethodGet, "http://example.com", nil)
+		r.Header.Set("Authorization", "Bearer token")
+		require.Equal(t, "token", extractAccessToken(r))
+	}
+	{ // cookie
+		r := httptest.NewRequest(http.MethodGet, "http://example.com", nil)
+		r.AddCookie(&http.Cookie{Name: "accessToken", Value: "token"})
+		require.Equal(t, "token", extractAccessToken(r))
+	}
+	{ // header has priority over cookie
+		r := httptest.NewRequest(http.MethodGet, "http://example.com", nil)
+		r.Header.Set("Authorization", "Bearer header")
+		r.AddCookie(&http.Cookie{Name: "accessToken", Value: "cookie"})
+		require.Equal(t, "header", extractAccessToken(r))
+	}
+	{ // unsupported scheme
+		r := httptest.NewRequest(http.MethodGet, "http://example.com", nil)
+		r.Header.Set("Authorization", "Basic dXNlcjpwYXNz")
+		require.Empty(t, extractAccessToken(r))
+	}
+	{ // no token
+		r := httptest.NewRequest(http.MethodGet, "http://example.com", nil)
+		require.Empty(t, extractAccessToken(r))
+	}
+}
+
+func TestAuthenticate(t *testing.T) {
+	next := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusOK)
+	})
+	handler := authenticator{jwtHandler: NewJwtHandler()}.Authenticate(next)
+
+	{ // missing token
+		w := httptest.NewRecorder()
+		r := httptest.NewRequest(http.MethodGet, "http://example.com", nil)
+		handler.ServeHTTP(w, r)
+		require.Equal(t, http.StatusUnauthorized, w.Result().StatusCode)
+	}
+	{ // invalid token
+		w := httptest.NewRecorder()
+		r := httptest.NewRequest(http.MethodGet, "http://example.com", nil)
+		r.Header.Set("Authorization", "Bearer invalid")
+		handler.ServeHTTP(w, r)
+		require.Equal(t, http.StatusUnauthorized, w.Result().StatusCode)
+	}
+	{ // refresh token is rejected
+		claims := NewCustomClaims[any]().WithExpireAt(time.Now().Add(time.Hour))
+		claims.Subject = "1"
+		claims.KeyType = RefreshKey
+		token, err := NewJwtHandler().WithClaims(claims).CreateToken()
+		require.NoError(t, err)
+
+		w := httptest.NewRecorder()
+		r := httptest.NewRequest(http.MethodGet, "http://example.com", nil)
+		r.Header.Set("Authorization", "Bearer "+token)
+		handler.ServeHTTP(w, r)
+		require.Equal(t, http.StatusUnauthorized, w.Result().StatusCode)
+	}
+	{ // malformed actor is rejected
+		claims := NewCustomClaims[any]().WithExpireAt(time.Now().Add(time.Hour))
+		claims.Subject = "1"
+		claims.KeyType = AccessKey
+		claims.Act = &Actor{Subject: "admin"}
+		token, err := NewJwtHandler().WithClaims(claims).CreateToken()
+		require.NoError(t, err)
+
+		w := httptest.NewRecorder()
+		r := httptest.NewRequest(http.MethodGet, "http://example.com", nil)
+		r.Header.Set("Authorization", "Bearer "+token)
+		handler.ServeHTTP(w, r)
+		require.Equal(t, http.StatusUnauthorized, w.Result().StatusCode)
+	}
+	{ // malformed api key
+		w := httptest.NewRecorder()
+		r := httptest.NewRequest(http.MethodGet, "http://example.com", nil)
+		r.Header.Set("Authorization", "ApiKey not-a-key")
+		handler.ServeHTTP(w, r)
+		require.Equal(t, http.StatusUnauthorized, w.Result().StatusCode)
+	}
+}
+
+func TestScopedPermissions(t *testing.T) {
+	{ // scopes the owner lost are dropped
+		result := scopedPermissions([]string{"users:read", "users:write"}, []string{"users:read", "roles:read"})
+		require.Equal(t, []string{"users:read"}, result)
+	}
+	{ // no scopes
+		require.Empty(t, scopedPermissions(nil, []string{"users:read"}))
+	}
+}
@@ -1,13 +1,24 @@
 package auth
 
-type auth struct{}
+type auth struct {
+	params    argonParam
+	peppers   peppers
+	verifiers []prefixVerifier
+}
 
 //go:generate moq -pkg pkgmock -out ../../testing/mocking/pkgmock/authservice.mock.go . AuthService
 type AuthService interface {
 	HashPassword(password string) (string, error)
 	ComparePasswordAndHash(password, encodedHash string) (match bool, err error)
+	// NeedsRehash tells whether the hash was made with another scheme or other parameters than the configured ones
+	NeedsRehash(encodedHash string) bool
 }
 
 func NewAuthService() AuthService {
-	return auth{}
+	peppers := peppersFromEnv()
+	return auth{
+		params:    argonParamsFromEnv(),
+		peppers:   peppers,
+		verifiers: newVerifiers(peppers),
+	}
 }
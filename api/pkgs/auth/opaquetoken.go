@@ -0,0 +1,28 @@
+package auth
+
+import (
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/hex"
+
+	"github.com/pkg/errors"
+)
+
+const opaqueTokenLength = 32
+
+// GenerateOpaqueToken returns a random url-safe token and the hash to store instead of it
+func GenerateOpaqueToken() (token string, hash string, err error) {
+	b, err := generateRandomBytes(opaqueTokenLength)
+	if err != nil {
+		return "", "", errors.Wrap(err, "failed to generate token")
+	}
+
+	token = base64.RawURLEncoding.EncodeToString(b)
+	return token, HashOpaqueToken(token), nil
+}
+
+// HashOpaqueToken hex encoded sha256, the token has enough entropy to not need a salt
+func HashOpaqueToken(token string) string {
+	sum := sha256.Sum256([]byte(token))
+	return hex.EncodeToString(sum[:])
+}
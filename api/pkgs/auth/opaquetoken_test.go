@@ -0,0 +1,19 @@
+package auth
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestGenerateOpaqueToken(t *testing.T) {
+	tokenA, hashA, err := GenerateOpaqueToken()
+	require.NoError(t, err)
+	require.Len(t, hashA, 64)
+	require.Equal(t, hashA, HashOpaqueToken(tokenA))
+
+	tokenB, hashB, err := GenerateOpaqueToken()
+	require.NoError(t, err)
+	require.NotEqual(t, tokenA, tokenB)
+	require.NotEqual(t, hashA, hashB)
+}
@@ -0,0 +1,37 @@
+package auth
+
+import (
+	"crypto/hmac"
+	"crypto/sha256"
+	"mysite/pkgs/env"
+
+	"github.com/pkg/errors"
+)
+
+// peppers are server side secrets by version, the version is kept in the hash as keyid so that peppers can be rotated
+type peppers map[string][]byte
+
+func peppersFromEnv() peppers {
+	cfg := env.GetEnv().PasswordPepper
+	result := make(peppers, len(cfg.Keys))
+	for _, key := range cfg.Keys {
+		result[key.Version] = []byte(key.Secret)
+	}
+	return result
+}
+
+// apply hmacs the password with the pepper of the version, hashes without version are not peppered
+func (p peppers) apply(version, password string) ([]byte, error) {
+	if version == "" {
+		return []byte(password), nil
+	}
+
+	secret, found := p[version]
+	if !found {
+		return nil, errors.Errorf("unknown pepper version %s", version)
+	}
+
+	mac := hmac.New(sha256.New, secret)
+	mac.Write([]byte(password))
+	return mac.Sum(nil), nil
+}
@@ -0,0 +1,68 @@
+package auth
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestPepper(t *testing.T) {
+	keys := peppers{"v1": []byte("pepper-1"), "v2": []byte("pepper-2")}
+	withPepper := func(version string) auth {
+		params := defaultParams
+		params.pepperVersion = version
+		return auth{params: params, peppers: keys}
+	}
+
+	{ // peppered hash carries its version
+		svc := withPepper("v1")
+		hash, err := svc.HashPassword("secret")
+		assert.NoError(t, err)
+		assert.Contains(t, hash, ",keyid=v1$")
+		assert.False(t, svc.NeedsRehash(hash))
+
+		match, err := svc.ComparePasswordAndHash("secret", hash)
+		assert.NoError(t, err)
+		assert.True(t, match)
+
+		match, err = svc.ComparePasswordAndHash("secret1", hash)
+		assert.NoError(t, err)
+		assert.False(t, match)
+	}
+	{ // pepper is needed to verify
+		hash, err := withPepper("v1").HashPassword("secret")
+		assert.NoError(t, err)
+
+		_, err = auth{}.ComparePasswordAndHash("secret", hash)
+		assert.Error(t, err)
+
+		match, err := auth{peppers: peppers{"v1": []byte("other")}}.ComparePasswordAndHash("secret", hash)
+		assert.NoError(t, err)
+		assert.False(t, match)
+	}
+	{ // rotated pepper, old version still verifies and is rehashed
+		hash, err := withPepper("v1").HashPassword("secret")
+		assert.NoError(t, err)
+
+		svc := withPepper("v2")
+		match, err := svc.ComparePasswordAndHash("secret", hash)
+		assert.NoError(t, err)
+		assert.True(t, match)
+		assert.True(t, svc.NeedsRehash(hash))
+	}
+	{ // hash without pepper falls back to no pepper and is rehashed
+		hash, err := auth{}.HashPassword("secret")
+		assert.NoError(t, err)
+		assert.NotContains(t, hash, "keyid")
+
+		svc := withPepper("v1")
+		match, err := svc.ComparePasswordAndHash("secret", hash)
+		assert.NoError(t, err)
+		assert.True(t, match)
+		assert.True(t, svc.NeedsRehash(hash))
+	}
+	{ // unknown active version
+		_, err := withPepper("v3").HashPassword("secret")
+		assert.Error(t, err)
+	}
+}
@@ -0,0 +1,68 @@
+package auth
+
+import (
+	"log/slog"
+	"mysite/pkgs/logger"
+	"mysite/utils/httputil"
+	"net/http"
+
+	"github.com/go-chi/render"
+	"github.com/pkg/errors"
+)
+
+// RequirePermission rejects callers whose access token does not grant the permission, it must run after Authenticate
+func RequirePermission(permission string) func(http.Handler) http.Handler {
+	return func(next http.Handler) http.Handler {
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			if err := checkPermission(r, permission); err != nil {
+				if err := render.Render(w, r, httputil.NewFailureRender(err)); err != nil {
+					slog.Error("failed to render", logger.AttrError(err))
+				}
+				return
+			}
+
+			next.ServeHTTP(w, r)
+		})
+	}
+}
+
+// RequireLogin rejects requests authenticated by an api key or by a token of an oauth client, for routes which
+// change or mint credentials of the user, it must run after Authenticate
+func RequireLogin(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if principal, found := PrincipalFromContext(r.Context()); found && (principal.ApiKeyID != 0 || principal.ClientID != "") {
+			if err := render.Render(w, r, httputil.NewFailureRender(errors.Wrap(httputil.ErrForbidden, "only a login can manage credentials"))); err != nil {
+				slog.Error("failed to render", logger.AttrError(err))
+			}
+			return
+		}
+
+		next.ServeHTTP(w, r)
+	})
+}
+
+// RejectImpersonation rejects requests made by an admin as another user, for routes which change or mint
+// credentials of the user, it must run after Authenticate
+func RejectImpersonation(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if principal, found := PrincipalFromContext(r.Context()); found && principal.Impersonated() {
+			if err := render.Render(w, r, httputil.NewFailureRender(errors.Wrap(httputil.ErrForbidden, "not allowed while impersonating"))); err != nil {
+				slog.Error("failed to render", logger.AttrError(err))
+			}
+			return
+		}
+
+		next.ServeHTTP(w, r)
+	})
+}
+
+func checkPermission(r *http.Request, permission string) error {
+	principal, found := PrincipalFromContext(r.Context())
+	if !found {
+		return errors.Wrap(httputil.ErrUnauthorize, "missing principal")
+	}
+	if !principal.HasPermission(permission) {
+		return errors.Wrapf(httputil.ErrForbidden, "missing permission %s", permission)
+	}
+	return nil
+}
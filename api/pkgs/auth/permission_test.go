@@ -0,0 +1,82 @@
+package auth
+
+import (
+	"mysite/constants"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestRequirePermission(t *testing.T) {
+	next := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusOK)
+	})
+	handler := RequirePermission(constants.PermissionUsersRead)(next)
+
+	{ // permission granted
+		principal := Principal{UserID: 1, Permissions: []string{constants.PermissionUsersRead}}
+		w := httptest.NewRecorder()
+		r := httptest.NewRequest(http.MethodGet, "http://example.com", nil)
+		handler.ServeHTTP(w, r.WithContext(WithPrincipal(r.Context(), principal)))
+		require.Equal(t, http.StatusOK, w.Result().StatusCode)
+	}
+	{ // permission missing
+		principal := Principal{UserID: 1, Roles: []string{constants.RoleMember}}
+		w := httptest.NewRecorder()
+		r := httptest.NewRequest(http.MethodGet, "http://example.com", nil)
+		handler.ServeHTTP(w, r.WithContext(WithPrincipal(r.Context(), principal)))
+		require.Equal(t, http.StatusForbidden, w.Result().StatusCode)
+	}
+	{ // not authenticated
+		w := httptest.NewRecorder()
+		r := httptest.NewRequest(http.MethodGet, "http://example.com", nil)
+		handler.ServeHTTP(w, r)
+		require.Equal(t, http.StatusUnauthorized, w.Result().StatusCode)
+	}
+}
+
+func TestRejectImpersonation(t *testing.T) {
+	next := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusOK)
+	})
+	handler := RejectImpersonation(next)
+
+	{ // own session
+		principal := Principal{UserID: 1}
+		w := httptest.NewRecorder()
+		r := httptest.NewRequest(http.MethodGet, "http://example.com", nil)
+		handler.ServeHTTP(w, r.WithContext(WithPrincipal(r.Context(), principal)))
+		require.Equal(t, http.StatusOK, w.Result().StatusCode)
+	}
+	{ // impersonated session
+		principal := Principal{UserID: 1, ActorID: 2}
+		w := httptest.NewRecorder()
+		r := httptest.NewRequest(http.MethodGet, "http://example.com", nil)
+		handler.ServeHTTP(w, r.WithContext(WithPrincipal(r.Context(), principal)))
+		require.Equal(t, http.StatusForbidden, w.Result().StatusCode)
+	}
+}
+
+func TestRequireLogin(t *testing.T) {
+	next := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusOK)
+	})
+	handler := RequireLogin(next)
+
+	tests := []struct {
+		principal Principal
+		status    int
+	}{
+		{principal: Principal{UserID: 1}, status: http.StatusOK},
+		{principal: Principal{UserID: 1, ApiKeyID: 2}, status: http.StatusForbidden},
+		{principal: Principal{UserID: 1, ClientID: "client"}, status: http.StatusForbidden},
+	}
+	for _, tt := range tests {
+		w := httptest.NewRecorder()
+		r := httptest.NewRequest(http.MethodGet, "http://example.com", nil)
+		handler.ServeHTTP(w, r.WithContext(WithPrincipal(r.Context(), tt.principal)))
+		require.Equal(t, tt.status, w.Result().StatusCode)
+	}
+}
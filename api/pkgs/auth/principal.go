@@ -0,0 +1,54 @@
+package auth
+
+import (
+	"context"
+	"mysite/constants"
+	"slices"
+	"time"
+)
+
+// Principal is the authenticated caller of a request
+type Principal struct {
+	UserID   int
+	UserName string
+
+	// TokenID jti of the access token
+	TokenID string
+
+	// ApiKeyID id of the api key the request was authenticated by, 0 for access tokens
+	ApiKeyID int
+
+	// ClientID oauth client the access token was issued to, empty for the first-party login
+	ClientID string
+
+	// ExpiresAt expiry of the access token or api key
+	ExpiresAt time.Time
+
+	// Roles and Permissions as of when the access token was issued
+	Roles       []string
+	Permissions []string
+
+	// Membership name of the tier as of when the access token was issued
+	Membership string
+
+	// ActorID admin acting as the user by an impersonation token, 0 otherwise
+	ActorID int
+}
+
+func (p Principal) HasPermission(permission string) bool {
+	return slices.Contains(p.Permissions, permission)
+}
+
+// Impersonated tells whether an admin makes the request as the user
+func (p Principal) Impersonated() bool {
+	return p.ActorID != 0
+}
+
+func WithPrincipal(ctx context.Context, principal Principal) context.Context {
+	return context.WithValue(ctx, constants.Principal, principal)
+}
+
+func PrincipalFromContext(ctx context.Context) (Principal, bool) {
+	principal, found := ctx.Value(constants.Principal).(Principal)
+	return principal, found
+}
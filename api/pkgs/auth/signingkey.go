@@ -0,0 +1,271 @@
+package auth
+
+import (
+	"crypto"
+	"crypto/ecdsa"
+	"crypto/ed25519"
+	"crypto/elliptic"
+	"crypto/rsa"
+	"crypto/sha256"
+	"crypto/x509"
+	"encoding/base64"
+	"mysite/pkgs/env"
+	"os"
+	"sync"
+
+	"github.com/golang-jwt/jwt/v5"
+	"github.com/pkg/errors"
+)
+
+type KeyState string
+
+const (
+	// KeyStateActive signs new tokens, exactly one key per KeyType
+	KeyStateActive KeyState = "active"
+	// KeyStateVerifyOnly still verifies tokens signed before the rotation
+	KeyStateVerifyOnly KeyState = "verify-only"
+	// KeyStateRetired is rejected
+	KeyStateRetired KeyState = "retired"
+)
+
+type signingKey struct {
+	kid    string
+	state  KeyState
+	method jwt.SigningMethod
+	sign   interface{} // []byte for HMAC, crypto.Signer otherwise
+	verify interface{} // []byte for HMAC, crypto.PublicKey otherwise
+}
+
+// asymmetric tells whether the key can be published in the jwks
+func (k signingKey) asymmetric() bool {
+	_, ok := k.method.(*jwt.SigningMethodHMAC)
+	return !ok
+}
+
+type keySet []signingKey
+
+// active returns the key which signs new tokens
+func (ks keySet) active() (*signingKey, error) {
+	for i := range ks {
+		if ks[i].state == KeyStateActive {
+			return &ks[i], nil
+		}
+	}
+	return nil, errors.New("no active key")
+}
+
+// verifier returns the key to verify a token, tokens without kid are verified by the active key
+func (ks keySet) verifier(kid string) (*signingKey, error) {
+	if kid == "" {
+		return ks.active()
+	}
+
+	for i := range ks {
+		if ks[i].kid != kid {
+			continue
+		}
+		if ks[i].state == KeyStateRetired {
+			return nil, errors.Errorf("key %s is retired", kid)
+		}
+		return &ks[i], nil
+	}
+	return nil, errors.Errorf("unknown key %s", kid)
+}
+
+var (
+	privateKeyMu    sync.Mutex
+	privateKeyCache = map[string]crypto.Signer{}
+)
+
+func getKeySet(keyType KeyType) (keySet, error) {
+	envObj := env.GetEnv().Jwt
+	switch keyType {
+	case CursorKey:
+		return newKeySet(envObj.CursorKey, envObj.CursorSigning)
+	case AccessKey:
+		return newKeySet(envObj.AccessKey, envObj.AccessSigning)
+	case RefreshKey:
+		return newKeySet(envObj.RefreshKey, envObj.RefreshSigning)
+	case VerifyEmailKey:
+		return newKeySet(envObj.VerifyEmailKey, envObj.VerifyEmailSigning)
+	case MfaPendingKey:
+		return newKeySet(envObj.MfaPendingKey, envObj.MfaPendingSigning)
+	case OidcStateKey:
+		return newKeySet(envObj.OidcStateKey, envObj.OidcStateSigning)
+	case MagicLinkKey:
+		return newKeySet(envObj.MagicLinkKey, envObj.MagicLinkSigning)
+	default:
+		return nil, errors.New("unsupported key type")
+	}
+}
+
+func newKeySet(secret string, signing env.JwtSigning) (keySet, error) {
+	// without rotation the single configured key is the active one
+	if len(signing.Keys) == 0 {
+		key, err := newSigningKey(secret, signing.Algorithm, signing.PrivateKeyFile)
+		if err != nil {
+			return nil, errors.Wrap(err, "failed create signing key")
+		}
+		return keySet{*key}, nil
+	}
+
+	var (
+		result = make(keySet, 0, len(signing.Keys))
+		seen   = map[string]bool{}
+		active int
+	)
+	for _, cfg := range signing.Keys {
+		if cfg.Kid == "" {
+			return nil, errors.New("missing kid")
+		}
+		if seen[cfg.Kid] {
+			return nil, errors.Errorf("duplicated kid %s", cfg.Kid)
+		}
+		seen[cfg.Kid] = true
+
+		state := KeyState(cfg.State)
+		switch state {
+		case KeyStateActive:
+			active++
+		case KeyStateVerifyOnly:
+		case KeyStateRetired:
+			// retired keys are kept so that tokens signed by them are rejected with a clear reason
+			result = append(result, signingKey{kid: cfg.Kid, state: state})
+			continue
+		default:
+			return nil, errors.Errorf("invalid state %s of key %s", cfg.State, cfg.Kid)
+		}
+
+		key, err := newSigningKey(cfg.Secret, cfg.Algorithm, cfg.PrivateKeyFile)
+		if err != nil {
+			return nil, errors.Wrapf(err, "failed create signing key %s", cfg.Kid)
+		}
+		key.kid = cfg.Kid
+		key.state = state
+		result = append(result, *key)
+	}
+
+	if active != 1 {
+		return nil, errors.Errorf("expected exactly one active key, got %d", active)
+	}
+	return result, nil
+}
+
+func newSigningKey(secret, algorithm, privateKeyFile string) (*signingKey, error) {
+	if algorithm == "" || algorithm == jwt.SigningMethodHS256.Alg() {
+		// an empty key would let anyone sign tokens
+		if secret == "" {
+			return nil, errors.New("missing secret")
+		}
+		return &signingKey{
+			state:  KeyStateActive,
+			method: jwt.SigningMethodHS256,
+			sign:   []byte(secret),
+			verify: []byte(secret),
+		}, nil
+	}
+
+	method, err := asymmetricMethod(algorithm)
+	if err != nil {
+		return nil, errors.Wrap(err, "invalid signing algorithm")
+	}
+
+	privateKey, err := loadPrivateKey(privateKeyFile)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed load private key")
+	}
+	if err := checkKeyMatchesMethod(privateKey, method); err != nil {
+		return nil, errors.Wrap(err, "invalid private key")
+	}
+
+	kid, err := thumbprint(privateKey.Public())
+	if err != nil {
+		return nil, errors.Wrap(err, "failed create kid")
+	}
+
+	return &signingKey{
+		kid:    kid,
+		state:  KeyStateActive,
+		method: method,
+		sign:   privateKey,
+		verify: privateKey.Public(),
+	}, nil
+}
+
+func asymmetricMethod(alg string) (jwt.SigningMethod, error) {
+	switch alg {
+	case jwt.SigningMethodRS256.Alg():
+		return jwt.SigningMethodRS256, nil
+	case jwt.SigningMethodES256.Alg():
+		return jwt.SigningMethodES256, nil
+	case jwt.SigningMethodEdDSA.Alg():
+		return jwt.SigningMethodEdDSA, nil
+	default:
+		return nil, errors.Errorf("unsupported algorithm %s", alg)
+	}
+}
+
+func checkKeyMatchesMethod(privateKey crypto.Signer, method jwt.SigningMethod) error {
+	switch key := privateKey.(type) {
+	case *rsa.PrivateKey:
+		if method == jwt.SigningMethodRS256 {
+			return nil
+		}
+	case *ecdsa.PrivateKey:
+		if method == jwt.SigningMethodES256 && key.Curve == elliptic.P256() {
+			return nil
+		}
+	case ed25519.PrivateKey:
+		if method == jwt.SigningMethodEdDSA {
+			return nil
+		}
+	}
+	return errors.Errorf("private key %T can not be used with %s", privateKey, method.Alg())
+}
+
+// loadPrivateKey reads a PKCS#8, PKCS#1 or SEC 1 PEM file, keys are cached by path
+func loadPrivateKey(path string) (crypto.Signer, error) {
+	if path == "" {
+		return nil, errors.New("missing private key file")
+	}
+
+	privateKeyMu.Lock()
+	defer privateKeyMu.Unlock()
+
+	if key, found := privateKeyCache[path]; found {
+		return key, nil
+	}
+
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed read private key file")
+	}
+
+	var key crypto.Signer
+	if rsaKey, err := jwt.ParseRSAPrivateKeyFromPEM(data); err == nil {
+		key = rsaKey
+	} else if ecKey, err := jwt.ParseECPrivateKeyFromPEM(data); err == nil {
+		key = ecKey
+	} else if edKey, err := jwt.ParseEdPrivateKeyFromPEM(data); err == nil {
+		signer, ok := edKey.(crypto.Signer)
+		if !ok {
+			return nil, errors.New("invalid ed25519 private key")
+		}
+		key = signer
+	} else {
+		return nil, errors.New("unsupported private key format")
+	}
+
+	privateKeyCache[path] = key
+	return key, nil
+}
+
+// thumbprint identifies a public key, sha256 of its PKIX encoding
+func thumbprint(publicKey crypto.PublicKey) (string, error) {
+	der, err := x509.MarshalPKIXPublicKey(publicKey)
+	if err != nil {
+		return "", errors.Wrap(err, "failed marshal public key")
+	}
+	sum := sha256.Sum256(der)
+	return base64.RawURLEncoding.EncodeToString(sum[:16]), nil
+}
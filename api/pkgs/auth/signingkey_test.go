@@ -0,0 +1,229 @@
+package auth
+
+import (
+	"crypto"
+	"crypto/ecdsa"
+	"crypto/ed25519"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/x509"
+	"encoding/pem"
+	"mysite/pkgs/env"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/golang-jwt/jwt/v5"
+	"github.com/stretchr/testify/require"
+)
+
+func writePrivateKey(t *testing.T, key crypto.Signer) string {
+	der, err := x509.MarshalPKCS8PrivateKey(key)
+	require.NoError(t, err)
+
+	path := filepath.Join(t.TempDir(), "private.pem")
+	require.NoError(t, os.WriteFile(path, pem.EncodeToMemory(&pem.Block{Type: "PRIVATE KEY", Bytes: der}), 0600))
+	return path
+}
+
+func withAccessSigning(t *testing.T, algorithm, privateKeyFile string) {
+	origin := env.GetEnv()
+	require.NoError(t, env.ReadEnv(func(appEnv *env.AppEnv) {
+		appEnv.Jwt.AccessSigning.Algorithm = algorithm
+		appEnv.Jwt.AccessSigning.PrivateKeyFile = privateKeyFile
+	}))
+	t.Cleanup(func() {
+		_ = env.ReadEnv(func(appEnv *env.AppEnv) { *appEnv = origin })
+	})
+}
+
+func TestAsymmetricSigning(t *testing.T) {
+	rsaKey, err := rsa.GenerateKey(rand.Reader, 2048)
+	require.NoError(t, err)
+	ecKey, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	require.NoError(t, err)
+	_, edKey, err := ed25519.GenerateKey(rand.Reader)
+	require.NoError(t, err)
+
+	testCases := []struct {
+		algorithm string
+		key       crypto.Signer
+		kty       string
+	}{
+		{algorithm: "RS256", key: rsaKey, kty: "RSA"},
+		{algorithm: "ES256", key: ecKey, kty: "EC"},
+		{algorithm: "EdDSA", key: edKey, kty: "OKP"},
+	}
+
+	for _, tt := range testCases {
+		t.Run(tt.algorithm, func(t *testing.T) {
+			withAccessSigning(t, tt.algorithm, writePrivateKey(t, tt.key))
+
+			claims := NewCustomClaims[any]().WithExpireAt(time.Now().Add(time.Hour))
+			claims.KeyType = AccessKey
+			tokenStr, err := NewJwtHandler().WithClaims(claims).CreateToken()
+			require.NoError(t, err)
+
+			token, _, err := jwt.NewParser().ParseUnverified(tokenStr, &CustomClaims[any]{})
+			require.NoError(t, err)
+			require.Equal(t, tt.algorithm, token.Method.Alg())
+			require.NotEmpty(t, token.Header["kid"])
+
+			var parsed CustomClaims[any]
+			require.NoError(t, NewJwtHandler().ParseToken(tokenStr, &parsed))
+			require.Equal(t, claims.ID, parsed.ID)
+
+			jwks, err := PublicJwks()
+			require.NoError(t, err)
+			require.Len(t, jwks, 1)
+			require.Equal(t, tt.kty, jwks[0].Kty)
+			require.Equal(t, tt.algorithm, jwks[0].Alg)
+			require.Equal(t, token.Header["kid"], jwks[0].Kid)
+		})
+	}
+}
+
+func TestAsymmetricSigningRejectsHS256(t *testing.T) {
+	// token signed with the shared secret before switching the key type to RS256
+	claims := NewCustomClaims[any]().WithExpireAt(time.Now().Add(time.Hour))
+	claims.KeyType = AccessKey
+	tokenStr, err := NewJwtHandler().WithClaims(claims).CreateToken()
+	require.NoError(t, err)
+
+	rsaKey, err := rsa.GenerateKey(rand.Reader, 2048)
+	require.NoError(t, err)
+	withAccessSigning(t, "RS256", writePrivateKey(t, rsaKey))
+
+	var parsed CustomClaims[any]
+	require.Error(t, NewJwtHandler().ParseToken(tokenStr, &parsed))
+}
+
+func TestNewSigningKey(t *testing.T) {
+	{ // default to HS256
+		key, err := newSigningKey("secret", "", "")
+		require.NoError(t, err)
+		require.Equal(t, jwt.SigningMethodHS256, key.method)
+		require.False(t, key.asymmetric())
+	}
+	{ // HS256 without secret
+		_, err := newSigningKey("", "", "")
+		require.Error(t, err)
+
+		_, err = newSigningKey("", "HS256", "")
+		require.Error(t, err)
+	}
+	{ // unsupported algorithm
+		_, err := newSigningKey("secret", "PS512", "")
+		require.Error(t, err)
+	}
+	{ // missing private key file
+		_, err := newSigningKey("", "RS256", "")
+		require.Error(t, err)
+	}
+	{ // private key does not match the algorithm
+		ecKey, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+		require.NoError(t, err)
+		_, err = newSigningKey("", "RS256", writePrivateKey(t, ecKey))
+		require.Error(t, err)
+	}
+}
+
+func withAccessKeys(t *testing.T, keys ...env.JwtKey) {
+	origin := env.GetEnv()
+	require.NoError(t, env.ReadEnv(func(appEnv *env.AppEnv) {
+		appEnv.Jwt.AccessSigning.Keys = keys
+	}))
+	t.Cleanup(func() {
+		_ = env.ReadEnv(func(appEnv *env.AppEnv) { *appEnv = origin })
+	})
+}
+
+func TestKeyRotation(t *testing.T) {
+	newToken := func() (string, *CustomClaims[any]) {
+		claims := NewCustomClaims[any]().WithExpireAt(time.Now().Add(time.Hour))
+		claims.KeyType = AccessKey
+		tokenStr, err := NewJwtHandler().WithClaims(claims).CreateToken()
+		require.NoError(t, err)
+		return tokenStr, claims
+	}
+	kidOf := func(tokenStr string) interface{} {
+		token, _, err := jwt.NewParser().ParseUnverified(tokenStr, &CustomClaims[any]{})
+		require.NoError(t, err)
+		return token.Header["kid"]
+	}
+
+	ecKey, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	require.NoError(t, err)
+	ecKeyFile := writePrivateKey(t, ecKey)
+
+	// key-1 signs
+	withAccessKeys(t, env.JwtKey{Kid: "key-1", State: "active", Secret: "secret-1"})
+	oldToken, _ := newToken()
+	require.Equal(t, "key-1", kidOf(oldToken))
+
+	// key-2 signs, key-1 still verifies
+	withAccessKeys(t,
+		env.JwtKey{Kid: "key-1", State: "verify-only", Secret: "secret-1"},
+		env.JwtKey{Kid: "key-2", State: "active", Algorithm: "ES256", PrivateKeyFile: ecKeyFile},
+	)
+	newTokenStr, _ := newToken()
+	require.Equal(t, "key-2", kidOf(newTokenStr))
+
+	var parsed CustomClaims[any]
+	require.NoError(t, NewJwtHandler().ParseToken(oldToken, &parsed))
+	require.NoError(t, NewJwtHandler().ParseToken(newTokenStr, &parsed))
+
+	jwks, err := PublicJwks()
+	require.NoError(t, err)
+	require.Len(t, jwks, 1)
+	require.Equal(t, "key-2", jwks[0].Kid)
+
+	// key-1 retired
+	withAccessKeys(t,
+		env.JwtKey{Kid: "key-1", State: "retired"},
+		env.JwtKey{Kid: "key-2", State: "active", Algorithm: "ES256", PrivateKeyFile: ecKeyFile},
+	)
+	require.Error(t, NewJwtHandler().ParseToken(oldToken, &parsed))
+	require.NoError(t, NewJwtHandler().ParseToken(newTokenStr, &parsed))
+}
+
+func TestNewKeySet(t *testing.T) {
+	{ // legacy single key is active
+		keys, err := newKeySet("secret", env.JwtSigning{})
+		require.NoError(t, err)
+		require.Len(t, keys, 1)
+		key, err := keys.active()
+		require.NoError(t, err)
+		require.Empty(t, key.kid)
+	}
+	{ // no active key
+		_, err := newKeySet("", env.JwtSigning{Keys: []env.JwtKey{{Kid: "key-1", State: "verify-only", Secret: "secret"}}})
+		require.Error(t, err)
+	}
+	{ // two active keys
+		_, err := newKeySet("", env.JwtSigning{Keys: []env.JwtKey{
+			{Kid: "key-1", State: "active", Secret: "secret-1"},
+			{Kid: "key-2", State: "active", Secret: "secret-2"},
+		}})
+		require.Error(t, err)
+	}
+	{ // duplicated kid
+		_, err := newKeySet("", env.JwtSigning{Keys: []env.JwtKey{
+			{Kid: "key-1", State: "active", Secret: "secret-1"},
+			{Kid: "key-1", State: "verify-only", Secret: "secret-2"},
+		}})
+		require.Error(t, err)
+	}
+	{ // invalid state
+		_, err := newKeySet("", env.JwtSigning{Keys: []env.JwtKey{{Kid: "key-1", State: "enabled", Secret: "secret"}}})
+		require.Error(t, err)
+	}
+	{ // unknown kid
+		keys, err := newKeySet("", env.JwtSigning{Keys: []env.JwtKey{{Kid: "key-1", State: "active", Secret: "secret"}}})
+		require.NoError(t, err)
+		_, err = keys.verifier("key-2")
+		require.Error(t, err)
+	}
+}
@@ -0,0 +1,150 @@
+package auth
+
+import (
+	"crypto/sha256"
+	"crypto/subtle"
+	"encoding/base64"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/pkg/errors"
+	"golang.org/x/crypto/bcrypt"
+	"golang.org/x/crypto/pbkdf2"
+	"golang.org/x/crypto/scrypt"
+)
+
+// verifier checks a password against a hash of one scheme, only argon2id is used to make new hashes
+type verifier func(password, encodedHash string) (match bool, err error)
+
+type prefixVerifier struct {
+	prefix string
+	verify verifier
+}
+
+// newVerifiers are tried in order, the first matching prefix decides
+func newVerifiers(peppers peppers) []prefixVerifier {
+	return append([]prefixVerifier{
+		{prefix: "$argon2id$", verify: func(password, encodedHash string) (bool, error) {
+			return compareArgon2id(peppers, password, encodedHash)
+		}},
+	}, legacyVerifiers...)
+}
+
+// legacyVerifiers schemes of imported users, their hashes are replaced by argon2id on the next login
+var legacyVerifiers = []prefixVerifier{
+	{prefix: "$2a$", verify: compareBcrypt},
+	{prefix: "$2b$", verify: compareBcrypt},
+	{prefix: "$2y$", verify: compareBcrypt},
+	{prefix: "$scrypt$", verify: compareScrypt},
+	{prefix: "$pbkdf2-sha256$", verify: comparePbkdf2Sha256},
+	{prefix: "pbkdf2_sha256$", verify: compareDjangoPbkdf2Sha256},
+}
+
+// IsSupportedHash tells whether ComparePasswordAndHash can verify the hash, used before importing foreign hashes
+func IsSupportedHash(encodedHash string) bool {
+	_, err := findVerifier(newVerifiers(nil), encodedHash)
+	return err == nil
+}
+
+func findVerifier(verifiers []prefixVerifier, encodedHash string) (verifier, error) {
+	for _, v := range verifiers {
+		if strings.HasPrefix(encodedHash, v.prefix) {
+			return v.verify, nil
+		}
+	}
+	return nil, errors.New("unsupported hash scheme")
+}
+
+func compareBcrypt(password, encodedHash string) (bool, error) {
+	err := bcrypt.CompareHashAndPassword([]byte(encodedHash), []byte(password))
+	switch {
+	case err == nil:
+		return true, nil
+	case errors.Is(err, bcrypt.ErrMismatchedHashAndPassword):
+		return false, nil
+	default:
+		return false, errors.Wrap(err, "invalid bcrypt hash")
+	}
+}
+
+// compareScrypt reads $scrypt$ln=<log2 N>,r=<r>,p=<p>$<salt>$<hash>, salt and hash in unpadded base64
+func compareScrypt(password, encodedHash string) (bool, error) {
+	vals := strings.Split(encodedHash, "$")
+	if len(vals) != 5 {
+		return false, errors.New("invalid scrypt hash")
+	}
+
+	var ln, r, p int
+	if _, err := fmt.Sscanf(vals[2], "ln=%d,r=%d,p=%d", &ln, &r, &p); err != nil {
+		return false, errors.Wrap(err, "failed to get scrypt param")
+	}
+	if ln <= 0 || ln >= 32 {
+		return false, errors.Errorf("invalid scrypt cost %d", ln)
+	}
+
+	salt, err := decodeLegacyBase64(vals[3])
+	if err != nil {
+		return false, errors.Wrap(err, "failed to decode salt")
+	}
+	hash, err := decodeLegacyBase64(vals[4])
+	if err != nil {
+		return false, errors.Wrap(err, "failed to decode hash")
+	}
+
+	otherHash, err := scrypt.Key([]byte(password), salt, 1<<ln, r, p, len(hash))
+	if err != nil {
+		return false, errors.Wrap(err, "failed to derive scrypt key")
+	}
+	return subtle.ConstantTimeCompare(hash, otherHash) == 1, nil
+}
+
+// comparePbkdf2Sha256 reads the passlib format $pbkdf2-sha256$<rounds>$<salt>$<hash>
+func comparePbkdf2Sha256(password, encodedHash string) (bool, error) {
+	vals := strings.Split(encodedHash, "$")
+	if len(vals) != 5 {
+		return false, errors.New("invalid pbkdf2 hash")
+	}
+
+	rounds, err := strconv.Atoi(vals[2])
+	if err != nil || rounds <= 0 {
+		return false, errors.New("invalid pbkdf2 rounds")
+	}
+	salt, err := decodeLegacyBase64(vals[3])
+	if err != nil {
+		return false, errors.Wrap(err, "failed to decode salt")
+	}
+	hash, err := decodeLegacyBase64(vals[4])
+	if err != nil {
+		return false, errors.Wrap(err, "failed to decode hash")
+	}
+
+	otherHash := pbkdf2.Key([]byte(password), salt, rounds, len(hash), sha256.New)
+	return subtle.ConstantTimeCompare(hash, otherHash) == 1, nil
+}
+
+// compareDjangoPbkdf2Sha256 reads the django format pbkdf2_sha256$<rounds>$<salt>$<hash>, the salt is used as is
+func compareDjangoPbkdf2Sha256(password, encodedHash string) (bool, error) {
+	vals := strings.Split(encodedHash, "$")
+	if len(vals) != 4 {
+		return false, errors.New("invalid pbkdf2 hash")
+	}
+
+	rounds, err := strconv.Atoi(vals[1])
+	if err != nil || rounds <= 0 {
+		return false, errors.New("invalid pbkdf2 rounds")
+	}
+	hash, err := base64.StdEncoding.DecodeString(vals[3])
+	if err != nil {
+		return false, errors.Wrap(err, "failed to decode hash")
+	}
+
+	otherHash := pbkdf2.Key([]byte(password), []byte(vals[2]), rounds, len(hash), sha256.New)
+	return subtle.ConstantTimeCompare(hash, otherHash) == 1, nil
+}
+
+// decodeLegacyBase64 accepts standard base64 with or without padding, and passlib's variant using '.' for '+'
+func decodeLegacyBase64(s string) ([]byte, error) {
+	s = strings.TrimRight(strings.ReplaceAll(s, ".", "+"), "=")
+	return base64.RawStdEncoding.DecodeString(s)
+}
@@ -0,0 +1,59 @@
+package auth
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"golang.org/x/crypto/bcrypt"
+)
+
+func TestComparePasswordAndLegacyHash(t *testing.T) {
+	svc := auth{}
+	bcryptHash, err := bcrypt.GenerateFromPassword([]byte("secret"), bcrypt.MinCost)
+	require.NoError(t, err)
+
+	hashes := map[string]string{
+		"bcrypt 2a":      string(bcryptHash),
+		"bcrypt 2b":      strings.Replace(string(bcryptHash), "$2a$", "$2b$", 1),
+		"bcrypt 2y":      strings.Replace(string(bcryptHash), "$2a$", "$2y$", 1),
+		"scrypt":         "$scrypt$ln=10,r=8,p=1$c2FsdHNhbHRzYWx0c2FsdA$punalsgLVDmabDdlbwVieUjVAmz5/abwyjX3gfy3Xlo",
+		"pbkdf2 passlib": "$pbkdf2-sha256$1000$c2FsdHNhbHRzYWx0c2FsdA$dClvKSmj66n6MdMWNv3Go4mvH1Ym2WIGiJvquqa.mfE",
+		"pbkdf2 django":  "pbkdf2_sha256$1000$seasalt$+hs9qSCcGyNSGDNojIEbomuX9WI/mzTF5yfwAXqyKOo=",
+	}
+	for name, hash := range hashes {
+		{ // right password
+			match, err := svc.ComparePasswordAndHash("secret", hash)
+			assert.NoError(t, err, name)
+			assert.True(t, match, name)
+		}
+		{ // wrong password
+			match, err := svc.ComparePasswordAndHash("secret1", hash)
+			assert.NoError(t, err, name)
+			assert.False(t, match, name)
+		}
+		{ // legacy hash is upgraded
+			assert.True(t, svc.NeedsRehash(hash), name)
+		}
+	}
+
+	{ // unsupported scheme
+		_, err := svc.ComparePasswordAndHash("secret", "$md5$salt$hash")
+		assert.Error(t, err)
+	}
+	{ // malformed legacy hash
+		_, err := svc.ComparePasswordAndHash("secret", "$scrypt$ln=10$salt")
+		assert.Error(t, err)
+		_, err = svc.ComparePasswordAndHash("secret", "$pbkdf2-sha256$rounds$salt$hash")
+		assert.Error(t, err)
+	}
+}
+
+func TestIsSupportedHash(t *testing.T) {
+	assert.True(t, IsSupportedHash("$argon2id$v=19$m=65536,t=3,p=2$salt$hash"))
+	assert.True(t, IsSupportedHash("$2b$10$hash"))
+	assert.True(t, IsSupportedHash("pbkdf2_sha256$1000$salt$hash"))
+	assert.False(t, IsSupportedHash("$1$salt$hash"))
+	assert.False(t, IsSupportedHash("plain password"))
+}
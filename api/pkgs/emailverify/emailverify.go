@@ -0,0 +1,81 @@
+package emailverify
+
+import (
+	"context"
+	"fmt"
+	"mysite/pkgs/auth"
+	"mysite/pkgs/env"
+	"mysite/pkgs/mailer"
+	"net/url"
+	"strconv"
+	"time"
+
+	"github.com/pkg/errors"
+)
+
+// MetaData binds the token to the address it was sent to
+type MetaData struct {
+	UserName string `json:"user_name"`
+}
+
+//go:generate moq -pkg pkgmock -out ../../testing/mocking/pkgmock/emailverify.mock.go . Sender
+type Sender interface {
+	Send(ctx context.Context, userId int, userName string) error
+}
+
+type sender struct {
+	jwtHandler auth.JwtHandler
+	mailer     mailer.Mailer
+}
+
+func NewSender() Sender {
+	return sender{
+		jwtHandler: auth.NewJwtHandler(),
+		mailer:     mailer.NewMailer(),
+	}
+}
+
+// Enabled tells whether new accounts have to verify their email before login
+func Enabled() bool {
+	return env.GetEnv().EmailVerification.Enabled
+}
+
+// Send mails a signed verification link to userName
+func (s sender) Send(ctx context.Context, userId int, userName string) error {
+	claims := auth.NewCustomClaims[MetaData]().WithExpireAt(time.Now().Add(tokenTtl()))
+	claims.Subject = strconv.Itoa(userId)
+	claims.KeyType = auth.VerifyEmailKey
+	claims.MetaData = MetaData{UserName: userName}
+
+	token, err := s.jwtHandler.WithClaims(claims).CreateToken()
+	if err != nil {
+		return errors.Wrap(err, "failed create verification token")
+	}
+
+	if err := s.mailer.Send(ctx, newMessage(userName, token)); err != nil {
+		return errors.Wrap(err, "failed send verification mail")
+	}
+	return nil
+}
+
+func tokenTtl() time.Duration {
+	minutes := env.GetEnv().EmailVerification.ExpireMinutes
+	if minutes <= 0 {
+		minutes = 1440
+	}
+	return time.Duration(minutes) * time.Minute
+}
+
+func newMessage(to, token string) mailer.Message {
+	link := token
+	if linkUrl := env.GetEnv().EmailVerification.LinkUrl; linkUrl != "" {
+		link = fmt.Sprintf("%s?token=%s", linkUrl, url.QueryEscape(token))
+	}
+
+	return mailer.Message{
+		To:      []string{to},
+		Subject: "Verify your email",
+		Body: fmt.Sprintf("Please confirm your email address to activate your account.\n\n%s\n\nThe link expires in %s.",
+			link, tokenTtl()),
+	}
+}
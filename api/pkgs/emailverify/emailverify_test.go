@@ -0,0 +1,35 @@
+package emailverify
+
+import (
+	"context"
+	"mysite/pkgs/auth"
+	"mysite/pkgs/env"
+	"mysite/pkgs/mailer"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestSend(t *testing.T) {
+	require.NoError(t, env.ReadEnv(func(appEnv *env.AppEnv) { appEnv.Jwt.VerifyEmailKey = "verify-email-secret" }))
+	outbox := mailer.MemoryOutbox()
+	s := sender{
+		jwtHandler: auth.NewJwtHandler(),
+		mailer:     outbox,
+	}
+
+	require.NoError(t, s.Send(context.Background(), 1, "test@example.com"))
+
+	messages := outbox.Messages()
+	require.Len(t, messages, 1)
+	require.Equal(t, []string{"test@example.com"}, messages[0].To)
+
+	// the body carries the token, it must be a verification token of the user
+	token := strings.Split(messages[0].Body, "\n\n")[1]
+	var claims auth.CustomClaims[MetaData]
+	require.NoError(t, auth.NewJwtHandler().ParseToken(token, &claims))
+	require.Equal(t, auth.VerifyEmailKey, claims.KeyType)
+	require.Equal(t, "1", claims.Subject)
+	require.Equal(t, "test@example.com", claims.MetaData.UserName)
+}
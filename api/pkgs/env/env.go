@@ -5,8 +5,23 @@ import (
 )
 
 type AppEnv struct {
-	Database database `json:"database"`
-	Jwt      jwt      `json:"jwt"`
+	Database          database          `json:"database"`
+	Jwt               jwt               `json:"jwt"`
+	Mailer            mailer            `json:"mailer"`
+	PasswordReset     passwordReset     `json:"passwordReset"`
+	MagicLink         magicLink         `json:"magicLink"`
+	EmailVerification emailVerification `json:"emailVerification"`
+	LoginLockout      loginLockout      `json:"loginLockout"`
+	RateLimit         rateLimit         `json:"rateLimit"`
+	Argon2            argon2            `json:"argon2"`
+	PasswordPepper    passwordPepper    `json:"passwordPepper"`
+	PasswordPolicy    passwordPolicy    `json:"passwordPolicy"`
+	Loyalty           loyalty           `json:"loyalty"`
+	Quota             quota             `json:"quota"`
+	ApiKey            apiKey            `json:"apiKey"`
+	OAuth             oauth             `json:"oauth"`
+	Oidc              oidc              `json:"oidc"`
+	Impersonation     impersonation     `json:"impersonation"`
 }
 
 type database struct {
@@ -23,10 +38,169 @@ type database struct {
 }
 
 type jwt struct {
-	AccessKey  string `json:"accessKey"`
-	RefreshKey string `json:"refreshKey"`
-	CursorKey  string `json:"cursorKey"`
-	Issuer     string `json:"issuer"`
+	AccessKey      string `json:"accessKey" validate:"required_without_all=AccessSigning.Keys AccessSigning.PrivateKeyFile"`
+	RefreshKey     string `json:"refreshKey" validate:"required_without_all=RefreshSigning.Keys RefreshSigning.PrivateKeyFile"`
+	CursorKey      string `json:"cursorKey" validate:"required_without_all=CursorSigning.Keys CursorSigning.PrivateKeyFile"`
+	VerifyEmailKey string `json:"verifyEmailKey" validate:"required_without_all=VerifyEmailSigning.Keys VerifyEmailSigning.PrivateKeyFile"`
+	MfaPendingKey  string `json:"mfaPendingKey" validate:"required_without_all=MfaPendingSigning.Keys MfaPendingSigning.PrivateKeyFile"`
+	OidcStateKey   string `json:"oidcStateKey" validate:"required_without_all=OidcStateSigning.Keys OidcStateSigning.PrivateKeyFile"`
+	MagicLinkKey   string `json:"magicLinkKey" validate:"required_without_all=MagicLinkSigning.Keys MagicLinkSigning.PrivateKeyFile"`
+	Issuer         string `json:"issuer"`
+
+	// signing algorithm per key type, HS256 with the shared keys above when empty
+	AccessSigning      JwtSigning `json:"accessSigning"`
+	RefreshSigning     JwtSigning `json:"refreshSigning"`
+	CursorSigning      JwtSigning `json:"cursorSigning"`
+	VerifyEmailSigning JwtSigning `json:"verifyEmailSigning"`
+	MfaPendingSigning  JwtSigning `json:"mfaPendingSigning"`
+	OidcStateSigning   JwtSigning `json:"oidcStateSigning"`
+	MagicLinkSigning   JwtSigning `json:"magicLinkSigning"`
+}
+
+type JwtSigning struct {
+	Algorithm      string `json:"algorithm"`      // HS256, RS256, ES256 or EdDSA
+	PrivateKeyFile string `json:"privateKeyFile"` // PEM file, required by RS256, ES256 and EdDSA
+
+	// Keys enables key rotation, when set the fields above and the shared key are ignored
+	Keys []JwtKey `json:"keys" validate:"dive"`
+}
+
+type JwtKey struct {
+	Kid            string `json:"kid" validate:"required"`
+	State          string `json:"state" validate:"required,oneof=active verify-only retired"`
+	Algorithm      string `json:"algorithm"`                                         // HS256 when empty
+	Secret         string `json:"secret" validate:"required_without=PrivateKeyFile"` // HS256 only
+	PrivateKeyFile string `json:"privateKeyFile"`                                    // RS256, ES256 and EdDSA only
+}
+
+type mailer struct {
+	Driver    string `json:"driver"` // smtp or outbox
+	From      string `json:"from"`
+	Host      string `json:"host"`
+	Port      string `json:"port"`
+	UserName  string `json:"userName"`
+	Password  string `json:"password"`
+	OutboxDir string `json:"outboxDir"` // outbox only, messages are kept in memory when empty
+}
+
+type passwordReset struct {
+	LinkUrl       string `json:"linkUrl"` // reset page, the token is appended as query param
+	ExpireMinutes int    `json:"expireMinutes"`
+}
+
+type magicLink struct {
+	LinkUrl       string `json:"linkUrl"` // the verify endpoint or a page calling it, the token is appended as query param
+	ExpireMinutes int    `json:"expireMinutes"`
+}
+
+type emailVerification struct {
+	Enabled       bool   `json:"enabled"` // new accounts can not login until the email is verified
+	LinkUrl       string `json:"linkUrl"` // verification page, the token is appended as query param
+	ExpireMinutes int    `json:"expireMinutes"`
+}
+
+type loginLockout struct {
+	BackoffAfter     int `json:"backoffAfter"`   // failures of an account before each retry is delayed
+	MaxAttempts      int `json:"maxAttempts"`    // failures of an account before it is locked
+	IpBackoffAfter   int `json:"ipBackoffAfter"` // same per client ip, higher since ips are shared
+	IpMaxAttempts    int `json:"ipMaxAttempts"`
+	BaseDelaySeconds int `json:"baseDelaySeconds"` // first delay, doubled on every failure
+	MaxDelaySeconds  int `json:"maxDelaySeconds"`
+	LockoutMinutes   int `json:"lockoutMinutes"`
+	WindowMinutes    int `json:"windowMinutes"` // failures older than this are forgotten
+}
+
+type rateLimit struct {
+	Enabled bool `json:"enabled"`
+
+	// Groups rule per route group, a group without rule is not limited
+	Groups map[string]RateLimitRule `json:"groups" validate:"dive"`
+}
+
+type RateLimitRule struct {
+	Algorithm     string `json:"algorithm" validate:"oneof=token-bucket sliding-window"`
+	Limit         int    `json:"limit" validate:"gt=0"`         // requests per window, or bucket capacity
+	WindowSeconds int    `json:"windowSeconds" validate:"gt=0"` // window length, or time to refill an empty bucket
+	Key           string `json:"key" validate:"omitempty,oneof=ip user"`
+}
+
+// argon2 params of new password hashes, existing hashes are upgraded on the next login
+type argon2 struct {
+	Memory      uint32 `json:"memory"` // kilobytes
+	Iterations  uint32 `json:"iterations"`
+	Parallelism uint8  `json:"parallelism"`
+	SaltLength  uint32 `json:"saltLength"`
+	KeyLength   uint32 `json:"keyLength"`
+}
+
+// passwordPepper secret mixed into new password hashes, keep retired keys until their hashes were upgraded by a login
+type passwordPepper struct {
+	ActiveVersion string      `json:"activeVersion"` // version of new hashes, one of keys, no pepper when empty
+	Keys          []PepperKey `json:"keys" validate:"dive"`
+}
+
+type PepperKey struct {
+	Version string `json:"version" validate:"required,alphanum"`
+	Secret  string `json:"secret" validate:"required"`
+}
+
+type passwordPolicy struct {
+	MinLength        int      `json:"minLength"`
+	RequiredClasses  []string `json:"requiredClasses" validate:"dive,oneof=lower upper letter digit symbol"`
+	MaxRepeated      int      `json:"maxRepeated"`      // longest run of one character
+	BreachedListFile string   `json:"breachedListFile"` // one password per line, or a bloom filter when it ends with .bloom
+}
+
+// loyalty thresholds move users between membership tiers as their points change
+type loyalty struct {
+	Thresholds              map[string]int `json:"thresholds"`              // minimum points per membership name, tiers without threshold are never reached by points
+	Demote                  bool           `json:"demote"`                  // users falling below the threshold of their tier move down
+	EvaluateIntervalMinutes int            `json:"evaluateIntervalMinutes"` // periodic re-evaluation of every user, disabled when 0
+}
+
+// quota allowances per membership tier, a tier without the quota is unlimited
+type quota struct {
+	Enabled bool `json:"enabled"`
+
+	// Tiers quotas per membership name, then per quota name
+	Tiers map[string]map[string]QuotaLimit `json:"tiers" validate:"dive,dive"`
+}
+
+type QuotaLimit struct {
+	Limit  int    `json:"limit" validate:"gt=0"`
+	Period string `json:"period" validate:"oneof=day month"` // the count starts over at the next UTC day or month
+}
+
+// apiKey expiry of personal and service keys, a key never outlives MaxExpireDays
+type apiKey struct {
+	DefaultExpireDays int `json:"defaultExpireDays"` // used when the request has no expiresAt
+	MaxExpireDays     int `json:"maxExpireDays"`
+}
+
+// oidc external OpenID Connect providers users may log in with, keyed by the name used in the login path
+type oidc struct {
+	StateExpireMinutes int                     `json:"stateExpireMinutes"`
+	Providers          map[string]OidcProvider `json:"providers"`
+}
+
+type OidcProvider struct {
+	Issuer       string   `json:"issuer"` // discovery is read from issuer + /.well-known/openid-configuration
+	ClientID     string   `json:"clientId"`
+	ClientSecret string   `json:"clientSecret"` // sent with HTTP Basic, public clients rely on PKCE only when empty
+	RedirectURL  string   `json:"redirectUrl"`  // the callback of the login path, as registered at the provider
+	Scopes       []string `json:"scopes"`       // openid and email are always requested
+}
+
+// oauth lifetimes of what the authorization server issues to its clients
+type oauth struct {
+	CodeExpireSeconds   int `json:"codeExpireSeconds"`
+	AccessExpireMinutes int `json:"accessExpireMinutes"`
+	RefreshExpireHours  int `json:"refreshExpireHours"`
+}
+
+// impersonation lifetime of the access tokens admins act as another user with, there is no refresh token
+type impersonation struct {
+	ExpireMinutes int `json:"expireMinutes"`
 }
 
 type configure interface {
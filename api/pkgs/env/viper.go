@@ -5,7 +5,9 @@ import (
 	"mysite/pkgs/logger"
 
 	"github.com/fsnotify/fsnotify"
+	"github.com/go-playground/validator/v10"
 	"github.com/mitchellh/mapstructure"
+	"github.com/pkg/errors"
 	"github.com/spf13/viper"
 )
 
@@ -38,6 +40,59 @@ func (v viperConfig) setDefault() error {
 	v.viperCfg.SetDefault("database.sslmode", "disable")
 	v.viperCfg.SetDefault("database.port", "5432")
 	v.viperCfg.SetDefault("jwt.issuer", "mysite")
+	v.viperCfg.SetDefault("mailer.driver", "outbox")
+	v.viperCfg.SetDefault("mailer.port", "587")
+	v.viperCfg.SetDefault("passwordreset.expireminutes", 30)
+	v.viperCfg.SetDefault("magiclink.expireminutes", 15)
+	v.viperCfg.SetDefault("emailverification.expireminutes", 1440)
+	v.viperCfg.SetDefault("loginlockout.backoffafter", 3)
+	v.viperCfg.SetDefault("loginlockout.maxattempts", 10)
+	v.viperCfg.SetDefault("loginlockout.ipbackoffafter", 20)
+	v.viperCfg.SetDefault("loginlockout.ipmaxattempts", 100)
+	v.viperCfg.SetDefault("loginlockout.basedelayseconds", 1)
+	v.viperCfg.SetDefault("loginlockout.maxdelayseconds", 60)
+	v.viperCfg.SetDefault("loginlockout.lockoutminutes", 15)
+	v.viperCfg.SetDefault("loginlockout.windowminutes", 15)
+	v.viperCfg.SetDefault("argon2.memory", 64*1024)
+	v.viperCfg.SetDefault("argon2.iterations", 3)
+	v.viperCfg.SetDefault("argon2.parallelism", 2)
+	v.viperCfg.SetDefault("argon2.saltlength", 16)
+	v.viperCfg.SetDefault("argon2.keylength", 32)
+	v.viperCfg.SetDefault("passwordpolicy.minlength", 8)
+	v.viperCfg.SetDefault("passwordpolicy.requiredclasses", []string{"letter", "digit"})
+	v.viperCfg.SetDefault("passwordpolicy.maxrepeated", 3)
+	v.viperCfg.SetDefault("ratelimit.enabled", true)
+	v.viperCfg.SetDefault("ratelimit.groups.auth.algorithm", "sliding-window")
+	v.viperCfg.SetDefault("ratelimit.groups.auth.limit", 20)
+	v.viperCfg.SetDefault("ratelimit.groups.auth.windowseconds", 60)
+	v.viperCfg.SetDefault("ratelimit.groups.auth.key", "ip")
+	v.viperCfg.SetDefault("ratelimit.groups.api.algorithm", "token-bucket")
+	v.viperCfg.SetDefault("ratelimit.groups.api.limit", 100)
+	v.viperCfg.SetDefault("ratelimit.groups.api.windowseconds", 60)
+	v.viperCfg.SetDefault("ratelimit.groups.api.key", "user")
+	v.viperCfg.SetDefault("loyalty.thresholds.bronze", 0)
+	v.viperCfg.SetDefault("loyalty.thresholds.silver", 1000)
+	v.viperCfg.SetDefault("loyalty.thresholds.gold", 5000)
+	v.viperCfg.SetDefault("loyalty.thresholds.diamond", 20000)
+	v.viperCfg.SetDefault("loyalty.thresholds.platinum", 50000)
+	v.viperCfg.SetDefault("loyalty.demote", true)
+	v.viperCfg.SetDefault("loyalty.evaluateintervalminutes", 60)
+	v.viperCfg.SetDefault("quota.enabled", true)
+	v.viperCfg.SetDefault("quota.tiers.bronze.requests.limit", 1000)
+	v.viperCfg.SetDefault("quota.tiers.bronze.requests.period", "day")
+	v.viperCfg.SetDefault("quota.tiers.silver.requests.limit", 5000)
+	v.viperCfg.SetDefault("quota.tiers.silver.requests.period", "day")
+	v.viperCfg.SetDefault("quota.tiers.gold.requests.limit", 20000)
+	v.viperCfg.SetDefault("quota.tiers.gold.requests.period", "day")
+	v.viperCfg.SetDefault("quota.tiers.diamond.requests.limit", 100000)
+	v.viperCfg.SetDefault("quota.tiers.diamond.requests.period", "day")
+	v.viperCfg.SetDefault("apikey.defaultexpiredays", 90)
+	v.viperCfg.SetDefault("apikey.maxexpiredays", 365)
+	v.viperCfg.SetDefault("oauth.codeexpireseconds", 60)
+	v.viperCfg.SetDefault("oauth.accessexpireminutes", 15)
+	v.viperCfg.SetDefault("oauth.refreshexpirehours", 72)
+	v.viperCfg.SetDefault("oidc.stateexpireminutes", 10)
+	v.viperCfg.SetDefault("impersonation.expireminutes", 10)
 	return nil
 }
 
@@ -52,8 +107,34 @@ func (v viperConfig) setConfigFile() error {
 	return v.viperCfg.ReadInConfig()
 }
 
+// mappingStruct replaces the env only when the new one passes the validate tags,
+// so a broken config fails the startup and a broken reload keeps the running env
 func (v viperConfig) mappingStruct() error {
-	return v.viperCfg.Unmarshal(&internalEnv, viperUnmarshalOption)
+	var appEnv AppEnv
+	if err := v.viperCfg.Unmarshal(&appEnv, viperUnmarshalOption); err != nil {
+		return err
+	}
+	validate := validator.New()
+	validate.RegisterStructValidation(validatePasswordPepper, passwordPepper{})
+	if err := validate.Struct(appEnv); err != nil {
+		return errors.Wrap(err, "invalid env")
+	}
+	internalEnv = appEnv
+	return nil
+}
+
+// validatePasswordPepper new hashes can not be created with a version without key
+func validatePasswordPepper(sl validator.StructLevel) {
+	pepper := sl.Current().Interface().(passwordPepper)
+	if pepper.ActiveVersion == "" {
+		return
+	}
+	for _, key := range pepper.Keys {
+		if key.Version == pepper.ActiveVersion {
+			return
+		}
+	}
+	sl.ReportError(pepper.ActiveVersion, "ActiveVersion", "activeVersion", "activeversion", "")
 }
 
 func (v viperConfig) onConfigChangeFunc(e fsnotify.Event) {
@@ -12,6 +12,7 @@ import (
 
 type viperMock struct {
 	expectErr error
+	value     AppEnv
 }
 
 func (m *viperMock) SetConfigName(name string) {}
@@ -31,9 +32,33 @@ func (m *viperMock) ReadInConfig() error {
 }
 
 func (m *viperMock) Unmarshal(rawVal interface{}, opts ...viper.DecoderConfigOption) error {
+	if appEnv, ok := rawVal.(*AppEnv); ok {
+		*appEnv = m.value
+	}
 	return m.expectErr
 }
 
+func validEnv() AppEnv {
+	return AppEnv{
+		Database: database{Database: "mysite", HostName: "localhost", User: "mysite", Password: "secret"},
+		Jwt: jwt{
+			AccessKey:      "access-secret",
+			RefreshKey:     "refresh-secret",
+			CursorKey:      "cursor-secret",
+			VerifyEmailKey: "verify-email-secret",
+			MfaPendingKey:  "mfa-pending-secret",
+			OidcStateKey:   "oidc-state-secret",
+			MagicLinkKey:   "magic-link-secret",
+		},
+		RateLimit: rateLimit{Groups: map[string]RateLimitRule{
+			"auth": {Algorithm: "sliding-window", Limit: 20, WindowSeconds: 60, Key: "ip"},
+		}},
+		Quota: quota{Tiers: map[string]map[string]QuotaLimit{
+			"bronze": {"requests": {Limit: 1000, Period: "day"}},
+		}},
+	}
+}
+
 func TestSetConfigFile(t *testing.T) {
 	testCases := []struct {
 		name        string
@@ -63,8 +88,10 @@ func TestSetConfigFile(t *testing.T) {
 
 func TestMappingStruct(t *testing.T) {
 	testCases := []struct {
-		name        string
-		expectValue error
+		name          string
+		modify        func(appEnv *AppEnv)
+		expectValue   error
+		expectInvalid bool
 	}{
 		{
 			name:        "success",
@@ -74,20 +101,119 @@ func TestMappingStruct(t *testing.T) {
 			name:        "failed",
 			expectValue: errors.New("some error"),
 		},
+		{
+			name: "rotation keys or a private key replace the shared secret",
+			modify: func(appEnv *AppEnv) {
+				appEnv.Jwt.AccessKey = ""
+				appEnv.Jwt.AccessSigning.Keys = []JwtKey{{Kid: "k1", State: "active", Secret: "secret"}}
+				appEnv.Jwt.RefreshKey = ""
+				appEnv.Jwt.RefreshSigning = JwtSigning{Algorithm: "ES256", PrivateKeyFile: "refresh.pem"}
+			},
+			expectValue: nil,
+		},
+		{
+			name: "pepper active version with key",
+			modify: func(appEnv *AppEnv) {
+				appEnv.PasswordPepper = passwordPepper{ActiveVersion: "v2", Keys: []PepperKey{{Version: "v1", Secret: "old"}, {Version: "v2", Secret: "new"}}}
+			},
+			expectValue: nil,
+		},
+		{
+			name:          "empty database password",
+			modify:        func(appEnv *AppEnv) { appEnv.Database.Password = "" },
+			expectInvalid: true,
+		},
+		{
+			name:          "empty jwt secret",
+			modify:        func(appEnv *AppEnv) { appEnv.Jwt.MagicLinkKey = "" },
+			expectInvalid: true,
+		},
+		{
+			name: "zero rate limit",
+			modify: func(appEnv *AppEnv) {
+				appEnv.RateLimit.Groups["auth"] = RateLimitRule{Algorithm: "sliding-window", Limit: 0, WindowSeconds: 60}
+			},
+			expectInvalid: true,
+		},
+		{
+			name: "zero quota",
+			modify: func(appEnv *AppEnv) {
+				appEnv.Quota.Tiers["bronze"]["requests"] = QuotaLimit{Limit: 0, Period: "day"}
+			},
+			expectInvalid: true,
+		},
+		{
+			name: "jwt key without secret",
+			modify: func(appEnv *AppEnv) {
+				appEnv.Jwt.AccessSigning.Keys = []JwtKey{{Kid: "k1", State: "active"}}
+			},
+			expectInvalid: true,
+		},
+		{
+			name: "pepper key without secret",
+			modify: func(appEnv *AppEnv) {
+				appEnv.PasswordPepper.Keys = []PepperKey{{Version: "v1"}}
+			},
+			expectInvalid: true,
+		},
+		{
+			name: "pepper active version without key",
+			modify: func(appEnv *AppEnv) {
+				appEnv.PasswordPepper = passwordPepper{ActiveVersion: "v2", Keys: []PepperKey{{Version: "v1", Secret: "secret"}}}
+			},
+			expectInvalid: true,
+		},
+		{
+			name:          "unknown password class",
+			modify:        func(appEnv *AppEnv) { appEnv.PasswordPolicy.RequiredClasses = []string{"emoji"} },
+			expectInvalid: true,
+		},
 	}
 
 	for _, tt := range testCases {
 		t.Run(tt.name, func(t *testing.T) {
+			internalEnv = validEnv()
+			appEnv := validEnv()
+			if tt.modify != nil {
+				tt.modify(&appEnv)
+			}
+
 			viperCfg := viperConfig{
 				viperCfg: &viperMock{
 					expectErr: tt.expectValue,
+					value:     appEnv,
 				},
 			}
-			require.Equal(t, tt.expectValue, viperCfg.mappingStruct())
+			err := viperCfg.mappingStruct()
+			if tt.expectInvalid {
+				require.Error(t, err)
+				require.Equal(t, validEnv(), internalEnv, "the running env is kept")
+				return
+			}
+			require.Equal(t, tt.expectValue, err)
+			if err == nil {
+				require.Equal(t, appEnv, internalEnv)
+			}
 		})
 	}
 }
 
+func TestMappingStructDefaults(t *testing.T) {
+	// the defaults are not enough, the database and the jwt secrets have no default
+	viperCfg := newViperConfig()
+	require.NoError(t, viperCfg.setDefault())
+	viperCfg.viperCfg.SetDefault("database.database", "mysite")
+	viperCfg.viperCfg.SetDefault("database.hostname", "localhost")
+	viperCfg.viperCfg.SetDefault("database.user", "mysite")
+	viperCfg.viperCfg.SetDefault("database.password", "secret")
+	require.Error(t, viperCfg.mappingStruct())
+
+	for _, key := range []string{"accesskey", "refreshkey", "cursorkey", "verifyemailkey", "mfapendingkey", "oidcstatekey", "magiclinkkey"} {
+		viperCfg.viperCfg.SetDefault("jwt."+key, key+"-secret")
+	}
+	require.NoError(t, viperCfg.mappingStruct())
+}
+
 func TestViperUnmarshalOption(t *testing.T) {
 	{
 		testDecoderConfig := mapstructure.DecoderConfig{}
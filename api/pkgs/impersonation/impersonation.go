@@ -0,0 +1,37 @@
+package impersonation
+
+import (
+	"context"
+	"mysite/entities"
+	"mysite/pkgs/database"
+	"mysite/repositories/impersonationauditrepo"
+
+	"github.com/pkg/errors"
+	"github.com/volatiletech/sqlboiler/v4/boil"
+)
+
+//go:generate moq -pkg pkgmock -out ../../testing/mocking/pkgmock/impersonation.mock.go . Auditor
+type Auditor interface {
+	Record(ctx context.Context, audit entities.ImpersonationAudit) error
+}
+
+type auditor struct {
+	repo impersonationauditrepo.ImpersonationAuditRepo
+}
+
+func NewAuditor() Auditor {
+	return auditor{
+		repo: impersonationauditrepo.NewRepo(),
+	}
+}
+
+// Record writes the audit row in its own transaction so that it is kept even when the request fails
+func (a auditor) Record(ctx context.Context, audit entities.ImpersonationAudit) error {
+	if err := database.NewBoilerTransaction(ctx, func(ctx context.Context, tx boil.ContextTransactor) error {
+		return a.repo.Insert(ctx, tx, &audit)
+	}); err != nil {
+		return errors.Wrap(err, "failed record impersonation")
+	}
+
+	return nil
+}
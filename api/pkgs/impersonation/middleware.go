@@ -0,0 +1,60 @@
+package impersonation
+
+import (
+	"log/slog"
+	"mysite/constants"
+	"mysite/entities"
+	"mysite/pkgs/auth"
+	"mysite/pkgs/logger"
+	"mysite/utils/httputil"
+	"net/http"
+
+	"github.com/go-chi/chi/v5/middleware"
+	"github.com/go-chi/render"
+	"github.com/pkg/errors"
+	"github.com/volatiletech/null/v8"
+)
+
+var newAuditor = func() Auditor {
+	return NewAuditor()
+}
+
+// Middleware tags every request made by an admin as another user in the log and the audit table,
+// the request is rejected when it can not be audited
+func Middleware(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		principal, found := auth.PrincipalFromContext(r.Context())
+		if !found || !principal.Impersonated() {
+			next.ServeHTTP(w, r)
+			return
+		}
+
+		requestId := middleware.GetReqID(r.Context())
+		slog.Info("impersonated request",
+			slog.Int("userId", principal.UserID),
+			slog.Int("actorId", principal.ActorID),
+			slog.String("tokenId", principal.TokenID),
+			slog.String("method", r.Method),
+			slog.String("path", r.URL.Path),
+			slog.String("requestId", requestId),
+		)
+
+		audit := entities.ImpersonationAudit{
+			ActorUserAccountID: principal.ActorID,
+			UserAccountID:      principal.UserID,
+			TokenID:            principal.TokenID,
+			Action:             constants.ImpersonationRequest,
+			Method:             null.StringFrom(r.Method),
+			Path:               null.StringFrom(r.URL.Path),
+			RequestID:          null.NewString(requestId, requestId != ""),
+		}
+		if err := newAuditor().Record(r.Context(), audit); err != nil {
+			if err := render.Render(w, r, httputil.NewFailureRender(errors.Wrap(err, "failed audit impersonation"))); err != nil {
+				slog.Error("failed to render", logger.AttrError(err))
+			}
+			return
+		}
+
+		next.ServeHTTP(w, r)
+	})
+}
@@ -0,0 +1,80 @@
+package impersonation
+
+import (
+	"context"
+	"mysite/constants"
+	"mysite/entities"
+	"mysite/pkgs/auth"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/require"
+)
+
+// fakeAuditor keeps the audits in memory, pkgmock can not be used here since it imports this package
+type fakeAuditor struct {
+	audits *[]entities.ImpersonationAudit
+	err    error
+}
+
+func (f fakeAuditor) Record(ctx context.Context, audit entities.ImpersonationAudit) error {
+	if f.err != nil {
+		return f.err
+	}
+	*f.audits = append(*f.audits, audit)
+	return nil
+}
+
+func TestMiddleware(t *testing.T) {
+	next := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusOK)
+	})
+	handler := Middleware(next)
+	newRequest := func(principal *auth.Principal) *http.Request {
+		r := httptest.NewRequest(http.MethodGet, "http://example.com/api/v1/me", nil)
+		if principal == nil {
+			return r
+		}
+		return r.WithContext(auth.WithPrincipal(r.Context(), *principal))
+	}
+
+	{ // impersonated requests are audited
+		var audits []entities.ImpersonationAudit
+		newAuditor = func() Auditor { return fakeAuditor{audits: &audits} }
+
+		w := httptest.NewRecorder()
+		handler.ServeHTTP(w, newRequest(&auth.Principal{UserID: 1, ActorID: 2, TokenID: "jti"}))
+		require.Equal(t, http.StatusOK, w.Result().StatusCode)
+		require.Len(t, audits, 1)
+		require.Equal(t, 2, audits[0].ActorUserAccountID)
+		require.Equal(t, 1, audits[0].UserAccountID)
+		require.Equal(t, "jti", audits[0].TokenID)
+		require.Equal(t, constants.ImpersonationRequest, audits[0].Action)
+		require.Equal(t, http.MethodGet, audits[0].Method.String)
+		require.Equal(t, "/api/v1/me", audits[0].Path.String)
+		require.False(t, audits[0].RequestID.Valid)
+	}
+	{ // own requests are not audited
+		var audits []entities.ImpersonationAudit
+		newAuditor = func() Auditor { return fakeAuditor{audits: &audits} }
+
+		w := httptest.NewRecorder()
+		handler.ServeHTTP(w, newRequest(&auth.Principal{UserID: 1}))
+		require.Equal(t, http.StatusOK, w.Result().StatusCode)
+		require.Empty(t, audits)
+
+		w = httptest.NewRecorder()
+		handler.ServeHTTP(w, newRequest(nil))
+		require.Equal(t, http.StatusOK, w.Result().StatusCode)
+		require.Empty(t, audits)
+	}
+	{ // failed audit rejects the request
+		newAuditor = func() Auditor { return fakeAuditor{err: errors.New("db down")} }
+
+		w := httptest.NewRecorder()
+		handler.ServeHTTP(w, newRequest(&auth.Principal{UserID: 1, ActorID: 2, TokenID: "jti"}))
+		require.Equal(t, http.StatusInternalServerError, w.Result().StatusCode)
+	}
+}
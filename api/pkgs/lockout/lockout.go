@@ -0,0 +1,72 @@
+package lockout
+
+import (
+	"mysite/pkgs/env"
+	"time"
+)
+
+// Policy turns a count of failed attempts into a wait, first a growing back-off then a lockout
+type Policy struct {
+	BackoffAfter int
+	MaxAttempts  int
+	BaseDelay    time.Duration
+	MaxDelay     time.Duration
+	Lockout      time.Duration
+	Window       time.Duration // failures older than this are forgotten
+}
+
+// AccountPolicy applies to the failures of one user account
+func AccountPolicy() Policy {
+	cfg := env.GetEnv().LoginLockout
+	return newPolicy(cfg.BackoffAfter, cfg.MaxAttempts)
+}
+
+// IpPolicy applies to the failures of one client ip, whatever account was tried
+func IpPolicy() Policy {
+	cfg := env.GetEnv().LoginLockout
+	return newPolicy(cfg.IpBackoffAfter, cfg.IpMaxAttempts)
+}
+
+func newPolicy(backoffAfter, maxAttempts int) Policy {
+	cfg := env.GetEnv().LoginLockout
+	return Policy{
+		BackoffAfter: backoffAfter,
+		MaxAttempts:  maxAttempts,
+		BaseDelay:    time.Duration(cfg.BaseDelaySeconds) * time.Second,
+		MaxDelay:     time.Duration(cfg.MaxDelaySeconds) * time.Second,
+		Lockout:      time.Duration(cfg.LockoutMinutes) * time.Minute,
+		Window:       time.Duration(cfg.WindowMinutes) * time.Minute,
+	}
+}
+
+// Check returns how long to wait before the next attempt, locked tells a lockout from a back-off
+func (p Policy) Check(failedCount int, lastFailedAt, now time.Time) (wait time.Duration, locked bool) {
+	if p.MaxAttempts > 0 && failedCount >= p.MaxAttempts {
+		return remaining(lastFailedAt.Add(p.Lockout), now), true
+	}
+
+	if p.BackoffAfter <= 0 || failedCount < p.BackoffAfter || now.Sub(lastFailedAt) > p.Window {
+		return 0, false
+	}
+
+	delay := p.BaseDelay
+	for i := p.BackoffAfter; i < failedCount && (p.MaxDelay <= 0 || delay < p.MaxDelay); i++ {
+		delay *= 2
+	}
+	if p.MaxDelay > 0 && delay > p.MaxDelay {
+		delay = p.MaxDelay
+	}
+	return remaining(lastFailedAt.Add(delay), now), false
+}
+
+// StaleBefore failures before this time start a new count
+func (p Policy) StaleBefore(now time.Time) time.Time {
+	return now.Add(-p.Window)
+}
+
+func remaining(until, now time.Time) time.Duration {
+	if !now.Before(until) {
+		return 0
+	}
+	return until.Sub(now)
+}
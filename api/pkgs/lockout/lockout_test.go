@@ -0,0 +1,59 @@
+package lockout
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestCheck(t *testing.T) {
+	policy := Policy{
+		BackoffAfter: 3,
+		MaxAttempts:  6,
+		BaseDelay:    time.Second,
+		MaxDelay:     4 * time.Second,
+		Lockout:      15 * time.Minute,
+		Window:       15 * time.Minute,
+	}
+	now := time.Now()
+
+	{ // below the back-off threshold
+		wait, locked := policy.Check(2, now, now)
+		require.Zero(t, wait)
+		require.False(t, locked)
+	}
+	{ // back-off doubles on every failure
+		for failedCount, expected := range map[int]time.Duration{3: time.Second, 4: 2 * time.Second, 5: 4 * time.Second} {
+			wait, locked := policy.Check(failedCount, now, now)
+			require.Equal(t, expected, wait, failedCount)
+			require.False(t, locked)
+		}
+	}
+	{ // back-off is capped
+		policy := policy
+		policy.MaxAttempts = 0
+		wait, _ := policy.Check(20, now, now)
+		require.Equal(t, 4*time.Second, wait)
+	}
+	{ // back-off already waited
+		wait, locked := policy.Check(4, now.Add(-3*time.Second), now)
+		require.Zero(t, wait)
+		require.False(t, locked)
+	}
+	{ // locked after max attempts
+		wait, locked := policy.Check(6, now.Add(-time.Minute), now)
+		require.Equal(t, 14*time.Minute, wait)
+		require.True(t, locked)
+	}
+	{ // lockout expired
+		wait, locked := policy.Check(6, now.Add(-16*time.Minute), now)
+		require.Zero(t, wait)
+		require.True(t, locked)
+	}
+	{ // disabled policy
+		wait, locked := Policy{}.Check(100, now, now)
+		require.Zero(t, wait)
+		require.False(t, locked)
+	}
+}
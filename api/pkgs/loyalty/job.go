@@ -0,0 +1,32 @@
+package loyalty
+
+import (
+	"context"
+	"log/slog"
+	"mysite/pkgs/logger"
+	"time"
+)
+
+var newService = func() Service {
+	return NewService()
+}
+
+// RunJob re-evaluates the tier of every user each interval until ctx is done
+func RunJob(ctx context.Context, interval time.Duration) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			// a new service per run picks up changed thresholds
+			changed, err := newService().EvaluateAll(ctx)
+			if err != nil {
+				slog.Error("failed to evaluate memberships", logger.AttrError(err))
+			}
+			slog.Info("memberships evaluated", slog.Int("changed", changed))
+		}
+	}
+}
@@ -0,0 +1,42 @@
+package loyalty
+
+import (
+	"context"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+type countingService struct {
+	Service
+	runs *atomic.Int32
+}
+
+func (c countingService) EvaluateAll(ctx context.Context) (int, error) {
+	c.runs.Add(1)
+	return 0, nil
+}
+
+func TestRunJob(t *testing.T) {
+	var runs atomic.Int32
+	newService = func() Service {
+		return countingService{runs: &runs}
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	done := make(chan struct{})
+	go func() {
+		RunJob(ctx, 10*time.Millisecond)
+		close(done)
+	}()
+
+	require.Eventually(t, func() bool { return runs.Load() >= 2 }, time.Second, 5*time.Millisecond)
+	cancel()
+	select {
+	case <-done:
+	case <-time.After(time.Second):
+		t.Fatal("job did not stop")
+	}
+}
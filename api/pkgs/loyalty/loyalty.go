@@ -0,0 +1,206 @@
+package loyalty
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"mysite/entities"
+	"mysite/pkgs/database"
+	"mysite/pkgs/logger"
+	"mysite/pkgs/tier"
+	"mysite/repositories/loyaltyrepo"
+	"mysite/repositories/membershiprepo"
+	"mysite/repositories/userinforepo"
+	"mysite/utils/httputil"
+
+	"github.com/pkg/errors"
+	"github.com/volatiletech/null/v8"
+	"github.com/volatiletech/sqlboiler/v4/boil"
+)
+
+// evaluateBatch users read per page by EvaluateAll
+const evaluateBatch = 100
+
+//go:generate moq -pkg pkgmock -out ../../testing/mocking/pkgmock/loyalty.mock.go . Service:LoyaltyServiceMock
+type Service interface {
+	Credit(ctx context.Context, userId int, points int, reason string) (*Balance, error)
+	Debit(ctx context.Context, userId int, points int, reason string) (*Balance, error)
+	EvaluateAll(ctx context.Context) (int, error)
+}
+
+// Balance of a user after a change of points, with the tier the points earned
+type Balance struct {
+	Points     int
+	Membership entities.Membership
+}
+
+type service struct {
+	repo           userinforepo.UserInfoRepo
+	loyaltyRepo    loyaltyrepo.LoyaltyRepo
+	membershipRepo membershiprepo.MembershipRepo
+	changer        tier.Changer
+	rules          Rules
+}
+
+func NewService() Service {
+	return service{
+		repo:           userinforepo.NewRepo(),
+		loyaltyRepo:    loyaltyrepo.NewRepo(),
+		membershipRepo: membershiprepo.NewRepo(),
+		changer:        tier.NewChanger(),
+		rules:          EnvRules(),
+	}
+}
+
+// Credit adds points to the ledger of the user and moves the user to the tier of the new balance
+func (s service) Credit(ctx context.Context, userId int, points int, reason string) (*Balance, error) {
+	if points <= 0 {
+		return nil, errors.Wrap(httputil.ErrInvalidRequest, "points must be positive")
+	}
+
+	var result *Balance
+	if err := database.NewBoilerTransaction(ctx, func(ctx context.Context, tx boil.ContextTransactor) error {
+		var err error
+		result, err = s.add(ctx, tx, userId, points, reason)
+		return err
+	}); err != nil {
+		return nil, errors.Wrap(err, "failed credit points")
+	}
+
+	return result, nil
+}
+
+// Debit takes points from the ledger of the user, the balance can not go below zero
+func (s service) Debit(ctx context.Context, userId int, points int, reason string) (*Balance, error) {
+	if points <= 0 {
+		return nil, errors.Wrap(httputil.ErrInvalidRequest, "points must be positive")
+	}
+
+	var result *Balance
+	if err := database.NewBoilerTransaction(ctx, func(ctx context.Context, tx boil.ContextTransactor) error {
+		var err error
+		result, err = s.add(ctx, tx, userId, -points, reason)
+		return err
+	}); err != nil {
+		return nil, errors.Wrap(err, "failed debit points")
+	}
+
+	return result, nil
+}
+
+func (s service) add(ctx context.Context, tx boil.ContextTransactor, userId int, points int, reason string) (*Balance, error) {
+	pgUserAccount, err := s.repo.GetActiveUserAccountWithUserInfo(ctx, tx, userId)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed get userAccount with userInfo")
+	}
+	if pgUserAccount == nil {
+		return nil, errors.Wrap(httputil.ErrNotFound, "user not found")
+	}
+
+	// the lock keeps two debits from both passing the check below
+	balance, err := s.loyaltyRepo.GetBalanceForUpdate(ctx, tx, userId)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed get balance")
+	}
+	if balance+points < 0 {
+		return nil, errors.Wrapf(httputil.ErrInvalidRequest, "balance of %d points is too low", balance)
+	}
+
+	if err := s.loyaltyRepo.InsertPoint(ctx, tx, &entities.LoyaltyPoint{
+		UserAccountID: userId,
+		Points:        points,
+		Reason:        reason,
+	}); err != nil {
+		return nil, errors.Wrap(err, "failed insert points")
+	}
+	balance += points
+
+	membership, _, err := s.evaluate(ctx, tx, pgUserAccount, balance)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed evaluate membership")
+	}
+
+	return &Balance{Points: balance, Membership: *membership}, nil
+}
+
+// EvaluateAll moves every active user to the tier of their balance and returns how many moved,
+// it catches up users whose points did not change since the rules did. A tier set by an admin is kept
+// until the points of the user change again
+func (s service) EvaluateAll(ctx context.Context) (int, error) {
+	var changed, afterUserId int
+	for {
+		var balances []loyaltyrepo.Balance
+		if err := database.NewBoilerTransaction(ctx, func(ctx context.Context, tx boil.ContextTransactor) error {
+			var err error
+			balances, err = s.loyaltyRepo.GetBalances(ctx, tx, afterUserId, evaluateBatch)
+			return err
+		}); err != nil {
+			return changed, errors.Wrap(err, "failed get balances")
+		}
+
+		for _, balance := range balances {
+			// one user per transaction, a failure does not hold back the others
+			if err := database.NewBoilerTransaction(ctx, func(ctx context.Context, tx boil.ContextTransactor) error {
+				pgUserAccount, err := s.repo.GetActiveUserAccountWithUserInfo(ctx, tx, balance.UserAccountID)
+				if err != nil {
+					return errors.Wrap(err, "failed get userAccount with userInfo")
+				}
+				if pgUserAccount == nil {
+					return nil
+				}
+
+				_, moved, err := s.evaluate(ctx, tx, pgUserAccount, balance.Points)
+				if moved {
+					changed++
+				}
+				return err
+			}); err != nil {
+				slog.Error("failed to evaluate membership", slog.Int("userId", balance.UserAccountID), logger.AttrError(err))
+			}
+		}
+
+		if len(balances) < evaluateBatch {
+			return changed, nil
+		}
+		afterUserId = balances[len(balances)-1].UserAccountID
+	}
+}
+
+// evaluate moves a userAccount loaded with its userInfo to the tier earned by balance, the returned membership is the tier it ends up in
+func (s service) evaluate(ctx context.Context, tx boil.ContextTransactor, pgUserAccount *entities.UserAccount, balance int) (*entities.Membership, bool, error) {
+	current, err := s.membershipRepo.GetMembershipById(ctx, tx, tier.CurrentMembershipId(pgUserAccount))
+	if err != nil {
+		return nil, false, errors.Wrap(err, "failed get current membership")
+	}
+	if current == nil {
+		return nil, false, errors.Errorf("membership of user %d not found", pgUserAccount.ID)
+	}
+
+	name, found := s.rules.Tier(balance)
+	if !found {
+		return current, false, nil
+	}
+
+	target, err := s.membershipRepo.GetMembershipByName(ctx, tx, name)
+	if err != nil {
+		return nil, false, errors.Wrap(err, "failed get membership")
+	}
+	if target == nil {
+		return nil, false, errors.Errorf("membership %s of the loyalty thresholds not found", name)
+	}
+	if !s.rules.Moves(*current, *target) {
+		return current, false, nil
+	}
+
+	moved, err := s.changer.Change(ctx, tx, pgUserAccount, *target, tier.Change{
+		Reason: null.StringFrom(fmt.Sprintf("loyalty balance of %d points", balance)),
+	})
+	if err != nil {
+		return nil, false, errors.Wrap(err, "failed change membership")
+	}
+	if moved {
+		slog.Info("membership changed by loyalty points", slog.Int("userId", pgUserAccount.ID), slog.String("from", current.Name), slog.String("to", target.Name))
+	}
+
+	return target, moved, nil
+}
@@ -0,0 +1,151 @@
+package loyalty
+
+import (
+	"context"
+	"mysite/constants"
+	"mysite/entities"
+	"mysite/pkgs/tier"
+	"mysite/testing/mocking/repomock"
+	"mysite/utils/httputil"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"github.com/volatiletech/null/v8"
+	"github.com/volatiletech/sqlboiler/v4/boil"
+)
+
+var memberships = map[string]*entities.Membership{
+	"bronze": {ID: constants.Bronze, Name: "bronze", Rank: 1},
+	"silver": {ID: constants.Silver, Name: "silver", Rank: 2},
+	"gold":   {ID: constants.Gold, Name: "gold", Rank: 3},
+}
+
+func newUserAccount(membershipId int) *entities.UserAccount {
+	pgUserAccount := &entities.UserAccount{ID: 2}
+	pgUserAccount.R = pgUserAccount.R.NewStruct()
+	pgUserAccount.R.UserInfos = []*entities.UserInfo{{MembershipID: null.IntFrom(membershipId)}}
+	return pgUserAccount
+}
+
+// changerMock records tier changes and rejects an actor, loyalty moves are made by the system.
+// pkgmock can not be used here since it imports this package
+type changerMock struct {
+	calls *[]entities.Membership
+}
+
+func (c changerMock) Change(ctx context.Context, tx boil.ContextTransactor, pgUserAccount *entities.UserAccount, membership entities.Membership, change tier.Change) (bool, error) {
+	if change.ChangedBy.Valid {
+		return false, httputil.ErrInvalidRequest
+	}
+	*c.calls = append(*c.calls, membership)
+	return true, nil
+}
+
+func newTestService(user *entities.UserAccount, balance int) (service, *repomock.LoyaltyRepoMock, *[]entities.Membership) {
+	loyaltyMock := &repomock.LoyaltyRepoMock{
+		GetBalanceForUpdateFunc: func(ctx context.Context, tx boil.ContextTransactor, userAccountId int) (int, error) {
+			return balance, nil
+		},
+		InsertPointFunc: func(ctx context.Context, tx boil.ContextTransactor, point *entities.LoyaltyPoint) error {
+			return nil
+		},
+	}
+	var changes []entities.Membership
+	svc := service{
+		repo: &repomock.UserInfoRepoMock{
+			GetActiveUserAccountWithUserInfoFunc: func(ctx context.Context, tx boil.ContextTransactor, userAccountId int) (*entities.UserAccount, error) {
+				return user, nil
+			},
+		},
+		loyaltyRepo: loyaltyMock,
+		membershipRepo: &repomock.MembershipRepoMock{
+			GetMembershipByIdFunc: func(ctx context.Context, tx boil.ContextTransactor, id int) (*entities.Membership, error) {
+				for _, membership := range memberships {
+					if membership.ID == id {
+						return membership, nil
+					}
+				}
+				return nil, nil
+			},
+			GetMembershipByNameFunc: func(ctx context.Context, tx boil.ContextTransactor, name string) (*entities.Membership, error) {
+				return memberships[name], nil
+			},
+		},
+		changer: changerMock{calls: &changes},
+		rules:   Rules{Thresholds: map[string]int{"bronze": 0, "silver": 1000, "gold": 5000}},
+	}
+	return svc, loyaltyMock, &changes
+}
+
+func TestAdd(t *testing.T) {
+	ctx := context.Background()
+
+	{ // credit promotes
+		svc, loyaltyMock, changes := newTestService(newUserAccount(constants.Bronze), 900)
+
+		result, err := svc.add(ctx, nil, 2, 200, "order")
+		require.NoError(t, err)
+		require.Equal(t, 1100, result.Points)
+		require.Equal(t, "silver", result.Membership.Name)
+		require.Len(t, loyaltyMock.InsertPointCalls(), 1)
+		require.Equal(t, entities.LoyaltyPoint{UserAccountID: 2, Points: 200, Reason: "order"}, *loyaltyMock.InsertPointCalls()[0].Point)
+		require.Len(t, *changes, 1)
+		require.Equal(t, constants.Silver, (*changes)[0].ID)
+	}
+	{ // credit below the next threshold keeps the tier
+		svc, _, changes := newTestService(newUserAccount(constants.Bronze), 0)
+
+		result, err := svc.add(ctx, nil, 2, 10, "order")
+		require.NoError(t, err)
+		require.Equal(t, "bronze", result.Membership.Name)
+		require.Empty(t, *changes)
+	}
+	{ // debit demotes
+		svc, _, changes := newTestService(newUserAccount(constants.Gold), 5000)
+		svc.rules.Demote = true
+
+		result, err := svc.add(ctx, nil, 2, -4500, "refund")
+		require.NoError(t, err)
+		require.Equal(t, 500, result.Points)
+		require.Equal(t, "bronze", result.Membership.Name)
+		require.Len(t, *changes, 1)
+	}
+	{ // debit without demotion keeps the tier
+		svc, _, changes := newTestService(newUserAccount(constants.Gold), 5000)
+
+		result, err := svc.add(ctx, nil, 2, -4500, "refund")
+		require.NoError(t, err)
+		require.Equal(t, "gold", result.Membership.Name)
+		require.Empty(t, *changes)
+	}
+	{ // debit over the balance
+		svc, loyaltyMock, _ := newTestService(newUserAccount(constants.Bronze), 100)
+
+		_, err := svc.add(ctx, nil, 2, -101, "refund")
+		require.ErrorIs(t, err, httputil.ErrInvalidRequest)
+		require.Empty(t, loyaltyMock.InsertPointCalls())
+	}
+	{ // user not found
+		svc, loyaltyMock, _ := newTestService(nil, 0)
+
+		_, err := svc.add(ctx, nil, 2, 100, "order")
+		require.ErrorIs(t, err, httputil.ErrNotFound)
+		require.Empty(t, loyaltyMock.InsertPointCalls())
+	}
+	{ // threshold of an unknown membership
+		svc, _, _ := newTestService(newUserAccount(constants.Bronze), 0)
+		svc.rules.Thresholds = map[string]int{"unknown": 0}
+
+		_, err := svc.add(ctx, nil, 2, 100, "order")
+		require.Error(t, err)
+	}
+}
+
+func TestCreditDebitInvalidPoints(t *testing.T) {
+	svc, _, _ := newTestService(newUserAccount(constants.Bronze), 0)
+
+	_, err := svc.Credit(context.Background(), 2, 0, "order")
+	require.ErrorIs(t, err, httputil.ErrInvalidRequest)
+	_, err = svc.Debit(context.Background(), 2, -1, "refund")
+	require.ErrorIs(t, err, httputil.ErrInvalidRequest)
+}
@@ -0,0 +1,46 @@
+package loyalty
+
+import (
+	"mysite/entities"
+	"mysite/pkgs/env"
+)
+
+// Rules map a points balance to the membership tier it earns
+type Rules struct {
+	Thresholds map[string]int // minimum points per membership name
+	Demote     bool           // users falling below the threshold of their tier move down
+}
+
+func EnvRules() Rules {
+	cfg := env.GetEnv().Loyalty
+	return Rules{
+		Thresholds: cfg.Thresholds,
+		Demote:     cfg.Demote,
+	}
+}
+
+// Tier returns the membership with the highest threshold reached by points, false when none is reached
+func (r Rules) Tier(points int) (string, bool) {
+	var (
+		tier  string
+		found bool
+	)
+	for name, minPoints := range r.Thresholds {
+		if points < minPoints {
+			continue
+		}
+		// equal thresholds are settled by name so every run picks the same tier
+		if !found || minPoints > r.Thresholds[tier] || (minPoints == r.Thresholds[tier] && name < tier) {
+			tier, found = name, true
+		}
+	}
+	return tier, found
+}
+
+// Moves tells whether a user of current has to move to target
+func (r Rules) Moves(current, target entities.Membership) bool {
+	if current.ID == target.ID {
+		return false
+	}
+	return target.Rank > current.Rank || r.Demote
+}
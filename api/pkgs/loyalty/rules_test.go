@@ -0,0 +1,41 @@
+package loyalty
+
+import (
+	"mysite/constants"
+	"mysite/entities"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestTier(t *testing.T) {
+	rules := Rules{Thresholds: map[string]int{"bronze": 0, "silver": 1000, "gold": 5000}}
+
+	for points, expected := range map[int]string{0: "bronze", 999: "bronze", 1000: "silver", 4999: "silver", 100000: "gold"} {
+		tier, found := rules.Tier(points)
+		require.True(t, found, points)
+		require.Equal(t, expected, tier, points)
+	}
+
+	{ // below every threshold
+		_, found := Rules{Thresholds: map[string]int{"silver": 1000}}.Tier(10)
+		require.False(t, found)
+	}
+	{ // equal thresholds pick the same tier every time
+		rules := Rules{Thresholds: map[string]int{"silver": 1000, "gold": 1000}}
+		for i := 0; i < 10; i++ {
+			tier, _ := rules.Tier(1000)
+			require.Equal(t, "gold", tier)
+		}
+	}
+}
+
+func TestMoves(t *testing.T) {
+	bronze := entities.Membership{ID: constants.Bronze, Rank: 1}
+	gold := entities.Membership{ID: constants.Gold, Rank: 3}
+
+	require.False(t, Rules{Demote: true}.Moves(gold, gold))
+	require.True(t, Rules{}.Moves(bronze, gold))
+	require.False(t, Rules{}.Moves(gold, bronze))
+	require.True(t, Rules{Demote: true}.Moves(gold, bronze))
+}
@@ -0,0 +1,71 @@
+package mailer
+
+import (
+	"context"
+	"log/slog"
+	"mysite/pkgs/env"
+	"mysite/pkgs/logger"
+	"strings"
+	"time"
+
+	"github.com/pkg/errors"
+)
+
+const (
+	DriverSmtp   = "smtp"
+	DriverOutbox = "outbox"
+)
+
+type Message struct {
+	To      []string
+	Subject string
+	Body    string // plain text
+}
+
+//go:generate moq -pkg pkgmock -out ../../testing/mocking/pkgmock/mailer.mock.go . Mailer
+type Mailer interface {
+	Send(ctx context.Context, msg Message) error
+}
+
+// NewMailer returns the mailer of the configured driver, outbox by default
+func NewMailer() Mailer {
+	cfg := env.GetEnv().Mailer
+	switch cfg.Driver {
+	case DriverSmtp:
+		return NewSmtpMailer(cfg.Host, cfg.Port, cfg.UserName, cfg.Password, cfg.From)
+	default:
+		if cfg.OutboxDir == "" {
+			return MemoryOutbox()
+		}
+		return NewOutboxMailer(cfg.OutboxDir)
+	}
+}
+
+// sendTimeout bounds a send in the background, no request waits for it
+const sendTimeout = time.Minute
+
+// SendInBackground sends msg without blocking the caller, for responses whose latency must not tell
+// whether a mail was sent, a failure is only logged
+func SendInBackground(ctx context.Context, m Mailer, msg Message) {
+	ctx, cancel := context.WithTimeout(context.WithoutCancel(ctx), sendTimeout)
+	go func() {
+		defer cancel()
+		if err := m.Send(ctx, msg); err != nil {
+			slog.Error("failed send mail", "subject", msg.Subject, logger.AttrError(err))
+		}
+	}()
+}
+
+func (m Message) validate() error {
+	if len(m.To) == 0 {
+		return errors.New("missing recipient")
+	}
+
+	// headers are written as is, a line break would inject another header
+	for _, value := range append([]string{m.Subject}, m.To...) {
+		if strings.ContainsAny(value, "\r\n") {
+			return errors.New("invalid header value")
+		}
+	}
+	return nil
+}
@@ -0,0 +1,139 @@
+package mailer
+
+import (
+	"context"
+	"fmt"
+	"net/smtp"
+	"os"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/require"
+)
+
+func TestOutboxMailer(t *testing.T) {
+	ctx := context.Background()
+	msg := Message{To: []string{"user@example.com"}, Subject: "subject", Body: "body"}
+
+	{ // memory outbox
+		outbox := &OutboxMailer{}
+		require.NoError(t, outbox.Send(ctx, msg))
+		require.Equal(t, []Message{msg}, outbox.Messages())
+
+		outbox.Reset()
+		require.Empty(t, outbox.Messages())
+	}
+	{ // file outbox
+		dir := t.TempDir()
+		outbox := NewOutboxMailer(dir)
+		require.NoError(t, outbox.Send(ctx, msg))
+		require.Len(t, outbox.Messages(), 1)
+
+		files, err := os.ReadDir(dir)
+		require.NoError(t, err)
+		require.Len(t, files, 1)
+
+		data, err := os.ReadFile(dir + "/" + files[0].Name())
+		require.NoError(t, err)
+		require.Contains(t, string(data), "Subject: subject\r\n")
+	}
+	{ // only the latest messages are kept
+		outbox := &OutboxMailer{}
+		for i := 0; i < maxOutboxMessages+10; i++ {
+			require.NoError(t, outbox.Send(ctx, Message{To: []string{"user@example.com"}, Subject: fmt.Sprint(i)}))
+		}
+		messages := outbox.Messages()
+		require.Len(t, messages, maxOutboxMessages)
+		require.Equal(t, "10", messages[0].Subject)
+		require.Equal(t, fmt.Sprint(maxOutboxMessages+9), messages[len(messages)-1].Subject)
+	}
+	{ // invalid message
+		outbox := &OutboxMailer{}
+		require.Error(t, outbox.Send(ctx, Message{Subject: "subject"}))
+		require.Error(t, outbox.Send(ctx, Message{To: []string{"user@example.com"}, Subject: "subject\r\nBcc: other@example.com"}))
+		require.Empty(t, outbox.Messages())
+	}
+}
+
+func TestSendInBackground(t *testing.T) {
+	msg := Message{To: []string{"user@example.com"}, Subject: "subject", Body: "body"}
+
+	{ // sent after the request context ended
+		outbox := &OutboxMailer{}
+		ctx, cancel := context.WithCancel(context.Background())
+		SendInBackground(ctx, outbox, msg)
+		cancel()
+		require.Eventually(t, func() bool { return len(outbox.Messages()) == 1 }, time.Second, 10*time.Millisecond)
+	}
+	{ // the caller does not wait for the mailer
+		release := make(chan struct{})
+		defer close(release)
+		blocked := mailerFunc(func(ctx context.Context, msg Message) error {
+			<-release
+			return nil
+		})
+
+		done := make(chan struct{})
+		go func() {
+			SendInBackground(context.Background(), blocked, msg)
+			close(done)
+		}()
+		select {
+		case <-done:
+		case <-time.After(time.Second):
+			t.Fatal("SendInBackground waited for the mailer")
+		}
+	}
+}
+
+type mailerFunc func(ctx context.Context, msg Message) error
+
+func (f mailerFunc) Send(ctx context.Context, msg Message) error {
+	return f(ctx, msg)
+}
+
+func TestSmtpMailer(t *testing.T) {
+	ctx := context.Background()
+	msg := Message{To: []string{"user@example.com"}, Subject: "subject", Body: "line1\nline2"}
+
+	{ // send success
+		var gotAddr, gotFrom string
+		var gotTo []string
+		var gotMsg []byte
+		mailer := smtpMailer{
+			host: "smtp.example.com",
+			port: "587",
+			from: "noreply@example.com",
+			sendMail: func(addr string, a smtp.Auth, from string, to []string, msg []byte) error {
+				gotAddr, gotFrom, gotTo, gotMsg = addr, from, to, msg
+				return nil
+			},
+		}
+
+		require.NoError(t, mailer.Send(ctx, msg))
+		require.Equal(t, "smtp.example.com:587", gotAddr)
+		require.Equal(t, "noreply@example.com", gotFrom)
+		require.Equal(t, []string{"user@example.com"}, gotTo)
+		require.True(t, strings.HasSuffix(string(gotMsg), "\r\n\r\nline1\r\nline2"))
+	}
+	{ // send failed
+		mailer := smtpMailer{
+			sendMail: func(addr string, a smtp.Auth, from string, to []string, msg []byte) error {
+				return errors.New("connection refused")
+			},
+		}
+		require.Error(t, mailer.Send(ctx, msg))
+	}
+}
+
+func TestBuildMessage(t *testing.T) {
+	date := time.Date(2024, 1, 2, 3, 4, 5, 0, time.UTC)
+	data := string(buildMessage("noreply@example.com", Message{To: []string{"a@example.com", "b@example.com"}, Subject: "subject", Body: "body"}, date))
+
+	require.Contains(t, data, "From: noreply@example.com\r\n")
+	require.Contains(t, data, "To: a@example.com, b@example.com\r\n")
+	require.Contains(t, data, "Date: Tue, 02 Jan 2024 03:04:05 +0000\r\n")
+	require.True(t, strings.HasSuffix(data, "\r\n\r\nbody"))
+}
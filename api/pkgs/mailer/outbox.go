@@ -0,0 +1,79 @@
+package mailer
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"path/filepath"
+	"sync"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/pkg/errors"
+)
+
+// OutboxMailer keeps messages instead of delivering them, for local development and tests
+type OutboxMailer struct {
+	dir string
+
+	mu       sync.Mutex
+	messages []Message
+}
+
+// maxOutboxMessages only the latest messages are kept, the outbox must not grow with every request
+const maxOutboxMessages = 100
+
+var memoryOutbox = &OutboxMailer{}
+
+// MemoryOutbox is shared by the process, messages are only kept in memory
+func MemoryOutbox() *OutboxMailer {
+	return memoryOutbox
+}
+
+// NewOutboxMailer also writes every message as .eml file into dir
+func NewOutboxMailer(dir string) *OutboxMailer {
+	return &OutboxMailer{dir: dir}
+}
+
+func (o *OutboxMailer) Send(ctx context.Context, msg Message) error {
+	if err := msg.validate(); err != nil {
+		return errors.Wrap(err, "invalid message")
+	}
+
+	o.mu.Lock()
+	defer o.mu.Unlock()
+
+	if o.dir != "" {
+		if err := os.MkdirAll(o.dir, 0o755); err != nil {
+			return errors.Wrap(err, "failed create outbox dir")
+		}
+
+		now := time.Now()
+		name := fmt.Sprintf("%s-%s.eml", now.Format("20060102T150405"), uuid.NewString())
+		if err := os.WriteFile(filepath.Join(o.dir, name), buildMessage("outbox", msg, now), 0o644); err != nil {
+			return errors.Wrap(err, "failed write outbox file")
+		}
+	}
+
+	o.messages = append(o.messages, msg)
+	if len(o.messages) > maxOutboxMessages {
+		o.messages = o.messages[len(o.messages)-maxOutboxMessages:]
+	}
+	return nil
+}
+
+// Messages returns a copy of the sent messages
+func (o *OutboxMailer) Messages() []Message {
+	o.mu.Lock()
+	defer o.mu.Unlock()
+
+	return append([]Message(nil), o.messages...)
+}
+
+// Reset drops the sent messages
+func (o *OutboxMailer) Reset() {
+	o.mu.Lock()
+	defer o.mu.Unlock()
+
+	o.messages = nil
+}
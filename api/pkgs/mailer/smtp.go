@@ -0,0 +1,67 @@
+package mailer
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"net"
+	"net/smtp"
+	"strings"
+	"time"
+
+	"github.com/pkg/errors"
+)
+
+type smtpMailer struct {
+	host     string
+	port     string
+	userName string
+	password string
+	from     string
+
+	sendMail func(addr string, a smtp.Auth, from string, to []string, msg []byte) error
+}
+
+func NewSmtpMailer(host, port, userName, password, from string) Mailer {
+	return &smtpMailer{
+		host:     host,
+		port:     port,
+		userName: userName,
+		password: password,
+		from:     from,
+		sendMail: smtp.SendMail,
+	}
+}
+
+func (s smtpMailer) Send(ctx context.Context, msg Message) error {
+	if err := msg.validate(); err != nil {
+		return errors.Wrap(err, "invalid message")
+	}
+	if err := ctx.Err(); err != nil {
+		return errors.Wrap(err, "context done")
+	}
+
+	var auth smtp.Auth
+	if s.userName != "" {
+		auth = smtp.PlainAuth("", s.userName, s.password, s.host)
+	}
+
+	if err := s.sendMail(net.JoinHostPort(s.host, s.port), auth, s.from, msg.To, buildMessage(s.from, msg, time.Now())); err != nil {
+		return errors.Wrap(err, "failed to send mail")
+	}
+	return nil
+}
+
+// buildMessage formats the message as RFC 5322 plain text mail
+func buildMessage(from string, msg Message, date time.Time) []byte {
+	var buf bytes.Buffer
+	fmt.Fprintf(&buf, "From: %s\r\n", from)
+	fmt.Fprintf(&buf, "To: %s\r\n", strings.Join(msg.To, ", "))
+	fmt.Fprintf(&buf, "Subject: %s\r\n", msg.Subject)
+	fmt.Fprintf(&buf, "Date: %s\r\n", date.Format(time.RFC1123Z))
+	buf.WriteString("MIME-Version: 1.0\r\n")
+	buf.WriteString("Content-Type: text/plain; charset=UTF-8\r\n")
+	buf.WriteString("\r\n")
+	buf.WriteString(strings.ReplaceAll(msg.Body, "\n", "\r\n"))
+	return buf.Bytes()
+}
@@ -0,0 +1,37 @@
+package oauth
+
+import (
+	"net/http"
+	"net/url"
+)
+
+// ClientCredentials the client_id and client_secret of HTTP Basic, or of the body when the request has no Basic header.
+// Using both is rejected as RFC 6749 2.3 allows a single authentication method per request
+func ClientCredentials(r *http.Request, bodyClientId *string, bodyClientSecret *string) (clientId string, clientSecret string, err error) {
+	basicId, basicSecret, ok := r.BasicAuth()
+	if !ok {
+		if bodyClientId != nil {
+			clientId = *bodyClientId
+		}
+		if bodyClientSecret != nil {
+			clientSecret = *bodyClientSecret
+		}
+		return clientId, clientSecret, nil
+	}
+
+	if bodyClientSecret != nil {
+		return "", "", ErrInvalidRequest.WithDescription("client authenticated by more than one method")
+	}
+
+	// Basic credentials are form encoded before base64 by RFC 6749 2.3.1
+	if clientId, err = url.QueryUnescape(basicId); err != nil {
+		return "", "", ErrInvalidClient.WithDescription("malformed client_id")
+	}
+	if clientSecret, err = url.QueryUnescape(basicSecret); err != nil {
+		return "", "", ErrInvalidClient.WithDescription("malformed client_secret")
+	}
+	if bodyClientId != nil && *bodyClientId != clientId {
+		return "", "", ErrInvalidRequest.WithDescription("client_id does not match the authorization header")
+	}
+	return clientId, clientSecret, nil
+}
@@ -0,0 +1,66 @@
+package oauth
+
+import (
+	"log/slog"
+	"mysite/pkgs/logger"
+	"net/http"
+
+	"github.com/go-chi/render"
+	"github.com/pkg/errors"
+)
+
+// Error is the error response of RFC 6749, the oauth endpoints answer with it instead of httputil.ErrResponse
+type Error struct {
+	HTTPStatusCode int `json:"-"`
+
+	Code        string `json:"error"`
+	Description string `json:"error_description,omitempty"`
+}
+
+var (
+	ErrInvalidRequest       = Error{HTTPStatusCode: http.StatusBadRequest, Code: "invalid_request"}
+	ErrInvalidClient        = Error{HTTPStatusCode: http.StatusUnauthorized, Code: "invalid_client"}
+	ErrInvalidGrant         = Error{HTTPStatusCode: http.StatusBadRequest, Code: "invalid_grant"}
+	ErrUnauthorizedClient   = Error{HTTPStatusCode: http.StatusBadRequest, Code: "unauthorized_client"}
+	ErrUnsupportedGrantType = Error{HTTPStatusCode: http.StatusBadRequest, Code: "unsupported_grant_type"}
+	ErrUnsupportedResponse  = Error{HTTPStatusCode: http.StatusBadRequest, Code: "unsupported_response_type"}
+	ErrInvalidScope         = Error{HTTPStatusCode: http.StatusBadRequest, Code: "invalid_scope"}
+	ErrServerError          = Error{HTTPStatusCode: http.StatusInternalServerError, Code: "server_error"}
+)
+
+func (e Error) Error() string {
+	if e.Description == "" {
+		return e.Code
+	}
+	return e.Code + ": " + e.Description
+}
+
+// Is matches by code, so that errors built by WithDescription still match the base error
+func (e Error) Is(target error) bool {
+	t, ok := target.(Error)
+	return ok && t.Code == e.Code
+}
+
+func (e Error) WithDescription(description string) Error {
+	e.Description = description
+	return e
+}
+
+func (e Error) Render(w http.ResponseWriter, r *http.Request) error {
+	if e.HTTPStatusCode == http.StatusUnauthorized {
+		w.Header().Set("WWW-Authenticate", `Basic realm="oauth"`)
+	}
+	render.Status(r, e.HTTPStatusCode)
+	return nil
+}
+
+// NewFailureRender errors which are not an Error become a server_error, their text is logged instead of sent
+func NewFailureRender(err error) render.Renderer {
+	var oauthErr Error
+	if errors.As(err, &oauthErr) {
+		return oauthErr
+	}
+
+	slog.Error("oauth server error", logger.AttrError(err))
+	return ErrServerError
+}
@@ -0,0 +1,76 @@
+package oauth
+
+import (
+	"crypto/sha256"
+	"crypto/subtle"
+	"encoding/base64"
+	"mysite/entities"
+	"mysite/pkgs/auth"
+	"regexp"
+	"slices"
+	"strings"
+)
+
+// CodeChallengeS256 is the only PKCE method accepted, plain would let a stolen code be redeemed
+const CodeChallengeS256 = "S256"
+
+var codeVerifierPattern = regexp.MustCompile(`^[A-Za-z0-9\-._~]{43,128}$`)
+
+// ParseScope splits the space separated scope parameter, nil when it is empty
+func ParseScope(scope string) []string {
+	return strings.Fields(scope)
+}
+
+func FormatScope(scopes []string) string {
+	return strings.Join(scopes, " ")
+}
+
+// ResolveScopes the requested scopes when they are all allowed, every allowed scope when none is requested
+func ResolveScopes(requested []string, allowed []string) ([]string, bool) {
+	if len(requested) == 0 {
+		return slices.Clone(allowed), true
+	}
+
+	result := []string{}
+	for _, scope := range requested {
+		if !slices.Contains(allowed, scope) {
+			return nil, false
+		}
+		if !slices.Contains(result, scope) {
+			result = append(result, scope)
+		}
+	}
+	return result, true
+}
+
+// IsConfidential tells whether the client has a secret, public clients authenticate with PKCE only
+func IsConfidential(client entities.OauthClient) bool {
+	return client.SecretHash.Valid
+}
+
+func AllowsGrant(client entities.OauthClient, grantType string) bool {
+	return slices.Contains(client.GrantTypes, grantType)
+}
+
+// AllowsRedirectURI compares to the registered uris exactly, no prefix or wildcard matching
+func AllowsRedirectURI(client entities.OauthClient, redirectURI string) bool {
+	return slices.Contains(client.RedirectUris, redirectURI)
+}
+
+// VerifyClientSecret compares the secret to the stored hash in constant time, always false for public clients
+func VerifyClientSecret(client entities.OauthClient, secret string) bool {
+	if !IsConfidential(client) {
+		return false
+	}
+	return subtle.ConstantTimeCompare([]byte(auth.HashOpaqueToken(secret)), []byte(client.SecretHash.String)) == 1
+}
+
+// VerifyCodeChallenge checks the verifier against the S256 challenge of RFC 7636
+func VerifyCodeChallenge(verifier string, challenge string) bool {
+	if !codeVerifierPattern.MatchString(verifier) {
+		return false
+	}
+	sum := sha256.Sum256([]byte(verifier))
+	expected := base64.RawURLEncoding.EncodeToString(sum[:])
+	return subtle.ConstantTimeCompare([]byte(expected), []byte(challenge)) == 1
+}
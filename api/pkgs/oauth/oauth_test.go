@@ -0,0 +1,122 @@
+package oauth
+
+import (
+	"crypto/sha256"
+	"encoding/base64"
+	"mysite/entities"
+	"mysite/pkgs/auth"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/go-chi/render"
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/require"
+	"github.com/volatiletech/null/v8"
+	"github.com/volatiletech/sqlboiler/v4/types"
+)
+
+func TestResolveScopes(t *testing.T) {
+	allowed := []string{"users:read", "users:write"}
+	{ // no requested scope is every allowed scope
+		scopes, ok := ResolveScopes(ParseScope(""), allowed)
+		require.True(t, ok)
+		require.Equal(t, allowed, scopes)
+	}
+	{ // requested subset, duplicates dropped
+		scopes, ok := ResolveScopes(ParseScope("users:read  users:read"), allowed)
+		require.True(t, ok)
+		require.Equal(t, []string{"users:read"}, scopes)
+		require.Equal(t, "users:read", FormatScope(scopes))
+	}
+	{ // scope which is not allowed
+		_, ok := ResolveScopes(ParseScope("users:read roles:write"), allowed)
+		require.False(t, ok)
+	}
+}
+
+func TestClient(t *testing.T) {
+	secret := "secret"
+	client := entities.OauthClient{
+		SecretHash:   null.StringFrom(auth.HashOpaqueToken(secret)),
+		RedirectUris: types.StringArray{"https://example.com/callback"},
+		GrantTypes:   types.StringArray{"authorization_code"},
+	}
+
+	require.True(t, IsConfidential(client))
+	require.True(t, VerifyClientSecret(client, secret))
+	require.False(t, VerifyClientSecret(client, "other"))
+	require.True(t, AllowsGrant(client, "authorization_code"))
+	require.False(t, AllowsGrant(client, "client_credentials"))
+	require.True(t, AllowsRedirectURI(client, "https://example.com/callback"))
+	require.False(t, AllowsRedirectURI(client, "https://example.com/callback/other"))
+
+	public := entities.OauthClient{}
+	require.False(t, IsConfidential(public))
+	require.False(t, VerifyClientSecret(public, ""))
+}
+
+func TestVerifyCodeChallenge(t *testing.T) {
+	verifier := strings.Repeat("a1-._~", 8)
+	sum := sha256.Sum256([]byte(verifier))
+	challenge := base64.RawURLEncoding.EncodeToString(sum[:])
+
+	require.True(t, VerifyCodeChallenge(verifier, challenge))
+	require.False(t, VerifyCodeChallenge(verifier+"b", challenge))
+	// too short to be a verifier
+	require.False(t, VerifyCodeChallenge("short", challenge))
+}
+
+func TestNewFailureRender(t *testing.T) {
+	{ // oauth error keeps its code and status
+		w := httptest.NewRecorder()
+		r := httptest.NewRequest(http.MethodPost, "http://example.com", nil)
+		require.NoError(t, render.Render(w, r, NewFailureRender(errors.Wrap(ErrInvalidClient.WithDescription("unknown client"), "failed"))))
+		require.Equal(t, http.StatusUnauthorized, w.Result().StatusCode)
+		require.NotEmpty(t, w.Header().Get("WWW-Authenticate"))
+		require.JSONEq(t, `{"error":"invalid_client","error_description":"unknown client"}`, w.Body.String())
+	}
+	{ // other errors are a server error without their text
+		w := httptest.NewRecorder()
+		r := httptest.NewRequest(http.MethodPost, "http://example.com", nil)
+		require.NoError(t, render.Render(w, r, NewFailureRender(errors.New("connection refused"))))
+		require.Equal(t, http.StatusInternalServerError, w.Result().StatusCode)
+		require.JSONEq(t, `{"error":"server_error"}`, w.Body.String())
+	}
+	{ // Is matches by code
+		require.ErrorIs(t, errors.Wrap(ErrInvalidGrant.WithDescription("expired"), "failed"), ErrInvalidGrant)
+		require.NotErrorIs(t, ErrInvalidGrant, ErrInvalidClient)
+	}
+}
+
+func TestClientCredentials(t *testing.T) {
+	ptr := func(s string) *string { return &s }
+	{ // body credentials
+		r := httptest.NewRequest(http.MethodPost, "/oauth/token", nil)
+		clientId, clientSecret, err := ClientCredentials(r, ptr("client"), ptr("secret"))
+		require.NoError(t, err)
+		require.Equal(t, "client", clientId)
+		require.Equal(t, "secret", clientSecret)
+	}
+	{ // basic credentials are form decoded
+		r := httptest.NewRequest(http.MethodPost, "/oauth/token", nil)
+		r.SetBasicAuth("client", "se%2Bcret")
+		clientId, clientSecret, err := ClientCredentials(r, ptr("client"), nil)
+		require.NoError(t, err)
+		require.Equal(t, "client", clientId)
+		require.Equal(t, "se+cret", clientSecret)
+	}
+	{ // basic and body secret
+		r := httptest.NewRequest(http.MethodPost, "/oauth/token", nil)
+		r.SetBasicAuth("client", "secret")
+		_, _, err := ClientCredentials(r, nil, ptr("secret"))
+		require.ErrorIs(t, err, ErrInvalidRequest)
+	}
+	{ // body client_id differs from basic
+		r := httptest.NewRequest(http.MethodPost, "/oauth/token", nil)
+		r.SetBasicAuth("client", "secret")
+		_, _, err := ClientCredentials(r, ptr("other"), nil)
+		require.ErrorIs(t, err, ErrInvalidRequest)
+	}
+}
@@ -0,0 +1,174 @@
+package oidc
+
+import (
+	"context"
+	"crypto"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rsa"
+	"crypto/subtle"
+	"encoding/base64"
+	"math/big"
+	"mysite/pkgs/auth"
+	"sync"
+	"time"
+
+	"github.com/golang-jwt/jwt/v5"
+	"github.com/pkg/errors"
+)
+
+// clockSkew tolerated between the provider and this server for exp and iat
+const clockSkew = time.Minute
+
+// signingMethods accepted for ID tokens, HS256 with the client secret and none are rejected
+var signingMethods = []string{"RS256", "RS384", "RS512", "PS256", "PS384", "PS512", "ES256", "ES384", "ES512"}
+
+type idTokenClaims struct {
+	jwt.RegisteredClaims
+	Nonce         string `json:"nonce"`
+	Azp           string `json:"azp"`
+	Email         string `json:"email"`
+	EmailVerified bool   `json:"email_verified"`
+}
+
+var (
+	jwksMu    sync.Mutex
+	jwksCache = map[string]map[string]crypto.PublicKey{}
+)
+
+// verifyIDToken checks the signature by the jwks of the issuer, iss, aud, azp, exp, iat and the nonce of the login
+func (p provider) verifyIDToken(ctx context.Context, discovery Discovery, rawToken string, nonce string) (*IDToken, error) {
+	keyFunc := func(token *jwt.Token) (interface{}, error) {
+		kid, _ := token.Header["kid"].(string)
+		return p.publicKey(ctx, discovery.JwksURI, kid)
+	}
+
+	var claims idTokenClaims
+	parser := jwt.NewParser(
+		jwt.WithValidMethods(signingMethods),
+		jwt.WithIssuer(p.config.Issuer),
+		jwt.WithAudience(p.config.ClientID),
+		jwt.WithExpirationRequired(),
+		jwt.WithIssuedAt(),
+		jwt.WithLeeway(clockSkew),
+	)
+	if _, err := parser.ParseWithClaims(rawToken, &claims, keyFunc); err != nil {
+		return nil, errors.Wrap(err, "invalid id_token")
+	}
+
+	// OIDC Core 3.1.3.7, a token for several audiences must be authorized for this client
+	if (len(claims.Audience) > 1 || claims.Azp != "") && claims.Azp != p.config.ClientID {
+		return nil, errors.New("id_token azp is not the client")
+	}
+	if nonce == "" || subtle.ConstantTimeCompare([]byte(claims.Nonce), []byte(nonce)) != 1 {
+		return nil, errors.New("id_token nonce does not match")
+	}
+	if claims.Subject == "" {
+		return nil, errors.New("id_token without sub")
+	}
+
+	return &IDToken{
+		Issuer:        claims.Issuer,
+		Subject:       claims.Subject,
+		Email:         claims.Email,
+		EmailVerified: claims.EmailVerified,
+	}, nil
+}
+
+// publicKey the key of the kid, the jwks is fetched again once when the provider rotated to an unknown key
+func (p provider) publicKey(ctx context.Context, jwksURI string, kid string) (crypto.PublicKey, error) {
+	jwksMu.Lock()
+	keys, ok := jwksCache[jwksURI]
+	jwksMu.Unlock()
+	if key, found := lookupKey(keys, kid); ok && found {
+		return key, nil
+	}
+
+	var jwks struct {
+		Keys []auth.Jwk `json:"keys"`
+	}
+	if err := p.getJSON(ctx, jwksURI, &jwks); err != nil {
+		return nil, errors.Wrap(err, "failed get jwks")
+	}
+	keys = map[string]crypto.PublicKey{}
+	for _, jwk := range jwks.Keys {
+		if jwk.Use != "" && jwk.Use != "sig" {
+			continue
+		}
+		// keys of unsupported types are skipped, the provider may publish more than ID tokens use
+		if key, err := parseJwk(jwk); err == nil {
+			keys[jwk.Kid] = key
+		}
+	}
+
+	jwksMu.Lock()
+	jwksCache[jwksURI] = keys
+	jwksMu.Unlock()
+
+	key, found := lookupKey(keys, kid)
+	if !found {
+		return nil, errors.Errorf("unknown key %s", kid)
+	}
+	return key, nil
+}
+
+// lookupKey tokens without kid are only accepted while the jwks has a single key
+func lookupKey(keys map[string]crypto.PublicKey, kid string) (crypto.PublicKey, bool) {
+	if kid == "" && len(keys) == 1 {
+		for _, key := range keys {
+			return key, true
+		}
+	}
+	key, ok := keys[kid]
+	return key, ok && kid != ""
+}
+
+func parseJwk(jwk auth.Jwk) (crypto.PublicKey, error) {
+	switch jwk.Kty {
+	case "RSA":
+		n, err := decodeBase64Url(jwk.N)
+		if err != nil {
+			return nil, err
+		}
+		e, err := decodeBase64Url(jwk.E)
+		if err != nil {
+			return nil, err
+		}
+		return &rsa.PublicKey{N: new(big.Int).SetBytes(n), E: int(new(big.Int).SetBytes(e).Int64())}, nil
+	case "EC":
+		var curve elliptic.Curve
+		switch jwk.Crv {
+		case "P-256":
+			curve = elliptic.P256()
+		case "P-384":
+			curve = elliptic.P384()
+		case "P-521":
+			curve = elliptic.P521()
+		default:
+			return nil, errors.Errorf("unsupported curve %s", jwk.Crv)
+		}
+		x, err := decodeBase64Url(jwk.X)
+		if err != nil {
+			return nil, err
+		}
+		y, err := decodeBase64Url(jwk.Y)
+		if err != nil {
+			return nil, err
+		}
+		key := &ecdsa.PublicKey{Curve: curve, X: new(big.Int).SetBytes(x), Y: new(big.Int).SetBytes(y)}
+		if _, err := key.ECDH(); err != nil {
+			return nil, errors.Wrap(err, "invalid ec key")
+		}
+		return key, nil
+	default:
+		return nil, errors.Errorf("unsupported key type %s", jwk.Kty)
+	}
+}
+
+func decodeBase64Url(value string) ([]byte, error) {
+	decoded, err := base64.RawURLEncoding.DecodeString(value)
+	if err != nil {
+		return nil, errors.Wrap(err, "invalid base64url")
+	}
+	return decoded, nil
+}
@@ -0,0 +1,210 @@
+package oidc
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/json"
+	"io"
+	"mysite/pkgs/env"
+	"net/http"
+	"net/url"
+	"slices"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/pkg/errors"
+)
+
+// ErrUnknownProvider the name is not a provider of the oidc config
+var ErrUnknownProvider = errors.New("unknown oidc provider")
+
+// discoveryTtl how long the discovery document of an issuer is reused
+const discoveryTtl = time.Hour
+
+var defaultClient = &http.Client{Timeout: 10 * time.Second}
+
+//go:generate moq -pkg pkgmock -out ../../testing/mocking/pkgmock/oidc.mock.go . Provider
+type Provider interface {
+	AuthCodeURL(ctx context.Context, state string, nonce string, codeChallenge string) (string, error)
+	Exchange(ctx context.Context, code string, codeVerifier string, nonce string) (*IDToken, error)
+}
+
+// IDToken the validated claims of the ID token which identify the user
+type IDToken struct {
+	Issuer        string
+	Subject       string
+	Email         string
+	EmailVerified bool
+}
+
+// Discovery the fields of the OpenID Provider Metadata the code flow needs
+type Discovery struct {
+	Issuer                string `json:"issuer"`
+	AuthorizationEndpoint string `json:"authorization_endpoint"`
+	TokenEndpoint         string `json:"token_endpoint"`
+	JwksURI               string `json:"jwks_uri"`
+}
+
+type provider struct {
+	config env.OidcProvider
+	client *http.Client
+}
+
+type cachedDiscovery struct {
+	discovery Discovery
+	fetchedAt time.Time
+}
+
+var (
+	discoveryMu    sync.Mutex
+	discoveryCache = map[string]cachedDiscovery{}
+)
+
+// NewProvider the provider of the oidc config with the name
+func NewProvider(name string) (Provider, error) {
+	config, ok := env.GetEnv().Oidc.Providers[name]
+	if !ok {
+		return nil, errors.Wrap(ErrUnknownProvider, name)
+	}
+	return &provider{config: config, client: defaultClient}, nil
+}
+
+// AuthCodeURL the authorization endpoint with the params of the code flow, the challenge is S256
+func (p provider) AuthCodeURL(ctx context.Context, state string, nonce string, codeChallenge string) (string, error) {
+	discovery, err := p.discover(ctx)
+	if err != nil {
+		return "", err
+	}
+
+	u, err := url.Parse(discovery.AuthorizationEndpoint)
+	if err != nil {
+		return "", errors.Wrap(err, "invalid authorization_endpoint")
+	}
+	query := u.Query()
+	query.Set("response_type", "code")
+	query.Set("client_id", p.config.ClientID)
+	query.Set("redirect_uri", p.config.RedirectURL)
+	query.Set("scope", strings.Join(p.scopes(), " "))
+	query.Set("state", state)
+	query.Set("nonce", nonce)
+	query.Set("code_challenge", codeChallenge)
+	query.Set("code_challenge_method", "S256")
+	u.RawQuery = query.Encode()
+	return u.String(), nil
+}
+
+// Exchange redeems the code at the token endpoint and returns the validated ID token
+func (p provider) Exchange(ctx context.Context, code string, codeVerifier string, nonce string) (*IDToken, error) {
+	discovery, err := p.discover(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	form := url.Values{
+		"grant_type":    {"authorization_code"},
+		"code":          {code},
+		"redirect_uri":  {p.config.RedirectURL},
+		"code_verifier": {codeVerifier},
+	}
+	if p.config.ClientSecret == "" {
+		form.Set("client_id", p.config.ClientID)
+	}
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, discovery.TokenEndpoint, strings.NewReader(form.Encode()))
+	if err != nil {
+		return nil, errors.Wrap(err, "failed create token request")
+	}
+	req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+	req.Header.Set("Accept", "application/json")
+	if p.config.ClientSecret != "" {
+		// client_secret_basic, the credentials are form encoded first by RFC 6749 2.3.1
+		req.SetBasicAuth(url.QueryEscape(p.config.ClientID), url.QueryEscape(p.config.ClientSecret))
+	}
+
+	var token struct {
+		IDToken          string `json:"id_token"`
+		Error            string `json:"error"`
+		ErrorDescription string `json:"error_description"`
+	}
+	resp, err := p.client.Do(req)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed request token")
+	}
+	defer resp.Body.Close()
+	if err := json.NewDecoder(io.LimitReader(resp.Body, 1<<20)).Decode(&token); err != nil {
+		return nil, errors.Wrapf(err, "failed decode token response, status %d", resp.StatusCode)
+	}
+	if resp.StatusCode != http.StatusOK {
+		return nil, errors.Errorf("token request failed with %d: %s %s", resp.StatusCode, token.Error, token.ErrorDescription)
+	}
+	if token.IDToken == "" {
+		return nil, errors.New("token response without id_token")
+	}
+
+	return p.verifyIDToken(ctx, *discovery, token.IDToken, nonce)
+}
+
+// CodeChallenge the S256 PKCE challenge of the verifier
+func CodeChallenge(verifier string) string {
+	sum := sha256.Sum256([]byte(verifier))
+	return base64.RawURLEncoding.EncodeToString(sum[:])
+}
+
+// scopes openid is required for an ID token, email to link the identity
+func (p provider) scopes() []string {
+	scopes := []string{"openid", "email"}
+	for _, scope := range p.config.Scopes {
+		if !slices.Contains(scopes, scope) {
+			scopes = append(scopes, scope)
+		}
+	}
+	return scopes
+}
+
+// discover reads the metadata of the issuer, it must name the configured issuer exactly
+func (p provider) discover(ctx context.Context) (*Discovery, error) {
+	discoveryMu.Lock()
+	cached, ok := discoveryCache[p.config.Issuer]
+	discoveryMu.Unlock()
+	if ok && time.Since(cached.fetchedAt) < discoveryTtl {
+		return &cached.discovery, nil
+	}
+
+	var discovery Discovery
+	if err := p.getJSON(ctx, strings.TrimSuffix(p.config.Issuer, "/")+"/.well-known/openid-configuration", &discovery); err != nil {
+		return nil, errors.Wrap(err, "failed discover issuer")
+	}
+	if discovery.Issuer != p.config.Issuer {
+		return nil, errors.Errorf("discovery issuer %s does not match %s", discovery.Issuer, p.config.Issuer)
+	}
+	if discovery.AuthorizationEndpoint == "" || discovery.TokenEndpoint == "" || discovery.JwksURI == "" {
+		return nil, errors.New("discovery without authorization_endpoint, token_endpoint or jwks_uri")
+	}
+
+	discoveryMu.Lock()
+	discoveryCache[p.config.Issuer] = cachedDiscovery{discovery: discovery, fetchedAt: time.Now()}
+	discoveryMu.Unlock()
+	return &discovery, nil
+}
+
+func (p provider) getJSON(ctx context.Context, target string, result any) error {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, target, nil)
+	if err != nil {
+		return errors.Wrap(err, "failed create request")
+	}
+	req.Header.Set("Accept", "application/json")
+
+	resp, err := p.client.Do(req)
+	if err != nil {
+		return errors.Wrapf(err, "failed get %s", target)
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode != http.StatusOK {
+		return errors.Errorf("get %s failed with %d", target, resp.StatusCode)
+	}
+	if err := json.NewDecoder(io.LimitReader(resp.Body, 1<<20)).Decode(result); err != nil {
+		return errors.Wrapf(err, "failed decode %s", target)
+	}
+	return nil
+}
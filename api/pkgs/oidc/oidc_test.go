@@ -0,0 +1,223 @@
+package oidc
+
+import (
+	"context"
+	"encoding/json"
+	"mysite/pkgs/env"
+	"mysite/testing/oidctest"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"testing"
+	"time"
+
+	"github.com/golang-jwt/jwt/v5"
+	"github.com/stretchr/testify/require"
+)
+
+const (
+	testRedirectURL = "https://example.com/api/v1/login/oidc/test/callback"
+	testVerifier    = "verifier-verifier-verifier-verifier-verifier"
+	testNonce       = "nonce"
+)
+
+func newTestProvider(t *testing.T, clientSecret string) (*oidctest.Server, *provider) {
+	server, err := oidctest.NewServer("mysite", clientSecret)
+	require.NoError(t, err)
+	t.Cleanup(server.Close)
+	return server, &provider{config: server.Provider(testRedirectURL), client: server.Client()}
+}
+
+// login runs the code flow up to the exchange of the code
+func login(t *testing.T, server *oidctest.Server, p *provider, identity oidctest.Identity) (*IDToken, error) {
+	ctx := context.Background()
+	authURL, err := p.AuthCodeURL(ctx, "state", testNonce, CodeChallenge(testVerifier))
+	require.NoError(t, err)
+	code, state, err := server.Authorize(authURL, identity)
+	require.NoError(t, err)
+	require.Equal(t, "state", state)
+	return p.Exchange(ctx, code, testVerifier, testNonce)
+}
+
+func TestNewProvider(t *testing.T) {
+	origin := env.GetEnv()
+	require.NoError(t, env.ReadEnv(func(appEnv *env.AppEnv) {
+		appEnv.Oidc.Providers = map[string]env.OidcProvider{"google": {Issuer: "https://accounts.google.com"}}
+	}))
+	t.Cleanup(func() {
+		_ = env.ReadEnv(func(appEnv *env.AppEnv) { *appEnv = origin })
+	})
+
+	_, err := NewProvider("google")
+	require.NoError(t, err)
+	_, err = NewProvider("unknown")
+	require.ErrorIs(t, err, ErrUnknownProvider)
+}
+
+func TestAuthCodeURL(t *testing.T) {
+	t.Parallel()
+	server, p := newTestProvider(t, "secret")
+	p.config.Scopes = []string{"profile", "openid"}
+
+	authURL, err := p.AuthCodeURL(context.Background(), "state", testNonce, "challenge")
+	require.NoError(t, err)
+
+	u, err := url.Parse(authURL)
+	require.NoError(t, err)
+	require.Equal(t, server.URL+"/authorize", u.Scheme+"://"+u.Host+u.Path)
+	query := u.Query()
+	require.Equal(t, "code", query.Get("response_type"))
+	require.Equal(t, "mysite", query.Get("client_id"))
+	require.Equal(t, testRedirectURL, query.Get("redirect_uri"))
+	require.Equal(t, "openid email profile", query.Get("scope"))
+	require.Equal(t, "state", query.Get("state"))
+	require.Equal(t, testNonce, query.Get("nonce"))
+	require.Equal(t, "challenge", query.Get("code_challenge"))
+	require.Equal(t, "S256", query.Get("code_challenge_method"))
+}
+
+func TestExchange(t *testing.T) {
+	t.Parallel()
+	identity := oidctest.Identity{Subject: "subject", Email: "user@example.com", EmailVerified: true}
+
+	t.Run("confidential client", func(t *testing.T) {
+		t.Parallel()
+		server, p := newTestProvider(t, "se:cret")
+		token, err := login(t, server, p, identity)
+		require.NoError(t, err)
+		require.Equal(t, &IDToken{Issuer: server.URL, Subject: "subject", Email: "user@example.com", EmailVerified: true}, token)
+	})
+
+	t.Run("public client", func(t *testing.T) {
+		t.Parallel()
+		server, p := newTestProvider(t, "")
+		token, err := login(t, server, p, identity)
+		require.NoError(t, err)
+		require.Equal(t, "subject", token.Subject)
+	})
+
+	t.Run("rotated key is fetched again", func(t *testing.T) {
+		t.Parallel()
+		server, p := newTestProvider(t, "secret")
+		_, err := login(t, server, p, identity)
+		require.NoError(t, err)
+
+		require.NoError(t, server.RotateKey())
+		_, err = login(t, server, p, identity)
+		require.NoError(t, err)
+	})
+
+	t.Run("wrong code verifier", func(t *testing.T) {
+		t.Parallel()
+		server, p := newTestProvider(t, "secret")
+		ctx := context.Background()
+		authURL, err := p.AuthCodeURL(ctx, "state", testNonce, CodeChallenge(testVerifier))
+		require.NoError(t, err)
+		code, _, err := server.Authorize(authURL, identity)
+		require.NoError(t, err)
+
+		_, err = p.Exchange(ctx, code, "another-verifier", testNonce)
+		require.ErrorContains(t, err, "invalid_grant")
+	})
+
+	t.Run("code is single use", func(t *testing.T) {
+		t.Parallel()
+		server, p := newTestProvider(t, "secret")
+		ctx := context.Background()
+		authURL, err := p.AuthCodeURL(ctx, "state", testNonce, CodeChallenge(testVerifier))
+		require.NoError(t, err)
+		code, _, err := server.Authorize(authURL, identity)
+		require.NoError(t, err)
+
+		_, err = p.Exchange(ctx, code, testVerifier, testNonce)
+		require.NoError(t, err)
+		_, err = p.Exchange(ctx, code, testVerifier, testNonce)
+		require.Error(t, err)
+	})
+
+	t.Run("wrong client secret", func(t *testing.T) {
+		t.Parallel()
+		server, p := newTestProvider(t, "secret")
+		p.config.ClientSecret = "another"
+		_, err := login(t, server, p, identity)
+		require.ErrorContains(t, err, "invalid_client")
+	})
+
+	t.Run("wrong nonce", func(t *testing.T) {
+		t.Parallel()
+		server, p := newTestProvider(t, "secret")
+		ctx := context.Background()
+		authURL, err := p.AuthCodeURL(ctx, "state", "another-nonce", CodeChallenge(testVerifier))
+		require.NoError(t, err)
+		code, _, err := server.Authorize(authURL, identity)
+		require.NoError(t, err)
+
+		_, err = p.Exchange(ctx, code, testVerifier, testNonce)
+		require.ErrorContains(t, err, "nonce")
+	})
+
+	invalidClaims := []struct {
+		name   string
+		tamper func(claims jwt.MapClaims)
+	}{
+		{name: "another audience", tamper: func(claims jwt.MapClaims) { claims["aud"] = "another" }},
+		{name: "another issuer", tamper: func(claims jwt.MapClaims) { claims["iss"] = "https://issuer.example.com" }},
+		{name: "expired", tamper: func(claims jwt.MapClaims) { claims["exp"] = time.Now().Add(-time.Hour).Unix() }},
+		{name: "without exp", tamper: func(claims jwt.MapClaims) { delete(claims, "exp") }},
+		{name: "issued in the future", tamper: func(claims jwt.MapClaims) { claims["iat"] = time.Now().Add(time.Hour).Unix() }},
+		{name: "without sub", tamper: func(claims jwt.MapClaims) { delete(claims, "sub") }},
+		{name: "azp of another client", tamper: func(claims jwt.MapClaims) {
+			claims["aud"] = []string{"mysite", "another"}
+			claims["azp"] = "another"
+		}},
+	}
+	for _, tt := range invalidClaims {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			server, p := newTestProvider(t, "secret")
+			server.Tamper = tt.tamper
+			_, err := login(t, server, p, identity)
+			require.ErrorContains(t, err, "id_token")
+		})
+	}
+}
+
+func TestExchangeRejectsUnsignedToken(t *testing.T) {
+	t.Parallel()
+	server, p := newTestProvider(t, "secret")
+	ctx := context.Background()
+	discovery, err := p.discover(ctx)
+	require.NoError(t, err)
+
+	claims := jwt.MapClaims{"iss": server.URL, "aud": "mysite", "sub": "subject", "nonce": testNonce, "exp": time.Now().Add(time.Hour).Unix()}
+	{ // alg none
+		raw, err := jwt.NewWithClaims(jwt.SigningMethodNone, claims).SignedString(jwt.UnsafeAllowNoneSignatureType)
+		require.NoError(t, err)
+		_, err = p.verifyIDToken(ctx, *discovery, raw, testNonce)
+		require.Error(t, err)
+	}
+	{ // HS256 with the client secret
+		raw, err := jwt.NewWithClaims(jwt.SigningMethodHS256, claims).SignedString([]byte("secret"))
+		require.NoError(t, err)
+		_, err = p.verifyIDToken(ctx, *discovery, raw, testNonce)
+		require.Error(t, err)
+	}
+}
+
+func TestDiscoverIssuerMismatch(t *testing.T) {
+	t.Parallel()
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		_ = json.NewEncoder(w).Encode(Discovery{
+			Issuer:                "https://issuer.example.com",
+			AuthorizationEndpoint: "https://issuer.example.com/authorize",
+			TokenEndpoint:         "https://issuer.example.com/token",
+			JwksURI:               "https://issuer.example.com/jwks",
+		})
+	}))
+	t.Cleanup(server.Close)
+
+	p := &provider{config: env.OidcProvider{Issuer: server.URL, ClientID: "mysite"}, client: server.Client()}
+	_, err := p.AuthCodeURL(context.Background(), "state", testNonce, "challenge")
+	require.ErrorContains(t, err, "does not match")
+}
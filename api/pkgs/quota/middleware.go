@@ -0,0 +1,48 @@
+package quota
+
+import (
+	"log/slog"
+	"mysite/pkgs/auth"
+	"mysite/pkgs/logger"
+	"mysite/utils/httputil"
+	"net/http"
+
+	"github.com/go-chi/render"
+	"github.com/pkg/errors"
+)
+
+var newCounter = func() Counter {
+	return NewCounter()
+}
+
+// Middleware counts every request of the authenticated user against the quota name of their tier,
+// it rejects with 429 once the quota is exhausted and lets requests through when the counter fails
+func Middleware(name string) func(http.Handler) http.Handler {
+	return func(next http.Handler) http.Handler {
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			principal, found := auth.PrincipalFromContext(r.Context())
+			if !found {
+				next.ServeHTTP(w, r)
+				return
+			}
+
+			limit, found := LimitOf(principal.Membership, name)
+			if !found {
+				next.ServeHTTP(w, r)
+				return
+			}
+
+			if _, err := newCounter().Consume(r.Context(), principal.UserID, limit, 1); err != nil {
+				if errors.Is(err, httputil.ErrQuotaExceeded) {
+					if err := render.Render(w, r, httputil.NewFailureRender(err)); err != nil {
+						slog.Error("failed to render", logger.AttrError(err))
+					}
+					return
+				}
+				slog.Error("failed quota", logger.AttrError(err))
+			}
+
+			next.ServeHTTP(w, r)
+		})
+	}
+}
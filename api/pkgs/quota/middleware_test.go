@@ -0,0 +1,91 @@
+package quota
+
+import (
+	"context"
+	"encoding/json"
+	"mysite/constants"
+	"mysite/dtos"
+	"mysite/pkgs/auth"
+	"mysite/utils/httputil"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/require"
+)
+
+// fakeCounter keeps the count in memory, pkgmock can not be used here since it imports this package
+type fakeCounter struct {
+	used map[int]int
+	err  error
+}
+
+func (f fakeCounter) Consume(ctx context.Context, userId int, limit Limit, amount int) (*Usage, error) {
+	if f.err != nil {
+		return nil, f.err
+	}
+	if f.used[userId]+amount > limit.Limit {
+		return nil, httputil.ErrQuotaExceeded.WithRetryAfter(time.Hour)
+	}
+	f.used[userId] += amount
+	return &Usage{Limit: limit, Used: f.used[userId]}, nil
+}
+
+func (f fakeCounter) Usage(ctx context.Context, userId int, limit Limit) (*Usage, error) {
+	return &Usage{Limit: limit, Used: f.used[userId]}, nil
+}
+
+func TestMiddleware(t *testing.T) {
+	setQuotaEnv(t, true)
+	next := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusOK)
+	})
+	handler := Middleware("requests")(next)
+	newRequest := func(principal *auth.Principal) *http.Request {
+		r := httptest.NewRequest(http.MethodGet, "http://example.com", nil)
+		if principal == nil {
+			return r
+		}
+		return r.WithContext(auth.WithPrincipal(r.Context(), *principal))
+	}
+
+	{ // requests over the quota are rejected
+		counter := fakeCounter{used: map[int]int{}}
+		newCounter = func() Counter { return counter }
+		principal := &auth.Principal{UserID: 1, Membership: "bronze"}
+
+		for i := 0; i < 2; i++ {
+			w := httptest.NewRecorder()
+			handler.ServeHTTP(w, newRequest(principal))
+			require.Equal(t, http.StatusOK, w.Result().StatusCode)
+		}
+
+		w := httptest.NewRecorder()
+		handler.ServeHTTP(w, newRequest(principal))
+		require.Equal(t, http.StatusTooManyRequests, w.Result().StatusCode)
+		require.Equal(t, "3600", w.Header().Get("Retry-After"))
+		var resp dtos.ErrorResponse
+		require.NoError(t, json.NewDecoder(w.Body).Decode(&resp))
+		require.Equal(t, constants.AppCodeQuotaExceeded, *resp.AppCode)
+	}
+	{ // unlimited tier and anonymous requests are not counted
+		counter := fakeCounter{used: map[int]int{}}
+		newCounter = func() Counter { return counter }
+
+		for _, principal := range []*auth.Principal{{UserID: 1, Membership: "platinum"}, nil} {
+			w := httptest.NewRecorder()
+			handler.ServeHTTP(w, newRequest(principal))
+			require.Equal(t, http.StatusOK, w.Result().StatusCode)
+		}
+		require.Empty(t, counter.used)
+	}
+	{ // a failing counter lets requests through
+		newCounter = func() Counter { return fakeCounter{err: errors.New("database unavailable")} }
+
+		w := httptest.NewRecorder()
+		handler.ServeHTTP(w, newRequest(&auth.Principal{UserID: 1, Membership: "bronze"}))
+		require.Equal(t, http.StatusOK, w.Result().StatusCode)
+	}
+}
@@ -0,0 +1,133 @@
+package quota
+
+import (
+	"context"
+	"mysite/pkgs/database"
+	"mysite/pkgs/env"
+	"mysite/repositories/usagerepo"
+	"mysite/utils/httputil"
+	"sort"
+	"time"
+
+	"github.com/pkg/errors"
+	"github.com/volatiletech/sqlboiler/v4/boil"
+)
+
+const (
+	Day   = "day"
+	Month = "month"
+)
+
+// Limit is one quota of a membership tier
+type Limit struct {
+	Name   string
+	Limit  int
+	Period string
+}
+
+// Usage is the consumption of a quota in its current period
+type Usage struct {
+	Limit
+	Used    int
+	ResetAt time.Time
+}
+
+// LimitOf returns the quota name of the membership tier, false when it is unlimited
+func LimitOf(membership string, name string) (Limit, bool) {
+	cfg := env.GetEnv().Quota
+	if !cfg.Enabled {
+		return Limit{}, false
+	}
+	limit, found := cfg.Tiers[membership][name]
+	if !found {
+		return Limit{}, false
+	}
+	return Limit{Name: name, Limit: limit.Limit, Period: limit.Period}, true
+}
+
+// LimitsOf returns every quota of the membership tier sorted by name
+func LimitsOf(membership string) []Limit {
+	cfg := env.GetEnv().Quota
+	if !cfg.Enabled {
+		return nil
+	}
+
+	result := make([]Limit, 0, len(cfg.Tiers[membership]))
+	for name, limit := range cfg.Tiers[membership] {
+		result = append(result, Limit{Name: name, Limit: limit.Limit, Period: limit.Period})
+	}
+	sort.Slice(result, func(i, j int) bool { return result[i].Name < result[j].Name })
+	return result
+}
+
+// PeriodStart returns the start of the UTC day or month now is in
+func (l Limit) PeriodStart(now time.Time) time.Time {
+	now = now.UTC()
+	if l.Period == Month {
+		return time.Date(now.Year(), now.Month(), 1, 0, 0, 0, 0, time.UTC)
+	}
+	return time.Date(now.Year(), now.Month(), now.Day(), 0, 0, 0, 0, time.UTC)
+}
+
+// ResetAt returns when the count starts over
+func (l Limit) ResetAt(now time.Time) time.Time {
+	if l.Period == Month {
+		return l.PeriodStart(now).AddDate(0, 1, 0)
+	}
+	return l.PeriodStart(now).AddDate(0, 0, 1)
+}
+
+//go:generate moq -pkg pkgmock -out ../../testing/mocking/pkgmock/quota.mock.go . Counter
+type Counter interface {
+	Consume(ctx context.Context, userId int, limit Limit, amount int) (*Usage, error)
+	Usage(ctx context.Context, userId int, limit Limit) (*Usage, error)
+}
+
+type counter struct {
+	repo usagerepo.UsageRepo
+	now  func() time.Time
+}
+
+func NewCounter() Counter {
+	return counter{
+		repo: usagerepo.NewRepo(),
+		now:  time.Now,
+	}
+}
+
+// Consume counts amount against the quota, nothing is counted when it would go over the limit
+func (c counter) Consume(ctx context.Context, userId int, limit Limit, amount int) (*Usage, error) {
+	now := c.now()
+	usage := Usage{Limit: limit, ResetAt: limit.ResetAt(now)}
+
+	if err := database.NewBoilerTransaction(ctx, func(ctx context.Context, tx boil.ContextTransactor) error {
+		var err error
+		if usage.Used, err = c.repo.Increment(ctx, tx, userId, limit.Name, limit.PeriodStart(now), amount); err != nil {
+			return errors.Wrap(err, "failed increment usage")
+		}
+		// rolls the increment back
+		if usage.Used > limit.Limit {
+			return errors.Wrapf(httputil.ErrQuotaExceeded.WithRetryAfter(usage.ResetAt.Sub(now)), "quota %s of %d exhausted", limit.Name, limit.Limit)
+		}
+		return nil
+	}); err != nil {
+		return nil, err
+	}
+
+	return &usage, nil
+}
+
+func (c counter) Usage(ctx context.Context, userId int, limit Limit) (*Usage, error) {
+	now := c.now()
+	usage := Usage{Limit: limit, ResetAt: limit.ResetAt(now)}
+
+	if err := database.NewBoilerTransaction(ctx, func(ctx context.Context, tx boil.ContextTransactor) error {
+		var err error
+		usage.Used, err = c.repo.GetUsage(ctx, tx, userId, limit.Name, limit.PeriodStart(now))
+		return err
+	}); err != nil {
+		return nil, errors.Wrap(err, "failed get usage")
+	}
+
+	return &usage, nil
+}
@@ -0,0 +1,59 @@
+package quota
+
+import (
+	"mysite/pkgs/env"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+func setQuotaEnv(t *testing.T, enabled bool) {
+	origin := env.GetEnv()
+	require.NoError(t, env.ReadEnv(func(appEnv *env.AppEnv) {
+		appEnv.Quota.Enabled = enabled
+		appEnv.Quota.Tiers = map[string]map[string]env.QuotaLimit{
+			"bronze": {
+				"requests": {Limit: 2, Period: Day},
+				"items":    {Limit: 10, Period: Month},
+			},
+		}
+	}))
+	t.Cleanup(func() {
+		_ = env.ReadEnv(func(appEnv *env.AppEnv) { *appEnv = origin })
+	})
+}
+
+func TestLimitOf(t *testing.T) {
+	setQuotaEnv(t, true)
+
+	limit, found := LimitOf("bronze", "requests")
+	require.True(t, found)
+	require.Equal(t, Limit{Name: "requests", Limit: 2, Period: Day}, limit)
+
+	_, found = LimitOf("platinum", "requests")
+	require.False(t, found)
+
+	limits := LimitsOf("bronze")
+	require.Len(t, limits, 2)
+	require.Equal(t, "items", limits[0].Name)
+	require.Equal(t, "requests", limits[1].Name)
+	require.Empty(t, LimitsOf("platinum"))
+
+	setQuotaEnv(t, false)
+	_, found = LimitOf("bronze", "requests")
+	require.False(t, found)
+	require.Empty(t, LimitsOf("bronze"))
+}
+
+func TestPeriod(t *testing.T) {
+	now := time.Date(2024, 2, 29, 23, 30, 0, 0, time.FixedZone("JST", 9*60*60))
+
+	day := Limit{Period: Day}
+	require.Equal(t, time.Date(2024, 2, 29, 0, 0, 0, 0, time.UTC), day.PeriodStart(now))
+	require.Equal(t, time.Date(2024, 3, 1, 0, 0, 0, 0, time.UTC), day.ResetAt(now))
+
+	month := Limit{Period: Month}
+	require.Equal(t, time.Date(2024, 2, 1, 0, 0, 0, 0, time.UTC), month.PeriodStart(now))
+	require.Equal(t, time.Date(2024, 3, 1, 0, 0, 0, 0, time.UTC), month.ResetAt(now))
+}
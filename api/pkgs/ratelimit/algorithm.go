@@ -0,0 +1,123 @@
+package ratelimit
+
+import (
+	"math"
+	"time"
+
+	"github.com/pkg/errors"
+)
+
+const (
+	TokenBucket   = "token-bucket"
+	SlidingWindow = "sliding-window"
+)
+
+// Result is the decision for one request
+type Result struct {
+	Allowed   bool
+	Limit     int
+	Remaining int
+
+	// ResetAfter until the quota is fully available again
+	ResetAfter time.Duration
+
+	// RetryAfter until the next request is allowed, only set when denied
+	RetryAfter time.Duration
+}
+
+type algorithm interface {
+	take(state *State, now time.Time) Result
+	// ttl how long the state matters once the key stops sending requests
+	ttl() time.Duration
+}
+
+func newAlgorithm(name string, limit int, window time.Duration) (algorithm, error) {
+	if limit <= 0 || window <= 0 {
+		return nil, errors.Errorf("invalid limit %d per %s", limit, window)
+	}
+
+	switch name {
+	case TokenBucket:
+		return tokenBucket{capacity: limit, window: window}, nil
+	case SlidingWindow:
+		return slidingWindow{limit: limit, window: window}, nil
+	default:
+		return nil, errors.Errorf("unsupported algorithm %s", name)
+	}
+}
+
+// tokenBucket allows bursts up to capacity, the bucket refills evenly over the window
+type tokenBucket struct {
+	capacity int
+	window   time.Duration
+}
+
+func (b tokenBucket) take(state *State, now time.Time) Result {
+	rate := float64(b.capacity) / b.window.Seconds() // tokens per second
+
+	if state.UpdatedAt.IsZero() {
+		state.Tokens = float64(b.capacity)
+	} else if elapsed := now.Sub(state.UpdatedAt); elapsed > 0 {
+		state.Tokens = math.Min(float64(b.capacity), state.Tokens+elapsed.Seconds()*rate)
+	}
+	state.UpdatedAt = now
+
+	result := Result{Limit: b.capacity}
+	if state.Tokens >= 1 {
+		state.Tokens--
+		result.Allowed = true
+	} else {
+		result.RetryAfter = seconds((1 - state.Tokens) / rate)
+	}
+	result.Remaining = int(state.Tokens)
+	result.ResetAfter = seconds((float64(b.capacity) - state.Tokens) / rate)
+	return result
+}
+
+func (b tokenBucket) ttl() time.Duration {
+	return b.window
+}
+
+// slidingWindow counts requests of the current fixed window plus the previous one weighted by its overlap
+type slidingWindow struct {
+	limit  int
+	window time.Duration
+}
+
+func (s slidingWindow) take(state *State, now time.Time) Result {
+	windowStart := now.Truncate(s.window)
+	switch {
+	case state.WindowStart.Equal(windowStart):
+	case state.WindowStart.Equal(windowStart.Add(-s.window)):
+		state.Previous, state.Current = state.Current, 0
+	default:
+		state.Previous, state.Current = 0, 0
+	}
+	state.WindowStart = windowStart
+
+	elapsed := now.Sub(windowStart)
+	weight := 1 - float64(elapsed)/float64(s.window)
+	count := int(math.Ceil(float64(state.Previous)*weight)) + state.Current
+
+	result := Result{
+		Limit:      s.limit,
+		ResetAfter: s.window - elapsed,
+	}
+	if count < s.limit {
+		state.Current++
+		count++
+		result.Allowed = true
+	} else {
+		result.RetryAfter = result.ResetAfter
+	}
+	result.Remaining = max(s.limit-count, 0)
+	return result
+}
+
+func (s slidingWindow) ttl() time.Duration {
+	return 2 * s.window
+}
+
+func seconds(s float64) time.Duration {
+	return time.Duration(s * float64(time.Second))
+}
@@ -0,0 +1,104 @@
+package ratelimit
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestNewAlgorithm(t *testing.T) {
+	{ // supported algorithms
+		_, err := newAlgorithm(TokenBucket, 10, time.Minute)
+		require.NoError(t, err)
+		_, err = newAlgorithm(SlidingWindow, 10, time.Minute)
+		require.NoError(t, err)
+	}
+	{ // unsupported algorithm
+		_, err := newAlgorithm("fixed-window", 10, time.Minute)
+		require.Error(t, err)
+	}
+	{ // invalid limit
+		_, err := newAlgorithm(TokenBucket, 0, time.Minute)
+		require.Error(t, err)
+		_, err = newAlgorithm(SlidingWindow, 10, 0)
+		require.Error(t, err)
+	}
+}
+
+func TestTokenBucket(t *testing.T) {
+	bucket := tokenBucket{capacity: 3, window: 3 * time.Second}
+	now := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+
+	{ // burst up to capacity, then denied until a token is refilled
+		var state State
+		for i := 2; i >= 0; i-- {
+			result := bucket.take(&state, now)
+			require.True(t, result.Allowed)
+			require.Equal(t, 3, result.Limit)
+			require.Equal(t, i, result.Remaining)
+		}
+
+		result := bucket.take(&state, now)
+		require.False(t, result.Allowed)
+		require.Equal(t, 0, result.Remaining)
+		require.Equal(t, time.Second, result.RetryAfter)
+		require.Equal(t, 3*time.Second, result.ResetAfter)
+
+		result = bucket.take(&state, now.Add(time.Second))
+		require.True(t, result.Allowed)
+	}
+	{ // bucket never holds more than its capacity
+		var state State
+		bucket.take(&state, now)
+		result := bucket.take(&state, now.Add(time.Hour))
+		require.True(t, result.Allowed)
+		require.Equal(t, 2, result.Remaining)
+	}
+}
+
+func TestSlidingWindow(t *testing.T) {
+	window := slidingWindow{limit: 4, window: time.Minute}
+	start := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+
+	{ // limit within one window
+		var state State
+		for i := 3; i >= 0; i-- {
+			result := window.take(&state, start.Add(10*time.Second))
+			require.True(t, result.Allowed)
+			require.Equal(t, i, result.Remaining)
+		}
+
+		result := window.take(&state, start.Add(10*time.Second))
+		require.False(t, result.Allowed)
+		require.Equal(t, 50*time.Second, result.RetryAfter)
+	}
+	{ // previous window is weighted by its overlap
+		var state State
+		for i := 0; i < 4; i++ {
+			window.take(&state, start.Add(50*time.Second))
+		}
+
+		// a quarter into the next window, 3 of the previous 4 still count
+		result := window.take(&state, start.Add(75*time.Second))
+		require.True(t, result.Allowed)
+		require.Equal(t, 0, result.Remaining)
+
+		result = window.take(&state, start.Add(75*time.Second))
+		require.False(t, result.Allowed)
+
+		// half into the next window only 2 count
+		result = window.take(&state, start.Add(90*time.Second))
+		require.True(t, result.Allowed)
+	}
+	{ // windows older than the previous one are forgotten
+		var state State
+		for i := 0; i < 4; i++ {
+			window.take(&state, start)
+		}
+
+		result := window.take(&state, start.Add(3*time.Minute))
+		require.True(t, result.Allowed)
+		require.Equal(t, 3, result.Remaining)
+	}
+}
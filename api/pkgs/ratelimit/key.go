@@ -0,0 +1,32 @@
+package ratelimit
+
+import (
+	"mysite/pkgs/auth"
+	"mysite/utils/httputil"
+	"net/http"
+	"strconv"
+)
+
+// KeyFunc tells which client a request counts for, an empty key skips the limit
+type KeyFunc func(r *http.Request) string
+
+// KeyByIp counts requests per client ip
+func KeyByIp(r *http.Request) string {
+	return "ip:" + httputil.ClientIp(r)
+}
+
+// KeyByUser counts requests per authenticated user, anonymous requests fall back to the client ip
+func KeyByUser(r *http.Request) string {
+	principal, found := auth.PrincipalFromContext(r.Context())
+	if !found {
+		return KeyByIp(r)
+	}
+	return "user:" + strconv.Itoa(principal.UserID)
+}
+
+func keyFuncByName(name string) KeyFunc {
+	if name == "user" {
+		return KeyByUser
+	}
+	return KeyByIp
+}
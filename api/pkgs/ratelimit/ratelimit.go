@@ -0,0 +1,122 @@
+package ratelimit
+
+import (
+	"fmt"
+	"log/slog"
+	"math"
+	"mysite/pkgs/env"
+	"mysite/pkgs/logger"
+	"mysite/utils/httputil"
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/go-chi/render"
+	"github.com/pkg/errors"
+)
+
+type limiter struct {
+	name      string
+	algorithm algorithm
+	window    time.Duration
+	store     Store
+	keyFunc   KeyFunc
+	now       func() time.Time
+}
+
+type Option func(l *limiter)
+
+// WithStore replaces the in-memory store shared by the process
+func WithStore(store Store) Option {
+	return func(l *limiter) {
+		l.store = store
+	}
+}
+
+// WithKeyFunc replaces the key configured by the rule
+func WithKeyFunc(keyFunc KeyFunc) Option {
+	return func(l *limiter) {
+		l.keyFunc = keyFunc
+	}
+}
+
+// New limits requests by rule, name keeps the counts of limiters sharing a store apart
+func New(name string, rule env.RateLimitRule, opts ...Option) (*limiter, error) {
+	window := time.Duration(rule.WindowSeconds) * time.Second
+	algorithm, err := newAlgorithm(rule.Algorithm, rule.Limit, window)
+	if err != nil {
+		return nil, errors.Wrapf(err, "invalid rate limit rule %s", name)
+	}
+
+	l := &limiter{
+		name:      name,
+		algorithm: algorithm,
+		window:    window,
+		store:     defaultStore,
+		keyFunc:   keyFuncByName(rule.Key),
+		now:       time.Now,
+	}
+	for _, opt := range opts {
+		opt(l)
+	}
+	return l, nil
+}
+
+// Middleware limits the route group with the rule configured for it, requests pass through when there is none
+func Middleware(group string, opts ...Option) func(http.Handler) http.Handler {
+	cfg := env.GetEnv().RateLimit
+	rule, found := cfg.Groups[group]
+	if !cfg.Enabled || !found {
+		return passThrough
+	}
+
+	l, err := New(group, rule, opts...)
+	if err != nil {
+		slog.Error("rate limit disabled", slog.String("group", group), logger.AttrError(err))
+		return passThrough
+	}
+	return l.Handler
+}
+
+func passThrough(next http.Handler) http.Handler {
+	return next
+}
+
+// Handler rejects the request with 429 once the key went over the limit, a failing store lets requests through
+func (l *limiter) Handler(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		key := l.keyFunc(r)
+		if key == "" {
+			next.ServeHTTP(w, r)
+			return
+		}
+
+		var result Result
+		if err := l.store.Update(r.Context(), l.name+":"+key, l.algorithm.ttl(), func(state *State) {
+			result = l.algorithm.take(state, l.now())
+		}); err != nil {
+			slog.Error("failed rate limit", logger.AttrError(err))
+			next.ServeHTTP(w, r)
+			return
+		}
+
+		l.setHeaders(w, result)
+		if !result.Allowed {
+			err := httputil.ErrRateLimited.WithRetryAfter(result.RetryAfter)
+			if err := render.Render(w, r, httputil.NewFailureRender(errors.Wrapf(err, "rate limit of %s exceeded", l.name))); err != nil {
+				slog.Error("failed to render", logger.AttrError(err))
+			}
+			return
+		}
+
+		next.ServeHTTP(w, r)
+	})
+}
+
+// setHeaders writes the RateLimit header fields of the IETF httpapi draft
+func (l *limiter) setHeaders(w http.ResponseWriter, result Result) {
+	w.Header().Set("RateLimit-Limit", strconv.Itoa(result.Limit))
+	w.Header().Set("RateLimit-Remaining", strconv.Itoa(result.Remaining))
+	w.Header().Set("RateLimit-Reset", strconv.Itoa(int(math.Ceil(result.ResetAfter.Seconds()))))
+	w.Header().Set("RateLimit-Policy", fmt.Sprintf("%d;w=%d", result.Limit, int(l.window.Seconds())))
+}
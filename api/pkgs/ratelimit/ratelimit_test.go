@@ -0,0 +1,134 @@
+package ratelimit
+
+import (
+	"context"
+	"mysite/pkgs/auth"
+	"mysite/pkgs/env"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/require"
+)
+
+type failingStore struct{}
+
+func (failingStore) Update(ctx context.Context, key string, ttl time.Duration, fn func(state *State)) error {
+	return errors.New("store unavailable")
+}
+
+func TestHandler(t *testing.T) {
+	next := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusOK)
+	})
+	rule := env.RateLimitRule{Algorithm: SlidingWindow, Limit: 2, WindowSeconds: 60, Key: "ip"}
+	newRequest := func(remoteAddr string) *http.Request {
+		r := httptest.NewRequest(http.MethodPost, "http://example.com", nil)
+		r.RemoteAddr = remoteAddr
+		return r
+	}
+
+	{ // headers are set, over the limit is rejected
+		l, err := New("test", rule, WithStore(NewMemoryStore()))
+		require.NoError(t, err)
+		handler := l.Handler(next)
+
+		w := httptest.NewRecorder()
+		handler.ServeHTTP(w, newRequest("192.0.2.1:1234"))
+		require.Equal(t, http.StatusOK, w.Result().StatusCode)
+		require.Equal(t, "2", w.Header().Get("RateLimit-Limit"))
+		require.Equal(t, "1", w.Header().Get("RateLimit-Remaining"))
+		require.NotEmpty(t, w.Header().Get("RateLimit-Reset"))
+		require.Equal(t, "2;w=60", w.Header().Get("RateLimit-Policy"))
+
+		handler.ServeHTTP(httptest.NewRecorder(), newRequest("192.0.2.1:1234"))
+
+		w = httptest.NewRecorder()
+		handler.ServeHTTP(w, newRequest("192.0.2.1:5678"))
+		require.Equal(t, http.StatusTooManyRequests, w.Result().StatusCode)
+		require.Equal(t, "0", w.Header().Get("RateLimit-Remaining"))
+		require.NotEmpty(t, w.Header().Get("Retry-After"))
+
+		// another client has its own count
+		w = httptest.NewRecorder()
+		handler.ServeHTTP(w, newRequest("192.0.2.2:1234"))
+		require.Equal(t, http.StatusOK, w.Result().StatusCode)
+	}
+	{ // user key
+		userRule := rule
+		userRule.Key = "user"
+		l, err := New("test", userRule, WithStore(NewMemoryStore()))
+		require.NoError(t, err)
+		handler := l.Handler(next)
+
+		for i := 0; i < 2; i++ {
+			r := newRequest("192.0.2.1:1234")
+			handler.ServeHTTP(httptest.NewRecorder(), r.WithContext(auth.WithPrincipal(r.Context(), auth.Principal{UserID: 1})))
+		}
+
+		// same ip, another user
+		r := newRequest("192.0.2.1:1234")
+		w := httptest.NewRecorder()
+		handler.ServeHTTP(w, r.WithContext(auth.WithPrincipal(r.Context(), auth.Principal{UserID: 2})))
+		require.Equal(t, http.StatusOK, w.Result().StatusCode)
+	}
+	{ // custom key, empty key is not limited
+		l, err := New("test", rule, WithStore(NewMemoryStore()), WithKeyFunc(func(r *http.Request) string {
+			return r.Header.Get("X-Tenant")
+		}))
+		require.NoError(t, err)
+		handler := l.Handler(next)
+
+		for i := 0; i < 3; i++ {
+			w := httptest.NewRecorder()
+			handler.ServeHTTP(w, newRequest("192.0.2.1:1234"))
+			require.Equal(t, http.StatusOK, w.Result().StatusCode)
+			require.Empty(t, w.Header().Get("RateLimit-Limit"))
+		}
+	}
+	{ // failing store lets requests through
+		l, err := New("test", rule, WithStore(failingStore{}))
+		require.NoError(t, err)
+
+		w := httptest.NewRecorder()
+		l.Handler(next).ServeHTTP(w, newRequest("192.0.2.1:1234"))
+		require.Equal(t, http.StatusOK, w.Result().StatusCode)
+	}
+	{ // invalid rule
+		_, err := New("test", env.RateLimitRule{Algorithm: "unknown", Limit: 1, WindowSeconds: 1})
+		require.Error(t, err)
+	}
+}
+
+func TestMiddleware(t *testing.T) {
+	next := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusOK)
+	})
+
+	{ // group without rule is not limited
+		require.NoError(t, env.ReadEnv(func(appEnv *env.AppEnv) {
+			appEnv.RateLimit.Enabled = true
+			appEnv.RateLimit.Groups = nil
+		}))
+
+		w := httptest.NewRecorder()
+		Middleware("auth")(next).ServeHTTP(w, httptest.NewRequest(http.MethodPost, "http://example.com", nil))
+		require.Equal(t, http.StatusOK, w.Result().StatusCode)
+		require.Empty(t, w.Header().Get("RateLimit-Limit"))
+	}
+	{ // group with rule
+		require.NoError(t, env.ReadEnv(func(appEnv *env.AppEnv) {
+			appEnv.RateLimit.Enabled = true
+			appEnv.RateLimit.Groups = map[string]env.RateLimitRule{
+				"auth": {Algorithm: TokenBucket, Limit: 5, WindowSeconds: 60, Key: "ip"},
+			}
+		}))
+
+		w := httptest.NewRecorder()
+		Middleware("auth", WithStore(NewMemoryStore()))(next).ServeHTTP(w, httptest.NewRequest(http.MethodPost, "http://example.com", nil))
+		require.Equal(t, http.StatusOK, w.Result().StatusCode)
+		require.Equal(t, "5", w.Header().Get("RateLimit-Limit"))
+	}
+}
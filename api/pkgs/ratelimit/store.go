@@ -0,0 +1,78 @@
+package ratelimit
+
+import (
+	"context"
+	"sync"
+	"time"
+)
+
+// State is what an algorithm keeps per key, each algorithm uses its own fields
+type State struct {
+	// Tokens left in the bucket at UpdatedAt, token bucket only
+	Tokens    float64
+	UpdatedAt time.Time
+
+	// Previous and Current request counts of the windows, sliding window only
+	WindowStart time.Time
+	Previous    int
+	Current     int
+}
+
+// Store keeps the State of every key, a shared backend lets several instances enforce the same limit
+type Store interface {
+	// Update runs fn on the state of the key atomically, the state is dropped after ttl without update
+	Update(ctx context.Context, key string, ttl time.Duration, fn func(state *State)) error
+}
+
+type memoryEntry struct {
+	state     State
+	expiresAt time.Time
+}
+
+type memoryStore struct {
+	mu         sync.Mutex
+	entries    map[string]memoryEntry
+	prunedAt   time.Time
+	pruneEvery time.Duration
+}
+
+// defaultStore is shared by every limiter of the process
+var defaultStore = NewMemoryStore()
+
+func NewMemoryStore() Store {
+	return &memoryStore{
+		entries:    make(map[string]memoryEntry),
+		pruneEvery: time.Minute,
+	}
+}
+
+func (m *memoryStore) Update(ctx context.Context, key string, ttl time.Duration, fn func(state *State)) error {
+	now := time.Now()
+
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	if now.Sub(m.prunedAt) >= m.pruneEvery {
+		m.prune(now)
+	}
+
+	entry, found := m.entries[key]
+	if !found || !now.Before(entry.expiresAt) {
+		entry = memoryEntry{}
+	}
+
+	fn(&entry.state)
+	entry.expiresAt = now.Add(ttl)
+	m.entries[key] = entry
+	return nil
+}
+
+// prune drops keys which already expired, caller must hold the lock
+func (m *memoryStore) prune(now time.Time) {
+	for key, entry := range m.entries {
+		if !now.Before(entry.expiresAt) {
+			delete(m.entries, key)
+		}
+	}
+	m.prunedAt = now
+}
@@ -0,0 +1,36 @@
+package ratelimit
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestMemoryStore(t *testing.T) {
+	ctx := context.Background()
+
+	{ // state is kept per key
+		store := NewMemoryStore()
+		require.NoError(t, store.Update(ctx, "key", time.Hour, func(state *State) { state.Current++ }))
+		require.NoError(t, store.Update(ctx, "key", time.Hour, func(state *State) { state.Current++ }))
+
+		var current, other int
+		require.NoError(t, store.Update(ctx, "key", time.Hour, func(state *State) { current = state.Current }))
+		require.NoError(t, store.Update(ctx, "other-key", time.Hour, func(state *State) { other = state.Current }))
+		require.Equal(t, 2, current)
+		require.Equal(t, 0, other)
+	}
+	{ // expired state starts over and is pruned
+		store := NewMemoryStore()
+		require.NoError(t, store.Update(ctx, "key", -time.Second, func(state *State) { state.Current++ }))
+
+		var current int
+		require.NoError(t, store.Update(ctx, "key", time.Hour, func(state *State) { current = state.Current }))
+		require.Equal(t, 0, current)
+
+		store.(*memoryStore).prune(time.Now().Add(2 * time.Hour))
+		require.Empty(t, store.(*memoryStore).entries)
+	}
+}
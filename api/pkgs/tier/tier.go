@@ -0,0 +1,80 @@
+package tier
+
+import (
+	"context"
+	"mysite/constants"
+	"mysite/entities"
+	"mysite/repositories/membershiprepo"
+	"mysite/repositories/userinforepo"
+
+	"github.com/pkg/errors"
+	"github.com/volatiletech/null/v8"
+	"github.com/volatiletech/sqlboiler/v4/boil"
+)
+
+//go:generate moq -pkg pkgmock -out ../../testing/mocking/pkgmock/tier.mock.go . Changer
+type Changer interface {
+	Change(ctx context.Context, tx boil.ContextTransactor, pgUserAccount *entities.UserAccount, membership entities.Membership, change Change) (bool, error)
+}
+
+// Change tells who moved the user and why, ChangedBy is null when the system did
+type Change struct {
+	ChangedBy null.Int
+	Reason    null.String
+}
+
+type changer struct {
+	repo           userinforepo.UserInfoRepo
+	membershipRepo membershiprepo.MembershipRepo
+}
+
+func NewChanger() Changer {
+	return changer{
+		repo:           userinforepo.NewRepo(),
+		membershipRepo: membershiprepo.NewRepo(),
+	}
+}
+
+// CurrentMembershipId returns the tier of a userAccount loaded with its userInfo, bronze when it has none yet
+func CurrentMembershipId(pgUserAccount *entities.UserAccount) int {
+	if pgUserAccount.R != nil && len(pgUserAccount.R.UserInfos) > 0 && pgUserAccount.R.UserInfos[0].MembershipID.Valid {
+		return pgUserAccount.R.UserInfos[0].MembershipID.Int
+	}
+	return constants.Bronze
+}
+
+// Change moves a userAccount loaded with its userInfo to membership and records it in the history, false when it already has the tier
+func (c changer) Change(ctx context.Context, tx boil.ContextTransactor, pgUserAccount *entities.UserAccount, membership entities.Membership, change Change) (bool, error) {
+	var from null.Int
+	if pgUserAccount.R == nil || len(pgUserAccount.R.UserInfos) == 0 {
+		userInfo := entities.UserInfo{
+			UserAccountID: pgUserAccount.ID,
+			MembershipID:  null.IntFrom(membership.ID),
+		}
+		if err := c.repo.Insert(ctx, tx, &userInfo); err != nil {
+			return false, errors.Wrap(err, "failed insert userInfo")
+		}
+	} else {
+		userInfo := *pgUserAccount.R.UserInfos[0]
+		if userInfo.MembershipID.Valid && userInfo.MembershipID.Int == membership.ID {
+			return false, nil
+		}
+		from = userInfo.MembershipID
+		userInfo.MembershipID = null.IntFrom(membership.ID)
+		if err := c.repo.UpdateMembership(ctx, tx, userInfo); err != nil {
+			return false, errors.Wrap(err, "failed update userInfo membership")
+		}
+	}
+
+	if err := c.membershipRepo.InsertHistory(ctx, tx, &entities.MembershipHistory{
+		UserAccountID:    pgUserAccount.ID,
+		FromMembershipID: from,
+		ToMembershipID:   membership.ID,
+		ChangedBy:        change.ChangedBy,
+		Reason:           change.Reason,
+	}); err != nil {
+		return false, errors.Wrap(err, "failed insert membership history")
+	}
+
+	return true, nil
+}
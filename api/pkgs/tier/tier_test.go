@@ -0,0 +1,87 @@
+package tier
+
+import (
+	"context"
+	"mysite/constants"
+	"mysite/entities"
+	"mysite/testing/mocking/repomock"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"github.com/volatiletech/null/v8"
+	"github.com/volatiletech/sqlboiler/v4/boil"
+)
+
+func newUserAccount(userInfos ...*entities.UserInfo) *entities.UserAccount {
+	pgUserAccount := &entities.UserAccount{ID: 2}
+	pgUserAccount.R = pgUserAccount.R.NewStruct()
+	pgUserAccount.R.UserInfos = userInfos
+	return pgUserAccount
+}
+
+func newChanger() (changer, *repomock.UserInfoRepoMock, *repomock.MembershipRepoMock) {
+	repoMock := &repomock.UserInfoRepoMock{
+		InsertFunc: func(ctx context.Context, tx boil.ContextTransactor, userInfo *entities.UserInfo) error {
+			return nil
+		},
+		UpdateMembershipFunc: func(ctx context.Context, tx boil.ContextTransactor, userInfo entities.UserInfo) error {
+			return nil
+		},
+	}
+	membershipMock := &repomock.MembershipRepoMock{
+		InsertHistoryFunc: func(ctx context.Context, tx boil.ContextTransactor, history *entities.MembershipHistory) error {
+			return nil
+		},
+	}
+	return changer{repo: repoMock, membershipRepo: membershipMock}, repoMock, membershipMock
+}
+
+func TestCurrentMembershipId(t *testing.T) {
+	require.Equal(t, constants.Gold, CurrentMembershipId(newUserAccount(&entities.UserInfo{MembershipID: null.IntFrom(constants.Gold)})))
+	require.Equal(t, constants.Bronze, CurrentMembershipId(newUserAccount(&entities.UserInfo{})))
+	require.Equal(t, constants.Bronze, CurrentMembershipId(newUserAccount()))
+}
+
+func TestChange(t *testing.T) {
+	ctx := context.Background()
+	gold := entities.Membership{ID: constants.Gold, Name: "gold", Rank: constants.Gold}
+	change := Change{ChangedBy: null.IntFrom(1), Reason: null.StringFrom("promotion")}
+
+	{ // change success
+		c, repoMock, membershipMock := newChanger()
+
+		moved, err := c.Change(ctx, nil, newUserAccount(&entities.UserInfo{ID: 3, MembershipID: null.IntFrom(constants.Bronze)}), gold, change)
+		require.NoError(t, err)
+		require.True(t, moved)
+		require.Len(t, repoMock.UpdateMembershipCalls(), 1)
+		require.Equal(t, constants.Gold, repoMock.UpdateMembershipCalls()[0].UserInfo.MembershipID.Int)
+		require.Len(t, membershipMock.InsertHistoryCalls(), 1)
+		history := membershipMock.InsertHistoryCalls()[0].History
+		require.Equal(t, 2, history.UserAccountID)
+		require.Equal(t, null.IntFrom(constants.Bronze), history.FromMembershipID)
+		require.Equal(t, constants.Gold, history.ToMembershipID)
+		require.Equal(t, null.IntFrom(1), history.ChangedBy)
+		require.Equal(t, null.StringFrom("promotion"), history.Reason)
+	}
+	{ // change success, without userInfo
+		c, repoMock, membershipMock := newChanger()
+
+		moved, err := c.Change(ctx, nil, newUserAccount(), gold, Change{})
+		require.NoError(t, err)
+		require.True(t, moved)
+		require.Len(t, repoMock.InsertCalls(), 1)
+		require.Equal(t, null.IntFrom(constants.Gold), repoMock.InsertCalls()[0].UserInfo.MembershipID)
+		require.Len(t, membershipMock.InsertHistoryCalls(), 1)
+		require.False(t, membershipMock.InsertHistoryCalls()[0].History.FromMembershipID.Valid)
+		require.False(t, membershipMock.InsertHistoryCalls()[0].History.ChangedBy.Valid)
+	}
+	{ // same tier is not recorded
+		c, repoMock, membershipMock := newChanger()
+
+		moved, err := c.Change(ctx, nil, newUserAccount(&entities.UserInfo{ID: 3, MembershipID: null.IntFrom(constants.Gold)}), gold, change)
+		require.NoError(t, err)
+		require.False(t, moved)
+		require.Empty(t, repoMock.UpdateMembershipCalls())
+		require.Empty(t, membershipMock.InsertHistoryCalls())
+	}
+}
@@ -0,0 +1,36 @@
+package totp
+
+import (
+	"crypto/rand"
+	"mysite/pkgs/auth"
+	"strings"
+
+	"github.com/pkg/errors"
+)
+
+const (
+	RecoveryCodeCount  = 10
+	recoveryCodeLength = 10 // base32 characters, 50 bits
+)
+
+// NewRecoveryCodes returns one-time codes to show once and the hashes to store
+func NewRecoveryCodes() (codes []string, hashes []string, err error) {
+	for i := 0; i < RecoveryCodeCount; i++ {
+		b := make([]byte, recoveryCodeLength)
+		if _, err := rand.Read(b); err != nil {
+			return nil, nil, errors.Wrap(err, "failed to generate recovery code")
+		}
+
+		encoded := strings.ToLower(b32.EncodeToString(b))[:recoveryCodeLength]
+		code := encoded[:5] + "-" + encoded[5:]
+		codes = append(codes, code)
+		hashes = append(hashes, HashRecoveryCode(code))
+	}
+	return codes, hashes, nil
+}
+
+// HashRecoveryCode ignores case, spaces and dashes the user may type
+func HashRecoveryCode(code string) string {
+	normalized := strings.NewReplacer("-", "", " ", "").Replace(strings.ToLower(code))
+	return auth.HashOpaqueToken(normalized)
+}
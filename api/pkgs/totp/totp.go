@@ -0,0 +1,113 @@
+package totp
+
+import (
+	"crypto/hmac"
+	"crypto/rand"
+	"crypto/sha1"
+	"crypto/subtle"
+	"encoding/base32"
+	"encoding/binary"
+	"fmt"
+	"mysite/entities"
+	"net/url"
+	"strings"
+	"time"
+
+	"github.com/pkg/errors"
+	"github.com/volatiletech/null/v8"
+)
+
+// RFC 6238 defaults, the only parameters authenticator apps reliably support
+const (
+	period       = 30
+	digits       = 6
+	skew         = 1 // accepted steps before and after the current one
+	secretLength = 20
+)
+
+var b32 = base32.StdEncoding.WithPadding(base32.NoPadding)
+
+// ErrCodeReplayed the code is valid but its step was already used
+var ErrCodeReplayed = errors.New("code already used")
+
+// GenerateSecret returns a random base32 encoded secret
+func GenerateSecret() (string, error) {
+	b := make([]byte, secretLength)
+	if _, err := rand.Read(b); err != nil {
+		return "", errors.Wrap(err, "failed to generate secret")
+	}
+	return b32.EncodeToString(b), nil
+}
+
+// URI returns the otpauth uri to enrol the secret in an authenticator app
+func URI(issuer, accountName, secret string) string {
+	query := url.Values{}
+	query.Set("secret", secret)
+	query.Set("issuer", issuer)
+	query.Set("algorithm", "SHA1")
+	query.Set("digits", fmt.Sprint(digits))
+	query.Set("period", fmt.Sprint(period))
+
+	label := url.PathEscape(issuer) + ":" + url.PathEscape(accountName)
+	return "otpauth://totp/" + label + "?" + query.Encode()
+}
+
+// Code returns the current code of the secret, what the authenticator app shows
+func Code(secret string, now time.Time) (string, error) {
+	key, err := b32.DecodeString(strings.ToUpper(secret))
+	if err != nil {
+		return "", errors.Wrap(err, "invalid secret")
+	}
+	return generateCode(key, uint64(now.Unix()/period), digits), nil
+}
+
+// Validate checks code against the secret and returns the matched time step,
+// callers reject steps which are not newer than the last used one to prevent replay
+func Validate(secret, code string, now time.Time) (int64, bool) {
+	key, err := b32.DecodeString(strings.ToUpper(secret))
+	if err != nil || len(code) != digits {
+		return 0, false
+	}
+
+	current := now.Unix() / period
+	for step := current - skew; step <= current+skew; step++ {
+		if subtle.ConstantTimeCompare([]byte(generateCode(key, uint64(step), digits)), []byte(code)) == 1 {
+			return step, true
+		}
+	}
+	return 0, false
+}
+
+// Accept validates code against the 2FA of the user and advances LastUsedStep to its step, the caller stores mfa.
+// matched is false for a code which is not a TOTP code of the secret, a valid code of a step not newer than
+// the last used one is matched and returns ErrCodeReplayed
+func Accept(mfa *entities.UserMfa, code string, now time.Time) (matched bool, err error) {
+	step, ok := Validate(mfa.Secret, code, now)
+	if !ok {
+		return false, nil
+	}
+	if mfa.LastUsedStep.Valid && step <= mfa.LastUsedStep.Int64 {
+		return true, ErrCodeReplayed
+	}
+	mfa.LastUsedStep = null.Int64From(step)
+	return true, nil
+}
+
+// generateCode HOTP of RFC 4226
+func generateCode(key []byte, counter uint64, digits int) string {
+	var msg [8]byte
+	binary.BigEndian.PutUint64(msg[:], counter)
+
+	mac := hmac.New(sha1.New, key)
+	mac.Write(msg[:])
+	sum := mac.Sum(nil)
+
+	offset := sum[len(sum)-1] & 0x0f
+	value := binary.BigEndian.Uint32(sum[offset:offset+4]) & 0x7fffffff
+
+	mod := uint32(1)
+	for i := 0; i < digits; i++ {
+		mod *= 10
+	}
+	return fmt.Sprintf("%0*d", digits, value%mod)
+}
@@ -0,0 +1,110 @@
+package totp
+
+import (
+	"mysite/entities"
+	"net/url"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestGenerateCode(t *testing.T) {
+	// test vectors of RFC 6238 appendix B, SHA1
+	key := []byte("12345678901234567890")
+	for unix, expected := range map[int64]string{
+		59:          "94287082",
+		1111111109:  "07081804",
+		1111111111:  "14050471",
+		1234567890:  "89005924",
+		2000000000:  "69279037",
+		20000000000: "65353130",
+	} {
+		require.Equal(t, expected, generateCode(key, uint64(unix/period), 8), unix)
+	}
+}
+
+func TestValidate(t *testing.T) {
+	secret, err := GenerateSecret()
+	require.NoError(t, err)
+	key, err := b32.DecodeString(secret)
+	require.NoError(t, err)
+	now := time.Unix(1700000000, 0)
+	current := now.Unix() / period
+
+	{ // current code
+		code, err := Code(secret, now)
+		require.NoError(t, err)
+		step, ok := Validate(secret, code, now)
+		require.True(t, ok)
+		require.Equal(t, current, step)
+	}
+	{ // previous code is accepted for clock skew
+		step, ok := Validate(secret, generateCode(key, uint64(current-1), digits), now)
+		require.True(t, ok)
+		require.Equal(t, current-1, step)
+	}
+	{ // code out of the window
+		_, ok := Validate(secret, generateCode(key, uint64(current-2), digits), now)
+		require.False(t, ok)
+	}
+	{ // invalid code
+		_, ok := Validate(secret, "abc", now)
+		require.False(t, ok)
+	}
+	{ // invalid secret
+		_, ok := Validate("not base32!", "123456", now)
+		require.False(t, ok)
+	}
+}
+
+func TestAccept(t *testing.T) {
+	secret, err := GenerateSecret()
+	require.NoError(t, err)
+	now := time.Unix(1700000000, 0)
+	code, err := Code(secret, now)
+	require.NoError(t, err)
+
+	mfa := entities.UserMfa{Secret: secret}
+	{ // first use advances the step
+		matched, err := Accept(&mfa, code, now)
+		require.True(t, matched)
+		require.NoError(t, err)
+		require.Equal(t, now.Unix()/period, mfa.LastUsedStep.Int64)
+	}
+	{ // replayed code
+		matched, err := Accept(&mfa, code, now)
+		require.True(t, matched)
+		require.ErrorIs(t, err, ErrCodeReplayed)
+	}
+	{ // wrong code
+		matched, err := Accept(&mfa, "abc", now)
+		require.False(t, matched)
+		require.NoError(t, err)
+	}
+}
+
+func TestURI(t *testing.T) {
+	uri := URI("mysite", "test@example.com", "SECRET")
+	require.True(t, strings.HasPrefix(uri, "otpauth://totp/mysite:test@example.com?"))
+
+	parsed, err := url.Parse(uri)
+	require.NoError(t, err)
+	require.Equal(t, "SECRET", parsed.Query().Get("secret"))
+	require.Equal(t, "mysite", parsed.Query().Get("issuer"))
+}
+
+func TestRecoveryCodes(t *testing.T) {
+	codes, hashes, err := NewRecoveryCodes()
+	require.NoError(t, err)
+	require.Len(t, codes, RecoveryCodeCount)
+	require.Len(t, hashes, RecoveryCodeCount)
+
+	for i, code := range codes {
+		require.Len(t, code, recoveryCodeLength+1)
+		require.Equal(t, hashes[i], HashRecoveryCode(code))
+		// typed without dash and in upper case
+		require.Equal(t, hashes[i], HashRecoveryCode(strings.ToUpper(strings.ReplaceAll(code, "-", ""))))
+	}
+}
@@ -0,0 +1,98 @@
+package validate
+
+import (
+	"bytes"
+	"crypto/sha256"
+	"encoding/binary"
+	"io"
+	"math"
+
+	"github.com/pkg/errors"
+)
+
+var bloomMagic = []byte("BLM1")
+
+// BloomFilter tells that a password is certainly not in the list, or that it probably is
+type BloomFilter struct {
+	k    uint32
+	m    uint64
+	bits []byte
+}
+
+// NewBloomFilter sizes a filter for n passwords with the given false positive rate
+func NewBloomFilter(n int, falsePositiveRate float64) *BloomFilter {
+	if n < 1 {
+		n = 1
+	}
+	m := uint64(math.Ceil(-float64(n) * math.Log(falsePositiveRate) / (math.Ln2 * math.Ln2)))
+	if m < 8 {
+		m = 8
+	}
+	k := uint32(math.Round(float64(m) / float64(n) * math.Ln2))
+	if k < 1 {
+		k = 1
+	}
+	return &BloomFilter{k: k, m: m, bits: make([]byte, (m+7)/8)}
+}
+
+// ReadBloomFilter reads a filter written by WriteTo
+func ReadBloomFilter(r io.Reader) (*BloomFilter, error) {
+	magic := make([]byte, len(bloomMagic))
+	if _, err := io.ReadFull(r, magic); err != nil || !bytes.Equal(magic, bloomMagic) {
+		return nil, errors.New("not a bloom filter")
+	}
+
+	var f BloomFilter
+	if err := binary.Read(r, binary.LittleEndian, &f.k); err != nil {
+		return nil, errors.Wrap(err, "failed read hash count")
+	}
+	if err := binary.Read(r, binary.LittleEndian, &f.m); err != nil {
+		return nil, errors.Wrap(err, "failed read size")
+	}
+	if f.k == 0 || f.m == 0 || f.m > 1<<36 {
+		return nil, errors.New("invalid bloom filter header")
+	}
+
+	f.bits = make([]byte, (f.m+7)/8)
+	if _, err := io.ReadFull(r, f.bits); err != nil {
+		return nil, errors.Wrap(err, "failed read bits")
+	}
+	return &f, nil
+}
+
+func (f *BloomFilter) WriteTo(w io.Writer) (int64, error) {
+	var buf bytes.Buffer
+	buf.Write(bloomMagic)
+	_ = binary.Write(&buf, binary.LittleEndian, f.k)
+	_ = binary.Write(&buf, binary.LittleEndian, f.m)
+	buf.Write(f.bits)
+	return buf.WriteTo(w)
+}
+
+func (f *BloomFilter) Add(password string) {
+	for _, i := range f.indexes(password) {
+		f.bits[i/8] |= 1 << (i % 8)
+	}
+}
+
+func (f *BloomFilter) Contains(password string) bool {
+	for _, i := range f.indexes(password) {
+		if f.bits[i/8]&(1<<(i%8)) == 0 {
+			return false
+		}
+	}
+	return true
+}
+
+// indexes derives the k bits of the password by double hashing
+func (f *BloomFilter) indexes(password string) []uint64 {
+	sum := sha256.Sum256([]byte(normalizeBreached(password)))
+	h1 := binary.LittleEndian.Uint64(sum[0:8])
+	h2 := binary.LittleEndian.Uint64(sum[8:16])
+
+	result := make([]uint64, f.k)
+	for i := range result {
+		result[i] = (h1 + uint64(i)*h2) % f.m
+	}
+	return result
+}
@@ -0,0 +1,105 @@
+package validate
+
+import (
+	"bufio"
+	_ "embed"
+	"io"
+	"log/slog"
+	"mysite/pkgs/logger"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync"
+
+	"github.com/pkg/errors"
+)
+
+// commonPasswords is always checked, the configured file adds a larger list
+//
+//go:embed common_passwords.txt
+var commonPasswords string
+
+// BreachedList is an offline list of common or breached passwords
+type BreachedList interface {
+	Contains(password string) bool
+}
+
+type wordList map[string]struct{}
+
+func (l wordList) Contains(password string) bool {
+	_, found := l[normalizeBreached(password)]
+	return found
+}
+
+type breachedLists []BreachedList
+
+func (l breachedLists) Contains(password string) bool {
+	for _, list := range l {
+		if list.Contains(password) {
+			return true
+		}
+	}
+	return false
+}
+
+var (
+	breachedMu    sync.Mutex
+	breachedCache = map[string]BreachedList{}
+)
+
+// ReadWordList reads one password per line
+func ReadWordList(r io.Reader) (BreachedList, error) {
+	result := wordList{}
+	scanner := bufio.NewScanner(r)
+	for scanner.Scan() {
+		if word := normalizeBreached(scanner.Text()); word != "" {
+			result[word] = struct{}{}
+		}
+	}
+	if err := scanner.Err(); err != nil {
+		return nil, errors.Wrap(err, "failed read word list")
+	}
+	return result, nil
+}
+
+// LoadBreachedList reads a bloom filter when the file ends with .bloom, a word list otherwise
+func LoadBreachedList(path string) (BreachedList, error) {
+	file, err := os.Open(path)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed open breached list")
+	}
+	defer file.Close()
+
+	if strings.EqualFold(filepath.Ext(path), ".bloom") {
+		return ReadBloomFilter(bufio.NewReader(file))
+	}
+	return ReadWordList(file)
+}
+
+// breachedList is the bundled list plus the file, loaded once per path; a broken file only leaves the bundled list
+func breachedList(path string) BreachedList {
+	breachedMu.Lock()
+	defer breachedMu.Unlock()
+
+	if list, found := breachedCache[path]; found {
+		return list
+	}
+
+	common, _ := ReadWordList(strings.NewReader(commonPasswords))
+	lists := breachedLists{common}
+	if path != "" {
+		list, err := LoadBreachedList(path)
+		if err != nil {
+			slog.Error("failed load breached password list", slog.String("path", path), logger.AttrError(err))
+		} else {
+			lists = append(lists, list)
+		}
+	}
+
+	breachedCache[path] = lists
+	return lists
+}
+
+func normalizeBreached(password string) string {
+	return strings.ToLower(strings.TrimSpace(password))
+}
@@ -0,0 +1,101 @@
+package validate
+
+import (
+	"bytes"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestBloomFilter(t *testing.T) {
+	filter := NewBloomFilter(1000, 0.001)
+	for i := 0; i < 1000; i++ {
+		filter.Add(fmt.Sprintf("password-%d", i))
+	}
+
+	{ // added passwords are always found, case insensitive
+		require.True(t, filter.Contains("password-1"))
+		require.True(t, filter.Contains("PASSWORD-999"))
+	}
+	{ // false positives stay close to the rate
+		var positives int
+		for i := 0; i < 10000; i++ {
+			if filter.Contains(fmt.Sprintf("other-%d", i)) {
+				positives++
+			}
+		}
+		require.Less(t, positives, 50)
+	}
+	{ // round trip
+		var buf bytes.Buffer
+		_, err := filter.WriteTo(&buf)
+		require.NoError(t, err)
+
+		read, err := ReadBloomFilter(&buf)
+		require.NoError(t, err)
+		require.Equal(t, filter, read)
+	}
+	{ // invalid file
+		_, err := ReadBloomFilter(strings.NewReader("password\n"))
+		require.Error(t, err)
+
+		_, err = ReadBloomFilter(bytes.NewReader(append([]byte("BLM1"), make([]byte, 12)...)))
+		require.Error(t, err)
+	}
+}
+
+func TestLoadBreachedList(t *testing.T) {
+	dir := t.TempDir()
+
+	{ // word list
+		path := filepath.Join(dir, "list.txt")
+		require.NoError(t, os.WriteFile(path, []byte("Hunter2-horse\n\n  staple-battery  \n"), 0o600))
+
+		list, err := LoadBreachedList(path)
+		require.NoError(t, err)
+		require.True(t, list.Contains("hunter2-horse"))
+		require.True(t, list.Contains("staple-battery"))
+		require.False(t, list.Contains("correct-horse-7"))
+	}
+	{ // bloom filter
+		filter := NewBloomFilter(10, 0.001)
+		filter.Add("hunter2-horse")
+		var buf bytes.Buffer
+		_, err := filter.WriteTo(&buf)
+		require.NoError(t, err)
+
+		path := filepath.Join(dir, "list.bloom")
+		require.NoError(t, os.WriteFile(path, buf.Bytes(), 0o600))
+
+		list, err := LoadBreachedList(path)
+		require.NoError(t, err)
+		require.True(t, list.Contains("hunter2-horse"))
+	}
+	{ // missing file
+		_, err := LoadBreachedList(filepath.Join(dir, "missing.txt"))
+		require.Error(t, err)
+	}
+}
+
+func TestBreachedList(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "list.txt")
+	require.NoError(t, os.WriteFile(path, []byte("hunter2-horse\n"), 0o600))
+
+	{ // bundled list plus the file
+		list := breachedList(path)
+		require.True(t, list.Contains("password123"))
+		require.True(t, list.Contains("hunter2-horse"))
+	}
+	{ // loaded once per path
+		require.NoError(t, os.WriteFile(path, []byte("staple-battery\n"), 0o600))
+		require.False(t, breachedList(path).Contains("staple-battery"))
+	}
+	{ // broken file only leaves the bundled list
+		list := breachedList(filepath.Join(t.TempDir(), "missing.txt"))
+		require.True(t, list.Contains("password123"))
+	}
+}
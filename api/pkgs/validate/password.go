@@ -0,0 +1,173 @@
+package validate
+
+import (
+	"fmt"
+	"mysite/pkgs/env"
+	"strings"
+	"unicode"
+
+	"github.com/pkg/errors"
+)
+
+// rules reported by PasswordError
+const (
+	RuleMinLength   = "minLength"
+	RuleLower       = "lower"
+	RuleUpper       = "upper"
+	RuleLetter      = "letter"
+	RuleDigit       = "digit"
+	RuleSymbol      = "symbol"
+	RuleMaxRepeated = "maxRepeated"
+	RuleUserName    = "userName"
+	RuleBreached    = "breached"
+)
+
+// userInputMinLength shorter user inputs are too common to be rejected inside a password
+const userInputMinLength = 3
+
+type PasswordPolicy struct {
+	MinLength int
+
+	// RequiredClasses lower, upper, letter, digit or symbol
+	RequiredClasses []string
+
+	// MaxRepeated longest run of one character, 0 disables the rule
+	MaxRepeated int
+
+	// Breached rejects listed passwords, nil disables the rule
+	Breached BreachedList
+}
+
+var defaultPasswordPolicy = PasswordPolicy{
+	MinLength:       8,
+	RequiredClasses: []string{RuleLetter, RuleDigit},
+	MaxRepeated:     3,
+}
+
+// PasswordError lists every rule the password failed
+type PasswordError struct {
+	FailedRules []string
+}
+
+func (e PasswordError) Error() string {
+	return fmt.Sprintf("password failed rules [%s]", strings.Join(e.FailedRules, ", "))
+}
+
+// FailedRules returns the rules of a PasswordError in the chain, nil for other errors
+func FailedRules(err error) []string {
+	var passwordErr PasswordError
+	if errors.As(err, &passwordErr) {
+		return passwordErr.FailedRules
+	}
+	return nil
+}
+
+// PasswordPolicyFromEnv overrides the default policy with the configured one
+func PasswordPolicyFromEnv() PasswordPolicy {
+	cfg := env.GetEnv().PasswordPolicy
+	policy := defaultPasswordPolicy
+	if cfg.MinLength > 0 {
+		policy.MinLength = cfg.MinLength
+	}
+	if cfg.RequiredClasses != nil {
+		policy.RequiredClasses = cfg.RequiredClasses
+	}
+	if cfg.MaxRepeated > 0 {
+		policy.MaxRepeated = cfg.MaxRepeated
+	}
+	policy.Breached = breachedList(cfg.BreachedListFile)
+	return policy
+}
+
+// ValidatePassword checks a new password against the configured policy, userInputs are the user name and email of the account
+func ValidatePassword(password string, userInputs ...string) error {
+	return PasswordPolicyFromEnv().Validate(password, userInputs...)
+}
+
+// Validate returns a PasswordError with every failed rule
+func (p PasswordPolicy) Validate(password string, userInputs ...string) error {
+	var failed []string
+
+	if len([]rune(password)) < p.MinLength {
+		failed = append(failed, RuleMinLength)
+	}
+
+	classes := characterClasses(password)
+	for _, class := range p.RequiredClasses {
+		if !classes[class] {
+			failed = append(failed, class)
+		}
+	}
+
+	if p.MaxRepeated > 0 && longestRun(password) > p.MaxRepeated {
+		failed = append(failed, RuleMaxRepeated)
+	}
+
+	if containsUserInput(password, userInputs) {
+		failed = append(failed, RuleUserName)
+	}
+
+	if p.Breached != nil && p.Breached.Contains(password) {
+		failed = append(failed, RuleBreached)
+	}
+
+	if len(failed) > 0 {
+		return PasswordError{FailedRules: failed}
+	}
+	return nil
+}
+
+func characterClasses(password string) map[string]bool {
+	classes := map[string]bool{}
+	for _, r := range password {
+		switch {
+		case unicode.IsLower(r):
+			classes[RuleLower], classes[RuleLetter] = true, true
+		case unicode.IsUpper(r):
+			classes[RuleUpper], classes[RuleLetter] = true, true
+		case unicode.IsLetter(r):
+			classes[RuleLetter] = true
+		case unicode.IsDigit(r):
+			classes[RuleDigit] = true
+		default:
+			classes[RuleSymbol] = true
+		}
+	}
+	return classes
+}
+
+func longestRun(password string) int {
+	var (
+		longest, run int
+		prev         rune = -1
+	)
+	for _, r := range password {
+		if r == prev {
+			run++
+		} else {
+			run = 1
+			prev = r
+		}
+		longest = max(longest, run)
+	}
+	return longest
+}
+
+// containsUserInput also checks the local part of emails, "alice" of alice@example.com
+func containsUserInput(password string, userInputs []string) bool {
+	lower := strings.ToLower(password)
+	for _, input := range userInputs {
+		input = strings.ToLower(strings.TrimSpace(input))
+		candidates := []string{input}
+		if local, _, found := strings.Cut(input, "@"); found {
+			candidates = append(candidates, local)
+		}
+
+		for _, candidate := range candidates {
+			if len([]rune(candidate)) >= userInputMinLength && strings.Contains(lower, candidate) {
+				return true
+			}
+		}
+	}
+	return false
+}
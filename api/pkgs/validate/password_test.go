@@ -0,0 +1,70 @@
+package validate
+
+import (
+	"testing"
+
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/require"
+)
+
+func TestValidatePassword(t *testing.T) {
+	{ // valid password
+		require.NoError(t, ValidatePassword("correct-horse-7"))
+	}
+	{ // too short
+		require.Equal(t, []string{RuleMinLength}, FailedRules(ValidatePassword("pass1")))
+	}
+	{ // letters only
+		require.Equal(t, []string{RuleDigit}, FailedRules(ValidatePassword("horsebattery")))
+	}
+	{ // digits only
+		require.Equal(t, []string{RuleLetter}, FailedRules(ValidatePassword("7391046528")))
+	}
+	{ // repeated characters
+		require.Equal(t, []string{RuleMaxRepeated}, FailedRules(ValidatePassword("horse-7777")))
+	}
+	{ // breached password
+		require.Equal(t, []string{RuleBreached}, FailedRules(ValidatePassword("password123")))
+	}
+	{ // contains user name or the local part of the email
+		require.Equal(t, []string{RuleUserName}, FailedRules(ValidatePassword("Alice-horse-7", "bob", "alice@example.com")))
+		require.NoError(t, ValidatePassword("al-horse-7", "al"))
+	}
+	{ // every failed rule is reported
+		require.ElementsMatch(t,
+			[]string{RuleMinLength, RuleDigit, RuleMaxRepeated},
+			FailedRules(ValidatePassword("aaaa")),
+		)
+	}
+}
+
+func TestPasswordPolicy(t *testing.T) {
+	policy := PasswordPolicy{
+		MinLength:       12,
+		RequiredClasses: []string{RuleLower, RuleUpper, RuleDigit, RuleSymbol},
+		Breached:        wordList{"correct-horse-7": {}},
+	}
+
+	{ // valid password
+		require.NoError(t, policy.Validate("Battery-Staple-42"))
+	}
+	{ // missing classes
+		require.ElementsMatch(t,
+			[]string{RuleMinLength, RuleUpper, RuleSymbol},
+			FailedRules(policy.Validate("horse7")),
+		)
+	}
+	{ // breached is case insensitive
+		require.Equal(t, []string{RuleBreached}, FailedRules(PasswordPolicy{Breached: policy.Breached}.Validate("Correct-Horse-7")))
+	}
+	{ // rules are disabled by their zero value
+		require.NoError(t, PasswordPolicy{}.Validate("aaaa"))
+	}
+	{ // PasswordError survives wrapping
+		err := errors.Wrap(policy.Validate("horse"), "failed validate")
+		var passwordErr PasswordError
+		require.ErrorAs(t, err, &passwordErr)
+		require.Equal(t, FailedRules(err), passwordErr.FailedRules)
+		require.Nil(t, FailedRules(errors.New("other")))
+	}
+}
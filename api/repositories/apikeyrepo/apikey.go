@@ -0,0 +1,43 @@
+package apikeyrepo
+
+import (
+	"context"
+	"mysite/entities"
+	"time"
+
+	"github.com/volatiletech/sqlboiler/v4/boil"
+)
+
+type Get interface {
+	GetApiKeyByPrefix(ctx context.Context, tx boil.ContextTransactor, prefix string) (*entities.APIKey, error)
+	GetApiKeyById(ctx context.Context, tx boil.ContextTransactor, userAccountId int, apiKeyId int) (*entities.APIKey, error)
+	GetApiKeysByUserAccountId(ctx context.Context, tx boil.ContextTransactor, userAccountId int) (entities.APIKeySlice, error)
+}
+
+type Insert interface {
+	Insert(ctx context.Context, tx boil.ContextTransactor, apiKey *entities.APIKey) error
+}
+
+type Update interface {
+	Update(ctx context.Context, tx boil.ContextTransactor, apiKey entities.APIKey) error
+	MarkUsed(ctx context.Context, tx boil.ContextTransactor, apiKeyId int, usedAt time.Time) error
+}
+
+type Delete interface {
+	Delete(ctx context.Context, tx boil.ContextTransactor, userAccountId int, apiKeyId int) (bool, error)
+}
+
+//go:generate moq -pkg repomock -out ../../testing/mocking/repomock/apikeymock.go . ApiKeyRepo
+type ApiKeyRepo interface {
+	Get
+	Insert
+	Update
+	Delete
+}
+
+type apiKeyRepo struct {
+}
+
+func NewRepo() ApiKeyRepo {
+	return &apiKeyRepo{}
+}
@@ -0,0 +1,23 @@
+package apikeyrepo
+
+import (
+	"fmt"
+	"mysite/pkgs/database"
+	"mysite/testing/dbtest"
+	"testing"
+)
+
+func TestMain(m *testing.M) {
+	pool, resource, err := dbtest.SetupDatabaseForTesting()
+	if err != nil {
+		return
+	}
+
+	defer func() {
+		database.Close()
+		if err := dbtest.PurgeResource(pool, resource); err != nil {
+			fmt.Println("failed to purge resource")
+		}
+	}()
+	m.Run()
+}
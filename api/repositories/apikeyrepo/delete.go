@@ -0,0 +1,24 @@
+package apikeyrepo
+
+import (
+	"context"
+	"mysite/entities"
+
+	"github.com/friendsofgo/errors"
+	"github.com/volatiletech/sqlboiler/v4/boil"
+	"github.com/volatiletech/sqlboiler/v4/queries/qm"
+)
+
+// Delete revokes the key of the user, false when the user has no such key
+func (a apiKeyRepo) Delete(ctx context.Context, tx boil.ContextTransactor, userAccountId int, apiKeyId int) (bool, error) {
+	mods := []qm.QueryMod{
+		entities.APIKeyWhere.ID.EQ(apiKeyId),
+		entities.APIKeyWhere.UserAccountID.EQ(userAccountId),
+	}
+
+	rowEffected, err := entities.APIKeys(mods...).DeleteAll(ctx, tx)
+	if err != nil {
+		return false, errors.Wrap(err, "failed to delete apiKey")
+	}
+	return rowEffected > 0, nil
+}
@@ -0,0 +1,44 @@
+package apikeyrepo
+
+import (
+	"context"
+	"mysite/entities"
+	"mysite/pkgs/database"
+	"mysite/testing/dbtest"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"github.com/volatiletech/sqlboiler/v4/boil"
+)
+
+func TestDeleteApiKey(t *testing.T) {
+	t.Parallel()
+	require.NoError(t, database.SetupDatabase())
+	repo := NewRepo()
+	ctx := dbtest.SetTestTransactionCtx(context.Background())
+
+	var (
+		otherUser, deleted bool
+		afterDelete        *entities.APIKey
+	)
+	err := database.NewBoilerTransaction(ctx, func(ctx context.Context, tx boil.ContextTransactor) error {
+		apiKey, err := insertTestApiKey(ctx, tx, "delkey01")
+		if err != nil {
+			return err
+		}
+
+		if otherUser, err = repo.Delete(ctx, tx, apiKey.UserAccountID+1, apiKey.ID); err != nil {
+			return err
+		}
+		if deleted, err = repo.Delete(ctx, tx, apiKey.UserAccountID, apiKey.ID); err != nil {
+			return err
+		}
+		afterDelete, err = repo.GetApiKeyByPrefix(ctx, tx, "delkey01")
+		return err
+	})
+
+	require.NoError(t, err)
+	require.False(t, otherUser)
+	require.True(t, deleted)
+	require.Nil(t, afterDelete)
+}
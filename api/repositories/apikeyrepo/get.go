@@ -0,0 +1,49 @@
+package apikeyrepo
+
+import (
+	"context"
+	"database/sql"
+	"mysite/entities"
+
+	"github.com/friendsofgo/errors"
+	"github.com/volatiletech/sqlboiler/v4/boil"
+	"github.com/volatiletech/sqlboiler/v4/queries/qm"
+)
+
+func (a apiKeyRepo) GetApiKeyByPrefix(ctx context.Context, tx boil.ContextTransactor, prefix string) (*entities.APIKey, error) {
+	pgApiKey, err := entities.APIKeys(entities.APIKeyWhere.Prefix.EQ(prefix)).One(ctx, tx)
+	if err != nil && !errors.Is(err, sql.ErrNoRows) {
+		return nil, errors.Wrap(err, "failed to get apiKey")
+	}
+
+	return pgApiKey, nil
+}
+
+// GetApiKeyById returns the key only when it belongs to the user
+func (a apiKeyRepo) GetApiKeyById(ctx context.Context, tx boil.ContextTransactor, userAccountId int, apiKeyId int) (*entities.APIKey, error) {
+	mods := []qm.QueryMod{
+		entities.APIKeyWhere.ID.EQ(apiKeyId),
+		entities.APIKeyWhere.UserAccountID.EQ(userAccountId),
+	}
+
+	pgApiKey, err := entities.APIKeys(mods...).One(ctx, tx)
+	if err != nil && !errors.Is(err, sql.ErrNoRows) {
+		return nil, errors.Wrap(err, "failed to get apiKey")
+	}
+
+	return pgApiKey, nil
+}
+
+func (a apiKeyRepo) GetApiKeysByUserAccountId(ctx context.Context, tx boil.ContextTransactor, userAccountId int) (entities.APIKeySlice, error) {
+	mods := []qm.QueryMod{
+		entities.APIKeyWhere.UserAccountID.EQ(userAccountId),
+		qm.OrderBy(entities.APIKeyColumns.ID),
+	}
+
+	pgApiKeys, err := entities.APIKeys(mods...).All(ctx, tx)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to get apiKeys")
+	}
+
+	return pgApiKeys, nil
+}
@@ -0,0 +1,48 @@
+package apikeyrepo
+
+import (
+	"context"
+	"mysite/entities"
+	"mysite/pkgs/database"
+	"mysite/testing/dbtest"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"github.com/volatiletech/sqlboiler/v4/boil"
+)
+
+func TestGetApiKey(t *testing.T) {
+	t.Parallel()
+	require.NoError(t, database.SetupDatabase())
+	repo := NewRepo()
+	ctx := dbtest.SetTestTransactionCtx(context.Background())
+
+	var (
+		inserted, byPrefix, byId, otherUser *entities.APIKey
+		list                                entities.APIKeySlice
+	)
+	err := database.NewBoilerTransaction(ctx, func(ctx context.Context, tx boil.ContextTransactor) error {
+		var err error
+		if inserted, err = insertTestApiKey(ctx, tx, "getkey01"); err != nil {
+			return err
+		}
+		if byPrefix, err = repo.GetApiKeyByPrefix(ctx, tx, "getkey01"); err != nil {
+			return err
+		}
+		if byId, err = repo.GetApiKeyById(ctx, tx, inserted.UserAccountID, inserted.ID); err != nil {
+			return err
+		}
+		if otherUser, err = repo.GetApiKeyById(ctx, tx, inserted.UserAccountID+1, inserted.ID); err != nil {
+			return err
+		}
+		list, err = repo.GetApiKeysByUserAccountId(ctx, tx, inserted.UserAccountID)
+		return err
+	})
+
+	require.NoError(t, err)
+	require.Equal(t, inserted.ID, byPrefix.ID)
+	require.Equal(t, inserted.ID, byId.ID)
+	require.Nil(t, otherUser)
+	require.Len(t, list, 1)
+	require.Equal(t, []string{"users:read"}, []string(list[0].Scopes))
+}
@@ -0,0 +1,37 @@
+package apikeyrepo
+
+import (
+	"context"
+	"mysite/entities"
+	"time"
+
+	"github.com/friendsofgo/errors"
+	"github.com/volatiletech/sqlboiler/v4/boil"
+	"github.com/volatiletech/sqlboiler/v4/types"
+)
+
+// insertTestApiKey inserts a user with one key of the given prefix
+func insertTestApiKey(ctx context.Context, tx boil.ContextTransactor, prefix string) (*entities.APIKey, error) {
+	userAccount := entities.UserAccount{
+		UserName: "apikey-" + prefix,
+		Password: "password",
+		IsActive: true,
+	}
+	if err := userAccount.Insert(ctx, tx, boil.Infer()); err != nil {
+		return nil, errors.Wrap(err, "failed insert userAccount")
+	}
+
+	apiKey := entities.APIKey{
+		UserAccountID: userAccount.ID,
+		Name:          "script",
+		Kind:          "personal",
+		Prefix:        prefix,
+		KeyHash:       "hash",
+		Scopes:        types.StringArray{"users:read"},
+		ExpiresAt:     time.Now().Add(time.Hour),
+	}
+	if err := NewRepo().Insert(ctx, tx, &apiKey); err != nil {
+		return nil, err
+	}
+	return &apiKey, nil
+}
@@ -0,0 +1,16 @@
+package apikeyrepo
+
+import (
+	"context"
+	"mysite/entities"
+
+	"github.com/friendsofgo/errors"
+	"github.com/volatiletech/sqlboiler/v4/boil"
+)
+
+func (a apiKeyRepo) Insert(ctx context.Context, tx boil.ContextTransactor, apiKey *entities.APIKey) error {
+	if err := apiKey.Insert(ctx, tx, boil.Infer()); err != nil {
+		return errors.Wrap(err, "failed to insert apiKey")
+	}
+	return nil
+}
@@ -0,0 +1,32 @@
+package apikeyrepo
+
+import (
+	"context"
+	"mysite/entities"
+	"time"
+
+	"github.com/friendsofgo/errors"
+	"github.com/volatiletech/null/v8"
+	"github.com/volatiletech/sqlboiler/v4/boil"
+)
+
+// Update saves the name and scopes, the key itself and its expiry can not change
+func (a apiKeyRepo) Update(ctx context.Context, tx boil.ContextTransactor, apiKey entities.APIKey) error {
+	apiKey.UpdatedAt = null.TimeFrom(time.Now())
+	columns := boil.Whitelist(entities.APIKeyColumns.Name, entities.APIKeyColumns.Scopes, entities.APIKeyColumns.UpdatedAt)
+	rowEffected, err := apiKey.Update(ctx, tx, columns)
+	if err != nil || rowEffected == 0 {
+		return errors.Wrap(err, "failed to update apiKey")
+	}
+	return nil
+}
+
+func (a apiKeyRepo) MarkUsed(ctx context.Context, tx boil.ContextTransactor, apiKeyId int, usedAt time.Time) error {
+	_, err := entities.APIKeys(entities.APIKeyWhere.ID.EQ(apiKeyId)).UpdateAll(ctx, tx, entities.M{
+		entities.APIKeyColumns.LastUsedAt: null.TimeFrom(usedAt),
+	})
+	if err != nil {
+		return errors.Wrap(err, "failed to mark apiKey used")
+	}
+	return nil
+}
@@ -0,0 +1,48 @@
+package apikeyrepo
+
+import (
+	"context"
+	"mysite/entities"
+	"mysite/pkgs/database"
+	"mysite/testing/dbtest"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"github.com/volatiletech/sqlboiler/v4/boil"
+	"github.com/volatiletech/sqlboiler/v4/types"
+)
+
+func TestUpdateApiKey(t *testing.T) {
+	t.Parallel()
+	require.NoError(t, database.SetupDatabase())
+	repo := NewRepo()
+	ctx := dbtest.SetTestTransactionCtx(context.Background())
+	usedAt := time.Now().Truncate(time.Millisecond)
+
+	var updated *entities.APIKey
+	err := database.NewBoilerTransaction(ctx, func(ctx context.Context, tx boil.ContextTransactor) error {
+		apiKey, err := insertTestApiKey(ctx, tx, "updkey01")
+		if err != nil {
+			return err
+		}
+
+		apiKey.Name = "renamed"
+		apiKey.Scopes = types.StringArray{}
+		apiKey.KeyHash = "not saved"
+		if err := repo.Update(ctx, tx, *apiKey); err != nil {
+			return err
+		}
+		if err := repo.MarkUsed(ctx, tx, apiKey.ID, usedAt); err != nil {
+			return err
+		}
+		updated, err = repo.GetApiKeyByPrefix(ctx, tx, "updkey01")
+		return err
+	})
+
+	require.NoError(t, err)
+	require.Equal(t, "renamed", updated.Name)
+	require.Empty(t, updated.Scopes)
+	require.Equal(t, "hash", updated.KeyHash)
+	require.True(t, updated.LastUsedAt.Valid)
+}
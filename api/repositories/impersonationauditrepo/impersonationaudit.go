@@ -0,0 +1,24 @@
+package impersonationauditrepo
+
+import (
+	"context"
+	"mysite/entities"
+
+	"github.com/volatiletech/sqlboiler/v4/boil"
+)
+
+type Insert interface {
+	Insert(ctx context.Context, tx boil.ContextTransactor, audit *entities.ImpersonationAudit) error
+}
+
+//go:generate moq -pkg repomock -out ../../testing/mocking/repomock/impersonationauditmock.go . ImpersonationAuditRepo
+type ImpersonationAuditRepo interface {
+	Insert
+}
+
+type impersonationAuditRepo struct {
+}
+
+func NewRepo() ImpersonationAuditRepo {
+	return &impersonationAuditRepo{}
+}
@@ -0,0 +1,18 @@
+package impersonationauditrepo
+
+import (
+	"context"
+	"mysite/entities"
+
+	"github.com/friendsofgo/errors"
+	"github.com/volatiletech/sqlboiler/v4/boil"
+)
+
+// Insert records an action of an admin as another user, the rows are never updated
+func (i impersonationAuditRepo) Insert(ctx context.Context, tx boil.ContextTransactor, audit *entities.ImpersonationAudit) error {
+	if err := audit.Insert(ctx, tx, boil.Infer()); err != nil {
+		return errors.Wrap(err, "failed to insert impersonationAudit")
+	}
+
+	return nil
+}
@@ -0,0 +1,59 @@
+package impersonationauditrepo
+
+import (
+	"context"
+	"mysite/constants"
+	"mysite/entities"
+	"mysite/pkgs/database"
+	"mysite/testing/dbtest"
+	"testing"
+
+	"github.com/friendsofgo/errors"
+	"github.com/stretchr/testify/require"
+	"github.com/volatiletech/null/v8"
+	"github.com/volatiletech/sqlboiler/v4/boil"
+)
+
+func TestInsert(t *testing.T) {
+	t.Parallel()
+	require.NoError(t, database.SetupDatabase())
+	repo := NewRepo()
+	ctx := dbtest.SetTestTransactionCtx(context.Background())
+
+	{ // audit of a request as another user
+		var audit entities.ImpersonationAudit
+		err := database.NewBoilerTransaction(ctx, func(ctx context.Context, tx boil.ContextTransactor) error {
+			admin := entities.UserAccount{
+				UserName: "impersonation-admin",
+				Password: "password",
+				IsActive: true,
+			}
+			if err := admin.Insert(ctx, tx, boil.Infer()); err != nil {
+				return errors.Wrap(err, "failed insert userAccount")
+			}
+			userAccount := entities.UserAccount{
+				UserName: "impersonation-user",
+				Password: "password",
+				IsActive: true,
+			}
+			if err := userAccount.Insert(ctx, tx, boil.Infer()); err != nil {
+				return errors.Wrap(err, "failed insert userAccount")
+			}
+
+			audit = entities.ImpersonationAudit{
+				ActorUserAccountID: admin.ID,
+				UserAccountID:      userAccount.ID,
+				TokenID:            "6f1c0a52-1d0e-4c7a-9d43-8f3a1b2c4d5e",
+				Action:             constants.ImpersonationRequest,
+				Method:             null.StringFrom("GET"),
+				Path:               null.StringFrom("/api/v1/me"),
+			}
+			return repo.Insert(ctx, tx, &audit)
+		})
+
+		require.NoError(t, err)
+		require.NotZero(t, audit.ID)
+		require.False(t, audit.RequestID.Valid)
+		require.False(t, audit.CreatedAt.IsZero())
+	}
+}
@@ -0,0 +1,18 @@
+package loginattemptrepo
+
+import (
+	"context"
+	"mysite/entities"
+
+	"github.com/friendsofgo/errors"
+	"github.com/volatiletech/sqlboiler/v4/boil"
+)
+
+// DeleteByKey resets the failures of the key after a successful login
+func (l loginAttemptRepo) DeleteByKey(ctx context.Context, tx boil.ContextTransactor, attemptKey string) error {
+	if _, err := entities.LoginAttempts(entities.LoginAttemptWhere.AttemptKey.EQ(attemptKey)).DeleteAll(ctx, tx); err != nil {
+		return errors.Wrap(err, "failed to delete loginAttempt")
+	}
+
+	return nil
+}
@@ -0,0 +1,50 @@
+package loginattemptrepo
+
+import (
+	"context"
+	"mysite/entities"
+	"mysite/pkgs/database"
+	"mysite/testing/dbtest"
+	"testing"
+	"time"
+
+	"github.com/friendsofgo/errors"
+	"github.com/stretchr/testify/require"
+	"github.com/volatiletech/sqlboiler/v4/boil"
+)
+
+func TestDeleteByKey(t *testing.T) {
+	t.Parallel()
+	require.NoError(t, database.SetupDatabase())
+	repo := NewRepo()
+	ctx := dbtest.SetTestTransactionCtx(context.Background())
+
+	{ // attempt removed, other keys are kept
+		var deleted, kept *entities.LoginAttempt
+		err := database.NewBoilerTransaction(ctx, func(ctx context.Context, tx boil.ContextTransactor) error {
+			if _, err := generateTestData(ctx, tx, "account:delete", 3, time.Now()); err != nil {
+				return errors.Wrap(err, "failed generate data")
+			}
+			if _, err := generateTestData(ctx, tx, "ip:delete", 3, time.Now()); err != nil {
+				return errors.Wrap(err, "failed generate data")
+			}
+
+			if err := repo.DeleteByKey(ctx, tx, "account:delete"); err != nil {
+				return errors.Wrap(err, "failed delete loginAttempt")
+			}
+
+			var err error
+			if deleted, err = repo.GetLoginAttemptByKey(ctx, tx, "account:delete"); err != nil {
+				return errors.Wrap(err, "failed get loginAttempt")
+			}
+			if kept, err = repo.GetLoginAttemptByKey(ctx, tx, "ip:delete"); err != nil {
+				return errors.Wrap(err, "failed get loginAttempt")
+			}
+			return nil
+		})
+
+		require.NoError(t, err)
+		require.Nil(t, deleted)
+		require.NotNil(t, kept)
+	}
+}
@@ -0,0 +1,25 @@
+package loginattemptrepo
+
+import (
+	"context"
+	"database/sql"
+	"mysite/entities"
+
+	"github.com/friendsofgo/errors"
+	"github.com/volatiletech/sqlboiler/v4/boil"
+	"github.com/volatiletech/sqlboiler/v4/queries/qm"
+)
+
+func (l loginAttemptRepo) GetLoginAttemptByKey(ctx context.Context, tx boil.ContextTransactor, attemptKey string) (*entities.LoginAttempt, error) {
+	mods := []qm.QueryMod{
+		entities.LoginAttemptWhere.AttemptKey.EQ(attemptKey),
+		qm.For("UPDATE"),
+	}
+
+	pgAttempt, err := entities.LoginAttempts(mods...).One(ctx, tx)
+	if err != nil && !errors.Is(err, sql.ErrNoRows) {
+		return nil, errors.Wrap(err, "failed to get loginAttempt")
+	}
+
+	return pgAttempt, nil
+}
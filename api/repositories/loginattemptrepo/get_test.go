@@ -0,0 +1,67 @@
+package loginattemptrepo
+
+import (
+	"context"
+	"mysite/entities"
+	"mysite/pkgs/database"
+	"mysite/testing/dbtest"
+	"testing"
+	"time"
+
+	"github.com/friendsofgo/errors"
+	"github.com/stretchr/testify/require"
+	"github.com/volatiletech/sqlboiler/v4/boil"
+)
+
+func generateTestData(ctx context.Context, tx boil.ContextTransactor, attemptKey string, failedCount int, lastFailedAt time.Time) (*entities.LoginAttempt, error) {
+	attempt := entities.LoginAttempt{
+		AttemptKey:   attemptKey,
+		FailedCount:  failedCount,
+		LastFailedAt: lastFailedAt,
+	}
+	if err := attempt.Insert(ctx, tx, boil.Infer()); err != nil {
+		return nil, errors.Wrap(err, "failed insert loginAttempt")
+	}
+	return &attempt, nil
+}
+
+func TestGetLoginAttemptByKey(t *testing.T) {
+	t.Parallel()
+	require.NoError(t, database.SetupDatabase())
+	repo := NewRepo()
+	ctx := dbtest.SetTestTransactionCtx(context.Background())
+
+	{ // found attempt
+		var expected, attempt *entities.LoginAttempt
+		err := database.NewBoilerTransaction(ctx, func(ctx context.Context, tx boil.ContextTransactor) error {
+			var err error
+			if expected, err = generateTestData(ctx, tx, "account:found", 2, time.Now()); err != nil {
+				return errors.Wrap(err, "failed generate data")
+			}
+
+			attempt, err = repo.GetLoginAttemptByKey(ctx, tx, "account:found")
+			if err != nil {
+				return errors.Wrap(err, "failed get loginAttempt")
+			}
+			return nil
+		})
+
+		require.NoError(t, err)
+		require.Equal(t, expected.ID, attempt.ID)
+		require.Equal(t, 2, attempt.FailedCount)
+	}
+	{ // not found attempt
+		var attempt *entities.LoginAttempt
+		err := database.NewBoilerTransaction(ctx, func(ctx context.Context, tx boil.ContextTransactor) error {
+			var err error
+			attempt, err = repo.GetLoginAttemptByKey(ctx, tx, "account:not-found")
+			if err != nil {
+				return errors.Wrap(err, "failed get loginAttempt")
+			}
+			return nil
+		})
+
+		require.NoError(t, err)
+		require.Nil(t, attempt)
+	}
+}
@@ -0,0 +1,35 @@
+package loginattemptrepo
+
+import (
+	"context"
+	"mysite/entities"
+	"time"
+
+	"github.com/volatiletech/sqlboiler/v4/boil"
+)
+
+type Get interface {
+	GetLoginAttemptByKey(ctx context.Context, tx boil.ContextTransactor, attemptKey string) (*entities.LoginAttempt, error)
+}
+
+type Update interface {
+	RecordFailure(ctx context.Context, tx boil.ContextTransactor, attemptKey string, staleBefore time.Time) (*entities.LoginAttempt, error)
+}
+
+type Delete interface {
+	DeleteByKey(ctx context.Context, tx boil.ContextTransactor, attemptKey string) error
+}
+
+//go:generate moq -pkg repomock -out ../../testing/mocking/repomock/loginattemptmock.go . LoginAttemptRepo
+type LoginAttemptRepo interface {
+	Get
+	Update
+	Delete
+}
+
+type loginAttemptRepo struct {
+}
+
+func NewRepo() LoginAttemptRepo {
+	return &loginAttemptRepo{}
+}
@@ -0,0 +1,46 @@
+package loginattemptrepo
+
+import (
+	"context"
+	"mysite/entities"
+	"time"
+
+	"github.com/friendsofgo/errors"
+	"github.com/volatiletech/null/v8"
+	"github.com/volatiletech/sqlboiler/v4/boil"
+)
+
+// RecordFailure counts a failed login of the key, the count starts over when the last failure is older than staleBefore
+func (l loginAttemptRepo) RecordFailure(ctx context.Context, tx boil.ContextTransactor, attemptKey string, staleBefore time.Time) (*entities.LoginAttempt, error) {
+	now := time.Now()
+
+	// concurrent first failures of the same key must not collide on the unique key
+	attempt := entities.LoginAttempt{
+		AttemptKey:   attemptKey,
+		LastFailedAt: now,
+	}
+	if err := attempt.Upsert(ctx, tx, false, []string{entities.LoginAttemptColumns.AttemptKey}, boil.None(), boil.Infer()); err != nil {
+		return nil, errors.Wrap(err, "failed to insert loginAttempt")
+	}
+
+	pgAttempt, err := l.GetLoginAttemptByKey(ctx, tx, attemptKey)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to get loginAttempt")
+	}
+	if pgAttempt == nil {
+		return nil, errors.New("loginAttempt not found")
+	}
+
+	if pgAttempt.LastFailedAt.Before(staleBefore) {
+		pgAttempt.FailedCount = 0
+	}
+	pgAttempt.FailedCount++
+	pgAttempt.LastFailedAt = now
+	pgAttempt.UpdatedAt = null.TimeFrom(now)
+	rowEffected, err := pgAttempt.Update(ctx, tx, boil.Infer())
+	if err != nil || rowEffected == 0 {
+		return nil, errors.Wrap(err, "failed to update loginAttempt")
+	}
+
+	return pgAttempt, nil
+}
@@ -0,0 +1,58 @@
+package loginattemptrepo
+
+import (
+	"context"
+	"mysite/entities"
+	"mysite/pkgs/database"
+	"mysite/testing/dbtest"
+	"testing"
+	"time"
+
+	"github.com/friendsofgo/errors"
+	"github.com/stretchr/testify/require"
+	"github.com/volatiletech/sqlboiler/v4/boil"
+)
+
+func TestRecordFailure(t *testing.T) {
+	t.Parallel()
+	require.NoError(t, database.SetupDatabase())
+	repo := NewRepo()
+	ctx := dbtest.SetTestTransactionCtx(context.Background())
+
+	{ // first failure creates the attempt, next one increments it
+		var first, second *entities.LoginAttempt
+		err := database.NewBoilerTransaction(ctx, func(ctx context.Context, tx boil.ContextTransactor) error {
+			var err error
+			staleBefore := time.Now().Add(-time.Hour)
+			if first, err = repo.RecordFailure(ctx, tx, "ip:127.0.0.1", staleBefore); err != nil {
+				return errors.Wrap(err, "failed record failure")
+			}
+			if second, err = repo.RecordFailure(ctx, tx, "ip:127.0.0.1", staleBefore); err != nil {
+				return errors.Wrap(err, "failed record failure")
+			}
+			return nil
+		})
+
+		require.NoError(t, err)
+		require.Equal(t, 1, first.FailedCount)
+		require.Equal(t, 2, second.FailedCount)
+		require.True(t, second.UpdatedAt.Valid)
+	}
+	{ // stale failures are forgotten
+		var attempt *entities.LoginAttempt
+		err := database.NewBoilerTransaction(ctx, func(ctx context.Context, tx boil.ContextTransactor) error {
+			if _, err := generateTestData(ctx, tx, "account:stale", 9, time.Now().Add(-2*time.Hour)); err != nil {
+				return errors.Wrap(err, "failed generate data")
+			}
+
+			var err error
+			if attempt, err = repo.RecordFailure(ctx, tx, "account:stale", time.Now().Add(-time.Hour)); err != nil {
+				return errors.Wrap(err, "failed record failure")
+			}
+			return nil
+		})
+
+		require.NoError(t, err)
+		require.Equal(t, 1, attempt.FailedCount)
+	}
+}
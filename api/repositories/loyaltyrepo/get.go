@@ -0,0 +1,68 @@
+package loyaltyrepo
+
+import (
+	"context"
+	"database/sql"
+	"mysite/entities"
+
+	"github.com/friendsofgo/errors"
+	"github.com/volatiletech/sqlboiler/v4/boil"
+	"github.com/volatiletech/sqlboiler/v4/queries/qm"
+)
+
+func (l loyaltyRepo) GetBalance(ctx context.Context, tx boil.ContextTransactor, userAccountId int) (int, error) {
+	var balance struct {
+		Points int `boil:"points"`
+	}
+	mods := []qm.QueryMod{
+		qm.Select("COALESCE(SUM(points), 0) AS points"),
+		entities.LoyaltyPointWhere.UserAccountID.EQ(userAccountId),
+	}
+
+	if err := entities.LoyaltyPoints(mods...).Bind(ctx, tx, &balance); err != nil {
+		return 0, errors.Wrap(err, "failed to get loyalty balance")
+	}
+
+	return balance.Points, nil
+}
+
+// GetBalanceForUpdate locks the userAccount row before summing the ledger,
+// a concurrent change of the points of the user waits until the transaction ends
+func (l loyaltyRepo) GetBalanceForUpdate(ctx context.Context, tx boil.ContextTransactor, userAccountId int) (int, error) {
+	mods := []qm.QueryMod{
+		qm.Select(entities.UserAccountColumns.ID),
+		entities.UserAccountWhere.ID.EQ(userAccountId),
+		qm.For("UPDATE"),
+	}
+	if _, err := entities.UserAccounts(mods...).One(ctx, tx); err != nil && !errors.Is(err, sql.ErrNoRows) {
+		return 0, errors.Wrap(err, "failed to lock userAccount")
+	}
+
+	return l.GetBalance(ctx, tx, userAccountId)
+}
+
+// GetBalances pages through every active user in id order, users without points have a zero balance.
+// Users whose latest tier change was made by an admin are left out so that the manual tier is kept
+func (l loyaltyRepo) GetBalances(ctx context.Context, tx boil.ContextTransactor, afterUserAccountId int, limit int) ([]Balance, error) {
+	var balances []Balance
+	mods := []qm.QueryMod{
+		qm.Select("user_account.id AS user_account_id", "COALESCE(SUM(lp.points), 0) AS points"),
+		qm.LeftOuterJoin("loyalty_point lp ON lp.user_account_id = user_account.id"),
+		entities.UserAccountWhere.ID.GT(afterUserAccountId),
+		entities.UserAccountWhere.IsActive.EQ(true),
+		entities.UserAccountWhere.IsDeleted.EQ(false),
+		qm.Where(`COALESCE((
+			SELECT mh.changed_by IS NOT NULL FROM membership_history mh
+			WHERE mh.user_account_id = user_account.id ORDER BY mh.id DESC LIMIT 1
+		), false) = false`),
+		qm.GroupBy("user_account.id"),
+		qm.OrderBy("user_account.id"),
+		qm.Limit(limit),
+	}
+
+	if err := entities.UserAccounts(mods...).Bind(ctx, tx, &balances); err != nil {
+		return nil, errors.Wrap(err, "failed to get loyalty balances")
+	}
+
+	return balances, nil
+}
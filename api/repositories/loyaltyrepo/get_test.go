@@ -0,0 +1,166 @@
+package loyaltyrepo
+
+import (
+	"context"
+	"mysite/constants"
+	"mysite/entities"
+	"mysite/pkgs/database"
+	"mysite/testing/dbtest"
+	"testing"
+	"time"
+
+	"github.com/friendsofgo/errors"
+	"github.com/stretchr/testify/require"
+	"github.com/volatiletech/null/v8"
+	"github.com/volatiletech/sqlboiler/v4/boil"
+)
+
+func TestGetBalance(t *testing.T) {
+	t.Parallel()
+	require.NoError(t, database.SetupDatabase())
+	repo := NewRepo()
+	ctx := dbtest.SetTestTransactionCtx(context.Background())
+
+	var (
+		balance, empty int
+		balances       []Balance
+		userIds        []int
+	)
+	err := database.NewBoilerTransaction(ctx, func(ctx context.Context, tx boil.ContextTransactor) error {
+		for _, userName := range []string{"loyalty-get-1", "loyalty-get-2"} {
+			userAccount := entities.UserAccount{
+				UserName: userName,
+				Password: "password",
+				IsActive: true,
+			}
+			if err := userAccount.Insert(ctx, tx, boil.Infer()); err != nil {
+				return errors.Wrap(err, "failed insert userAccount")
+			}
+			userIds = append(userIds, userAccount.ID)
+		}
+		for _, points := range []int{500, -200, 50} {
+			if err := repo.InsertPoint(ctx, tx, &entities.LoyaltyPoint{UserAccountID: userIds[0], Points: points, Reason: "test"}); err != nil {
+				return err
+			}
+		}
+
+		var err error
+		if balance, err = repo.GetBalance(ctx, tx, userIds[0]); err != nil {
+			return err
+		}
+		if empty, err = repo.GetBalance(ctx, tx, userIds[1]); err != nil {
+			return err
+		}
+		balances, err = repo.GetBalances(ctx, tx, userIds[0]-1, 2)
+		return err
+	})
+
+	require.NoError(t, err)
+	require.Equal(t, 350, balance)
+	require.Zero(t, empty)
+	require.Equal(t, []Balance{{UserAccountID: userIds[0], Points: 350}, {UserAccountID: userIds[1], Points: 0}}, balances)
+}
+
+func TestGetBalancesManualTier(t *testing.T) {
+	t.Parallel()
+	require.NoError(t, database.SetupDatabase())
+	repo := NewRepo()
+	ctx := dbtest.SetTestTransactionCtx(context.Background())
+
+	var (
+		balances []Balance
+		userIds  []int
+	)
+	err := database.NewBoilerTransaction(ctx, func(ctx context.Context, tx boil.ContextTransactor) error {
+		for _, userName := range []string{"loyalty-manual-admin", "loyalty-manual-system", "loyalty-manual-admin-changed"} {
+			userAccount := entities.UserAccount{
+				UserName: userName,
+				Password: "password",
+				IsActive: true,
+			}
+			if err := userAccount.Insert(ctx, tx, boil.Infer()); err != nil {
+				return errors.Wrap(err, "failed insert userAccount")
+			}
+			userIds = append(userIds, userAccount.ID)
+		}
+		histories := []entities.MembershipHistory{
+			// changed by the system
+			{UserAccountID: userIds[1], ToMembershipID: constants.Silver},
+			// changed by the system, then by the admin
+			{UserAccountID: userIds[2], ToMembershipID: constants.Silver},
+			{UserAccountID: userIds[2], ToMembershipID: constants.Gold, ChangedBy: null.IntFrom(userIds[0])},
+		}
+		for i := range histories {
+			if err := histories[i].Insert(ctx, tx, boil.Infer()); err != nil {
+				return errors.Wrap(err, "failed insert membershipHistory")
+			}
+		}
+
+		var err error
+		balances, err = repo.GetBalances(ctx, tx, userIds[0]-1, 3)
+		return err
+	})
+
+	require.NoError(t, err)
+	require.Equal(t, []Balance{{UserAccountID: userIds[0], Points: 0}, {UserAccountID: userIds[1], Points: 0}}, balances)
+}
+
+func TestGetBalanceForUpdate(t *testing.T) {
+	require.NoError(t, database.SetupDatabase())
+	repo := NewRepo()
+	ctx := context.Background()
+
+	// the rows are committed since the lock is only visible across two transactions
+	userAccount := entities.UserAccount{
+		UserName: "loyalty-lock",
+		Password: "password",
+		IsActive: true,
+	}
+	setup, err := boil.BeginTx(ctx, nil)
+	require.NoError(t, err)
+	require.NoError(t, userAccount.Insert(ctx, setup, boil.Infer()))
+	require.NoError(t, repo.InsertPoint(ctx, setup, &entities.LoyaltyPoint{UserAccountID: userAccount.ID, Points: 100, Reason: "test"}))
+	require.NoError(t, setup.Commit())
+	t.Cleanup(func() {
+		_, _ = entities.LoyaltyPoints(entities.LoyaltyPointWhere.UserAccountID.EQ(userAccount.ID)).DeleteAll(ctx, boil.GetContextDB())
+		_, _ = userAccount.Delete(ctx, boil.GetContextDB())
+	})
+
+	first, err := boil.BeginTx(ctx, nil)
+	require.NoError(t, err)
+	balance, err := repo.GetBalanceForUpdate(ctx, first, userAccount.ID)
+	require.NoError(t, err)
+	require.Equal(t, 100, balance)
+
+	// the second debit waits for the first one and sees the balance it left
+	done := make(chan int, 1)
+	go func() {
+		second, err := boil.BeginTx(ctx, nil)
+		if err != nil {
+			done <- -1
+			return
+		}
+		defer func() { _ = second.Rollback() }()
+		balance, err := repo.GetBalanceForUpdate(ctx, second, userAccount.ID)
+		if err != nil {
+			done <- -1
+			return
+		}
+		done <- balance
+	}()
+
+	select {
+	case <-done:
+		t.Fatal("second transaction did not wait for the lock")
+	case <-time.After(200 * time.Millisecond):
+	}
+	require.NoError(t, repo.InsertPoint(ctx, first, &entities.LoyaltyPoint{UserAccountID: userAccount.ID, Points: -100, Reason: "test"}))
+	require.NoError(t, first.Commit())
+
+	select {
+	case balance := <-done:
+		require.Zero(t, balance)
+	case <-time.After(5 * time.Second):
+		t.Fatal("second transaction did not get the lock")
+	}
+}
@@ -0,0 +1,16 @@
+package loyaltyrepo
+
+import (
+	"context"
+	"mysite/entities"
+
+	"github.com/friendsofgo/errors"
+	"github.com/volatiletech/sqlboiler/v4/boil"
+)
+
+func (l loyaltyRepo) InsertPoint(ctx context.Context, tx boil.ContextTransactor, point *entities.LoyaltyPoint) error {
+	if err := point.Insert(ctx, tx, boil.Infer()); err != nil {
+		return errors.Wrap(err, "failed to insert loyaltyPoint")
+	}
+	return nil
+}
@@ -0,0 +1,45 @@
+package loyaltyrepo
+
+import (
+	"context"
+	"mysite/entities"
+	"mysite/pkgs/database"
+	"mysite/testing/dbtest"
+	"testing"
+
+	"github.com/friendsofgo/errors"
+	"github.com/stretchr/testify/require"
+	"github.com/volatiletech/sqlboiler/v4/boil"
+)
+
+func TestInsertPoint(t *testing.T) {
+	t.Parallel()
+	require.NoError(t, database.SetupDatabase())
+	repo := NewRepo()
+	ctx := dbtest.SetTestTransactionCtx(context.Background())
+
+	{ // credit
+		var point entities.LoyaltyPoint
+		err := database.NewBoilerTransaction(ctx, func(ctx context.Context, tx boil.ContextTransactor) error {
+			userAccount := entities.UserAccount{
+				UserName: "loyalty-insert",
+				Password: "password",
+				IsActive: true,
+			}
+			if err := userAccount.Insert(ctx, tx, boil.Infer()); err != nil {
+				return errors.Wrap(err, "failed insert userAccount")
+			}
+
+			point = entities.LoyaltyPoint{
+				UserAccountID: userAccount.ID,
+				Points:        100,
+				Reason:        "order",
+			}
+			return repo.InsertPoint(ctx, tx, &point)
+		})
+
+		require.NoError(t, err)
+		require.NotZero(t, point.ID)
+		require.False(t, point.CreatedAt.IsZero())
+	}
+}
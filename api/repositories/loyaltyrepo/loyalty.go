@@ -0,0 +1,37 @@
+package loyaltyrepo
+
+import (
+	"context"
+	"mysite/entities"
+
+	"github.com/volatiletech/sqlboiler/v4/boil"
+)
+
+// Balance is the sum of the ledger of one user
+type Balance struct {
+	UserAccountID int `boil:"user_account_id"`
+	Points        int `boil:"points"`
+}
+
+type Get interface {
+	GetBalance(ctx context.Context, tx boil.ContextTransactor, userAccountId int) (int, error)
+	GetBalanceForUpdate(ctx context.Context, tx boil.ContextTransactor, userAccountId int) (int, error)
+	GetBalances(ctx context.Context, tx boil.ContextTransactor, afterUserAccountId int, limit int) ([]Balance, error)
+}
+
+type Insert interface {
+	InsertPoint(ctx context.Context, tx boil.ContextTransactor, point *entities.LoyaltyPoint) error
+}
+
+//go:generate moq -pkg repomock -out ../../testing/mocking/repomock/loyaltymock.go . LoyaltyRepo
+type LoyaltyRepo interface {
+	Get
+	Insert
+}
+
+type loyaltyRepo struct {
+}
+
+func NewRepo() LoyaltyRepo {
+	return &loyaltyRepo{}
+}
@@ -0,0 +1,26 @@
+package magiclinkrepo
+
+import (
+	"context"
+	"database/sql"
+	"mysite/entities"
+
+	"github.com/friendsofgo/errors"
+	"github.com/volatiletech/sqlboiler/v4/boil"
+	"github.com/volatiletech/sqlboiler/v4/queries/qm"
+)
+
+// GetMagicLinkTokenByJti locks the row, two requests with the same link can not both use it
+func (m magicLinkRepo) GetMagicLinkTokenByJti(ctx context.Context, tx boil.ContextTransactor, jti string) (*entities.MagicLinkToken, error) {
+	mods := []qm.QueryMod{
+		entities.MagicLinkTokenWhere.Jti.EQ(jti),
+		qm.For("UPDATE"),
+	}
+
+	pgToken, err := entities.MagicLinkTokens(mods...).One(ctx, tx)
+	if err != nil && !errors.Is(err, sql.ErrNoRows) {
+		return nil, errors.Wrap(err, "failed to get magicLinkToken")
+	}
+
+	return pgToken, nil
+}
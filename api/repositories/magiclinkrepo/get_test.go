@@ -0,0 +1,75 @@
+package magiclinkrepo
+
+import (
+	"context"
+	"mysite/entities"
+	"mysite/pkgs/database"
+	"mysite/testing/dbtest"
+	"testing"
+	"time"
+
+	"github.com/friendsofgo/errors"
+	"github.com/stretchr/testify/require"
+	"github.com/volatiletech/sqlboiler/v4/boil"
+)
+
+func generateTestData(ctx context.Context, tx boil.ContextTransactor, jti string) (*entities.MagicLinkToken, error) {
+	userAccount := entities.UserAccount{
+		UserName: "userName",
+		Password: "password",
+		IsActive: true,
+	}
+	if err := userAccount.Insert(ctx, tx, boil.Infer()); err != nil {
+		return nil, errors.Wrap(err, "failed insert userAccount")
+	}
+
+	token := entities.MagicLinkToken{
+		UserAccountID: userAccount.ID,
+		Jti:           jti,
+		ExpiresAt:     time.Now().Add(time.Hour),
+	}
+	if err := token.Insert(ctx, tx, boil.Infer()); err != nil {
+		return nil, errors.Wrap(err, "failed insert magicLinkToken")
+	}
+	return &token, nil
+}
+
+func TestGetMagicLinkTokenByJti(t *testing.T) {
+	t.Parallel()
+	require.NoError(t, database.SetupDatabase())
+	repo := NewRepo()
+	ctx := dbtest.SetTestTransactionCtx(context.Background())
+
+	{ // found token
+		var token *entities.MagicLinkToken
+		err := database.NewBoilerTransaction(ctx, func(ctx context.Context, tx boil.ContextTransactor) error {
+			if _, err := generateTestData(ctx, tx, "found-jti"); err != nil {
+				return errors.Wrap(err, "failed generate data")
+			}
+
+			var err error
+			token, err = repo.GetMagicLinkTokenByJti(ctx, tx, "found-jti")
+			if err != nil {
+				return errors.Wrap(err, "failed get magicLinkToken")
+			}
+			return nil
+		})
+
+		require.NoError(t, err)
+		require.Equal(t, "found-jti", token.Jti)
+	}
+	{ // not found token
+		var token *entities.MagicLinkToken
+		err := database.NewBoilerTransaction(ctx, func(ctx context.Context, tx boil.ContextTransactor) error {
+			var err error
+			token, err = repo.GetMagicLinkTokenByJti(ctx, tx, "not-found-jti")
+			if err != nil {
+				return errors.Wrap(err, "failed get magicLinkToken")
+			}
+			return nil
+		})
+
+		require.NoError(t, err)
+		require.Nil(t, token)
+	}
+}
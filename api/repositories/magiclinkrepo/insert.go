@@ -0,0 +1,17 @@
+package magiclinkrepo
+
+import (
+	"context"
+	"mysite/entities"
+
+	"github.com/friendsofgo/errors"
+	"github.com/volatiletech/sqlboiler/v4/boil"
+)
+
+func (m magicLinkRepo) Insert(ctx context.Context, tx boil.ContextTransactor, token *entities.MagicLinkToken) error {
+	if err := token.Insert(ctx, tx, boil.Infer()); err != nil {
+		return errors.Wrap(err, "failed to insert magicLinkToken")
+	}
+
+	return nil
+}
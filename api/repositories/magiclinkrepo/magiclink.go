@@ -0,0 +1,37 @@
+package magiclinkrepo
+
+import (
+	"context"
+	"mysite/entities"
+
+	"github.com/volatiletech/sqlboiler/v4/boil"
+)
+
+type Get interface {
+	GetMagicLinkTokenByJti(ctx context.Context, tx boil.ContextTransactor, jti string) (*entities.MagicLinkToken, error)
+}
+
+type Insert interface {
+	Insert(ctx context.Context, tx boil.ContextTransactor, token *entities.MagicLinkToken) error
+}
+
+type Update interface {
+	MarkUsed(ctx context.Context, tx boil.ContextTransactor, token entities.MagicLinkToken) error
+}
+
+type Delete interface{}
+
+//go:generate moq -pkg repomock -out ../../testing/mocking/repomock/magiclinkmock.go . MagicLinkRepo
+type MagicLinkRepo interface {
+	Get
+	Insert
+	Update
+	Delete
+}
+
+type magicLinkRepo struct {
+}
+
+func NewRepo() MagicLinkRepo {
+	return &magicLinkRepo{}
+}
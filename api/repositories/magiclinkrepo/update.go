@@ -0,0 +1,22 @@
+package magiclinkrepo
+
+import (
+	"context"
+	"mysite/entities"
+	"time"
+
+	"github.com/friendsofgo/errors"
+	"github.com/volatiletech/null/v8"
+	"github.com/volatiletech/sqlboiler/v4/boil"
+)
+
+func (m magicLinkRepo) MarkUsed(ctx context.Context, tx boil.ContextTransactor, token entities.MagicLinkToken) error {
+	now := time.Now()
+	token.UsedAt = null.TimeFrom(now)
+	token.UpdatedAt = null.TimeFrom(now)
+	rowEffected, err := token.Update(ctx, tx, boil.Whitelist(entities.MagicLinkTokenColumns.UsedAt, entities.MagicLinkTokenColumns.UpdatedAt))
+	if err != nil || rowEffected == 0 {
+		return errors.Wrap(err, "failed to update magicLinkToken")
+	}
+	return nil
+}
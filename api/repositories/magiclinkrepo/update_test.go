@@ -0,0 +1,43 @@
+package magiclinkrepo
+
+import (
+	"context"
+	"mysite/entities"
+	"mysite/pkgs/database"
+	"mysite/testing/dbtest"
+	"testing"
+
+	"github.com/friendsofgo/errors"
+	"github.com/stretchr/testify/require"
+	"github.com/volatiletech/sqlboiler/v4/boil"
+)
+
+func TestMarkUsed(t *testing.T) {
+	t.Parallel()
+	require.NoError(t, database.SetupDatabase())
+	repo := NewRepo()
+	ctx := dbtest.SetTestTransactionCtx(context.Background())
+
+	{ // mark used success
+		var result *entities.MagicLinkToken
+		err := database.NewBoilerTransaction(ctx, func(ctx context.Context, tx boil.ContextTransactor) error {
+			token, err := generateTestData(ctx, tx, "mark-used-jti")
+			if err != nil {
+				return errors.Wrap(err, "failed generate data")
+			}
+
+			if err := repo.MarkUsed(ctx, tx, *token); err != nil {
+				return errors.Wrap(err, "failed to mark used")
+			}
+
+			result, err = repo.GetMagicLinkTokenByJti(ctx, tx, "mark-used-jti")
+			if err != nil {
+				return errors.Wrap(err, "failed GetMagicLinkTokenByJti")
+			}
+			return nil
+		})
+
+		require.NoError(t, err)
+		require.True(t, result.UsedAt.Valid)
+	}
+}
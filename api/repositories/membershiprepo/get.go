@@ -0,0 +1,43 @@
+package membershiprepo
+
+import (
+	"context"
+	"database/sql"
+	"mysite/constants"
+	"mysite/entities"
+
+	"github.com/friendsofgo/errors"
+	"github.com/volatiletech/sqlboiler/v4/boil"
+	"github.com/volatiletech/sqlboiler/v4/queries/qm"
+)
+
+func (m membershipRepo) GetMembershipById(ctx context.Context, tx boil.ContextTransactor, id int) (*entities.Membership, error) {
+	pgMembership, err := entities.Memberships(entities.MembershipWhere.ID.EQ(id)).One(ctx, tx)
+	if err != nil && !errors.Is(err, sql.ErrNoRows) {
+		return nil, errors.Wrap(err, "failed to get membership")
+	}
+
+	return pgMembership, nil
+}
+
+func (m membershipRepo) GetMembershipByName(ctx context.Context, tx boil.ContextTransactor, name string) (*entities.Membership, error) {
+	pgMembership, err := entities.Memberships(entities.MembershipWhere.Name.EQ(name)).One(ctx, tx)
+	if err != nil && !errors.Is(err, sql.ErrNoRows) {
+		return nil, errors.Wrap(err, "failed to get membership")
+	}
+
+	return pgMembership, nil
+}
+
+// GetCurrentMembershipByUserAccountId returns the tier of the first userInfo of the user, bronze when it has none yet
+func (m membershipRepo) GetCurrentMembershipByUserAccountId(ctx context.Context, tx boil.ContextTransactor, userAccountId int) (*entities.Membership, error) {
+	mods := []qm.QueryMod{
+		qm.Where("membership.id = COALESCE((SELECT ui.membership_id FROM user_info ui WHERE ui.user_account_id = ? AND ui.is_deleted = false ORDER BY ui.id LIMIT 1), ?)", userAccountId, constants.Bronze),
+	}
+
+	pgMembership, err := entities.Memberships(mods...).One(ctx, tx)
+	if err != nil && !errors.Is(err, sql.ErrNoRows) {
+		return nil, errors.Wrap(err, "failed to get current membership")
+	}
+	return pgMembership, nil
+}
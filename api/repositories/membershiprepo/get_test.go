@@ -0,0 +1,93 @@
+package membershiprepo
+
+import (
+	"context"
+	"mysite/constants"
+	"mysite/entities"
+	"mysite/pkgs/database"
+	"mysite/testing/dbtest"
+	"testing"
+
+	"github.com/friendsofgo/errors"
+	"github.com/stretchr/testify/require"
+	"github.com/volatiletech/null/v8"
+	"github.com/volatiletech/sqlboiler/v4/boil"
+)
+
+func TestGetMembership(t *testing.T) {
+	t.Parallel()
+	require.NoError(t, database.SetupDatabase())
+	repo := NewRepo()
+	ctx := dbtest.SetTestTransactionCtx(context.Background())
+
+	{ // seeded tiers match the constants
+		var bronze, gold *entities.Membership
+		err := database.NewBoilerTransaction(ctx, func(ctx context.Context, tx boil.ContextTransactor) error {
+			var err error
+			if bronze, err = repo.GetMembershipById(ctx, tx, constants.Bronze); err != nil {
+				return err
+			}
+			gold, err = repo.GetMembershipByName(ctx, tx, "gold")
+			return err
+		})
+
+		require.NoError(t, err)
+		require.Equal(t, "bronze", bronze.Name)
+		require.NotEmpty(t, bronze.Benefits)
+		require.Equal(t, constants.Gold, gold.ID)
+		require.Greater(t, gold.Rank, bronze.Rank)
+	}
+	{ // unknown tier
+		var byId, byName *entities.Membership
+		err := database.NewBoilerTransaction(ctx, func(ctx context.Context, tx boil.ContextTransactor) error {
+			var err error
+			if byId, err = repo.GetMembershipById(ctx, tx, -1); err != nil {
+				return err
+			}
+			byName, err = repo.GetMembershipByName(ctx, tx, "unknown")
+			return err
+		})
+
+		require.NoError(t, err)
+		require.Nil(t, byId)
+		require.Nil(t, byName)
+	}
+}
+
+func TestGetCurrentMembershipByUserAccountId(t *testing.T) {
+	t.Parallel()
+	require.NoError(t, database.SetupDatabase())
+	repo := NewRepo()
+	ctx := dbtest.SetTestTransactionCtx(context.Background())
+
+	var withoutInfo, withTier *entities.Membership
+	err := database.NewBoilerTransaction(ctx, func(ctx context.Context, tx boil.ContextTransactor) error {
+		userAccount := entities.UserAccount{
+			UserName: "membership-current",
+			Password: "password",
+			IsActive: true,
+		}
+		if err := userAccount.Insert(ctx, tx, boil.Infer()); err != nil {
+			return errors.Wrap(err, "failed insert userAccount")
+		}
+
+		var err error
+		if withoutInfo, err = repo.GetCurrentMembershipByUserAccountId(ctx, tx, userAccount.ID); err != nil {
+			return err
+		}
+
+		userInfo := entities.UserInfo{
+			UserAccountID: userAccount.ID,
+			MembershipID:  null.IntFrom(constants.Gold),
+		}
+		if err := userInfo.Insert(ctx, tx, boil.Infer()); err != nil {
+			return errors.Wrap(err, "failed insert userInfo")
+		}
+		withTier, err = repo.GetCurrentMembershipByUserAccountId(ctx, tx, userAccount.ID)
+		return err
+	})
+
+	require.NoError(t, err)
+	require.Equal(t, constants.Bronze, withoutInfo.ID)
+	require.Equal(t, constants.Gold, withTier.ID)
+}
@@ -0,0 +1,18 @@
+package membershiprepo
+
+import (
+	"context"
+	"mysite/entities"
+
+	"github.com/friendsofgo/errors"
+	"github.com/volatiletech/sqlboiler/v4/boil"
+)
+
+// InsertHistory records a tier change, ChangedBy is null when the system changed the tier
+func (m membershipRepo) InsertHistory(ctx context.Context, tx boil.ContextTransactor, history *entities.MembershipHistory) error {
+	if err := history.Insert(ctx, tx, boil.Infer()); err != nil {
+		return errors.Wrap(err, "failed to insert membershipHistory")
+	}
+
+	return nil
+}
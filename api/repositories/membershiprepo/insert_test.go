@@ -0,0 +1,48 @@
+package membershiprepo
+
+import (
+	"context"
+	"mysite/constants"
+	"mysite/entities"
+	"mysite/pkgs/database"
+	"mysite/testing/dbtest"
+	"testing"
+
+	"github.com/friendsofgo/errors"
+	"github.com/stretchr/testify/require"
+	"github.com/volatiletech/null/v8"
+	"github.com/volatiletech/sqlboiler/v4/boil"
+)
+
+func TestInsertHistory(t *testing.T) {
+	t.Parallel()
+	require.NoError(t, database.SetupDatabase())
+	repo := NewRepo()
+	ctx := dbtest.SetTestTransactionCtx(context.Background())
+
+	{ // history of a tier change
+		var history entities.MembershipHistory
+		err := database.NewBoilerTransaction(ctx, func(ctx context.Context, tx boil.ContextTransactor) error {
+			userAccount := entities.UserAccount{
+				UserName: "membership-history",
+				Password: "password",
+				IsActive: true,
+			}
+			if err := userAccount.Insert(ctx, tx, boil.Infer()); err != nil {
+				return errors.Wrap(err, "failed insert userAccount")
+			}
+
+			history = entities.MembershipHistory{
+				UserAccountID:    userAccount.ID,
+				FromMembershipID: null.IntFrom(constants.Bronze),
+				ToMembershipID:   constants.Silver,
+				Reason:           null.StringFrom("promotion"),
+			}
+			return repo.InsertHistory(ctx, tx, &history)
+		})
+
+		require.NoError(t, err)
+		require.NotZero(t, history.ID)
+		require.False(t, history.ChangedBy.Valid)
+	}
+}
@@ -0,0 +1,31 @@
+package membershiprepo
+
+import (
+	"context"
+	"mysite/entities"
+
+	"github.com/volatiletech/sqlboiler/v4/boil"
+)
+
+type Get interface {
+	GetMembershipById(ctx context.Context, tx boil.ContextTransactor, id int) (*entities.Membership, error)
+	GetMembershipByName(ctx context.Context, tx boil.ContextTransactor, name string) (*entities.Membership, error)
+	GetCurrentMembershipByUserAccountId(ctx context.Context, tx boil.ContextTransactor, userAccountId int) (*entities.Membership, error)
+}
+
+type Insert interface {
+	InsertHistory(ctx context.Context, tx boil.ContextTransactor, history *entities.MembershipHistory) error
+}
+
+//go:generate moq -pkg repomock -out ../../testing/mocking/repomock/membershipmock.go . MembershipRepo
+type MembershipRepo interface {
+	Get
+	Insert
+}
+
+type membershipRepo struct {
+}
+
+func NewRepo() MembershipRepo {
+	return &membershipRepo{}
+}
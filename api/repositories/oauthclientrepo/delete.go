@@ -0,0 +1,18 @@
+package oauthclientrepo
+
+import (
+	"context"
+	"mysite/entities"
+
+	"github.com/friendsofgo/errors"
+	"github.com/volatiletech/sqlboiler/v4/boil"
+)
+
+// Delete removes the client with its codes and sessions, false when there is no such client
+func (o oauthClientRepo) Delete(ctx context.Context, tx boil.ContextTransactor, clientId string) (bool, error) {
+	rowEffected, err := entities.OauthClients(entities.OauthClientWhere.ClientID.EQ(clientId)).DeleteAll(ctx, tx)
+	if err != nil {
+		return false, errors.Wrap(err, "failed to delete oauthClient")
+	}
+	return rowEffected > 0, nil
+}
@@ -0,0 +1,38 @@
+package oauthclientrepo
+
+import (
+	"context"
+	"database/sql"
+	"mysite/entities"
+
+	"github.com/friendsofgo/errors"
+	"github.com/volatiletech/sqlboiler/v4/boil"
+	"github.com/volatiletech/sqlboiler/v4/queries/qm"
+)
+
+func (o oauthClientRepo) GetOauthClientByClientId(ctx context.Context, tx boil.ContextTransactor, clientId string) (*entities.OauthClient, error) {
+	pgClient, err := entities.OauthClients(entities.OauthClientWhere.ClientID.EQ(clientId)).One(ctx, tx)
+	if err != nil && !errors.Is(err, sql.ErrNoRows) {
+		return nil, errors.Wrap(err, "failed to get oauthClient")
+	}
+
+	return pgClient, nil
+}
+
+func (o oauthClientRepo) GetOauthClientById(ctx context.Context, tx boil.ContextTransactor, oauthClientId int) (*entities.OauthClient, error) {
+	pgClient, err := entities.OauthClients(entities.OauthClientWhere.ID.EQ(oauthClientId)).One(ctx, tx)
+	if err != nil && !errors.Is(err, sql.ErrNoRows) {
+		return nil, errors.Wrap(err, "failed to get oauthClient")
+	}
+
+	return pgClient, nil
+}
+
+func (o oauthClientRepo) GetOauthClients(ctx context.Context, tx boil.ContextTransactor) (entities.OauthClientSlice, error) {
+	pgClients, err := entities.OauthClients(qm.OrderBy(entities.OauthClientColumns.ID)).All(ctx, tx)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to get oauthClients")
+	}
+
+	return pgClients, nil
+}
@@ -0,0 +1,67 @@
+package oauthclientrepo
+
+import (
+	"context"
+	"mysite/entities"
+	"mysite/pkgs/database"
+	"mysite/testing/dbtest"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"github.com/volatiletech/null/v8"
+	"github.com/volatiletech/sqlboiler/v4/boil"
+	"github.com/volatiletech/sqlboiler/v4/types"
+)
+
+func TestOauthClient(t *testing.T) {
+	t.Parallel()
+	require.NoError(t, database.SetupDatabase())
+	repo := NewRepo()
+	ctx := dbtest.SetTestTransactionCtx(context.Background())
+
+	var (
+		byClientId, byId, afterDelete *entities.OauthClient
+		list                          entities.OauthClientSlice
+		deleted, deletedAgain         bool
+	)
+	err := database.NewBoilerTransaction(ctx, func(ctx context.Context, tx boil.ContextTransactor) error {
+		client := entities.OauthClient{
+			ClientID:     "client-get",
+			SecretHash:   null.StringFrom("hash"),
+			Name:         "dashboard",
+			RedirectUris: types.StringArray{"https://example.com/callback"},
+			GrantTypes:   types.StringArray{"authorization_code"},
+			Scopes:       types.StringArray{"users:read"},
+		}
+		if err := repo.Insert(ctx, tx, &client); err != nil {
+			return err
+		}
+
+		var err error
+		if byClientId, err = repo.GetOauthClientByClientId(ctx, tx, "client-get"); err != nil {
+			return err
+		}
+		if byId, err = repo.GetOauthClientById(ctx, tx, client.ID); err != nil {
+			return err
+		}
+		if list, err = repo.GetOauthClients(ctx, tx); err != nil {
+			return err
+		}
+		if deleted, err = repo.Delete(ctx, tx, "client-get"); err != nil {
+			return err
+		}
+		if deletedAgain, err = repo.Delete(ctx, tx, "client-get"); err != nil {
+			return err
+		}
+		afterDelete, err = repo.GetOauthClientByClientId(ctx, tx, "client-get")
+		return err
+	})
+
+	require.NoError(t, err)
+	require.Equal(t, "dashboard", byClientId.Name)
+	require.Equal(t, byClientId.ID, byId.ID)
+	require.Len(t, list, 1)
+	require.True(t, deleted)
+	require.False(t, deletedAgain)
+	require.Nil(t, afterDelete)
+}
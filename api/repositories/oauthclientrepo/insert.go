@@ -0,0 +1,16 @@
+package oauthclientrepo
+
+import (
+	"context"
+	"mysite/entities"
+
+	"github.com/friendsofgo/errors"
+	"github.com/volatiletech/sqlboiler/v4/boil"
+)
+
+func (o oauthClientRepo) Insert(ctx context.Context, tx boil.ContextTransactor, client *entities.OauthClient) error {
+	if err := client.Insert(ctx, tx, boil.Infer()); err != nil {
+		return errors.Wrap(err, "failed to insert oauthClient")
+	}
+	return nil
+}
@@ -0,0 +1,36 @@
+package oauthclientrepo
+
+import (
+	"context"
+	"mysite/entities"
+
+	"github.com/volatiletech/sqlboiler/v4/boil"
+)
+
+type Get interface {
+	GetOauthClientByClientId(ctx context.Context, tx boil.ContextTransactor, clientId string) (*entities.OauthClient, error)
+	GetOauthClientById(ctx context.Context, tx boil.ContextTransactor, oauthClientId int) (*entities.OauthClient, error)
+	GetOauthClients(ctx context.Context, tx boil.ContextTransactor) (entities.OauthClientSlice, error)
+}
+
+type Insert interface {
+	Insert(ctx context.Context, tx boil.ContextTransactor, client *entities.OauthClient) error
+}
+
+type Delete interface {
+	Delete(ctx context.Context, tx boil.ContextTransactor, clientId string) (bool, error)
+}
+
+//go:generate moq -pkg repomock -out ../../testing/mocking/repomock/oauthclientmock.go . OauthClientRepo
+type OauthClientRepo interface {
+	Get
+	Insert
+	Delete
+}
+
+type oauthClientRepo struct {
+}
+
+func NewRepo() OauthClientRepo {
+	return &oauthClientRepo{}
+}
@@ -0,0 +1,19 @@
+package oauthcoderepo
+
+import (
+	"context"
+	"database/sql"
+	"mysite/entities"
+
+	"github.com/friendsofgo/errors"
+	"github.com/volatiletech/sqlboiler/v4/boil"
+)
+
+func (o oauthCodeRepo) GetOauthAuthorizationCodeByHash(ctx context.Context, tx boil.ContextTransactor, codeHash string) (*entities.OauthAuthorizationCode, error) {
+	pgCode, err := entities.OauthAuthorizationCodes(entities.OauthAuthorizationCodeWhere.CodeHash.EQ(codeHash)).One(ctx, tx)
+	if err != nil && !errors.Is(err, sql.ErrNoRows) {
+		return nil, errors.Wrap(err, "failed to get oauthAuthorizationCode")
+	}
+
+	return pgCode, nil
+}
@@ -0,0 +1,16 @@
+package oauthcoderepo
+
+import (
+	"context"
+	"mysite/entities"
+
+	"github.com/friendsofgo/errors"
+	"github.com/volatiletech/sqlboiler/v4/boil"
+)
+
+func (o oauthCodeRepo) Insert(ctx context.Context, tx boil.ContextTransactor, code *entities.OauthAuthorizationCode) error {
+	if err := code.Insert(ctx, tx, boil.Infer()); err != nil {
+		return errors.Wrap(err, "failed to insert oauthAuthorizationCode")
+	}
+	return nil
+}
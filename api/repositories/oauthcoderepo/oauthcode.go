@@ -0,0 +1,34 @@
+package oauthcoderepo
+
+import (
+	"context"
+	"mysite/entities"
+
+	"github.com/volatiletech/sqlboiler/v4/boil"
+)
+
+type Get interface {
+	GetOauthAuthorizationCodeByHash(ctx context.Context, tx boil.ContextTransactor, codeHash string) (*entities.OauthAuthorizationCode, error)
+}
+
+type Insert interface {
+	Insert(ctx context.Context, tx boil.ContextTransactor, code *entities.OauthAuthorizationCode) error
+}
+
+type Update interface {
+	MarkUsed(ctx context.Context, tx boil.ContextTransactor, codeId int, familyId string) (bool, error)
+}
+
+//go:generate moq -pkg repomock -out ../../testing/mocking/repomock/oauthcodemock.go . OauthCodeRepo
+type OauthCodeRepo interface {
+	Get
+	Insert
+	Update
+}
+
+type oauthCodeRepo struct {
+}
+
+func NewRepo() OauthCodeRepo {
+	return &oauthCodeRepo{}
+}
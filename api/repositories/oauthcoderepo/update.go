@@ -0,0 +1,29 @@
+package oauthcoderepo
+
+import (
+	"context"
+	"mysite/entities"
+	"time"
+
+	"github.com/friendsofgo/errors"
+	"github.com/volatiletech/null/v8"
+	"github.com/volatiletech/sqlboiler/v4/boil"
+	"github.com/volatiletech/sqlboiler/v4/queries/qm"
+)
+
+// MarkUsed redeems the code for the session family, false when it was already redeemed
+func (o oauthCodeRepo) MarkUsed(ctx context.Context, tx boil.ContextTransactor, codeId int, familyId string) (bool, error) {
+	mods := []qm.QueryMod{
+		entities.OauthAuthorizationCodeWhere.ID.EQ(codeId),
+		entities.OauthAuthorizationCodeWhere.UsedAt.IsNull(),
+	}
+
+	rowEffected, err := entities.OauthAuthorizationCodes(mods...).UpdateAll(ctx, tx, entities.M{
+		entities.OauthAuthorizationCodeColumns.UsedAt:   null.TimeFrom(time.Now()),
+		entities.OauthAuthorizationCodeColumns.FamilyID: null.StringFrom(familyId),
+	})
+	if err != nil {
+		return false, errors.Wrap(err, "failed to mark oauthAuthorizationCode used")
+	}
+	return rowEffected > 0, nil
+}
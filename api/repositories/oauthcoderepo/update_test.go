@@ -0,0 +1,65 @@
+package oauthcoderepo
+
+import (
+	"context"
+	"mysite/entities"
+	"mysite/pkgs/database"
+	"mysite/testing/dbtest"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/require"
+	"github.com/volatiletech/sqlboiler/v4/boil"
+)
+
+func TestOauthAuthorizationCode(t *testing.T) {
+	t.Parallel()
+	require.NoError(t, database.SetupDatabase())
+	repo := NewRepo()
+	ctx := dbtest.SetTestTransactionCtx(context.Background())
+	familyId := uuid.NewString()
+
+	var (
+		redeemed, redeemedAgain bool
+		stored                  *entities.OauthAuthorizationCode
+	)
+	err := database.NewBoilerTransaction(ctx, func(ctx context.Context, tx boil.ContextTransactor) error {
+		userAccount := entities.UserAccount{UserName: "oauth-code", Password: "password", IsActive: true}
+		if err := userAccount.Insert(ctx, tx, boil.Infer()); err != nil {
+			return err
+		}
+		client := entities.OauthClient{ClientID: "client-code", Name: "dashboard"}
+		if err := client.Insert(ctx, tx, boil.Infer()); err != nil {
+			return err
+		}
+
+		code := entities.OauthAuthorizationCode{
+			CodeHash:      "code-hash",
+			OauthClientID: client.ID,
+			UserAccountID: userAccount.ID,
+			RedirectURI:   "https://example.com/callback",
+			CodeChallenge: "challenge",
+			ExpiresAt:     time.Now().Add(time.Minute),
+		}
+		if err := repo.Insert(ctx, tx, &code); err != nil {
+			return err
+		}
+
+		var err error
+		if redeemed, err = repo.MarkUsed(ctx, tx, code.ID, familyId); err != nil {
+			return err
+		}
+		if redeemedAgain, err = repo.MarkUsed(ctx, tx, code.ID, familyId); err != nil {
+			return err
+		}
+		stored, err = repo.GetOauthAuthorizationCodeByHash(ctx, tx, "code-hash")
+		return err
+	})
+
+	require.NoError(t, err)
+	require.True(t, redeemed)
+	require.False(t, redeemedAgain)
+	require.True(t, stored.UsedAt.Valid)
+	require.Equal(t, familyId, stored.FamilyID.String)
+}
@@ -0,0 +1,25 @@
+package passwordresetrepo
+
+import (
+	"context"
+	"database/sql"
+	"mysite/entities"
+
+	"github.com/friendsofgo/errors"
+	"github.com/volatiletech/sqlboiler/v4/boil"
+	"github.com/volatiletech/sqlboiler/v4/queries/qm"
+)
+
+func (p passwordResetRepo) GetPasswordResetTokenByHash(ctx context.Context, tx boil.ContextTransactor, tokenHash string) (*entities.PasswordResetToken, error) {
+	mods := []qm.QueryMod{
+		entities.PasswordResetTokenWhere.TokenHash.EQ(tokenHash),
+		qm.For("UPDATE"),
+	}
+
+	pgToken, err := entities.PasswordResetTokens(mods...).One(ctx, tx)
+	if err != nil && !errors.Is(err, sql.ErrNoRows) {
+		return nil, errors.Wrap(err, "failed to get passwordResetToken")
+	}
+
+	return pgToken, nil
+}
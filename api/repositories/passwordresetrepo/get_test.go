@@ -0,0 +1,75 @@
+package passwordresetrepo
+
+import (
+	"context"
+	"mysite/entities"
+	"mysite/pkgs/database"
+	"mysite/testing/dbtest"
+	"testing"
+	"time"
+
+	"github.com/friendsofgo/errors"
+	"github.com/stretchr/testify/require"
+	"github.com/volatiletech/sqlboiler/v4/boil"
+)
+
+func generateTestData(ctx context.Context, tx boil.ContextTransactor, tokenHash string) (*entities.PasswordResetToken, error) {
+	userAccount := entities.UserAccount{
+		UserName: "userName",
+		Password: "password",
+		IsActive: true,
+	}
+	if err := userAccount.Insert(ctx, tx, boil.Infer()); err != nil {
+		return nil, errors.Wrap(err, "failed insert userAccount")
+	}
+
+	token := entities.PasswordResetToken{
+		UserAccountID: userAccount.ID,
+		TokenHash:     tokenHash,
+		ExpiresAt:     time.Now().Add(time.Hour),
+	}
+	if err := token.Insert(ctx, tx, boil.Infer()); err != nil {
+		return nil, errors.Wrap(err, "failed insert passwordResetToken")
+	}
+	return &token, nil
+}
+
+func TestGetPasswordResetTokenByHash(t *testing.T) {
+	t.Parallel()
+	require.NoError(t, database.SetupDatabase())
+	repo := NewRepo()
+	ctx := dbtest.SetTestTransactionCtx(context.Background())
+
+	{ // found token
+		var token *entities.PasswordResetToken
+		err := database.NewBoilerTransaction(ctx, func(ctx context.Context, tx boil.ContextTransactor) error {
+			if _, err := generateTestData(ctx, tx, "found-hash"); err != nil {
+				return errors.Wrap(err, "failed generate data")
+			}
+
+			var err error
+			token, err = repo.GetPasswordResetTokenByHash(ctx, tx, "found-hash")
+			if err != nil {
+				return errors.Wrap(err, "failed get passwordResetToken")
+			}
+			return nil
+		})
+
+		require.NoError(t, err)
+		require.Equal(t, "found-hash", token.TokenHash)
+	}
+	{ // not found token
+		var token *entities.PasswordResetToken
+		err := database.NewBoilerTransaction(ctx, func(ctx context.Context, tx boil.ContextTransactor) error {
+			var err error
+			token, err = repo.GetPasswordResetTokenByHash(ctx, tx, "not-found-hash")
+			if err != nil {
+				return errors.Wrap(err, "failed get passwordResetToken")
+			}
+			return nil
+		})
+
+		require.NoError(t, err)
+		require.Nil(t, token)
+	}
+}
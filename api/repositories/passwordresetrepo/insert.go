@@ -0,0 +1,17 @@
+package passwordresetrepo
+
+import (
+	"context"
+	"mysite/entities"
+
+	"github.com/friendsofgo/errors"
+	"github.com/volatiletech/sqlboiler/v4/boil"
+)
+
+func (p passwordResetRepo) Insert(ctx context.Context, tx boil.ContextTransactor, token *entities.PasswordResetToken) error {
+	if err := token.Insert(ctx, tx, boil.Infer()); err != nil {
+		return errors.Wrap(err, "failed to insert passwordResetToken")
+	}
+
+	return nil
+}
@@ -0,0 +1,54 @@
+package passwordresetrepo
+
+import (
+	"context"
+	"mysite/entities"
+	"mysite/pkgs/database"
+	"mysite/testing/dbtest"
+	"testing"
+	"time"
+
+	"github.com/friendsofgo/errors"
+	"github.com/stretchr/testify/require"
+	"github.com/volatiletech/sqlboiler/v4/boil"
+)
+
+func TestInsert(t *testing.T) {
+	t.Parallel()
+	require.NoError(t, database.SetupDatabase())
+	repo := NewRepo()
+	ctx := dbtest.SetTestTransactionCtx(context.Background())
+
+	{ // insert success
+		var result *entities.PasswordResetToken
+		err := database.NewBoilerTransaction(ctx, func(ctx context.Context, tx boil.ContextTransactor) error {
+			userAccount := entities.UserAccount{
+				UserName: "userName",
+				Password: "password",
+				IsActive: true,
+			}
+			if err := userAccount.Insert(ctx, tx, boil.Infer()); err != nil {
+				return errors.Wrap(err, "failed insert userAccount")
+			}
+
+			if err := repo.Insert(ctx, tx, &entities.PasswordResetToken{
+				UserAccountID: userAccount.ID,
+				TokenHash:     "insert-hash",
+				ExpiresAt:     time.Now().Add(time.Hour),
+			}); err != nil {
+				return errors.Wrap(err, "failed insert passwordResetToken")
+			}
+
+			var err error
+			result, err = repo.GetPasswordResetTokenByHash(ctx, tx, "insert-hash")
+			if err != nil {
+				return errors.Wrap(err, "failed GetPasswordResetTokenByHash")
+			}
+			return nil
+		})
+
+		require.NoError(t, err)
+		require.Equal(t, "insert-hash", result.TokenHash)
+		require.False(t, result.UsedAt.Valid)
+	}
+}
@@ -0,0 +1,38 @@
+package passwordresetrepo
+
+import (
+	"context"
+	"mysite/entities"
+
+	"github.com/volatiletech/sqlboiler/v4/boil"
+)
+
+type Get interface {
+	GetPasswordResetTokenByHash(ctx context.Context, tx boil.ContextTransactor, tokenHash string) (*entities.PasswordResetToken, error)
+}
+
+type Insert interface {
+	Insert(ctx context.Context, tx boil.ContextTransactor, token *entities.PasswordResetToken) error
+}
+
+type Update interface {
+	MarkUsed(ctx context.Context, tx boil.ContextTransactor, token entities.PasswordResetToken) error
+	InvalidateByUserAccountId(ctx context.Context, tx boil.ContextTransactor, userAccountId int) error
+}
+
+type Delete interface{}
+
+//go:generate moq -pkg repomock -out ../../testing/mocking/repomock/passwordresetmock.go . PasswordResetRepo
+type PasswordResetRepo interface {
+	Get
+	Insert
+	Update
+	Delete
+}
+
+type passwordResetRepo struct {
+}
+
+func NewRepo() PasswordResetRepo {
+	return &passwordResetRepo{}
+}
@@ -0,0 +1,41 @@
+package passwordresetrepo
+
+import (
+	"context"
+	"mysite/entities"
+	"time"
+
+	"github.com/friendsofgo/errors"
+	"github.com/volatiletech/null/v8"
+	"github.com/volatiletech/sqlboiler/v4/boil"
+	"github.com/volatiletech/sqlboiler/v4/queries/qm"
+)
+
+func (p passwordResetRepo) MarkUsed(ctx context.Context, tx boil.ContextTransactor, token entities.PasswordResetToken) error {
+	now := time.Now()
+	token.UsedAt = null.TimeFrom(now)
+	token.UpdatedAt = null.TimeFrom(now)
+	rowEffected, err := token.Update(ctx, tx, boil.Whitelist(entities.PasswordResetTokenColumns.UsedAt, entities.PasswordResetTokenColumns.UpdatedAt))
+	if err != nil || rowEffected == 0 {
+		return errors.Wrap(err, "failed to update passwordResetToken")
+	}
+	return nil
+}
+
+// InvalidateByUserAccountId marks every unused token of the user as used, only the latest token stays valid
+func (p passwordResetRepo) InvalidateByUserAccountId(ctx context.Context, tx boil.ContextTransactor, userAccountId int) error {
+	mods := []qm.QueryMod{
+		entities.PasswordResetTokenWhere.UserAccountID.EQ(userAccountId),
+		entities.PasswordResetTokenWhere.UsedAt.IsNull(),
+	}
+
+	now := time.Now()
+	_, err := entities.PasswordResetTokens(mods...).UpdateAll(ctx, tx, entities.M{
+		entities.PasswordResetTokenColumns.UsedAt:    null.TimeFrom(now),
+		entities.PasswordResetTokenColumns.UpdatedAt: null.TimeFrom(now),
+	})
+	if err != nil {
+		return errors.Wrap(err, "failed to invalidate passwordResetTokens")
+	}
+	return nil
+}
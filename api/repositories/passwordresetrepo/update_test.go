@@ -0,0 +1,85 @@
+package passwordresetrepo
+
+import (
+	"context"
+	"mysite/entities"
+	"mysite/pkgs/database"
+	"mysite/testing/dbtest"
+	"testing"
+	"time"
+
+	"github.com/friendsofgo/errors"
+	"github.com/stretchr/testify/require"
+	"github.com/volatiletech/sqlboiler/v4/boil"
+)
+
+func TestMarkUsed(t *testing.T) {
+	t.Parallel()
+	require.NoError(t, database.SetupDatabase())
+	repo := NewRepo()
+	ctx := dbtest.SetTestTransactionCtx(context.Background())
+
+	{ // mark used success
+		var result *entities.PasswordResetToken
+		err := database.NewBoilerTransaction(ctx, func(ctx context.Context, tx boil.ContextTransactor) error {
+			token, err := generateTestData(ctx, tx, "mark-used-hash")
+			if err != nil {
+				return errors.Wrap(err, "failed generate data")
+			}
+
+			if err := repo.MarkUsed(ctx, tx, *token); err != nil {
+				return errors.Wrap(err, "failed to mark used")
+			}
+
+			result, err = repo.GetPasswordResetTokenByHash(ctx, tx, "mark-used-hash")
+			if err != nil {
+				return errors.Wrap(err, "failed GetPasswordResetTokenByHash")
+			}
+			return nil
+		})
+
+		require.NoError(t, err)
+		require.True(t, result.UsedAt.Valid)
+	}
+}
+
+func TestInvalidateByUserAccountId(t *testing.T) {
+	t.Parallel()
+	require.NoError(t, database.SetupDatabase())
+	repo := NewRepo()
+	ctx := dbtest.SetTestTransactionCtx(context.Background())
+
+	{ // invalidate every unused token of the user
+		var first, second *entities.PasswordResetToken
+		err := database.NewBoilerTransaction(ctx, func(ctx context.Context, tx boil.ContextTransactor) error {
+			token, err := generateTestData(ctx, tx, "invalidate-hash-1")
+			if err != nil {
+				return errors.Wrap(err, "failed generate data")
+			}
+
+			if err := repo.Insert(ctx, tx, &entities.PasswordResetToken{
+				UserAccountID: token.UserAccountID,
+				TokenHash:     "invalidate-hash-2",
+				ExpiresAt:     time.Now().Add(time.Hour),
+			}); err != nil {
+				return errors.Wrap(err, "failed insert passwordResetToken")
+			}
+
+			if err := repo.InvalidateByUserAccountId(ctx, tx, token.UserAccountID); err != nil {
+				return errors.Wrap(err, "failed to invalidate")
+			}
+
+			if first, err = repo.GetPasswordResetTokenByHash(ctx, tx, "invalidate-hash-1"); err != nil {
+				return errors.Wrap(err, "failed GetPasswordResetTokenByHash")
+			}
+			if second, err = repo.GetPasswordResetTokenByHash(ctx, tx, "invalidate-hash-2"); err != nil {
+				return errors.Wrap(err, "failed GetPasswordResetTokenByHash")
+			}
+			return nil
+		})
+
+		require.NoError(t, err)
+		require.True(t, first.UsedAt.Valid)
+		require.True(t, second.UsedAt.Valid)
+	}
+}
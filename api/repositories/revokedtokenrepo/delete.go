@@ -0,0 +1,21 @@
+package revokedtokenrepo
+
+import (
+	"context"
+	"mysite/entities"
+	"time"
+
+	"github.com/friendsofgo/errors"
+	"github.com/volatiletech/sqlboiler/v4/boil"
+)
+
+// DeleteExpired drops the revocations of tokens which expired anyway
+func (r revokedTokenRepo) DeleteExpired(ctx context.Context, tx boil.ContextTransactor, now time.Time) error {
+	if _, err := entities.RevokedTokens(entities.RevokedTokenWhere.ExpiresAt.LTE(now)).DeleteAll(ctx, tx); err != nil {
+		return errors.Wrap(err, "failed to delete expired revokedTokens")
+	}
+	if _, err := entities.RevokedSubjects(entities.RevokedSubjectWhere.ExpiresAt.LTE(now)).DeleteAll(ctx, tx); err != nil {
+		return errors.Wrap(err, "failed to delete expired revokedSubjects")
+	}
+	return nil
+}
@@ -0,0 +1,66 @@
+package revokedtokenrepo
+
+import (
+	"context"
+	"mysite/entities"
+	"mysite/pkgs/database"
+	"mysite/testing/dbtest"
+	"testing"
+	"time"
+
+	"github.com/friendsofgo/errors"
+	"github.com/stretchr/testify/require"
+	"github.com/volatiletech/sqlboiler/v4/boil"
+)
+
+func TestDeleteExpired(t *testing.T) {
+	t.Parallel()
+	require.NoError(t, database.SetupDatabase())
+	repo := NewRepo()
+	ctx := dbtest.SetTestTransactionCtx(context.Background())
+
+	{ // only expired revocations are dropped
+		var expiredToken, keptToken bool
+		var expiredSubject, keptSubject bool
+		err := database.NewBoilerTransaction(ctx, func(ctx context.Context, tx boil.ContextTransactor) error {
+			if err := repo.InsertToken(ctx, tx, "delete-expired-token-id", time.Now().Add(-time.Minute)); err != nil {
+				return errors.Wrap(err, "failed insert revokedToken")
+			}
+			if err := repo.InsertToken(ctx, tx, "delete-kept-token-id", time.Now().Add(time.Hour)); err != nil {
+				return errors.Wrap(err, "failed insert revokedToken")
+			}
+			if err := repo.UpsertSubject(ctx, tx, "delete-expired-subject", time.Now(), time.Now().Add(-time.Minute)); err != nil {
+				return errors.Wrap(err, "failed upsert revokedSubject")
+			}
+			if err := repo.UpsertSubject(ctx, tx, "delete-kept-subject", time.Now(), time.Now().Add(time.Hour)); err != nil {
+				return errors.Wrap(err, "failed upsert revokedSubject")
+			}
+
+			if err := repo.DeleteExpired(ctx, tx, time.Now()); err != nil {
+				return errors.Wrap(err, "failed DeleteExpired")
+			}
+
+			for id, found := range map[string]*bool{"delete-expired-token-id": &expiredToken, "delete-kept-token-id": &keptToken} {
+				exists, err := entities.RevokedTokens(entities.RevokedTokenWhere.TokenID.EQ(id)).Exists(ctx, tx)
+				if err != nil {
+					return err
+				}
+				*found = exists
+			}
+			for subject, found := range map[string]*bool{"delete-expired-subject": &expiredSubject, "delete-kept-subject": &keptSubject} {
+				pgSubject, err := repo.GetRevokedSubject(ctx, tx, subject)
+				if err != nil {
+					return err
+				}
+				*found = pgSubject != nil
+			}
+			return nil
+		})
+
+		require.NoError(t, err)
+		require.False(t, expiredToken)
+		require.True(t, keptToken)
+		require.False(t, expiredSubject)
+		require.True(t, keptSubject)
+	}
+}
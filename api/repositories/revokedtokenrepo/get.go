@@ -0,0 +1,31 @@
+package revokedtokenrepo
+
+import (
+	"context"
+	"database/sql"
+	"mysite/entities"
+	"time"
+
+	"github.com/friendsofgo/errors"
+	"github.com/volatiletech/sqlboiler/v4/boil"
+)
+
+// IsTokenRevoked tells whether the token id was revoked and the revocation did not expire yet
+func (r revokedTokenRepo) IsTokenRevoked(ctx context.Context, tx boil.ContextTransactor, tokenId string) (bool, error) {
+	revoked, err := entities.RevokedTokens(
+		entities.RevokedTokenWhere.TokenID.EQ(tokenId),
+		entities.RevokedTokenWhere.ExpiresAt.GT(time.Now()),
+	).Exists(ctx, tx)
+	if err != nil {
+		return false, errors.Wrap(err, "failed to get revokedToken")
+	}
+	return revoked, nil
+}
+
+func (r revokedTokenRepo) GetRevokedSubject(ctx context.Context, tx boil.ContextTransactor, subject string) (*entities.RevokedSubject, error) {
+	pgSubject, err := entities.RevokedSubjects(entities.RevokedSubjectWhere.Subject.EQ(subject)).One(ctx, tx)
+	if err != nil && !errors.Is(err, sql.ErrNoRows) {
+		return nil, errors.Wrap(err, "failed to get revokedSubject")
+	}
+	return pgSubject, nil
+}
@@ -0,0 +1,75 @@
+package revokedtokenrepo
+
+import (
+	"context"
+	"mysite/entities"
+	"mysite/pkgs/database"
+	"mysite/testing/dbtest"
+	"testing"
+	"time"
+
+	"github.com/friendsofgo/errors"
+	"github.com/stretchr/testify/require"
+	"github.com/volatiletech/sqlboiler/v4/boil"
+)
+
+func TestIsTokenRevoked(t *testing.T) {
+	t.Parallel()
+	require.NoError(t, database.SetupDatabase())
+	repo := NewRepo()
+	ctx := dbtest.SetTestTransactionCtx(context.Background())
+
+	var revoked, expired, unknown bool
+	err := database.NewBoilerTransaction(ctx, func(ctx context.Context, tx boil.ContextTransactor) error {
+		if err := repo.InsertToken(ctx, tx, "revoked-token-id", time.Now().Add(time.Hour)); err != nil {
+			return errors.Wrap(err, "failed insert revokedToken")
+		}
+		if err := repo.InsertToken(ctx, tx, "expired-token-id", time.Now().Add(-time.Minute)); err != nil {
+			return errors.Wrap(err, "failed insert revokedToken")
+		}
+
+		var err error
+		if revoked, err = repo.IsTokenRevoked(ctx, tx, "revoked-token-id"); err != nil {
+			return errors.Wrap(err, "failed IsTokenRevoked")
+		}
+		if expired, err = repo.IsTokenRevoked(ctx, tx, "expired-token-id"); err != nil {
+			return errors.Wrap(err, "failed IsTokenRevoked")
+		}
+		if unknown, err = repo.IsTokenRevoked(ctx, tx, "unknown-token-id"); err != nil {
+			return errors.Wrap(err, "failed IsTokenRevoked")
+		}
+		return nil
+	})
+
+	require.NoError(t, err)
+	require.True(t, revoked)
+	require.False(t, expired)
+	require.False(t, unknown)
+}
+
+func TestGetRevokedSubject(t *testing.T) {
+	t.Parallel()
+	require.NoError(t, database.SetupDatabase())
+	repo := NewRepo()
+	ctx := dbtest.SetTestTransactionCtx(context.Background())
+
+	var found, notFound *entities.RevokedSubject
+	err := database.NewBoilerTransaction(ctx, func(ctx context.Context, tx boil.ContextTransactor) error {
+		if err := repo.UpsertSubject(ctx, tx, "get-subject", time.Now(), time.Now().Add(time.Hour)); err != nil {
+			return errors.Wrap(err, "failed upsert revokedSubject")
+		}
+
+		var err error
+		if found, err = repo.GetRevokedSubject(ctx, tx, "get-subject"); err != nil {
+			return errors.Wrap(err, "failed GetRevokedSubject")
+		}
+		if notFound, err = repo.GetRevokedSubject(ctx, tx, "unknown-subject"); err != nil {
+			return errors.Wrap(err, "failed GetRevokedSubject")
+		}
+		return nil
+	})
+
+	require.NoError(t, err)
+	require.Equal(t, "get-subject", found.Subject)
+	require.Nil(t, notFound)
+}
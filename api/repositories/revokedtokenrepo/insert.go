@@ -0,0 +1,34 @@
+package revokedtokenrepo
+
+import (
+	"context"
+	"time"
+
+	"github.com/friendsofgo/errors"
+	"github.com/volatiletech/sqlboiler/v4/boil"
+	"github.com/volatiletech/sqlboiler/v4/queries"
+)
+
+// InsertToken revokes the token id until expiresAt, revoking it again is a no-op
+func (r revokedTokenRepo) InsertToken(ctx context.Context, tx boil.ContextTransactor, tokenId string, expiresAt time.Time) error {
+	query := queries.Raw(`INSERT INTO "revoked_token" ("token_id", "expires_at") VALUES ($1, $2)
+		ON CONFLICT ("token_id") DO NOTHING`, tokenId, expiresAt)
+	if _, err := query.ExecContext(ctx, tx); err != nil {
+		return errors.Wrap(err, "failed to insert revokedToken")
+	}
+	return nil
+}
+
+// UpsertSubject revokes the tokens of the subject issued up to issuedBefore, an earlier revocation is
+// extended, never shortened
+func (r revokedTokenRepo) UpsertSubject(ctx context.Context, tx boil.ContextTransactor, subject string, issuedBefore time.Time, expiresAt time.Time) error {
+	query := queries.Raw(`INSERT INTO "revoked_subject" ("subject", "issued_before", "expires_at") VALUES ($1, $2, $3)
+		ON CONFLICT ("subject") DO UPDATE SET
+			"issued_before" = GREATEST("revoked_subject"."issued_before", EXCLUDED."issued_before"),
+			"expires_at" = GREATEST("revoked_subject"."expires_at", EXCLUDED."expires_at"),
+			"updated_at" = NOW()`, subject, issuedBefore, expiresAt)
+	if _, err := query.ExecContext(ctx, tx); err != nil {
+		return errors.Wrap(err, "failed to upsert revokedSubject")
+	}
+	return nil
+}
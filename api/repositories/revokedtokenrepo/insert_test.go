@@ -0,0 +1,70 @@
+package revokedtokenrepo
+
+import (
+	"context"
+	"mysite/entities"
+	"mysite/pkgs/database"
+	"mysite/testing/dbtest"
+	"testing"
+	"time"
+
+	"github.com/friendsofgo/errors"
+	"github.com/stretchr/testify/require"
+	"github.com/volatiletech/sqlboiler/v4/boil"
+)
+
+func TestInsertToken(t *testing.T) {
+	t.Parallel()
+	require.NoError(t, database.SetupDatabase())
+	repo := NewRepo()
+	ctx := dbtest.SetTestTransactionCtx(context.Background())
+
+	{ // revoking twice is a no-op
+		var count int64
+		err := database.NewBoilerTransaction(ctx, func(ctx context.Context, tx boil.ContextTransactor) error {
+			for i := 0; i < 2; i++ {
+				if err := repo.InsertToken(ctx, tx, "twice-token-id", time.Now().Add(time.Hour)); err != nil {
+					return errors.Wrap(err, "failed insert revokedToken")
+				}
+			}
+
+			var err error
+			count, err = entities.RevokedTokens(entities.RevokedTokenWhere.TokenID.EQ("twice-token-id")).Count(ctx, tx)
+			return err
+		})
+
+		require.NoError(t, err)
+		require.Equal(t, int64(1), count)
+	}
+}
+
+func TestUpsertSubject(t *testing.T) {
+	t.Parallel()
+	require.NoError(t, database.SetupDatabase())
+	repo := NewRepo()
+	ctx := dbtest.SetTestTransactionCtx(context.Background())
+
+	{ // a later revocation moves the cutoff, the expiry is never shortened
+		first := time.Now().Add(-time.Minute).Truncate(time.Second)
+		second := time.Now().Truncate(time.Second)
+		longExpiry := time.Now().Add(2 * time.Hour).Truncate(time.Second)
+
+		var result *entities.RevokedSubject
+		err := database.NewBoilerTransaction(ctx, func(ctx context.Context, tx boil.ContextTransactor) error {
+			if err := repo.UpsertSubject(ctx, tx, "upsert-subject", first, longExpiry); err != nil {
+				return errors.Wrap(err, "failed upsert revokedSubject")
+			}
+			if err := repo.UpsertSubject(ctx, tx, "upsert-subject", second, time.Now().Add(time.Hour)); err != nil {
+				return errors.Wrap(err, "failed upsert revokedSubject")
+			}
+
+			var err error
+			result, err = repo.GetRevokedSubject(ctx, tx, "upsert-subject")
+			return err
+		})
+
+		require.NoError(t, err)
+		require.True(t, result.IssuedBefore.Equal(second))
+		require.True(t, result.ExpiresAt.Equal(longExpiry))
+	}
+}
@@ -0,0 +1,37 @@
+package revokedtokenrepo
+
+import (
+	"context"
+	"mysite/entities"
+	"time"
+
+	"github.com/volatiletech/sqlboiler/v4/boil"
+)
+
+type Get interface {
+	IsTokenRevoked(ctx context.Context, tx boil.ContextTransactor, tokenId string) (bool, error)
+	GetRevokedSubject(ctx context.Context, tx boil.ContextTransactor, subject string) (*entities.RevokedSubject, error)
+}
+
+type Insert interface {
+	InsertToken(ctx context.Context, tx boil.ContextTransactor, tokenId string, expiresAt time.Time) error
+	UpsertSubject(ctx context.Context, tx boil.ContextTransactor, subject string, issuedBefore time.Time, expiresAt time.Time) error
+}
+
+type Delete interface {
+	DeleteExpired(ctx context.Context, tx boil.ContextTransactor, now time.Time) error
+}
+
+//go:generate moq -pkg repomock -out ../../testing/mocking/repomock/revokedtokenmock.go . RevokedTokenRepo
+type RevokedTokenRepo interface {
+	Get
+	Insert
+	Delete
+}
+
+type revokedTokenRepo struct {
+}
+
+func NewRepo() RevokedTokenRepo {
+	return &revokedTokenRepo{}
+}
@@ -0,0 +1,23 @@
+package rolerepo
+
+import (
+	"context"
+	"mysite/entities"
+
+	"github.com/friendsofgo/errors"
+	"github.com/volatiletech/sqlboiler/v4/boil"
+	"github.com/volatiletech/sqlboiler/v4/queries/qm"
+)
+
+// RemoveRole is a no-op when the user does not have the role
+func (r roleRepo) RemoveRole(ctx context.Context, tx boil.ContextTransactor, userAccountId int, roleId int) error {
+	mods := []qm.QueryMod{
+		entities.UserRoleWhere.UserAccountID.EQ(userAccountId),
+		entities.UserRoleWhere.RoleID.EQ(roleId),
+	}
+	if _, err := entities.UserRoles(mods...).DeleteAll(ctx, tx); err != nil {
+		return errors.Wrap(err, "failed to delete userRole")
+	}
+
+	return nil
+}
@@ -0,0 +1,56 @@
+package rolerepo
+
+import (
+	"context"
+	"mysite/constants"
+	"mysite/pkgs/database"
+	"mysite/testing/dbtest"
+	"testing"
+
+	"github.com/friendsofgo/errors"
+	"github.com/stretchr/testify/require"
+	"github.com/volatiletech/sqlboiler/v4/boil"
+)
+
+func TestRemoveRole(t *testing.T) {
+	t.Parallel()
+	require.NoError(t, database.SetupDatabase())
+	repo := NewRepo()
+	ctx := dbtest.SetTestTransactionCtx(context.Background())
+
+	{ // only the given role is removed, removing again is a no-op
+		var roles []string
+		err := database.NewBoilerTransaction(ctx, func(ctx context.Context, tx boil.ContextTransactor) error {
+			user, err := generateTestData(ctx, tx, "remove-role")
+			if err != nil {
+				return errors.Wrap(err, "failed generate data")
+			}
+			admin, err := repo.GetRoleByName(ctx, tx, constants.RoleAdmin)
+			if err != nil {
+				return errors.Wrap(err, "failed get role")
+			}
+			member, err := repo.GetRoleByName(ctx, tx, constants.RoleMember)
+			if err != nil {
+				return errors.Wrap(err, "failed get role")
+			}
+			if err := repo.AssignRole(ctx, tx, user.ID, admin.ID); err != nil {
+				return errors.Wrap(err, "failed assign role")
+			}
+			if err := repo.AssignRole(ctx, tx, user.ID, member.ID); err != nil {
+				return errors.Wrap(err, "failed assign role")
+			}
+
+			for i := 0; i < 2; i++ {
+				if err := repo.RemoveRole(ctx, tx, user.ID, admin.ID); err != nil {
+					return errors.Wrap(err, "failed remove role")
+				}
+			}
+
+			roles, err = repo.GetRoleNamesByUserAccountId(ctx, tx, user.ID)
+			return err
+		})
+
+		require.NoError(t, err)
+		require.Equal(t, []string{constants.RoleMember}, roles)
+	}
+}
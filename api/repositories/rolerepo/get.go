@@ -0,0 +1,61 @@
+package rolerepo
+
+import (
+	"context"
+	"database/sql"
+	"mysite/entities"
+
+	"github.com/friendsofgo/errors"
+	"github.com/volatiletech/sqlboiler/v4/boil"
+	"github.com/volatiletech/sqlboiler/v4/queries/qm"
+)
+
+func (r roleRepo) GetRoleByName(ctx context.Context, tx boil.ContextTransactor, name string) (*entities.Role, error) {
+	pgRole, err := entities.Roles(entities.RoleWhere.Name.EQ(name)).One(ctx, tx)
+	if err != nil && !errors.Is(err, sql.ErrNoRows) {
+		return nil, errors.Wrap(err, "failed to get role")
+	}
+
+	return pgRole, nil
+}
+
+func (r roleRepo) GetRoleNamesByUserAccountId(ctx context.Context, tx boil.ContextTransactor, userAccountId int) ([]string, error) {
+	mods := []qm.QueryMod{
+		qm.InnerJoin("user_role ur ON ur.role_id = role.id"),
+		qm.Where("ur.user_account_id = ?", userAccountId),
+		qm.OrderBy("role.name"),
+	}
+
+	pgRoles, err := entities.Roles(mods...).All(ctx, tx)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to get roles")
+	}
+
+	result := make([]string, 0, len(pgRoles))
+	for _, role := range pgRoles {
+		result = append(result, role.Name)
+	}
+	return result, nil
+}
+
+// GetPermissionNamesByUserAccountId returns the permissions granted by every role of the user
+func (r roleRepo) GetPermissionNamesByUserAccountId(ctx context.Context, tx boil.ContextTransactor, userAccountId int) ([]string, error) {
+	mods := []qm.QueryMod{
+		qm.Select("DISTINCT permission.name"),
+		qm.InnerJoin("role_permission rp ON rp.permission_id = permission.id"),
+		qm.InnerJoin("user_role ur ON ur.role_id = rp.role_id"),
+		qm.Where("ur.user_account_id = ?", userAccountId),
+		qm.OrderBy("permission.name"),
+	}
+
+	pgPermissions, err := entities.Permissions(mods...).All(ctx, tx)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to get permissions")
+	}
+
+	result := make([]string, 0, len(pgPermissions))
+	for _, permission := range pgPermissions {
+		result = append(result, permission.Name)
+	}
+	return result, nil
+}
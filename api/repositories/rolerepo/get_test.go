@@ -0,0 +1,111 @@
+package rolerepo
+
+import (
+	"context"
+	"mysite/constants"
+	"mysite/entities"
+	"mysite/pkgs/database"
+	"mysite/testing/dbtest"
+	"testing"
+
+	"github.com/friendsofgo/errors"
+	"github.com/stretchr/testify/require"
+	"github.com/volatiletech/sqlboiler/v4/boil"
+)
+
+func generateTestData(ctx context.Context, tx boil.ContextTransactor, userName string) (*entities.UserAccount, error) {
+	userAccount := entities.UserAccount{
+		UserName: userName,
+		Password: "password",
+		IsActive: true,
+	}
+	if err := userAccount.Insert(ctx, tx, boil.Infer()); err != nil {
+		return nil, errors.Wrap(err, "failed insert userAccount")
+	}
+	return &userAccount, nil
+}
+
+func TestGetRoleByName(t *testing.T) {
+	t.Parallel()
+	require.NoError(t, database.SetupDatabase())
+	repo := NewRepo()
+	ctx := dbtest.SetTestTransactionCtx(context.Background())
+
+	{ // seeded role
+		var role *entities.Role
+		err := database.NewBoilerTransaction(ctx, func(ctx context.Context, tx boil.ContextTransactor) error {
+			var err error
+			role, err = repo.GetRoleByName(ctx, tx, constants.RoleAdmin)
+			return err
+		})
+
+		require.NoError(t, err)
+		require.Equal(t, constants.RoleAdmin, role.Name)
+	}
+	{ // unknown role
+		var role *entities.Role
+		err := database.NewBoilerTransaction(ctx, func(ctx context.Context, tx boil.ContextTransactor) error {
+			var err error
+			role, err = repo.GetRoleByName(ctx, tx, "unknown")
+			return err
+		})
+
+		require.NoError(t, err)
+		require.Nil(t, role)
+	}
+}
+
+func TestGetRoleAndPermissionNamesByUserAccountId(t *testing.T) {
+	t.Parallel()
+	require.NoError(t, database.SetupDatabase())
+	repo := NewRepo()
+	ctx := dbtest.SetTestTransactionCtx(context.Background())
+
+	{ // admin has every seeded permission, member none
+		var roles, permissions []string
+		err := database.NewBoilerTransaction(ctx, func(ctx context.Context, tx boil.ContextTransactor) error {
+			user, err := generateTestData(ctx, tx, "role-names")
+			if err != nil {
+				return errors.Wrap(err, "failed generate data")
+			}
+			for _, name := range []string{constants.RoleAdmin, constants.RoleMember} {
+				role, err := repo.GetRoleByName(ctx, tx, name)
+				if err != nil {
+					return errors.Wrap(err, "failed get role")
+				}
+				if err := repo.AssignRole(ctx, tx, user.ID, role.ID); err != nil {
+					return errors.Wrap(err, "failed assign role")
+				}
+			}
+
+			if roles, err = repo.GetRoleNamesByUserAccountId(ctx, tx, user.ID); err != nil {
+				return errors.Wrap(err, "failed get roles")
+			}
+			if permissions, err = repo.GetPermissionNamesByUserAccountId(ctx, tx, user.ID); err != nil {
+				return errors.Wrap(err, "failed get permissions")
+			}
+			return nil
+		})
+
+		require.NoError(t, err)
+		require.Equal(t, []string{constants.RoleAdmin, constants.RoleMember}, roles)
+		require.Equal(t, []string{constants.PermissionRolesWrite, constants.PermissionUsersRead, constants.PermissionUsersWrite}, permissions)
+	}
+	{ // user without roles
+		var roles, permissions []string
+		err := database.NewBoilerTransaction(ctx, func(ctx context.Context, tx boil.ContextTransactor) error {
+			var err error
+			if roles, err = repo.GetRoleNamesByUserAccountId(ctx, tx, -1); err != nil {
+				return errors.Wrap(err, "failed get roles")
+			}
+			if permissions, err = repo.GetPermissionNamesByUserAccountId(ctx, tx, -1); err != nil {
+				return errors.Wrap(err, "failed get permissions")
+			}
+			return nil
+		})
+
+		require.NoError(t, err)
+		require.Empty(t, roles)
+		require.Empty(t, permissions)
+	}
+}
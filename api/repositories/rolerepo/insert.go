@@ -0,0 +1,23 @@
+package rolerepo
+
+import (
+	"context"
+	"mysite/entities"
+
+	"github.com/friendsofgo/errors"
+	"github.com/volatiletech/sqlboiler/v4/boil"
+)
+
+// AssignRole is a no-op when the user already has the role
+func (r roleRepo) AssignRole(ctx context.Context, tx boil.ContextTransactor, userAccountId int, roleId int) error {
+	userRole := entities.UserRole{
+		UserAccountID: userAccountId,
+		RoleID:        roleId,
+	}
+	conflictColumns := []string{entities.UserRoleColumns.UserAccountID, entities.UserRoleColumns.RoleID}
+	if err := userRole.Upsert(ctx, tx, false, conflictColumns, boil.None(), boil.Infer()); err != nil {
+		return errors.Wrap(err, "failed to insert userRole")
+	}
+
+	return nil
+}
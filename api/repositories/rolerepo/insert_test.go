@@ -0,0 +1,45 @@
+package rolerepo
+
+import (
+	"context"
+	"mysite/constants"
+	"mysite/pkgs/database"
+	"mysite/testing/dbtest"
+	"testing"
+
+	"github.com/friendsofgo/errors"
+	"github.com/stretchr/testify/require"
+	"github.com/volatiletech/sqlboiler/v4/boil"
+)
+
+func TestAssignRole(t *testing.T) {
+	t.Parallel()
+	require.NoError(t, database.SetupDatabase())
+	repo := NewRepo()
+	ctx := dbtest.SetTestTransactionCtx(context.Background())
+
+	{ // assigning twice keeps one role
+		var roles []string
+		err := database.NewBoilerTransaction(ctx, func(ctx context.Context, tx boil.ContextTransactor) error {
+			user, err := generateTestData(ctx, tx, "assign-role")
+			if err != nil {
+				return errors.Wrap(err, "failed generate data")
+			}
+			role, err := repo.GetRoleByName(ctx, tx, constants.RoleMember)
+			if err != nil {
+				return errors.Wrap(err, "failed get role")
+			}
+			for i := 0; i < 2; i++ {
+				if err := repo.AssignRole(ctx, tx, user.ID, role.ID); err != nil {
+					return errors.Wrap(err, "failed assign role")
+				}
+			}
+
+			roles, err = repo.GetRoleNamesByUserAccountId(ctx, tx, user.ID)
+			return err
+		})
+
+		require.NoError(t, err)
+		require.Equal(t, []string{constants.RoleMember}, roles)
+	}
+}
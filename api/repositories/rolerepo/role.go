@@ -0,0 +1,36 @@
+package rolerepo
+
+import (
+	"context"
+	"mysite/entities"
+
+	"github.com/volatiletech/sqlboiler/v4/boil"
+)
+
+type Get interface {
+	GetRoleByName(ctx context.Context, tx boil.ContextTransactor, name string) (*entities.Role, error)
+	GetRoleNamesByUserAccountId(ctx context.Context, tx boil.ContextTransactor, userAccountId int) ([]string, error)
+	GetPermissionNamesByUserAccountId(ctx context.Context, tx boil.ContextTransactor, userAccountId int) ([]string, error)
+}
+
+type Insert interface {
+	AssignRole(ctx context.Context, tx boil.ContextTransactor, userAccountId int, roleId int) error
+}
+
+type Delete interface {
+	RemoveRole(ctx context.Context, tx boil.ContextTransactor, userAccountId int, roleId int) error
+}
+
+//go:generate moq -pkg repomock -out ../../testing/mocking/repomock/rolemock.go . RoleRepo
+type RoleRepo interface {
+	Get
+	Insert
+	Delete
+}
+
+type roleRepo struct {
+}
+
+func NewRepo() RoleRepo {
+	return &roleRepo{}
+}
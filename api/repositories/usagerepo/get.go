@@ -0,0 +1,28 @@
+package usagerepo
+
+import (
+	"context"
+	"database/sql"
+	"mysite/entities"
+	"time"
+
+	"github.com/friendsofgo/errors"
+	"github.com/volatiletech/sqlboiler/v4/boil"
+)
+
+// GetUsage returns the consumption of the quota in the period, zero when nothing was counted yet
+func (u usageRepo) GetUsage(ctx context.Context, tx boil.ContextTransactor, userAccountId int, quota string, periodStart time.Time) (int, error) {
+	pgCounter, err := entities.UsageCounters(
+		entities.UsageCounterWhere.UserAccountID.EQ(userAccountId),
+		entities.UsageCounterWhere.Quota.EQ(quota),
+		entities.UsageCounterWhere.PeriodStart.EQ(periodStart),
+	).One(ctx, tx)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return 0, nil
+		}
+		return 0, errors.Wrap(err, "failed to get usageCounter")
+	}
+
+	return pgCounter.Used, nil
+}
@@ -0,0 +1,25 @@
+package usagerepo
+
+import (
+	"context"
+	"time"
+
+	"github.com/friendsofgo/errors"
+	"github.com/volatiletech/sqlboiler/v4/boil"
+	"github.com/volatiletech/sqlboiler/v4/queries"
+)
+
+// Increment adds amount to the counter of the period in one statement, so that concurrent requests do not lose counts
+func (u usageRepo) Increment(ctx context.Context, tx boil.ContextTransactor, userAccountId int, quota string, periodStart time.Time, amount int) (int, error) {
+	var result struct {
+		Used int `boil:"used"`
+	}
+	query := queries.Raw(`INSERT INTO "usage_counter" ("user_account_id", "quota", "period_start", "used") VALUES ($1, $2, $3, $4)
+		ON CONFLICT ("user_account_id", "quota", "period_start") DO UPDATE SET "used" = "usage_counter"."used" + EXCLUDED."used", "updated_at" = NOW()
+		RETURNING "used"`, userAccountId, quota, periodStart, amount)
+	if err := query.Bind(ctx, tx, &result); err != nil {
+		return 0, errors.Wrap(err, "failed to increment usageCounter")
+	}
+
+	return result.Used, nil
+}
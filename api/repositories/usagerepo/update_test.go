@@ -0,0 +1,57 @@
+package usagerepo
+
+import (
+	"context"
+	"mysite/entities"
+	"mysite/pkgs/database"
+	"mysite/testing/dbtest"
+	"testing"
+	"time"
+
+	"github.com/friendsofgo/errors"
+	"github.com/stretchr/testify/require"
+	"github.com/volatiletech/sqlboiler/v4/boil"
+)
+
+func TestIncrement(t *testing.T) {
+	t.Parallel()
+	require.NoError(t, database.SetupDatabase())
+	repo := NewRepo()
+	ctx := dbtest.SetTestTransactionCtx(context.Background())
+	today := time.Now().UTC().Truncate(24 * time.Hour)
+
+	var first, second, otherPeriod, used, empty int
+	err := database.NewBoilerTransaction(ctx, func(ctx context.Context, tx boil.ContextTransactor) error {
+		userAccount := entities.UserAccount{
+			UserName: "usage-increment",
+			Password: "password",
+			IsActive: true,
+		}
+		if err := userAccount.Insert(ctx, tx, boil.Infer()); err != nil {
+			return errors.Wrap(err, "failed insert userAccount")
+		}
+
+		var err error
+		if first, err = repo.Increment(ctx, tx, userAccount.ID, "requests", today, 1); err != nil {
+			return err
+		}
+		if second, err = repo.Increment(ctx, tx, userAccount.ID, "requests", today, 2); err != nil {
+			return err
+		}
+		if otherPeriod, err = repo.Increment(ctx, tx, userAccount.ID, "requests", today.AddDate(0, 0, 1), 1); err != nil {
+			return err
+		}
+		if used, err = repo.GetUsage(ctx, tx, userAccount.ID, "requests", today); err != nil {
+			return err
+		}
+		empty, err = repo.GetUsage(ctx, tx, userAccount.ID, "items", today)
+		return err
+	})
+
+	require.NoError(t, err)
+	require.Equal(t, 1, first)
+	require.Equal(t, 3, second)
+	require.Equal(t, 1, otherPeriod)
+	require.Equal(t, 3, used)
+	require.Zero(t, empty)
+}
@@ -0,0 +1,29 @@
+package usagerepo
+
+import (
+	"context"
+	"time"
+
+	"github.com/volatiletech/sqlboiler/v4/boil"
+)
+
+type Get interface {
+	GetUsage(ctx context.Context, tx boil.ContextTransactor, userAccountId int, quota string, periodStart time.Time) (int, error)
+}
+
+type Update interface {
+	Increment(ctx context.Context, tx boil.ContextTransactor, userAccountId int, quota string, periodStart time.Time, amount int) (int, error)
+}
+
+//go:generate moq -pkg repomock -out ../../testing/mocking/repomock/usagemock.go . UsageRepo
+type UsageRepo interface {
+	Get
+	Update
+}
+
+type usageRepo struct {
+}
+
+func NewRepo() UsageRepo {
+	return &usageRepo{}
+}
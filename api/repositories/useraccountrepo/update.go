@@ -3,6 +3,7 @@ package useraccountrepo
 import (
 	"context"
 	"mysite/entities"
+	"time"
 
 	"github.com/friendsofgo/errors"
 	"github.com/volatiletech/null/v8"
@@ -19,3 +20,27 @@ func (u userAccountRepo) ActiveUser(ctx context.Context, tx boil.ContextTransact
 	}
 	return nil
 }
+
+func (u userAccountRepo) Update(ctx context.Context, tx boil.ContextTransactor, pgUser entities.UserAccount) error {
+	pgUser.UpdatedAt = null.TimeFrom(time.Now())
+	rowEffected, err := pgUser.Update(ctx, tx, boil.Infer())
+	if err != nil || rowEffected == 0 {
+		return errors.Wrap(err, "failed to update UserAccount")
+	}
+	return nil
+}
+
+// ReplacePassword stores newHash only while the password is still oldHash, false when it was changed meanwhile
+func (u userAccountRepo) ReplacePassword(ctx context.Context, tx boil.ContextTransactor, userId int, oldHash string, newHash string) (bool, error) {
+	rowEffected, err := entities.UserAccounts(
+		entities.UserAccountWhere.ID.EQ(userId),
+		entities.UserAccountWhere.Password.EQ(oldHash),
+	).UpdateAll(ctx, tx, entities.M{
+		entities.UserAccountColumns.Password:  newHash,
+		entities.UserAccountColumns.UpdatedAt: null.TimeFrom(time.Now()),
+	})
+	if err != nil {
+		return false, errors.Wrap(err, "failed to replace password of UserAccount")
+	}
+	return rowEffected > 0, nil
+}
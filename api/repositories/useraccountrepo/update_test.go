@@ -59,3 +59,112 @@ func TestActiveUser(t *testing.T) {
 
 	}
 }
+
+func TestUpdate(t *testing.T) {
+	t.Parallel()
+	require.NoError(t, database.SetupDatabase())
+	repo := NewRepo()
+	ctx := dbtest.SetTestTransactionCtx(context.Background())
+
+	{ // success update password
+		userAccount := entities.UserAccount{
+			UserName: "updateUserName",
+			Password: "password",
+			IsActive: true,
+		}
+
+		var result *entities.UserAccount
+		err := database.NewBoilerTransaction(ctx, func(ctx context.Context, tx boil.ContextTransactor) error {
+			if err := repo.Insert(ctx, tx, &userAccount); err != nil {
+				return errors.Wrap(err, "failed insert userAccount")
+			}
+
+			userAccount.Password = "newPassword"
+			if err := repo.Update(ctx, tx, userAccount); err != nil {
+				return errors.Wrap(err, "failed to update user")
+			}
+
+			var err error
+			result, err = repo.GetUserAccountByUserName(ctx, tx, "updateUserName")
+			if err != nil {
+				return errors.Wrap(err, "failed GetUserAccountByUserName")
+			}
+
+			return nil
+		})
+
+		require.NoError(t, err)
+		require.Equal(t, "newPassword", result.Password)
+		require.True(t, result.UpdatedAt.Valid)
+	}
+}
+
+func TestReplacePassword(t *testing.T) {
+	t.Parallel()
+	require.NoError(t, database.SetupDatabase())
+	repo := NewRepo()
+	ctx := dbtest.SetTestTransactionCtx(context.Background())
+
+	{ // replaced while the password is still the old hash, other columns untouched
+		userAccount := entities.UserAccount{
+			UserName: "replacePasswordUserName",
+			Password: "old-hash",
+			IsActive: true,
+		}
+
+		var replaced bool
+		var result *entities.UserAccount
+		err := database.NewBoilerTransaction(ctx, func(ctx context.Context, tx boil.ContextTransactor) error {
+			if err := repo.Insert(ctx, tx, &userAccount); err != nil {
+				return errors.Wrap(err, "failed insert userAccount")
+			}
+
+			var err error
+			if replaced, err = repo.ReplacePassword(ctx, tx, userAccount.ID, "old-hash", "new-hash"); err != nil {
+				return errors.Wrap(err, "failed to replace password")
+			}
+
+			result, err = repo.GetUserAccountByUserName(ctx, tx, "replacePasswordUserName")
+			if err != nil {
+				return errors.Wrap(err, "failed GetUserAccountByUserName")
+			}
+			return nil
+		})
+
+		require.NoError(t, err)
+		require.True(t, replaced)
+		require.Equal(t, "new-hash", result.Password)
+		require.True(t, result.IsActive)
+		require.True(t, result.UpdatedAt.Valid)
+	}
+	{ // password changed meanwhile, kept as it is
+		userAccount := entities.UserAccount{
+			UserName: "changedPasswordUserName",
+			Password: "reset-hash",
+			IsActive: true,
+		}
+
+		var replaced bool
+		var result *entities.UserAccount
+		err := database.NewBoilerTransaction(ctx, func(ctx context.Context, tx boil.ContextTransactor) error {
+			if err := repo.Insert(ctx, tx, &userAccount); err != nil {
+				return errors.Wrap(err, "failed insert userAccount")
+			}
+
+			var err error
+			if replaced, err = repo.ReplacePassword(ctx, tx, userAccount.ID, "old-hash", "new-hash"); err != nil {
+				return errors.Wrap(err, "failed to replace password")
+			}
+
+			result, err = repo.GetUserAccountByUserName(ctx, tx, "changedPasswordUserName")
+			if err != nil {
+				return errors.Wrap(err, "failed GetUserAccountByUserName")
+			}
+			return nil
+		})
+
+		require.NoError(t, err)
+		require.False(t, replaced)
+		require.Equal(t, "reset-hash", result.Password)
+	}
+}
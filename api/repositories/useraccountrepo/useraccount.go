@@ -19,6 +19,8 @@ type Insert interface {
 
 type Update interface {
 	ActiveUser(ctx context.Context, tx boil.ContextTransactor, pgUser entities.UserAccount) error
+	Update(ctx context.Context, tx boil.ContextTransactor, pgUser entities.UserAccount) error
+	ReplacePassword(ctx context.Context, tx boil.ContextTransactor, userId int, oldHash string, newHash string) (bool, error)
 }
 
 type Delete interface{}
@@ -0,0 +1,22 @@
+package useridentityrepo
+
+import (
+	"context"
+	"database/sql"
+	"mysite/entities"
+
+	"github.com/friendsofgo/errors"
+	"github.com/volatiletech/sqlboiler/v4/boil"
+)
+
+func (u userIdentityRepo) GetUserIdentityBySubject(ctx context.Context, tx boil.ContextTransactor, issuer string, subject string) (*entities.UserIdentity, error) {
+	pgIdentity, err := entities.UserIdentities(
+		entities.UserIdentityWhere.Issuer.EQ(issuer),
+		entities.UserIdentityWhere.Subject.EQ(subject),
+	).One(ctx, tx)
+	if err != nil && !errors.Is(err, sql.ErrNoRows) {
+		return nil, errors.Wrap(err, "failed to get userIdentity")
+	}
+
+	return pgIdentity, nil
+}
@@ -0,0 +1,48 @@
+package useridentityrepo
+
+import (
+	"context"
+	"mysite/entities"
+	"mysite/pkgs/database"
+	"mysite/testing/dbtest"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"github.com/volatiletech/null/v8"
+	"github.com/volatiletech/sqlboiler/v4/boil"
+)
+
+func TestUserIdentity(t *testing.T) {
+	t.Parallel()
+	require.NoError(t, database.SetupDatabase())
+	repo := NewRepo()
+	ctx := dbtest.SetTestTransactionCtx(context.Background())
+
+	var found, otherIssuer *entities.UserIdentity
+	err := database.NewBoilerTransaction(ctx, func(ctx context.Context, tx boil.ContextTransactor) error {
+		user := entities.UserAccount{UserName: "identity@example.com", Password: "hash", IsActive: true}
+		if err := user.Insert(ctx, tx, boil.Infer()); err != nil {
+			return err
+		}
+		if err := repo.Insert(ctx, tx, &entities.UserIdentity{
+			UserAccountID: user.ID,
+			Provider:      "google",
+			Issuer:        "https://accounts.example.com",
+			Subject:       "subject",
+			Email:         null.StringFrom("identity@example.com"),
+		}); err != nil {
+			return err
+		}
+
+		var err error
+		if found, err = repo.GetUserIdentityBySubject(ctx, tx, "https://accounts.example.com", "subject"); err != nil {
+			return err
+		}
+		otherIssuer, err = repo.GetUserIdentityBySubject(ctx, tx, "https://other.example.com", "subject")
+		return err
+	})
+
+	require.NoError(t, err)
+	require.Equal(t, "google", found.Provider)
+	require.Nil(t, otherIssuer)
+}
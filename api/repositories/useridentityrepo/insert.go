@@ -0,0 +1,16 @@
+package useridentityrepo
+
+import (
+	"context"
+	"mysite/entities"
+
+	"github.com/friendsofgo/errors"
+	"github.com/volatiletech/sqlboiler/v4/boil"
+)
+
+func (u userIdentityRepo) Insert(ctx context.Context, tx boil.ContextTransactor, identity *entities.UserIdentity) error {
+	if err := identity.Insert(ctx, tx, boil.Infer()); err != nil {
+		return errors.Wrap(err, "failed to insert userIdentity")
+	}
+	return nil
+}
@@ -0,0 +1,29 @@
+package useridentityrepo
+
+import (
+	"context"
+	"mysite/entities"
+
+	"github.com/volatiletech/sqlboiler/v4/boil"
+)
+
+type Get interface {
+	GetUserIdentityBySubject(ctx context.Context, tx boil.ContextTransactor, issuer string, subject string) (*entities.UserIdentity, error)
+}
+
+type Insert interface {
+	Insert(ctx context.Context, tx boil.ContextTransactor, identity *entities.UserIdentity) error
+}
+
+//go:generate moq -pkg repomock -out ../../testing/mocking/repomock/useridentitymock.go . UserIdentityRepo
+type UserIdentityRepo interface {
+	Get
+	Insert
+}
+
+type userIdentityRepo struct {
+}
+
+func NewRepo() UserIdentityRepo {
+	return &userIdentityRepo{}
+}
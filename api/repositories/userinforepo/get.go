@@ -0,0 +1,31 @@
+package userinforepo
+
+import (
+	"context"
+	"database/sql"
+	"mysite/entities"
+
+	"github.com/friendsofgo/errors"
+	"github.com/volatiletech/sqlboiler/v4/boil"
+	"github.com/volatiletech/sqlboiler/v4/queries/qm"
+)
+
+// GetActiveUserAccountWithUserInfo loads the userAccount with its userInfo in R.UserInfos
+func (u userInfoRepo) GetActiveUserAccountWithUserInfo(ctx context.Context, tx boil.ContextTransactor, userAccountId int) (*entities.UserAccount, error) {
+	mods := []qm.QueryMod{
+		entities.UserAccountWhere.ID.EQ(userAccountId),
+		entities.UserAccountWhere.IsActive.EQ(true),
+		entities.UserAccountWhere.IsDeleted.EQ(false),
+		qm.Load(entities.UserAccountRels.UserInfos,
+			entities.UserInfoWhere.IsDeleted.EQ(false),
+			qm.OrderBy(entities.UserInfoColumns.ID),
+		),
+	}
+
+	pgUserAccount, err := entities.UserAccounts(mods...).One(ctx, tx)
+	if err != nil && !errors.Is(err, sql.ErrNoRows) {
+		return nil, errors.Wrap(err, "failed to get userAccount with userInfo")
+	}
+
+	return pgUserAccount, nil
+}
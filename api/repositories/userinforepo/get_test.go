@@ -0,0 +1,100 @@
+package userinforepo
+
+import (
+	"context"
+	"mysite/entities"
+	"mysite/pkgs/database"
+	"mysite/testing/dbtest"
+	"testing"
+
+	"github.com/friendsofgo/errors"
+	"github.com/stretchr/testify/require"
+	"github.com/volatiletech/null/v8"
+	"github.com/volatiletech/sqlboiler/v4/boil"
+)
+
+func generateTestData(ctx context.Context, tx boil.ContextTransactor, withUserInfo bool) (*entities.UserAccount, error) {
+	userAccount := entities.UserAccount{
+		UserName: "userName",
+		Password: "password",
+		IsActive: true,
+	}
+	if err := userAccount.Insert(ctx, tx, boil.Infer()); err != nil {
+		return nil, errors.Wrap(err, "failed insert userAccount")
+	}
+
+	if !withUserInfo {
+		return &userAccount, nil
+	}
+
+	userInfo := entities.UserInfo{
+		UserAccountID: userAccount.ID,
+		Name:          null.StringFrom("name"),
+		Email:         null.StringFrom("name@example.com"),
+	}
+	if err := userInfo.Insert(ctx, tx, boil.Infer()); err != nil {
+		return nil, errors.Wrap(err, "failed insert userInfo")
+	}
+	return &userAccount, nil
+}
+
+func TestGetActiveUserAccountWithUserInfo(t *testing.T) {
+	t.Parallel()
+	require.NoError(t, database.SetupDatabase())
+	repo := NewRepo()
+	ctx := dbtest.SetTestTransactionCtx(context.Background())
+
+	{ // found user with userInfo
+		var userAccount *entities.UserAccount
+		err := database.NewBoilerTransaction(ctx, func(ctx context.Context, tx boil.ContextTransactor) error {
+			generated, err := generateTestData(ctx, tx, true)
+			if err != nil {
+				return errors.Wrap(err, "failed generate data")
+			}
+
+			userAccount, err = repo.GetActiveUserAccountWithUserInfo(ctx, tx, generated.ID)
+			if err != nil {
+				return errors.Wrap(err, "failed get userAccount")
+			}
+			return nil
+		})
+
+		require.NoError(t, err)
+		require.NotNil(t, userAccount)
+		require.Len(t, userAccount.R.UserInfos, 1)
+		require.Equal(t, "name", userAccount.R.UserInfos[0].Name.String)
+	}
+	{ // found user without userInfo
+		var userAccount *entities.UserAccount
+		err := database.NewBoilerTransaction(ctx, func(ctx context.Context, tx boil.ContextTransactor) error {
+			generated, err := generateTestData(ctx, tx, false)
+			if err != nil {
+				return errors.Wrap(err, "failed generate data")
+			}
+
+			userAccount, err = repo.GetActiveUserAccountWithUserInfo(ctx, tx, generated.ID)
+			if err != nil {
+				return errors.Wrap(err, "failed get userAccount")
+			}
+			return nil
+		})
+
+		require.NoError(t, err)
+		require.NotNil(t, userAccount)
+		require.Empty(t, userAccount.R.UserInfos)
+	}
+	{ // not found user
+		var userAccount *entities.UserAccount
+		err := database.NewBoilerTransaction(ctx, func(ctx context.Context, tx boil.ContextTransactor) error {
+			var err error
+			userAccount, err = repo.GetActiveUserAccountWithUserInfo(ctx, tx, -1)
+			if err != nil {
+				return errors.Wrap(err, "failed get userAccount")
+			}
+			return nil
+		})
+
+		require.NoError(t, err)
+		require.Nil(t, userAccount)
+	}
+}
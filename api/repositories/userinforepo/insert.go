@@ -0,0 +1,17 @@
+package userinforepo
+
+import (
+	"context"
+	"mysite/entities"
+
+	"github.com/friendsofgo/errors"
+	"github.com/volatiletech/sqlboiler/v4/boil"
+)
+
+func (u userInfoRepo) Insert(ctx context.Context, tx boil.ContextTransactor, userInfo *entities.UserInfo) error {
+	if err := userInfo.Insert(ctx, tx, boil.Infer()); err != nil {
+		return errors.Wrap(err, "failed to insert userInfo")
+	}
+
+	return nil
+}
@@ -0,0 +1,49 @@
+package userinforepo
+
+import (
+	"context"
+	"mysite/entities"
+	"mysite/pkgs/database"
+	"mysite/testing/dbtest"
+	"testing"
+
+	"github.com/friendsofgo/errors"
+	"github.com/stretchr/testify/require"
+	"github.com/volatiletech/null/v8"
+	"github.com/volatiletech/sqlboiler/v4/boil"
+)
+
+func TestInsert(t *testing.T) {
+	t.Parallel()
+	require.NoError(t, database.SetupDatabase())
+	repo := NewRepo()
+	ctx := dbtest.SetTestTransactionCtx(context.Background())
+
+	{ // insert success
+		var userAccount *entities.UserAccount
+		err := database.NewBoilerTransaction(ctx, func(ctx context.Context, tx boil.ContextTransactor) error {
+			generated, err := generateTestData(ctx, tx, false)
+			if err != nil {
+				return errors.Wrap(err, "failed generate data")
+			}
+
+			userInfo := entities.UserInfo{
+				UserAccountID: generated.ID,
+				Phone:         null.StringFrom("0123456789"),
+			}
+			if err := repo.Insert(ctx, tx, &userInfo); err != nil {
+				return errors.Wrap(err, "failed insert userInfo")
+			}
+
+			userAccount, err = repo.GetActiveUserAccountWithUserInfo(ctx, tx, generated.ID)
+			if err != nil {
+				return errors.Wrap(err, "failed get userAccount")
+			}
+			return nil
+		})
+
+		require.NoError(t, err)
+		require.Len(t, userAccount.R.UserInfos, 1)
+		require.Equal(t, "0123456789", userAccount.R.UserInfos[0].Phone.String)
+	}
+}
@@ -0,0 +1,40 @@
+package userinforepo
+
+import (
+	"context"
+	"mysite/entities"
+	"time"
+
+	"github.com/friendsofgo/errors"
+	"github.com/volatiletech/null/v8"
+	"github.com/volatiletech/sqlboiler/v4/boil"
+)
+
+func (u userInfoRepo) Update(ctx context.Context, tx boil.ContextTransactor, userInfo entities.UserInfo) error {
+	userInfo.UpdatedAt = null.TimeFrom(time.Now())
+	rowEffected, err := userInfo.Update(ctx, tx, boil.Whitelist(
+		entities.UserInfoColumns.Name,
+		entities.UserInfoColumns.Phone,
+		entities.UserInfoColumns.Email,
+		entities.UserInfoColumns.Gender,
+		entities.UserInfoColumns.UpdatedAt,
+	))
+	if err != nil || rowEffected == 0 {
+		return errors.Wrap(err, "failed to update userInfo")
+	}
+	return nil
+}
+
+// UpdateMembership only changes the tier, Update never touches it
+func (u userInfoRepo) UpdateMembership(ctx context.Context, tx boil.ContextTransactor, userInfo entities.UserInfo) error {
+	userInfo.UpdatedAt = null.TimeFrom(time.Now())
+	rowEffected, err := userInfo.Update(ctx, tx, boil.Whitelist(
+		entities.UserInfoColumns.MembershipID,
+		entities.UserInfoColumns.UpdatedAt,
+	))
+	if err != nil || rowEffected == 0 {
+		return errors.Wrap(err, "failed to update userInfo membership")
+	}
+
+	return nil
+}
@@ -0,0 +1,92 @@
+package userinforepo
+
+import (
+	"context"
+	"mysite/constants"
+	"mysite/entities"
+	"mysite/pkgs/database"
+	"mysite/testing/dbtest"
+	"testing"
+
+	"github.com/friendsofgo/errors"
+	"github.com/stretchr/testify/require"
+	"github.com/volatiletech/null/v8"
+	"github.com/volatiletech/sqlboiler/v4/boil"
+)
+
+func TestUpdate(t *testing.T) {
+	t.Parallel()
+	require.NoError(t, database.SetupDatabase())
+	repo := NewRepo()
+	ctx := dbtest.SetTestTransactionCtx(context.Background())
+
+	{ // update success
+		var userAccount *entities.UserAccount
+		err := database.NewBoilerTransaction(ctx, func(ctx context.Context, tx boil.ContextTransactor) error {
+			generated, err := generateTestData(ctx, tx, true)
+			if err != nil {
+				return errors.Wrap(err, "failed generate data")
+			}
+
+			userAccount, err = repo.GetActiveUserAccountWithUserInfo(ctx, tx, generated.ID)
+			if err != nil {
+				return errors.Wrap(err, "failed get userAccount")
+			}
+
+			userInfo := *userAccount.R.UserInfos[0]
+			userInfo.Name = null.StringFrom("new name")
+			if err := repo.Update(ctx, tx, userInfo); err != nil {
+				return errors.Wrap(err, "failed update userInfo")
+			}
+
+			userAccount, err = repo.GetActiveUserAccountWithUserInfo(ctx, tx, generated.ID)
+			if err != nil {
+				return errors.Wrap(err, "failed get userAccount")
+			}
+			return nil
+		})
+
+		require.NoError(t, err)
+		require.Equal(t, "new name", userAccount.R.UserInfos[0].Name.String)
+		require.True(t, userAccount.R.UserInfos[0].UpdatedAt.Valid)
+	}
+}
+
+func TestUpdateMembership(t *testing.T) {
+	t.Parallel()
+	require.NoError(t, database.SetupDatabase())
+	repo := NewRepo()
+	ctx := dbtest.SetTestTransactionCtx(context.Background())
+
+	{ // only the tier is changed
+		var userAccount *entities.UserAccount
+		err := database.NewBoilerTransaction(ctx, func(ctx context.Context, tx boil.ContextTransactor) error {
+			generated, err := generateTestData(ctx, tx, true)
+			if err != nil {
+				return errors.Wrap(err, "failed generate data")
+			}
+
+			userAccount, err = repo.GetActiveUserAccountWithUserInfo(ctx, tx, generated.ID)
+			if err != nil {
+				return errors.Wrap(err, "failed get userAccount")
+			}
+
+			userInfo := *userAccount.R.UserInfos[0]
+			userInfo.MembershipID = null.IntFrom(constants.Gold)
+			userInfo.Name = null.StringFrom("ignored")
+			if err := repo.UpdateMembership(ctx, tx, userInfo); err != nil {
+				return errors.Wrap(err, "failed update userInfo membership")
+			}
+
+			userAccount, err = repo.GetActiveUserAccountWithUserInfo(ctx, tx, generated.ID)
+			if err != nil {
+				return errors.Wrap(err, "failed get userAccount")
+			}
+			return nil
+		})
+
+		require.NoError(t, err)
+		require.Equal(t, constants.Gold, userAccount.R.UserInfos[0].MembershipID.Int)
+		require.NotEqual(t, "ignored", userAccount.R.UserInfos[0].Name.String)
+	}
+}
@@ -0,0 +1,38 @@
+package userinforepo
+
+import (
+	"context"
+	"mysite/entities"
+
+	"github.com/volatiletech/sqlboiler/v4/boil"
+)
+
+type Get interface {
+	GetActiveUserAccountWithUserInfo(ctx context.Context, tx boil.ContextTransactor, userAccountId int) (*entities.UserAccount, error)
+}
+
+type Insert interface {
+	Insert(ctx context.Context, tx boil.ContextTransactor, userInfo *entities.UserInfo) error
+}
+
+type Update interface {
+	Update(ctx context.Context, tx boil.ContextTransactor, userInfo entities.UserInfo) error
+	UpdateMembership(ctx context.Context, tx boil.ContextTransactor, userInfo entities.UserInfo) error
+}
+
+type Delete interface{}
+
+//go:generate moq -pkg repomock -out ../../testing/mocking/repomock/userinfomock.go . UserInfoRepo
+type UserInfoRepo interface {
+	Get
+	Insert
+	Update
+	Delete
+}
+
+type userInfoRepo struct {
+}
+
+func NewRepo() UserInfoRepo {
+	return &userInfoRepo{}
+}
@@ -0,0 +1,22 @@
+package usermfarepo
+
+import (
+	"context"
+	"mysite/entities"
+
+	"github.com/friendsofgo/errors"
+	"github.com/volatiletech/sqlboiler/v4/boil"
+)
+
+// DeleteByUserAccountId turns 2FA off, the secret and every recovery code are removed
+func (u userMfaRepo) DeleteByUserAccountId(ctx context.Context, tx boil.ContextTransactor, userAccountId int) error {
+	if _, err := entities.UserRecoveryCodes(entities.UserRecoveryCodeWhere.UserAccountID.EQ(userAccountId)).DeleteAll(ctx, tx); err != nil {
+		return errors.Wrap(err, "failed to delete userRecoveryCodes")
+	}
+
+	if _, err := entities.UserMfas(entities.UserMfaWhere.UserAccountID.EQ(userAccountId)).DeleteAll(ctx, tx); err != nil {
+		return errors.Wrap(err, "failed to delete userMfa")
+	}
+
+	return nil
+}
@@ -0,0 +1,50 @@
+package usermfarepo
+
+import (
+	"context"
+	"mysite/entities"
+	"mysite/pkgs/database"
+	"mysite/testing/dbtest"
+	"testing"
+
+	"github.com/friendsofgo/errors"
+	"github.com/stretchr/testify/require"
+	"github.com/volatiletech/sqlboiler/v4/boil"
+)
+
+func TestDeleteByUserAccountId(t *testing.T) {
+	t.Parallel()
+	require.NoError(t, database.SetupDatabase())
+	repo := NewRepo()
+	ctx := dbtest.SetTestTransactionCtx(context.Background())
+
+	{ // secret and recovery codes removed
+		var mfa *entities.UserMfa
+		var code *entities.UserRecoveryCode
+		err := database.NewBoilerTransaction(ctx, func(ctx context.Context, tx boil.ContextTransactor) error {
+			data, err := generateTestData(ctx, tx, "delete-mfa")
+			if err != nil {
+				return errors.Wrap(err, "failed generate data")
+			}
+			if err := repo.ReplaceRecoveryCodes(ctx, tx, data.UserAccountID, []string{"hash"}); err != nil {
+				return errors.Wrap(err, "failed insert recovery codes")
+			}
+
+			if err := repo.DeleteByUserAccountId(ctx, tx, data.UserAccountID); err != nil {
+				return errors.Wrap(err, "failed delete userMfa")
+			}
+
+			if mfa, err = repo.GetUserMfaByUserAccountId(ctx, tx, data.UserAccountID); err != nil {
+				return errors.Wrap(err, "failed get userMfa")
+			}
+			if code, err = repo.GetUnusedRecoveryCode(ctx, tx, data.UserAccountID, "hash"); err != nil {
+				return errors.Wrap(err, "failed get recovery code")
+			}
+			return nil
+		})
+
+		require.NoError(t, err)
+		require.Nil(t, mfa)
+		require.Nil(t, code)
+	}
+}
@@ -0,0 +1,41 @@
+package usermfarepo
+
+import (
+	"context"
+	"database/sql"
+	"mysite/entities"
+
+	"github.com/friendsofgo/errors"
+	"github.com/volatiletech/sqlboiler/v4/boil"
+	"github.com/volatiletech/sqlboiler/v4/queries/qm"
+)
+
+func (u userMfaRepo) GetUserMfaByUserAccountId(ctx context.Context, tx boil.ContextTransactor, userAccountId int) (*entities.UserMfa, error) {
+	mods := []qm.QueryMod{
+		entities.UserMfaWhere.UserAccountID.EQ(userAccountId),
+		qm.For("UPDATE"),
+	}
+
+	pgMfa, err := entities.UserMfas(mods...).One(ctx, tx)
+	if err != nil && !errors.Is(err, sql.ErrNoRows) {
+		return nil, errors.Wrap(err, "failed to get userMfa")
+	}
+
+	return pgMfa, nil
+}
+
+func (u userMfaRepo) GetUnusedRecoveryCode(ctx context.Context, tx boil.ContextTransactor, userAccountId int, codeHash string) (*entities.UserRecoveryCode, error) {
+	mods := []qm.QueryMod{
+		entities.UserRecoveryCodeWhere.UserAccountID.EQ(userAccountId),
+		entities.UserRecoveryCodeWhere.CodeHash.EQ(codeHash),
+		entities.UserRecoveryCodeWhere.UsedAt.IsNull(),
+		qm.For("UPDATE"),
+	}
+
+	pgCode, err := entities.UserRecoveryCodes(mods...).One(ctx, tx)
+	if err != nil && !errors.Is(err, sql.ErrNoRows) {
+		return nil, errors.Wrap(err, "failed to get userRecoveryCode")
+	}
+
+	return pgCode, nil
+}
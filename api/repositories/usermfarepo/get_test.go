@@ -0,0 +1,114 @@
+package usermfarepo
+
+import (
+	"context"
+	"mysite/entities"
+	"mysite/pkgs/database"
+	"mysite/testing/dbtest"
+	"testing"
+
+	"github.com/friendsofgo/errors"
+	"github.com/stretchr/testify/require"
+	"github.com/volatiletech/sqlboiler/v4/boil"
+)
+
+func generateTestData(ctx context.Context, tx boil.ContextTransactor, userName string) (*entities.UserMfa, error) {
+	userAccount := entities.UserAccount{
+		UserName: userName,
+		Password: "password",
+		IsActive: true,
+	}
+	if err := userAccount.Insert(ctx, tx, boil.Infer()); err != nil {
+		return nil, errors.Wrap(err, "failed insert userAccount")
+	}
+
+	mfa := entities.UserMfa{
+		UserAccountID: userAccount.ID,
+		Secret:        "SECRET",
+	}
+	if err := mfa.Insert(ctx, tx, boil.Infer()); err != nil {
+		return nil, errors.Wrap(err, "failed insert userMfa")
+	}
+	return &mfa, nil
+}
+
+func TestGetUserMfaByUserAccountId(t *testing.T) {
+	t.Parallel()
+	require.NoError(t, database.SetupDatabase())
+	repo := NewRepo()
+	ctx := dbtest.SetTestTransactionCtx(context.Background())
+
+	{ // found mfa
+		var expected, mfa *entities.UserMfa
+		err := database.NewBoilerTransaction(ctx, func(ctx context.Context, tx boil.ContextTransactor) error {
+			var err error
+			if expected, err = generateTestData(ctx, tx, "found-mfa"); err != nil {
+				return errors.Wrap(err, "failed generate data")
+			}
+
+			mfa, err = repo.GetUserMfaByUserAccountId(ctx, tx, expected.UserAccountID)
+			if err != nil {
+				return errors.Wrap(err, "failed get userMfa")
+			}
+			return nil
+		})
+
+		require.NoError(t, err)
+		require.Equal(t, expected.ID, mfa.ID)
+		require.Equal(t, "SECRET", mfa.Secret)
+	}
+	{ // not found mfa
+		var mfa *entities.UserMfa
+		err := database.NewBoilerTransaction(ctx, func(ctx context.Context, tx boil.ContextTransactor) error {
+			var err error
+			mfa, err = repo.GetUserMfaByUserAccountId(ctx, tx, -1)
+			if err != nil {
+				return errors.Wrap(err, "failed get userMfa")
+			}
+			return nil
+		})
+
+		require.NoError(t, err)
+		require.Nil(t, mfa)
+	}
+}
+
+func TestGetUnusedRecoveryCode(t *testing.T) {
+	t.Parallel()
+	require.NoError(t, database.SetupDatabase())
+	repo := NewRepo()
+	ctx := dbtest.SetTestTransactionCtx(context.Background())
+
+	{ // found unused code, used code is not returned
+		var unused, used *entities.UserRecoveryCode
+		err := database.NewBoilerTransaction(ctx, func(ctx context.Context, tx boil.ContextTransactor) error {
+			mfa, err := generateTestData(ctx, tx, "recovery-code")
+			if err != nil {
+				return errors.Wrap(err, "failed generate data")
+			}
+			if err := repo.ReplaceRecoveryCodes(ctx, tx, mfa.UserAccountID, []string{"hash-1", "hash-2"}); err != nil {
+				return errors.Wrap(err, "failed insert recovery codes")
+			}
+
+			code, err := repo.GetUnusedRecoveryCode(ctx, tx, mfa.UserAccountID, "hash-2")
+			if err != nil {
+				return errors.Wrap(err, "failed get recovery code")
+			}
+			if err := repo.MarkRecoveryCodeUsed(ctx, tx, *code); err != nil {
+				return errors.Wrap(err, "failed mark recovery code used")
+			}
+
+			if unused, err = repo.GetUnusedRecoveryCode(ctx, tx, mfa.UserAccountID, "hash-1"); err != nil {
+				return errors.Wrap(err, "failed get recovery code")
+			}
+			if used, err = repo.GetUnusedRecoveryCode(ctx, tx, mfa.UserAccountID, "hash-2"); err != nil {
+				return errors.Wrap(err, "failed get recovery code")
+			}
+			return nil
+		})
+
+		require.NoError(t, err)
+		require.NotNil(t, unused)
+		require.Nil(t, used)
+	}
+}
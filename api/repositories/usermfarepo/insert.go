@@ -0,0 +1,36 @@
+package usermfarepo
+
+import (
+	"context"
+	"mysite/entities"
+
+	"github.com/friendsofgo/errors"
+	"github.com/volatiletech/sqlboiler/v4/boil"
+)
+
+func (u userMfaRepo) Insert(ctx context.Context, tx boil.ContextTransactor, mfa *entities.UserMfa) error {
+	if err := mfa.Insert(ctx, tx, boil.Infer()); err != nil {
+		return errors.Wrap(err, "failed to insert userMfa")
+	}
+
+	return nil
+}
+
+// ReplaceRecoveryCodes drops the previous codes of the user, old codes must not work once new ones are shown
+func (u userMfaRepo) ReplaceRecoveryCodes(ctx context.Context, tx boil.ContextTransactor, userAccountId int, codeHashes []string) error {
+	if _, err := entities.UserRecoveryCodes(entities.UserRecoveryCodeWhere.UserAccountID.EQ(userAccountId)).DeleteAll(ctx, tx); err != nil {
+		return errors.Wrap(err, "failed to delete userRecoveryCodes")
+	}
+
+	for _, codeHash := range codeHashes {
+		code := entities.UserRecoveryCode{
+			UserAccountID: userAccountId,
+			CodeHash:      codeHash,
+		}
+		if err := code.Insert(ctx, tx, boil.Infer()); err != nil {
+			return errors.Wrap(err, "failed to insert userRecoveryCode")
+		}
+	}
+
+	return nil
+}
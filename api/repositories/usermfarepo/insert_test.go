@@ -0,0 +1,81 @@
+package usermfarepo
+
+import (
+	"context"
+	"mysite/entities"
+	"mysite/pkgs/database"
+	"mysite/testing/dbtest"
+	"testing"
+
+	"github.com/friendsofgo/errors"
+	"github.com/stretchr/testify/require"
+	"github.com/volatiletech/sqlboiler/v4/boil"
+)
+
+func TestInsert(t *testing.T) {
+	t.Parallel()
+	require.NoError(t, database.SetupDatabase())
+	repo := NewRepo()
+	ctx := dbtest.SetTestTransactionCtx(context.Background())
+
+	{ // insert success
+		var mfa *entities.UserMfa
+		err := database.NewBoilerTransaction(ctx, func(ctx context.Context, tx boil.ContextTransactor) error {
+			userAccount := entities.UserAccount{
+				UserName: "insert-mfa",
+				Password: "password",
+				IsActive: true,
+			}
+			if err := userAccount.Insert(ctx, tx, boil.Infer()); err != nil {
+				return errors.Wrap(err, "failed insert userAccount")
+			}
+
+			if err := repo.Insert(ctx, tx, &entities.UserMfa{UserAccountID: userAccount.ID, Secret: "SECRET"}); err != nil {
+				return errors.Wrap(err, "failed insert userMfa")
+			}
+
+			var err error
+			mfa, err = repo.GetUserMfaByUserAccountId(ctx, tx, userAccount.ID)
+			return err
+		})
+
+		require.NoError(t, err)
+		require.NotNil(t, mfa)
+		require.False(t, mfa.EnabledAt.Valid)
+	}
+}
+
+func TestReplaceRecoveryCodes(t *testing.T) {
+	t.Parallel()
+	require.NoError(t, database.SetupDatabase())
+	repo := NewRepo()
+	ctx := dbtest.SetTestTransactionCtx(context.Background())
+
+	{ // previous codes are removed
+		var old, current *entities.UserRecoveryCode
+		err := database.NewBoilerTransaction(ctx, func(ctx context.Context, tx boil.ContextTransactor) error {
+			mfa, err := generateTestData(ctx, tx, "replace-recovery-code")
+			if err != nil {
+				return errors.Wrap(err, "failed generate data")
+			}
+			if err := repo.ReplaceRecoveryCodes(ctx, tx, mfa.UserAccountID, []string{"old-hash"}); err != nil {
+				return errors.Wrap(err, "failed insert recovery codes")
+			}
+			if err := repo.ReplaceRecoveryCodes(ctx, tx, mfa.UserAccountID, []string{"new-hash"}); err != nil {
+				return errors.Wrap(err, "failed replace recovery codes")
+			}
+
+			if old, err = repo.GetUnusedRecoveryCode(ctx, tx, mfa.UserAccountID, "old-hash"); err != nil {
+				return errors.Wrap(err, "failed get recovery code")
+			}
+			if current, err = repo.GetUnusedRecoveryCode(ctx, tx, mfa.UserAccountID, "new-hash"); err != nil {
+				return errors.Wrap(err, "failed get recovery code")
+			}
+			return nil
+		})
+
+		require.NoError(t, err)
+		require.Nil(t, old)
+		require.NotNil(t, current)
+	}
+}
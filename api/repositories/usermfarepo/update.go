@@ -0,0 +1,31 @@
+package usermfarepo
+
+import (
+	"context"
+	"mysite/entities"
+	"time"
+
+	"github.com/friendsofgo/errors"
+	"github.com/volatiletech/null/v8"
+	"github.com/volatiletech/sqlboiler/v4/boil"
+)
+
+func (u userMfaRepo) Update(ctx context.Context, tx boil.ContextTransactor, mfa entities.UserMfa) error {
+	mfa.UpdatedAt = null.TimeFrom(time.Now())
+	rowEffected, err := mfa.Update(ctx, tx, boil.Infer())
+	if err != nil || rowEffected == 0 {
+		return errors.Wrap(err, "failed to update userMfa")
+	}
+	return nil
+}
+
+func (u userMfaRepo) MarkRecoveryCodeUsed(ctx context.Context, tx boil.ContextTransactor, code entities.UserRecoveryCode) error {
+	now := time.Now()
+	code.UsedAt = null.TimeFrom(now)
+	code.UpdatedAt = null.TimeFrom(now)
+	rowEffected, err := code.Update(ctx, tx, boil.Whitelist(entities.UserRecoveryCodeColumns.UsedAt, entities.UserRecoveryCodeColumns.UpdatedAt))
+	if err != nil || rowEffected == 0 {
+		return errors.Wrap(err, "failed to update userRecoveryCode")
+	}
+	return nil
+}
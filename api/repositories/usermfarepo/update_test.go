@@ -0,0 +1,46 @@
+package usermfarepo
+
+import (
+	"context"
+	"mysite/entities"
+	"mysite/pkgs/database"
+	"mysite/testing/dbtest"
+	"testing"
+	"time"
+
+	"github.com/friendsofgo/errors"
+	"github.com/stretchr/testify/require"
+	"github.com/volatiletech/null/v8"
+	"github.com/volatiletech/sqlboiler/v4/boil"
+)
+
+func TestUpdate(t *testing.T) {
+	t.Parallel()
+	require.NoError(t, database.SetupDatabase())
+	repo := NewRepo()
+	ctx := dbtest.SetTestTransactionCtx(context.Background())
+
+	{ // update success
+		var result *entities.UserMfa
+		err := database.NewBoilerTransaction(ctx, func(ctx context.Context, tx boil.ContextTransactor) error {
+			mfa, err := generateTestData(ctx, tx, "update-mfa")
+			if err != nil {
+				return errors.Wrap(err, "failed generate data")
+			}
+
+			mfa.EnabledAt = null.TimeFrom(time.Now())
+			mfa.LastUsedStep = null.Int64From(42)
+			if err := repo.Update(ctx, tx, *mfa); err != nil {
+				return errors.Wrap(err, "failed update userMfa")
+			}
+
+			result, err = repo.GetUserMfaByUserAccountId(ctx, tx, mfa.UserAccountID)
+			return err
+		})
+
+		require.NoError(t, err)
+		require.True(t, result.EnabledAt.Valid)
+		require.Equal(t, int64(42), result.LastUsedStep.Int64)
+		require.True(t, result.UpdatedAt.Valid)
+	}
+}
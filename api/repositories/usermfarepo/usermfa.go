@@ -0,0 +1,42 @@
+package usermfarepo
+
+import (
+	"context"
+	"mysite/entities"
+
+	"github.com/volatiletech/sqlboiler/v4/boil"
+)
+
+type Get interface {
+	GetUserMfaByUserAccountId(ctx context.Context, tx boil.ContextTransactor, userAccountId int) (*entities.UserMfa, error)
+	GetUnusedRecoveryCode(ctx context.Context, tx boil.ContextTransactor, userAccountId int, codeHash string) (*entities.UserRecoveryCode, error)
+}
+
+type Insert interface {
+	Insert(ctx context.Context, tx boil.ContextTransactor, mfa *entities.UserMfa) error
+	ReplaceRecoveryCodes(ctx context.Context, tx boil.ContextTransactor, userAccountId int, codeHashes []string) error
+}
+
+type Update interface {
+	Update(ctx context.Context, tx boil.ContextTransactor, mfa entities.UserMfa) error
+	MarkRecoveryCodeUsed(ctx context.Context, tx boil.ContextTransactor, code entities.UserRecoveryCode) error
+}
+
+type Delete interface {
+	DeleteByUserAccountId(ctx context.Context, tx boil.ContextTransactor, userAccountId int) error
+}
+
+//go:generate moq -pkg repomock -out ../../testing/mocking/repomock/usermfamock.go . UserMfaRepo
+type UserMfaRepo interface {
+	Get
+	Insert
+	Update
+	Delete
+}
+
+type userMfaRepo struct {
+}
+
+func NewRepo() UserMfaRepo {
+	return &userMfaRepo{}
+}
@@ -0,0 +1,25 @@
+package usersessionrepo
+
+import (
+	"context"
+	"database/sql"
+	"mysite/entities"
+
+	"github.com/friendsofgo/errors"
+	"github.com/volatiletech/sqlboiler/v4/boil"
+	"github.com/volatiletech/sqlboiler/v4/queries/qm"
+)
+
+func (u userSessionRepo) GetUserSessionByTokenId(ctx context.Context, tx boil.ContextTransactor, tokenId string) (*entities.UserSession, error) {
+	mods := []qm.QueryMod{
+		entities.UserSessionWhere.TokenID.EQ(tokenId),
+		qm.For("UPDATE"),
+	}
+
+	pgUserSession, err := entities.UserSessions(mods...).One(ctx, tx)
+	if err != nil && !errors.Is(err, sql.ErrNoRows) {
+		return nil, errors.Wrap(err, "failed to get userSession")
+	}
+
+	return pgUserSession, nil
+}
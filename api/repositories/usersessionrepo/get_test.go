@@ -0,0 +1,79 @@
+package usersessionrepo
+
+import (
+	"context"
+	"mysite/entities"
+	"mysite/pkgs/database"
+	"mysite/testing/dbtest"
+	"testing"
+	"time"
+
+	"github.com/friendsofgo/errors"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/require"
+	"github.com/volatiletech/sqlboiler/v4/boil"
+)
+
+func generateTestData(ctx context.Context, tx boil.ContextTransactor, familyId, tokenId string) (*entities.UserSession, error) {
+	userAccount := entities.UserAccount{
+		UserName: "userName",
+		Password: "password",
+		IsActive: true,
+	}
+	if err := userAccount.Insert(ctx, tx, boil.Infer()); err != nil {
+		return nil, errors.Wrap(err, "failed insert userAccount")
+	}
+
+	session := entities.UserSession{
+		UserAccountID: userAccount.ID,
+		FamilyID:      familyId,
+		TokenID:       tokenId,
+		ExpiresAt:     time.Now().Add(time.Hour),
+	}
+	if err := session.Insert(ctx, tx, boil.Infer()); err != nil {
+		return nil, errors.Wrap(err, "failed insert userSession")
+	}
+	return &session, nil
+}
+
+func TestGetUserSessionByTokenId(t *testing.T) {
+	t.Parallel()
+	require.NoError(t, database.SetupDatabase())
+	repo := NewRepo()
+	ctx := dbtest.SetTestTransactionCtx(context.Background())
+
+	{ // found session
+		tokenId := uuid.NewString()
+		var session *entities.UserSession
+		err := database.NewBoilerTransaction(ctx, func(ctx context.Context, tx boil.ContextTransactor) error {
+			if _, err := generateTestData(ctx, tx, uuid.NewString(), tokenId); err != nil {
+				return errors.Wrap(err, "failed generate data")
+			}
+
+			var err error
+			session, err = repo.GetUserSessionByTokenId(ctx, tx, tokenId)
+			if err != nil {
+				return errors.Wrap(err, "failed get userSession")
+			}
+			return nil
+		})
+
+		require.NoError(t, err)
+		require.NotNil(t, session)
+		require.Equal(t, tokenId, session.TokenID)
+	}
+	{ // not found session
+		var session *entities.UserSession
+		err := database.NewBoilerTransaction(ctx, func(ctx context.Context, tx boil.ContextTransactor) error {
+			var err error
+			session, err = repo.GetUserSessionByTokenId(ctx, tx, uuid.NewString())
+			if err != nil {
+				return errors.Wrap(err, "failed get userSession")
+			}
+			return nil
+		})
+
+		require.NoError(t, err)
+		require.Nil(t, session)
+	}
+}
@@ -0,0 +1,17 @@
+package usersessionrepo
+
+import (
+	"context"
+	"mysite/entities"
+
+	"github.com/friendsofgo/errors"
+	"github.com/volatiletech/sqlboiler/v4/boil"
+)
+
+func (u userSessionRepo) Insert(ctx context.Context, tx boil.ContextTransactor, session *entities.UserSession) error {
+	if err := session.Insert(ctx, tx, boil.Infer()); err != nil {
+		return errors.Wrap(err, "failed to insert userSession")
+	}
+
+	return nil
+}
@@ -0,0 +1,58 @@
+package usersessionrepo
+
+import (
+	"context"
+	"mysite/entities"
+	"mysite/pkgs/database"
+	"mysite/testing/dbtest"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/require"
+	"github.com/volatiletech/sqlboiler/v4/boil"
+)
+
+func TestInsert(t *testing.T) {
+	t.Parallel()
+	require.NoError(t, database.SetupDatabase())
+	repo := NewRepo()
+	ctx := dbtest.SetTestTransactionCtx(context.Background())
+
+	{ // insert success
+		tokenId := uuid.NewString()
+		var result *entities.UserSession
+		err := database.NewBoilerTransaction(ctx, func(ctx context.Context, tx boil.ContextTransactor) error {
+			userAccount := entities.UserAccount{
+				UserName: "userName",
+				Password: "password",
+				IsActive: true,
+			}
+			if err := userAccount.Insert(ctx, tx, boil.Infer()); err != nil {
+				return errors.Wrap(err, "failed insert userAccount")
+			}
+
+			if err := repo.Insert(ctx, tx, &entities.UserSession{
+				UserAccountID: userAccount.ID,
+				FamilyID:      uuid.NewString(),
+				TokenID:       tokenId,
+				ExpiresAt:     time.Now().Add(time.Hour),
+			}); err != nil {
+				return errors.Wrap(err, "failed insert userSession")
+			}
+
+			var err error
+			result, err = repo.GetUserSessionByTokenId(ctx, tx, tokenId)
+			if err != nil {
+				return errors.Wrap(err, "failed GetUserSessionByTokenId")
+			}
+			return nil
+		})
+
+		require.NoError(t, err)
+		require.Equal(t, tokenId, result.TokenID)
+		require.False(t, result.UsedAt.Valid)
+		require.False(t, result.RevokedAt.Valid)
+	}
+}
@@ -0,0 +1,79 @@
+package usersessionrepo
+
+import (
+	"context"
+	"mysite/entities"
+	"time"
+
+	"github.com/friendsofgo/errors"
+	"github.com/volatiletech/null/v8"
+	"github.com/volatiletech/sqlboiler/v4/boil"
+	"github.com/volatiletech/sqlboiler/v4/queries/qm"
+)
+
+func (u userSessionRepo) MarkUsed(ctx context.Context, tx boil.ContextTransactor, session entities.UserSession) error {
+	session.UsedAt = null.TimeFrom(time.Now())
+	rowEffected, err := session.Update(ctx, tx, boil.Whitelist(entities.UserSessionColumns.UsedAt, entities.UserSessionColumns.UpdatedAt))
+	if err != nil || rowEffected == 0 {
+		return errors.Wrap(err, "failed to update UserSession")
+	}
+	return nil
+}
+
+// RevokeFamily revokes every session rotated from the same login
+func (u userSessionRepo) RevokeFamily(ctx context.Context, tx boil.ContextTransactor, familyId string) error {
+	mods := []qm.QueryMod{
+		entities.UserSessionWhere.FamilyID.EQ(familyId),
+		entities.UserSessionWhere.RevokedAt.IsNull(),
+	}
+
+	now := time.Now()
+	_, err := entities.UserSessions(mods...).UpdateAll(ctx, tx, entities.M{
+		entities.UserSessionColumns.RevokedAt: null.TimeFrom(now),
+		entities.UserSessionColumns.UpdatedAt: null.TimeFrom(now),
+	})
+	if err != nil {
+		return errors.Wrap(err, "failed to revoke userSession family")
+	}
+	return nil
+}
+
+// RevokeAllByUserAccountId revokes every session of the user
+func (u userSessionRepo) RevokeAllByUserAccountId(ctx context.Context, tx boil.ContextTransactor, userAccountId int) error {
+	mods := []qm.QueryMod{
+		entities.UserSessionWhere.UserAccountID.EQ(userAccountId),
+		entities.UserSessionWhere.RevokedAt.IsNull(),
+	}
+
+	now := time.Now()
+	_, err := entities.UserSessions(mods...).UpdateAll(ctx, tx, entities.M{
+		entities.UserSessionColumns.RevokedAt: null.TimeFrom(now),
+		entities.UserSessionColumns.UpdatedAt: null.TimeFrom(now),
+	})
+	if err != nil {
+		return errors.Wrap(err, "failed to revoke userSessions of userAccount")
+	}
+	return nil
+}
+
+// RevokeOtherFamilies revokes every session of the user except the ones of keepFamilyId, every session when it is empty
+func (u userSessionRepo) RevokeOtherFamilies(ctx context.Context, tx boil.ContextTransactor, userAccountId int, keepFamilyId string) error {
+	mods := []qm.QueryMod{
+		entities.UserSessionWhere.UserAccountID.EQ(userAccountId),
+		entities.UserSessionWhere.RevokedAt.IsNull(),
+	}
+	// family_id is a uuid, an empty string is not comparable with it
+	if keepFamilyId != "" {
+		mods = append(mods, entities.UserSessionWhere.FamilyID.NEQ(keepFamilyId))
+	}
+
+	now := time.Now()
+	_, err := entities.UserSessions(mods...).UpdateAll(ctx, tx, entities.M{
+		entities.UserSessionColumns.RevokedAt: null.TimeFrom(now),
+		entities.UserSessionColumns.UpdatedAt: null.TimeFrom(now),
+	})
+	if err != nil {
+		return errors.Wrap(err, "failed to revoke other userSessions")
+	}
+	return nil
+}
@@ -0,0 +1,202 @@
+package usersessionrepo
+
+import (
+	"context"
+	"mysite/entities"
+	"mysite/pkgs/database"
+	"mysite/testing/dbtest"
+	"testing"
+	"time"
+
+	"github.com/friendsofgo/errors"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/require"
+	"github.com/volatiletech/sqlboiler/v4/boil"
+)
+
+func TestMarkUsed(t *testing.T) {
+	t.Parallel()
+	require.NoError(t, database.SetupDatabase())
+	repo := NewRepo()
+	ctx := dbtest.SetTestTransactionCtx(context.Background())
+
+	{ // mark used success
+		tokenId := uuid.NewString()
+		var result *entities.UserSession
+		err := database.NewBoilerTransaction(ctx, func(ctx context.Context, tx boil.ContextTransactor) error {
+			session, err := generateTestData(ctx, tx, uuid.NewString(), tokenId)
+			if err != nil {
+				return errors.Wrap(err, "failed generate data")
+			}
+
+			if err := repo.MarkUsed(ctx, tx, *session); err != nil {
+				return errors.Wrap(err, "failed to mark used")
+			}
+
+			result, err = repo.GetUserSessionByTokenId(ctx, tx, tokenId)
+			if err != nil {
+				return errors.Wrap(err, "failed GetUserSessionByTokenId")
+			}
+			return nil
+		})
+
+		require.NoError(t, err)
+		require.True(t, result.UsedAt.Valid)
+	}
+}
+
+func TestRevokeFamily(t *testing.T) {
+	t.Parallel()
+	require.NoError(t, database.SetupDatabase())
+	repo := NewRepo()
+	ctx := dbtest.SetTestTransactionCtx(context.Background())
+
+	{ // revoke every session of the family
+		familyId := uuid.NewString()
+		tokenId := uuid.NewString()
+		var first, second *entities.UserSession
+		err := database.NewBoilerTransaction(ctx, func(ctx context.Context, tx boil.ContextTransactor) error {
+			session, err := generateTestData(ctx, tx, familyId, tokenId)
+			if err != nil {
+				return errors.Wrap(err, "failed generate data")
+			}
+
+			rotated := entities.UserSession{
+				UserAccountID: session.UserAccountID,
+				FamilyID:      familyId,
+				TokenID:       uuid.NewString(),
+				ExpiresAt:     time.Now().Add(time.Hour),
+			}
+			if err := repo.Insert(ctx, tx, &rotated); err != nil {
+				return errors.Wrap(err, "failed insert userSession")
+			}
+
+			if err := repo.RevokeFamily(ctx, tx, familyId); err != nil {
+				return errors.Wrap(err, "failed to revoke family")
+			}
+
+			if first, err = repo.GetUserSessionByTokenId(ctx, tx, tokenId); err != nil {
+				return errors.Wrap(err, "failed GetUserSessionByTokenId")
+			}
+			if second, err = repo.GetUserSessionByTokenId(ctx, tx, rotated.TokenID); err != nil {
+				return errors.Wrap(err, "failed GetUserSessionByTokenId")
+			}
+			return nil
+		})
+
+		require.NoError(t, err)
+		require.True(t, first.RevokedAt.Valid)
+		require.True(t, second.RevokedAt.Valid)
+	}
+}
+
+func TestRevokeAllByUserAccountId(t *testing.T) {
+	t.Parallel()
+	require.NoError(t, database.SetupDatabase())
+	repo := NewRepo()
+	ctx := dbtest.SetTestTransactionCtx(context.Background())
+
+	{ // revoke every session of the user
+		tokenId := uuid.NewString()
+		var first, second *entities.UserSession
+		err := database.NewBoilerTransaction(ctx, func(ctx context.Context, tx boil.ContextTransactor) error {
+			session, err := generateTestData(ctx, tx, uuid.NewString(), tokenId)
+			if err != nil {
+				return errors.Wrap(err, "failed generate data")
+			}
+
+			other := entities.UserSession{
+				UserAccountID: session.UserAccountID,
+				FamilyID:      uuid.NewString(),
+				TokenID:       uuid.NewString(),
+				ExpiresAt:     time.Now().Add(time.Hour),
+			}
+			if err := repo.Insert(ctx, tx, &other); err != nil {
+				return errors.Wrap(err, "failed insert userSession")
+			}
+
+			if err := repo.RevokeAllByUserAccountId(ctx, tx, session.UserAccountID); err != nil {
+				return errors.Wrap(err, "failed to revoke sessions")
+			}
+
+			if first, err = repo.GetUserSessionByTokenId(ctx, tx, tokenId); err != nil {
+				return errors.Wrap(err, "failed GetUserSessionByTokenId")
+			}
+			if second, err = repo.GetUserSessionByTokenId(ctx, tx, other.TokenID); err != nil {
+				return errors.Wrap(err, "failed GetUserSessionByTokenId")
+			}
+			return nil
+		})
+
+		require.NoError(t, err)
+		require.True(t, first.RevokedAt.Valid)
+		require.True(t, second.RevokedAt.Valid)
+	}
+}
+
+func TestRevokeOtherFamilies(t *testing.T) {
+	t.Parallel()
+	require.NoError(t, database.SetupDatabase())
+	repo := NewRepo()
+	ctx := dbtest.SetTestTransactionCtx(context.Background())
+
+	{ // revoke every session of the user except the kept family
+		keepFamilyId := uuid.NewString()
+		tokenId := uuid.NewString()
+		var kept, other *entities.UserSession
+		err := database.NewBoilerTransaction(ctx, func(ctx context.Context, tx boil.ContextTransactor) error {
+			session, err := generateTestData(ctx, tx, keepFamilyId, tokenId)
+			if err != nil {
+				return errors.Wrap(err, "failed generate data")
+			}
+
+			otherSession := entities.UserSession{
+				UserAccountID: session.UserAccountID,
+				FamilyID:      uuid.NewString(),
+				TokenID:       uuid.NewString(),
+				ExpiresAt:     time.Now().Add(time.Hour),
+			}
+			if err := repo.Insert(ctx, tx, &otherSession); err != nil {
+				return errors.Wrap(err, "failed insert userSession")
+			}
+
+			if err := repo.RevokeOtherFamilies(ctx, tx, session.UserAccountID, keepFamilyId); err != nil {
+				return errors.Wrap(err, "failed to revoke other families")
+			}
+
+			if kept, err = repo.GetUserSessionByTokenId(ctx, tx, tokenId); err != nil {
+				return errors.Wrap(err, "failed GetUserSessionByTokenId")
+			}
+			if other, err = repo.GetUserSessionByTokenId(ctx, tx, otherSession.TokenID); err != nil {
+				return errors.Wrap(err, "failed GetUserSessionByTokenId")
+			}
+			return nil
+		})
+
+		require.NoError(t, err)
+		require.False(t, kept.RevokedAt.Valid)
+		require.True(t, other.RevokedAt.Valid)
+	}
+	{ // without a family to keep every session of the user is revoked
+		tokenId := uuid.NewString()
+		var revoked *entities.UserSession
+		err := database.NewBoilerTransaction(ctx, func(ctx context.Context, tx boil.ContextTransactor) error {
+			session, err := generateTestData(ctx, tx, uuid.NewString(), tokenId)
+			if err != nil {
+				return errors.Wrap(err, "failed generate data")
+			}
+
+			if err := repo.RevokeOtherFamilies(ctx, tx, session.UserAccountID, ""); err != nil {
+				return errors.Wrap(err, "failed to revoke other families")
+			}
+
+			if revoked, err = repo.GetUserSessionByTokenId(ctx, tx, tokenId); err != nil {
+				return errors.Wrap(err, "failed GetUserSessionByTokenId")
+			}
+			return nil
+		})
+
+		require.NoError(t, err)
+		require.True(t, revoked.RevokedAt.Valid)
+	}
+}
@@ -0,0 +1,40 @@
+package usersessionrepo
+
+import (
+	"context"
+	"mysite/entities"
+
+	"github.com/volatiletech/sqlboiler/v4/boil"
+)
+
+type Get interface {
+	GetUserSessionByTokenId(ctx context.Context, tx boil.ContextTransactor, tokenId string) (*entities.UserSession, error)
+}
+
+type Insert interface {
+	Insert(ctx context.Context, tx boil.ContextTransactor, session *entities.UserSession) error
+}
+
+type Update interface {
+	MarkUsed(ctx context.Context, tx boil.ContextTransactor, session entities.UserSession) error
+	RevokeFamily(ctx context.Context, tx boil.ContextTransactor, familyId string) error
+	RevokeAllByUserAccountId(ctx context.Context, tx boil.ContextTransactor, userAccountId int) error
+	RevokeOtherFamilies(ctx context.Context, tx boil.ContextTransactor, userAccountId int, keepFamilyId string) error
+}
+
+type Delete interface{}
+
+//go:generate moq -pkg repomock -out ../../testing/mocking/repomock/usersessionmock.go . UserSessionRepo
+type UserSessionRepo interface {
+	Get
+	Insert
+	Update
+	Delete
+}
+
+type userSessionRepo struct {
+}
+
+func NewRepo() UserSessionRepo {
+	return &userSessionRepo{}
+}
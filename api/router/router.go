@@ -1,10 +1,34 @@
 package router
 
 import (
+	"mysite/constants"
+	"mysite/features/adminimpersonation"
+	"mysite/features/adminloyalty"
+	"mysite/features/adminmembership"
+	"mysite/features/adminoauth"
+	"mysite/features/apikey"
+	"mysite/features/changepassword"
 	"mysite/features/health"
+	"mysite/features/jwks"
 	"mysite/features/login"
+	"mysite/features/logout"
+	"mysite/features/me"
+	"mysite/features/membership"
+	"mysite/features/mfa"
+	"mysite/features/oauth"
+	"mysite/features/oauthauthorize"
+	"mysite/features/passwordreset"
 	"mysite/features/refresh"
 	"mysite/features/register"
+	"mysite/features/usage"
+	"mysite/features/userrole"
+	"mysite/features/verifyemail"
+	"mysite/pkgs/auth"
+	"mysite/pkgs/impersonation"
+	"mysite/pkgs/quota"
+	"mysite/pkgs/ratelimit"
+	"net/http"
+	"slices"
 	"time"
 
 	"github.com/go-chi/chi/v5"
@@ -28,26 +52,114 @@ func defaultMiddleWare(r chi.Router) {
 
 func buildRoute(r chi.Router) chi.Router {
 	health.HandlerFromMux(health.NewHandler(), r)
+	jwks.HandlerFromMux(jwks.NewHandler(), r)
 
 	r.Route("/api/v1", func(r chi.Router) {
 		r.Use(cors.Handler(cors.Options{
 			// AllowedOrigins:   []string{"https://foo.com"}, // Use this to allow specific origin hosts
 			AllowedOrigins: []string{"https://*", "http://*"},
 			// AllowOriginFunc:  func(r *http.Request, origin string) bool { return true },
-			AllowedMethods:   []string{"GET", "POST", "PUT", "DELETE", "OPTIONS"},
+			AllowedMethods:   []string{"GET", "POST", "PUT", "PATCH", "DELETE", "OPTIONS"},
 			AllowedHeaders:   []string{"Accept", "Authorization", "Content-Type", "X-CSRF-Token"},
+			ExposedHeaders:   []string{"RateLimit-Limit", "RateLimit-Remaining", "RateLimit-Reset", "RateLimit-Policy", "Retry-After"},
 			AllowCredentials: false,
 			MaxAge:           300, // Maximum value not ignored by any of major browsers
 		}))
 		publicApi(r)
+		privateApi(r)
 	})
 	return r
 }
 
 func publicApi(r chi.Router) {
 	r.Group(func(r chi.Router) {
+		r.Use(ratelimit.Middleware("auth"))
 		register.HandlerFromMux(register.NewHandler(), r)
 		login.HandlerFromMux(login.NewHandler(), r)
 		refresh.HandlerFromMux(refresh.NewHandler(), r)
+		passwordreset.HandlerFromMux(passwordreset.NewHandler(), r)
+		verifyemail.HandlerFromMux(verifyemail.NewHandler(), r)
+		oauth.HandlerFromMux(oauth.NewHandler(), r)
 	})
 }
+
+func privateApi(r chi.Router) {
+	r.Group(func(r chi.Router) {
+		r.Use(auth.NewAuthenticator().Authenticate)
+		r.Use(impersonation.Middleware)
+		r.Use(ratelimit.Middleware("api"))
+		r.Use(quota.Middleware(constants.QuotaRequests))
+		sessionApi(r)
+		profileApi(r)
+		membership.HandlerFromMux(membership.NewHandler(), r)
+		usage.HandlerFromMux(usage.NewHandler(), r)
+		credentialApi(r)
+		adminApi(r)
+	})
+}
+
+// sessionApi routes end sessions of the user, an admin acting as the user ends the impersonation by letting
+// its token expire instead of signing the user out
+func sessionApi(r chi.Router) {
+	r.Group(func(r chi.Router) {
+		r.Use(auth.RejectImpersonation)
+		logout.HandlerFromMux(logout.NewHandler(), r)
+	})
+}
+
+// profileApi routes of the user, an admin acting as the user may read the profile but not change it
+func profileApi(r chi.Router) {
+	r.Group(func(r chi.Router) {
+		r.Use(onMethods(auth.RejectImpersonation, http.MethodPatch))
+		me.HandlerFromMux(me.NewHandler(), r)
+	})
+}
+
+// credentialApi routes change or mint credentials of the user, only the user signed in themself can use them,
+// not an api key, an oauth client nor an admin acting as the user
+func credentialApi(r chi.Router) {
+	r.Group(func(r chi.Router) {
+		r.Use(auth.RequireLogin)
+		r.Use(auth.RejectImpersonation)
+		changepassword.HandlerFromMux(changepassword.NewHandler(), r)
+		mfa.HandlerFromMux(mfa.NewHandler(), r)
+		apikey.HandlerFromMux(apikey.NewHandler(), r)
+		oauthauthorize.HandlerFromMux(oauthauthorize.NewHandler(), r)
+	})
+}
+
+// adminApi routes are guarded per permission on top of authentication
+func adminApi(r chi.Router) {
+	r.Group(func(r chi.Router) {
+		r.Use(auth.RequirePermission(constants.PermissionRolesWrite))
+		userrole.HandlerFromMux(userrole.NewHandler(), r)
+	})
+	r.Group(func(r chi.Router) {
+		r.Use(auth.RequirePermission(constants.PermissionUsersWrite))
+		adminmembership.HandlerFromMux(adminmembership.NewHandler(), r)
+		adminloyalty.HandlerFromMux(adminloyalty.NewHandler(), r)
+	})
+	r.Group(func(r chi.Router) {
+		r.Use(auth.RequirePermission(constants.PermissionClientsWrite))
+		adminoauth.HandlerFromMux(adminoauth.NewHandler(), r)
+	})
+	r.Group(func(r chi.Router) {
+		r.Use(auth.RequirePermission(constants.PermissionUsersImpersonate))
+		adminimpersonation.HandlerFromMux(adminimpersonation.NewHandler(), r)
+	})
+}
+
+// onMethods applies the middleware to requests of the methods only, the generated handlers register every
+// operation of a feature at once
+func onMethods(middleware func(http.Handler) http.Handler, methods ...string) func(http.Handler) http.Handler {
+	return func(next http.Handler) http.Handler {
+		guarded := middleware(next)
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			if slices.Contains(methods, r.Method) {
+				guarded.ServeHTTP(w, r)
+				return
+			}
+			next.ServeHTTP(w, r)
+		})
+	}
+}
@@ -160,6 +160,13 @@ func updateEnv(resource *dockertest.Resource) error {
 		appEnv.Database.TransactionTimeout = 30
 		appEnv.Database.HostName = resource.GetBoundIP("5432/tcp")
 		appEnv.Database.Port = resource.GetPort("5432/tcp")
+		appEnv.Jwt.AccessKey = "access-secret"
+		appEnv.Jwt.RefreshKey = "refresh-secret"
+		appEnv.Jwt.CursorKey = "cursor-secret"
+		appEnv.Jwt.VerifyEmailKey = "verify-email-secret"
+		appEnv.Jwt.MfaPendingKey = "mfa-pending-secret"
+		appEnv.Jwt.OidcStateKey = "oidc-state-secret"
+		appEnv.Jwt.MagicLinkKey = "magic-link-secret"
 	}); err != nil {
 		return errors.Wrap(err, "failed to read env")
 	}
@@ -24,6 +24,9 @@ var _ auth.AuthService = &AuthServiceMock{}
 //			HashPasswordFunc: func(password string) (string, error) {
 //				panic("mock out the HashPassword method")
 //			},
+//			NeedsRehashFunc: func(encodedHash string) bool {
+//				panic("mock out the NeedsRehash method")
+//			},
 //		}
 //
 //		// use mockedAuthService in code that requires auth.AuthService
@@ -37,6 +40,9 @@ type AuthServiceMock struct {
 	// HashPasswordFunc mocks the HashPassword method.
 	HashPasswordFunc func(password string) (string, error)
 
+	// NeedsRehashFunc mocks the NeedsRehash method.
+	NeedsRehashFunc func(encodedHash string) bool
+
 	// calls tracks calls to the methods.
 	calls struct {
 		// ComparePasswordAndHash holds details about calls to the ComparePasswordAndHash method.
@@ -51,9 +57,15 @@ type AuthServiceMock struct {
 			// Password is the password argument value.
 			Password string
 		}
+		// NeedsRehash holds details about calls to the NeedsRehash method.
+		NeedsRehash []struct {
+			// EncodedHash is the encodedHash argument value.
+			EncodedHash string
+		}
 	}
 	lockComparePasswordAndHash sync.RWMutex
 	lockHashPassword           sync.RWMutex
+	lockNeedsRehash            sync.RWMutex
 }
 
 // ComparePasswordAndHash calls ComparePasswordAndHashFunc.
@@ -123,3 +135,35 @@ func (mock *AuthServiceMock) HashPasswordCalls() []struct {
 	mock.lockHashPassword.RUnlock()
 	return calls
 }
+
+// NeedsRehash calls NeedsRehashFunc.
+func (mock *AuthServiceMock) NeedsRehash(encodedHash string) bool {
+	if mock.NeedsRehashFunc == nil {
+		panic("AuthServiceMock.NeedsRehashFunc: method is nil but AuthService.NeedsRehash was just called")
+	}
+	callInfo := struct {
+		EncodedHash string
+	}{
+		EncodedHash: encodedHash,
+	}
+	mock.lockNeedsRehash.Lock()
+	mock.calls.NeedsRehash = append(mock.calls.NeedsRehash, callInfo)
+	mock.lockNeedsRehash.Unlock()
+	return mock.NeedsRehashFunc(encodedHash)
+}
+
+// NeedsRehashCalls gets all the calls that were made to NeedsRehash.
+// Check the length with:
+//
+//	len(mockedAuthService.NeedsRehashCalls())
+func (mock *AuthServiceMock) NeedsRehashCalls() []struct {
+	EncodedHash string
+} {
+	var calls []struct {
+		EncodedHash string
+	}
+	mock.lockNeedsRehash.RLock()
+	calls = mock.calls.NeedsRehash
+	mock.lockNeedsRehash.RUnlock()
+	return calls
+}
@@ -0,0 +1,88 @@
+// Code generated by moq; DO NOT EDIT.
+// github.com/matryer/moq
+
+package pkgmock
+
+import (
+	"context"
+	"mysite/pkgs/emailverify"
+	"sync"
+)
+
+// Ensure, that SenderMock does implement emailverify.Sender.
+// If this is not the case, regenerate this file with moq.
+var _ emailverify.Sender = &SenderMock{}
+
+// SenderMock is a mock implementation of emailverify.Sender.
+//
+//	func TestSomethingThatUsesSender(t *testing.T) {
+//
+//		// make and configure a mocked emailverify.Sender
+//		mockedSender := &SenderMock{
+//			SendFunc: func(ctx context.Context, userId int, userName string) error {
+//				panic("mock out the Send method")
+//			},
+//		}
+//
+//		// use mockedSender in code that requires emailverify.Sender
+//		// and then make assertions.
+//
+//	}
+type SenderMock struct {
+	// SendFunc mocks the Send method.
+	SendFunc func(ctx context.Context, userId int, userName string) error
+
+	// calls tracks calls to the methods.
+	calls struct {
+		// Send holds details about calls to the Send method.
+		Send []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// UserId is the userId argument value.
+			UserId int
+			// UserName is the userName argument value.
+			UserName string
+		}
+	}
+	lockSend sync.RWMutex
+}
+
+// Send calls SendFunc.
+func (mock *SenderMock) Send(ctx context.Context, userId int, userName string) error {
+	if mock.SendFunc == nil {
+		panic("SenderMock.SendFunc: method is nil but Sender.Send was just called")
+	}
+	callInfo := struct {
+		Ctx      context.Context
+		UserId   int
+		UserName string
+	}{
+		Ctx:      ctx,
+		UserId:   userId,
+		UserName: userName,
+	}
+	mock.lockSend.Lock()
+	mock.calls.Send = append(mock.calls.Send, callInfo)
+	mock.lockSend.Unlock()
+	return mock.SendFunc(ctx, userId, userName)
+}
+
+// SendCalls gets all the calls that were made to Send.
+// Check the length with:
+//
+//	len(mockedSender.SendCalls())
+func (mock *SenderMock) SendCalls() []struct {
+	Ctx      context.Context
+	UserId   int
+	UserName string
+} {
+	var calls []struct {
+		Ctx      context.Context
+		UserId   int
+		UserName string
+	}
+	mock.lockSend.RLock()
+	calls = mock.calls.Send
+	mock.lockSend.RUnlock()
+	return calls
+}
@@ -0,0 +1,83 @@
+// Code generated by moq; DO NOT EDIT.
+// github.com/matryer/moq
+
+package pkgmock
+
+import (
+	"context"
+	"mysite/entities"
+	"mysite/pkgs/impersonation"
+	"sync"
+)
+
+// Ensure, that AuditorMock does implement impersonation.Auditor.
+// If this is not the case, regenerate this file with moq.
+var _ impersonation.Auditor = &AuditorMock{}
+
+// AuditorMock is a mock implementation of impersonation.Auditor.
+//
+//	func TestSomethingThatUsesAuditor(t *testing.T) {
+//
+//		// make and configure a mocked impersonation.Auditor
+//		mockedAuditor := &AuditorMock{
+//			RecordFunc: func(ctx context.Context, audit entities.ImpersonationAudit) error {
+//				panic("mock out the Record method")
+//			},
+//		}
+//
+//		// use mockedAuditor in code that requires impersonation.Auditor
+//		// and then make assertions.
+//
+//	}
+type AuditorMock struct {
+	// RecordFunc mocks the Record method.
+	RecordFunc func(ctx context.Context, audit entities.ImpersonationAudit) error
+
+	// calls tracks calls to the methods.
+	calls struct {
+		// Record holds details about calls to the Record method.
+		Record []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// Audit is the audit argument value.
+			Audit entities.ImpersonationAudit
+		}
+	}
+	lockRecord sync.RWMutex
+}
+
+// Record calls RecordFunc.
+func (mock *AuditorMock) Record(ctx context.Context, audit entities.ImpersonationAudit) error {
+	if mock.RecordFunc == nil {
+		panic("AuditorMock.RecordFunc: method is nil but Auditor.Record was just called")
+	}
+	callInfo := struct {
+		Ctx   context.Context
+		Audit entities.ImpersonationAudit
+	}{
+		Ctx:   ctx,
+		Audit: audit,
+	}
+	mock.lockRecord.Lock()
+	mock.calls.Record = append(mock.calls.Record, callInfo)
+	mock.lockRecord.Unlock()
+	return mock.RecordFunc(ctx, audit)
+}
+
+// RecordCalls gets all the calls that were made to Record.
+// Check the length with:
+//
+//	len(mockedAuditor.RecordCalls())
+func (mock *AuditorMock) RecordCalls() []struct {
+	Ctx   context.Context
+	Audit entities.ImpersonationAudit
+} {
+	var calls []struct {
+		Ctx   context.Context
+		Audit entities.ImpersonationAudit
+	}
+	mock.lockRecord.RLock()
+	calls = mock.calls.Record
+	mock.lockRecord.RUnlock()
+	return calls
+}
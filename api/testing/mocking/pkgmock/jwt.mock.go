@@ -6,6 +6,7 @@ package pkgmock
 import (
 	"mysite/pkgs/auth"
 	"sync"
+	"time"
 )
 
 // Ensure, that JwtHandlerMock does implement auth.JwtHandler.
@@ -24,6 +25,12 @@ var _ auth.JwtHandler = &JwtHandlerMock{}
 //			ParseTokenFunc: func(tokenString string, claims auth.Claims) error {
 //				panic("mock out the ParseToken method")
 //			},
+//			RevokeSubjectFunc: func(subject string, expiresAt time.Time)  {
+//				panic("mock out the RevokeSubject method")
+//			},
+//			RevokeTokenFunc: func(tokenId string, expiresAt time.Time)  {
+//				panic("mock out the RevokeToken method")
+//			},
 //			WithClaimsFunc: func(claims auth.Claims) auth.JwtHandler {
 //				panic("mock out the WithClaims method")
 //			},
@@ -40,6 +47,12 @@ type JwtHandlerMock struct {
 	// ParseTokenFunc mocks the ParseToken method.
 	ParseTokenFunc func(tokenString string, claims auth.Claims) error
 
+	// RevokeSubjectFunc mocks the RevokeSubject method.
+	RevokeSubjectFunc func(subject string, expiresAt time.Time)
+
+	// RevokeTokenFunc mocks the RevokeToken method.
+	RevokeTokenFunc func(tokenId string, expiresAt time.Time)
+
 	// WithClaimsFunc mocks the WithClaims method.
 	WithClaimsFunc func(claims auth.Claims) auth.JwtHandler
 
@@ -55,15 +68,31 @@ type JwtHandlerMock struct {
 			// Claims is the claims argument value.
 			Claims auth.Claims
 		}
+		// RevokeSubject holds details about calls to the RevokeSubject method.
+		RevokeSubject []struct {
+			// Subject is the subject argument value.
+			Subject string
+			// ExpiresAt is the expiresAt argument value.
+			ExpiresAt time.Time
+		}
+		// RevokeToken holds details about calls to the RevokeToken method.
+		RevokeToken []struct {
+			// TokenId is the tokenId argument value.
+			TokenId string
+			// ExpiresAt is the expiresAt argument value.
+			ExpiresAt time.Time
+		}
 		// WithClaims holds details about calls to the WithClaims method.
 		WithClaims []struct {
 			// Claims is the claims argument value.
 			Claims auth.Claims
 		}
 	}
-	lockCreateToken sync.RWMutex
-	lockParseToken  sync.RWMutex
-	lockWithClaims  sync.RWMutex
+	lockCreateToken   sync.RWMutex
+	lockParseToken    sync.RWMutex
+	lockRevokeSubject sync.RWMutex
+	lockRevokeToken   sync.RWMutex
+	lockWithClaims    sync.RWMutex
 }
 
 // CreateToken calls CreateTokenFunc.
@@ -129,6 +158,78 @@ func (mock *JwtHandlerMock) ParseTokenCalls() []struct {
 	return calls
 }
 
+// RevokeSubject calls RevokeSubjectFunc.
+func (mock *JwtHandlerMock) RevokeSubject(subject string, expiresAt time.Time) {
+	if mock.RevokeSubjectFunc == nil {
+		panic("JwtHandlerMock.RevokeSubjectFunc: method is nil but JwtHandler.RevokeSubject was just called")
+	}
+	callInfo := struct {
+		Subject   string
+		ExpiresAt time.Time
+	}{
+		Subject:   subject,
+		ExpiresAt: expiresAt,
+	}
+	mock.lockRevokeSubject.Lock()
+	mock.calls.RevokeSubject = append(mock.calls.RevokeSubject, callInfo)
+	mock.lockRevokeSubject.Unlock()
+	mock.RevokeSubjectFunc(subject, expiresAt)
+}
+
+// RevokeSubjectCalls gets all the calls that were made to RevokeSubject.
+// Check the length with:
+//
+//	len(mockedJwtHandler.RevokeSubjectCalls())
+func (mock *JwtHandlerMock) RevokeSubjectCalls() []struct {
+	Subject   string
+	ExpiresAt time.Time
+} {
+	var calls []struct {
+		Subject   string
+		ExpiresAt time.Time
+	}
+	mock.lockRevokeSubject.RLock()
+	calls = mock.calls.RevokeSubject
+	mock.lockRevokeSubject.RUnlock()
+	return calls
+}
+
+// RevokeToken calls RevokeTokenFunc.
+func (mock *JwtHandlerMock) RevokeToken(tokenId string, expiresAt time.Time) {
+	if mock.RevokeTokenFunc == nil {
+		panic("JwtHandlerMock.RevokeTokenFunc: method is nil but JwtHandler.RevokeToken was just called")
+	}
+	callInfo := struct {
+		TokenId   string
+		ExpiresAt time.Time
+	}{
+		TokenId:   tokenId,
+		ExpiresAt: expiresAt,
+	}
+	mock.lockRevokeToken.Lock()
+	mock.calls.RevokeToken = append(mock.calls.RevokeToken, callInfo)
+	mock.lockRevokeToken.Unlock()
+	mock.RevokeTokenFunc(tokenId, expiresAt)
+}
+
+// RevokeTokenCalls gets all the calls that were made to RevokeToken.
+// Check the length with:
+//
+//	len(mockedJwtHandler.RevokeTokenCalls())
+func (mock *JwtHandlerMock) RevokeTokenCalls() []struct {
+	TokenId   string
+	ExpiresAt time.Time
+} {
+	var calls []struct {
+		TokenId   string
+		ExpiresAt time.Time
+	}
+	mock.lockRevokeToken.RLock()
+	calls = mock.calls.RevokeToken
+	mock.lockRevokeToken.RUnlock()
+	return calls
+}
+
 // WithClaims calls WithClaimsFunc.
 func (mock *JwtHandlerMock) WithClaims(claims auth.Claims) auth.JwtHandler {
 	if mock.WithClaimsFunc == nil {
@@ -0,0 +1,200 @@
+// Code generated by moq; DO NOT EDIT.
+// github.com/matryer/moq
+
+package pkgmock
+
+import (
+	"context"
+	"mysite/pkgs/loyalty"
+	"sync"
+)
+
+// Ensure, that LoyaltyServiceMock does implement loyalty.Service.
+// If this is not the case, regenerate this file with moq.
+var _ loyalty.Service = &LoyaltyServiceMock{}
+
+// LoyaltyServiceMock is a mock implementation of loyalty.Service.
+//
+//	func TestSomethingThatUsesService(t *testing.T) {
+//
+//		// make and configure a mocked loyalty.Service
+//		mockedService := &LoyaltyServiceMock{
+//			CreditFunc: func(ctx context.Context, userId int, points int, reason string) (*loyalty.Balance, error) {
+//				panic("mock out the Credit method")
+//			},
+//			DebitFunc: func(ctx context.Context, userId int, points int, reason string) (*loyalty.Balance, error) {
+//				panic("mock out the Debit method")
+//			},
+//			EvaluateAllFunc: func(ctx context.Context) (int, error) {
+//				panic("mock out the EvaluateAll method")
+//			},
+//		}
+//
+//		// use mockedService in code that requires loyalty.Service
+//		// and then make assertions.
+//
+//	}
+type LoyaltyServiceMock struct {
+	// CreditFunc mocks the Credit method.
+	CreditFunc func(ctx context.Context, userId int, points int, reason string) (*loyalty.Balance, error)
+
+	// DebitFunc mocks the Debit method.
+	DebitFunc func(ctx context.Context, userId int, points int, reason string) (*loyalty.Balance, error)
+
+	// EvaluateAllFunc mocks the EvaluateAll method.
+	EvaluateAllFunc func(ctx context.Context) (int, error)
+
+	// calls tracks calls to the methods.
+	calls struct {
+		// Credit holds details about calls to the Credit method.
+		Credit []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// UserId is the userId argument value.
+			UserId int
+			// Points is the points argument value.
+			Points int
+			// Reason is the reason argument value.
+			Reason string
+		}
+		// Debit holds details about calls to the Debit method.
+		Debit []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// UserId is the userId argument value.
+			UserId int
+			// Points is the points argument value.
+			Points int
+			// Reason is the reason argument value.
+			Reason string
+		}
+		// EvaluateAll holds details about calls to the EvaluateAll method.
+		EvaluateAll []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+		}
+	}
+	lockCredit      sync.RWMutex
+	lockDebit       sync.RWMutex
+	lockEvaluateAll sync.RWMutex
+}
+
+// Credit calls CreditFunc.
+func (mock *LoyaltyServiceMock) Credit(ctx context.Context, userId int, points int, reason string) (*loyalty.Balance, error) {
+	if mock.CreditFunc == nil {
+		panic("LoyaltyServiceMock.CreditFunc: method is nil but Service.Credit was just called")
+	}
+	callInfo := struct {
+		Ctx    context.Context
+		UserId int
+		Points int
+		Reason string
+	}{
+		Ctx:    ctx,
+		UserId: userId,
+		Points: points,
+		Reason: reason,
+	}
+	mock.lockCredit.Lock()
+	mock.calls.Credit = append(mock.calls.Credit, callInfo)
+	mock.lockCredit.Unlock()
+	return mock.CreditFunc(ctx, userId, points, reason)
+}
+
+// CreditCalls gets all the calls that were made to Credit.
+// Check the length with:
+//
+//	len(mockedService.CreditCalls())
+func (mock *LoyaltyServiceMock) CreditCalls() []struct {
+	Ctx    context.Context
+	UserId int
+	Points int
+	Reason string
+} {
+	var calls []struct {
+		Ctx    context.Context
+		UserId int
+		Points int
+		Reason string
+	}
+	mock.lockCredit.RLock()
+	calls = mock.calls.Credit
+	mock.lockCredit.RUnlock()
+	return calls
+}
+
+// Debit calls DebitFunc.
+func (mock *LoyaltyServiceMock) Debit(ctx context.Context, userId int, points int, reason string) (*loyalty.Balance, error) {
+	if mock.DebitFunc == nil {
+		panic("LoyaltyServiceMock.DebitFunc: method is nil but Service.Debit was just called")
+	}
+	callInfo := struct {
+		Ctx    context.Context
+		UserId int
+		Points int
+		Reason string
+	}{
+		Ctx:    ctx,
+		UserId: userId,
+		Points: points,
+		Reason: reason,
+	}
+	mock.lockDebit.Lock()
+	mock.calls.Debit = append(mock.calls.Debit, callInfo)
+	mock.lockDebit.Unlock()
+	return mock.DebitFunc(ctx, userId, points, reason)
+}
+
+// DebitCalls gets all the calls that were made to Debit.
+// Check the length with:
+//
+//	len(mockedService.DebitCalls())
+func (mock *LoyaltyServiceMock) DebitCalls() []struct {
+	Ctx    context.Context
+	UserId int
+	Points int
+	Reason string
+} {
+	var calls []struct {
+		Ctx    context.Context
+		UserId int
+		Points int
+		Reason string
+	}
+	mock.lockDebit.RLock()
+	calls = mock.calls.Debit
+	mock.lockDebit.RUnlock()
+	return calls
+}
+
+// EvaluateAll calls EvaluateAllFunc.
+func (mock *LoyaltyServiceMock) EvaluateAll(ctx context.Context) (int, error) {
+	if mock.EvaluateAllFunc == nil {
+		panic("LoyaltyServiceMock.EvaluateAllFunc: method is nil but Service.EvaluateAll was just called")
+	}
+	callInfo := struct {
+		Ctx context.Context
+	}{
+		Ctx: ctx,
+	}
+	mock.lockEvaluateAll.Lock()
+	mock.calls.EvaluateAll = append(mock.calls.EvaluateAll, callInfo)
+	mock.lockEvaluateAll.Unlock()
+	return mock.EvaluateAllFunc(ctx)
+}
+
+// EvaluateAllCalls gets all the calls that were made to EvaluateAll.
+// Check the length with:
+//
+//	len(mockedService.EvaluateAllCalls())
+func (mock *LoyaltyServiceMock) EvaluateAllCalls() []struct {
+	Ctx context.Context
+} {
+	var calls []struct {
+		Ctx context.Context
+	}
+	mock.lockEvaluateAll.RLock()
+	calls = mock.calls.EvaluateAll
+	mock.lockEvaluateAll.RUnlock()
+	return calls
+}
@@ -0,0 +1,150 @@
+// Code generated by moq; DO NOT EDIT.
+// github.com/matryer/moq
+
+package pkgmock
+
+import (
+	"context"
+	"mysite/pkgs/quota"
+	"sync"
+)
+
+// Ensure, that CounterMock does implement quota.Counter.
+// If this is not the case, regenerate this file with moq.
+var _ quota.Counter = &CounterMock{}
+
+// CounterMock is a mock implementation of quota.Counter.
+//
+//	func TestSomethingThatUsesCounter(t *testing.T) {
+//
+//		// make and configure a mocked quota.Counter
+//		mockedCounter := &CounterMock{
+//			ConsumeFunc: func(ctx context.Context, userId int, limit quota.Limit, amount int) (*quota.Usage, error) {
+//				panic("mock out the Consume method")
+//			},
+//			UsageFunc: func(ctx context.Context, userId int, limit quota.Limit) (*quota.Usage, error) {
+//				panic("mock out the Usage method")
+//			},
+//		}
+//
+//		// use mockedCounter in code that requires quota.Counter
+//		// and then make assertions.
+//
+//	}
+type CounterMock struct {
+	// ConsumeFunc mocks the Consume method.
+	ConsumeFunc func(ctx context.Context, userId int, limit quota.Limit, amount int) (*quota.Usage, error)
+
+	// UsageFunc mocks the Usage method.
+	UsageFunc func(ctx context.Context, userId int, limit quota.Limit) (*quota.Usage, error)
+
+	// calls tracks calls to the methods.
+	calls struct {
+		// Consume holds details about calls to the Consume method.
+		Consume []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// UserId is the userId argument value.
+			UserId int
+			// Limit is the limit argument value.
+			Limit quota.Limit
+			// Amount is the amount argument value.
+			Amount int
+		}
+		// Usage holds details about calls to the Usage method.
+		Usage []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// UserId is the userId argument value.
+			UserId int
+			// Limit is the limit argument value.
+			Limit quota.Limit
+		}
+	}
+	lockConsume sync.RWMutex
+	lockUsage   sync.RWMutex
+}
+
+// Consume calls ConsumeFunc.
+func (mock *CounterMock) Consume(ctx context.Context, userId int, limit quota.Limit, amount int) (*quota.Usage, error) {
+	if mock.ConsumeFunc == nil {
+		panic("CounterMock.ConsumeFunc: method is nil but Counter.Consume was just called")
+	}
+	callInfo := struct {
+		Ctx    context.Context
+		UserId int
+		Limit  quota.Limit
+		Amount int
+	}{
+		Ctx:    ctx,
+		UserId: userId,
+		Limit:  limit,
+		Amount: amount,
+	}
+	mock.lockConsume.Lock()
+	mock.calls.Consume = append(mock.calls.Consume, callInfo)
+	mock.lockConsume.Unlock()
+	return mock.ConsumeFunc(ctx, userId, limit, amount)
+}
+
+// ConsumeCalls gets all the calls that were made to Consume.
+// Check the length with:
+//
+//	len(mockedCounter.ConsumeCalls())
+func (mock *CounterMock) ConsumeCalls() []struct {
+	Ctx    context.Context
+	UserId int
+	Limit  quota.Limit
+	Amount int
+} {
+	var calls []struct {
+		Ctx    context.Context
+		UserId int
+		Limit  quota.Limit
+		Amount int
+	}
+	mock.lockConsume.RLock()
+	calls = mock.calls.Consume
+	mock.lockConsume.RUnlock()
+	return calls
+}
+
+// Usage calls UsageFunc.
+func (mock *CounterMock) Usage(ctx context.Context, userId int, limit quota.Limit) (*quota.Usage, error) {
+	if mock.UsageFunc == nil {
+		panic("CounterMock.UsageFunc: method is nil but Counter.Usage was just called")
+	}
+	callInfo := struct {
+		Ctx    context.Context
+		UserId int
+		Limit  quota.Limit
+	}{
+		Ctx:    ctx,
+		UserId: userId,
+		Limit:  limit,
+	}
+	mock.lockUsage.Lock()
+	mock.calls.Usage = append(mock.calls.Usage, callInfo)
+	mock.lockUsage.Unlock()
+	return mock.UsageFunc(ctx, userId, limit)
+}
+
+// UsageCalls gets all the calls that were made to Usage.
+// Check the length with:
+//
+//	len(mockedCounter.UsageCalls())
+func (mock *CounterMock) UsageCalls() []struct {
+	Ctx    context.Context
+	UserId int
+	Limit  quota.Limit
+} {
+	var calls []struct {
+		Ctx    context.Context
+		UserId int
+		Limit  quota.Limit
+	}
+	mock.lockUsage.RLock()
+	calls = mock.calls.Usage
+	mock.lockUsage.RUnlock()
+	return calls
+}
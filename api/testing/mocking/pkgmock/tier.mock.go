@@ -0,0 +1,102 @@
+// Code generated by moq; DO NOT EDIT.
+// github.com/matryer/moq
+
+package pkgmock
+
+import (
+	"context"
+	"github.com/volatiletech/sqlboiler/v4/boil"
+	"mysite/entities"
+	"mysite/pkgs/tier"
+	"sync"
+)
+
+// Ensure, that ChangerMock does implement tier.Changer.
+// If this is not the case, regenerate this file with moq.
+var _ tier.Changer = &ChangerMock{}
+
+// ChangerMock is a mock implementation of tier.Changer.
+//
+//	func TestSomethingThatUsesChanger(t *testing.T) {
+//
+//		// make and configure a mocked tier.Changer
+//		mockedChanger := &ChangerMock{
+//			ChangeFunc: func(ctx context.Context, tx boil.ContextTransactor, pgUserAccount *entities.UserAccount, membership entities.Membership, change tier.Change) (bool, error) {
+//				panic("mock out the Change method")
+//			},
+//		}
+//
+//		// use mockedChanger in code that requires tier.Changer
+//		// and then make assertions.
+//
+//	}
+type ChangerMock struct {
+	// ChangeFunc mocks the Change method.
+	ChangeFunc func(ctx context.Context, tx boil.ContextTransactor, pgUserAccount *entities.UserAccount, membership entities.Membership, change tier.Change) (bool, error)
+
+	// calls tracks calls to the methods.
+	calls struct {
+		// Change holds details about calls to the Change method.
+		Change []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// Tx is the tx argument value.
+			Tx boil.ContextTransactor
+			// PgUserAccount is the pgUserAccount argument value.
+			PgUserAccount *entities.UserAccount
+			// Membership is the membership argument value.
+			Membership entities.Membership
+			// Change is the change argument value.
+			Change tier.Change
+		}
+	}
+	lockChange sync.RWMutex
+}
+
+// Change calls ChangeFunc.
+func (mock *ChangerMock) Change(ctx context.Context, tx boil.ContextTransactor, pgUserAccount *entities.UserAccount, membership entities.Membership, change tier.Change) (bool, error) {
+	if mock.ChangeFunc == nil {
+		panic("ChangerMock.ChangeFunc: method is nil but Changer.Change was just called")
+	}
+	callInfo := struct {
+		Ctx           context.Context
+		Tx            boil.ContextTransactor
+		PgUserAccount *entities.UserAccount
+		Membership    entities.Membership
+		Change        tier.Change
+	}{
+		Ctx:           ctx,
+		Tx:            tx,
+		PgUserAccount: pgUserAccount,
+		Membership:    membership,
+		Change:        change,
+	}
+	mock.lockChange.Lock()
+	mock.calls.Change = append(mock.calls.Change, callInfo)
+	mock.lockChange.Unlock()
+	return mock.ChangeFunc(ctx, tx, pgUserAccount, membership, change)
+}
+
+// ChangeCalls gets all the calls that were made to Change.
+// Check the length with:
+//
+//	len(mockedChanger.ChangeCalls())
+func (mock *ChangerMock) ChangeCalls() []struct {
+	Ctx           context.Context
+	Tx            boil.ContextTransactor
+	PgUserAccount *entities.UserAccount
+	Membership    entities.Membership
+	Change        tier.Change
+} {
+	var calls []struct {
+		Ctx           context.Context
+		Tx            boil.ContextTransactor
+		PgUserAccount *entities.UserAccount
+		Membership    entities.Membership
+		Change        tier.Change
+	}
+	mock.lockChange.RLock()
+	calls = mock.calls.Change
+	mock.lockChange.RUnlock()
+	return calls
+}
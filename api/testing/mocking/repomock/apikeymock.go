@@ -0,0 +1,445 @@
+// Code generated by moq; DO NOT EDIT.
+// github.com/matryer/moq
+
+package repomock
+
+import (
+	"context"
+	"github.com/volatiletech/sqlboiler/v4/boil"
+	"mysite/entities"
+	"mysite/repositories/apikeyrepo"
+	"sync"
+	"time"
+)
+
+// Ensure, that ApiKeyRepoMock does implement apikeyrepo.ApiKeyRepo.
+// If this is not the case, regenerate this file with moq.
+var _ apikeyrepo.ApiKeyRepo = &ApiKeyRepoMock{}
+
+// ApiKeyRepoMock is a mock implementation of apikeyrepo.ApiKeyRepo.
+//
+//	func TestSomethingThatUsesApiKeyRepo(t *testing.T) {
+//
+//		// make and configure a mocked apikeyrepo.ApiKeyRepo
+//		mockedApiKeyRepo := &ApiKeyRepoMock{
+//			DeleteFunc: func(ctx context.Context, tx boil.ContextTransactor, userAccountId int, apiKeyId int) (bool, error) {
+//				panic("mock out the Delete method")
+//			},
+//			GetApiKeyByIdFunc: func(ctx context.Context, tx boil.ContextTransactor, userAccountId int, apiKeyId int) (*entities.APIKey, error) {
+//				panic("mock out the GetApiKeyById method")
+//			},
+//			GetApiKeyByPrefixFunc: func(ctx context.Context, tx boil.ContextTransactor, prefix string) (*entities.APIKey, error) {
+//				panic("mock out the GetApiKeyByPrefix method")
+//			},
+//			GetApiKeysByUserAccountIdFunc: func(ctx context.Context, tx boil.ContextTransactor, userAccountId int) (entities.APIKeySlice, error) {
+//				panic("mock out the GetApiKeysByUserAccountId method")
+//			},
+//			InsertFunc: func(ctx context.Context, tx boil.ContextTransactor, apiKey *entities.APIKey) error {
+//				panic("mock out the Insert method")
+//			},
+//			MarkUsedFunc: func(ctx context.Context, tx boil.ContextTransactor, apiKeyId int, usedAt time.Time) error {
+//				panic("mock out the MarkUsed method")
+//			},
+//			UpdateFunc: func(ctx context.Context, tx boil.ContextTransactor, apiKey entities.APIKey) error {
+//				panic("mock out the Update method")
+//			},
+//		}
+//
+//		// use mockedApiKeyRepo in code that requires apikeyrepo.ApiKeyRepo
+//		// and then make assertions.
+//
+//	}
+type ApiKeyRepoMock struct {
+	// DeleteFunc mocks the Delete method.
+	DeleteFunc func(ctx context.Context, tx boil.ContextTransactor, userAccountId int, apiKeyId int) (bool, error)
+
+	// GetApiKeyByIdFunc mocks the GetApiKeyById method.
+	GetApiKeyByIdFunc func(ctx context.Context, tx boil.ContextTransactor, userAccountId int, apiKeyId int) (*entities.APIKey, error)
+
+	// GetApiKeyByPrefixFunc mocks the GetApiKeyByPrefix method.
+	GetApiKeyByPrefixFunc func(ctx context.Context, tx boil.ContextTransactor, prefix string) (*entities.APIKey, error)
+
+	// GetApiKeysByUserAccountIdFunc mocks the GetApiKeysByUserAccountId method.
+	GetApiKeysByUserAccountIdFunc func(ctx context.Context, tx boil.ContextTransactor, userAccountId int) (entities.APIKeySlice, error)
+
+	// InsertFunc mocks the Insert method.
+	InsertFunc func(ctx context.Context, tx boil.ContextTransactor, apiKey *entities.APIKey) error
+
+	// MarkUsedFunc mocks the MarkUsed method.
+	MarkUsedFunc func(ctx context.Context, tx boil.ContextTransactor, apiKeyId int, usedAt time.Time) error
+
+	// UpdateFunc mocks the Update method.
+	UpdateFunc func(ctx context.Context, tx boil.ContextTransactor, apiKey entities.APIKey) error
+
+	// calls tracks calls to the methods.
+	calls struct {
+		// Delete holds details about calls to the Delete method.
+		Delete []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// Tx is the tx argument value.
+			Tx boil.ContextTransactor
+			// UserAccountId is the userAccountId argument value.
+			UserAccountId int
+			// ApiKeyId is the apiKeyId argument value.
+			ApiKeyId int
+		}
+		// GetApiKeyById holds details about calls to the GetApiKeyById method.
+		GetApiKeyById []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// Tx is the tx argument value.
+			Tx boil.ContextTransactor
+			// UserAccountId is the userAccountId argument value.
+			UserAccountId int
+			// ApiKeyId is the apiKeyId argument value.
+			ApiKeyId int
+		}
+		// GetApiKeyByPrefix holds details about calls to the GetApiKeyByPrefix method.
+		GetApiKeyByPrefix []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// Tx is the tx argument value.
+			Tx boil.ContextTransactor
+			// Prefix is the prefix argument value.
+			Prefix string
+		}
+		// GetApiKeysByUserAccountId holds details about calls to the GetApiKeysByUserAccountId method.
+		GetApiKeysByUserAccountId []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// Tx is the tx argument value.
+			Tx boil.ContextTransactor
+			// UserAccountId is the userAccountId argument value.
+			UserAccountId int
+		}
+		// Insert holds details about calls to the Insert method.
+		Insert []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// Tx is the tx argument value.
+			Tx boil.ContextTransactor
+			// ApiKey is the apiKey argument value.
+			ApiKey *entities.APIKey
+		}
+		// MarkUsed holds details about calls to the MarkUsed method.
+		MarkUsed []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// Tx is the tx argument value.
+			Tx boil.ContextTransactor
+			// ApiKeyId is the apiKeyId argument value.
+			ApiKeyId int
+			// UsedAt is the usedAt argument value.
+			UsedAt time.Time
+		}
+		// Update holds details about calls to the Update method.
+		Update []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// Tx is the tx argument value.
+			Tx boil.ContextTransactor
+			// ApiKey is the apiKey argument value.
+			ApiKey entities.APIKey
+		}
+	}
+	lockDelete                    sync.RWMutex
+	lockGetApiKeyById             sync.RWMutex
+	lockGetApiKeyByPrefix         sync.RWMutex
+	lockGetApiKeysByUserAccountId sync.RWMutex
+	lockInsert                    sync.RWMutex
+	lockMarkUsed                  sync.RWMutex
+	lockUpdate                    sync.RWMutex
+}
+
+// Delete calls DeleteFunc.
+func (mock *ApiKeyRepoMock) Delete(ctx context.Context, tx boil.ContextTransactor, userAccountId int, apiKeyId int) (bool, error) {
+	if mock.DeleteFunc == nil {
+		panic("ApiKeyRepoMock.DeleteFunc: method is nil but ApiKeyRepo.Delete was just called")
+	}
+	callInfo := struct {
+		Ctx           context.Context
+		Tx            boil.ContextTransactor
+		UserAccountId int
+		ApiKeyId      int
+	}{
+		Ctx:           ctx,
+		Tx:            tx,
+		UserAccountId: userAccountId,
+		ApiKeyId:      apiKeyId,
+	}
+	mock.lockDelete.Lock()
+	mock.calls.Delete = append(mock.calls.Delete, callInfo)
+	mock.lockDelete.Unlock()
+	return mock.DeleteFunc(ctx, tx, userAccountId, apiKeyId)
+}
+
+// DeleteCalls gets all the calls that were made to Delete.
+// Check the length with:
+//
+//	len(mockedApiKeyRepo.DeleteCalls())
+func (mock *ApiKeyRepoMock) DeleteCalls() []struct {
+	Ctx           context.Context
+	Tx            boil.ContextTransactor
+	UserAccountId int
+	ApiKeyId      int
+} {
+	var calls []struct {
+		Ctx           context.Context
+		Tx            boil.ContextTransactor
+		UserAccountId int
+		ApiKeyId      int
+	}
+	mock.lockDelete.RLock()
+	calls = mock.calls.Delete
+	mock.lockDelete.RUnlock()
+	return calls
+}
+
+// GetApiKeyById calls GetApiKeyByIdFunc.
+func (mock *ApiKeyRepoMock) GetApiKeyById(ctx context.Context, tx boil.ContextTransactor, userAccountId int, apiKeyId int) (*entities.APIKey, error) {
+	if mock.GetApiKeyByIdFunc == nil {
+		panic("ApiKeyRepoMock.GetApiKeyByIdFunc: method is nil but ApiKeyRepo.GetApiKeyById was just called")
+	}
+	callInfo := struct {
+		Ctx           context.Context
+		Tx            boil.ContextTransactor
+		UserAccountId int
+		ApiKeyId      int
+	}{
+		Ctx:           ctx,
+		Tx:            tx,
+		UserAccountId: userAccountId,
+		ApiKeyId:      apiKeyId,
+	}
+	mock.lockGetApiKeyById.Lock()
+	mock.calls.GetApiKeyById = append(mock.calls.GetApiKeyById, callInfo)
+	mock.lockGetApiKeyById.Unlock()
+	return mock.GetApiKeyByIdFunc(ctx, tx, userAccountId, apiKeyId)
+}
+
+// GetApiKeyByIdCalls gets all the calls that were made to GetApiKeyById.
+// Check the length with:
+//
+//	len(mockedApiKeyRepo.GetApiKeyByIdCalls())
+func (mock *ApiKeyRepoMock) GetApiKeyByIdCalls() []struct {
+	Ctx           context.Context
+	Tx            boil.ContextTransactor
+	UserAccountId int
+	ApiKeyId      int
+} {
+	var calls []struct {
+		Ctx           context.Context
+		Tx            boil.ContextTransactor
+		UserAccountId int
+		ApiKeyId      int
+	}
+	mock.lockGetApiKeyById.RLock()
+	calls = mock.calls.GetApiKeyById
+	mock.lockGetApiKeyById.RUnlock()
+	return calls
+}
+
+// GetApiKeyByPrefix calls GetApiKeyByPrefixFunc.
+func (mock *ApiKeyRepoMock) GetApiKeyByPrefix(ctx context.Context, tx boil.ContextTransactor, prefix string) (*entities.APIKey, error) {
+	if mock.GetApiKeyByPrefixFunc == nil {
+		panic("ApiKeyRepoMock.GetApiKeyByPrefixFunc: method is nil but ApiKeyRepo.GetApiKeyByPrefix was just called")
+	}
+	callInfo := struct {
+		Ctx    context.Context
+		Tx     boil.ContextTransactor
+		Prefix string
+	}{
+		Ctx:    ctx,
+		Tx:     tx,
+		Prefix: prefix,
+	}
+	mock.lockGetApiKeyByPrefix.Lock()
+	mock.calls.GetApiKeyByPrefix = append(mock.calls.GetApiKeyByPrefix, callInfo)
+	mock.lockGetApiKeyByPrefix.Unlock()
+	return mock.GetApiKeyByPrefixFunc(ctx, tx, prefix)
+}
+
+// GetApiKeyByPrefixCalls gets all the calls that were made to GetApiKeyByPrefix.
+// Check the length with:
+//
+//	len(mockedApiKeyRepo.GetApiKeyByPrefixCalls())
+func (mock *ApiKeyRepoMock) GetApiKeyByPrefixCalls() []struct {
+	Ctx    context.Context
+	Tx     boil.ContextTransactor
+	Prefix string
+} {
+	var calls []struct {
+		Ctx    context.Context
+		Tx     boil.ContextTransactor
+		Prefix string
+	}
+	mock.lockGetApiKeyByPrefix.RLock()
+	calls = mock.calls.GetApiKeyByPrefix
+	mock.lockGetApiKeyByPrefix.RUnlock()
+	return calls
+}
+
+// GetApiKeysByUserAccountId calls GetApiKeysByUserAccountIdFunc.
+func (mock *ApiKeyRepoMock) GetApiKeysByUserAccountId(ctx context.Context, tx boil.ContextTransactor, userAccountId int) (entities.APIKeySlice, error) {
+	if mock.GetApiKeysByUserAccountIdFunc == nil {
+		panic("ApiKeyRepoMock.GetApiKeysByUserAccountIdFunc: method is nil but ApiKeyRepo.GetApiKeysByUserAccountId was just called")
+	}
+	callInfo := struct {
+		Ctx           context.Context
+		Tx            boil.ContextTransactor
+		UserAccountId int
+	}{
+		Ctx:           ctx,
+		Tx:            tx,
+		UserAccountId: userAccountId,
+	}
+	mock.lockGetApiKeysByUserAccountId.Lock()
+	mock.calls.GetApiKeysByUserAccountId = append(mock.calls.GetApiKeysByUserAccountId, callInfo)
+	mock.lockGetApiKeysByUserAccountId.Unlock()
+	return mock.GetApiKeysByUserAccountIdFunc(ctx, tx, userAccountId)
+}
+
+// GetApiKeysByUserAccountIdCalls gets all the calls that were made to GetApiKeysByUserAccountId.
+// Check the length with:
+//
+//	len(mockedApiKeyRepo.GetApiKeysByUserAccountIdCalls())
+func (mock *ApiKeyRepoMock) GetApiKeysByUserAccountIdCalls() []struct {
+	Ctx           context.Context
+	Tx            boil.ContextTransactor
+	UserAccountId int
+} {
+	var calls []struct {
+		Ctx           context.Context
+		Tx            boil.ContextTransactor
+		UserAccountId int
+	}
+	mock.lockGetApiKeysByUserAccountId.RLock()
+	calls = mock.calls.GetApiKeysByUserAccountId
+	mock.lockGetApiKeysByUserAccountId.RUnlock()
+	return calls
+}
+
+// Insert calls InsertFunc.
+func (mock *ApiKeyRepoMock) Insert(ctx context.Context, tx boil.ContextTransactor, apiKey *entities.APIKey) error {
+	if mock.InsertFunc == nil {
+		panic("ApiKeyRepoMock.InsertFunc: method is nil but ApiKeyRepo.Insert was just called")
+	}
+	callInfo := struct {
+		Ctx    context.Context
+		Tx     boil.ContextTransactor
+		ApiKey *entities.APIKey
+	}{
+		Ctx:    ctx,
+		Tx:     tx,
+		ApiKey: apiKey,
+	}
+	mock.lockInsert.Lock()
+	mock.calls.Insert = append(mock.calls.Insert, callInfo)
+	mock.lockInsert.Unlock()
+	return mock.InsertFunc(ctx, tx, apiKey)
+}
+
+// InsertCalls gets all the calls that were made to Insert.
+// Check the length with:
+//
+//	len(mockedApiKeyRepo.InsertCalls())
+func (mock *ApiKeyRepoMock) InsertCalls() []struct {
+	Ctx    context.Context
+	Tx     boil.ContextTransactor
+	ApiKey *entities.APIKey
+} {
+	var calls []struct {
+		Ctx    context.Context
+		Tx     boil.ContextTransactor
+		ApiKey *entities.APIKey
+	}
+	mock.lockInsert.RLock()
+	calls = mock.calls.Insert
+	mock.lockInsert.RUnlock()
+	return calls
+}
+
+// MarkUsed calls MarkUsedFunc.
+func (mock *ApiKeyRepoMock) MarkUsed(ctx context.Context, tx boil.ContextTransactor, apiKeyId int, usedAt time.Time) error {
+	if mock.MarkUsedFunc == nil {
+		panic("ApiKeyRepoMock.MarkUsedFunc: method is nil but ApiKeyRepo.MarkUsed was just called")
+	}
+	callInfo := struct {
+		Ctx      context.Context
+		Tx       boil.ContextTransactor
+		ApiKeyId int
+		UsedAt   time.Time
+	}{
+		Ctx:      ctx,
+		Tx:       tx,
+		ApiKeyId: apiKeyId,
+		UsedAt:   usedAt,
+	}
+	mock.lockMarkUsed.Lock()
+	mock.calls.MarkUsed = append(mock.calls.MarkUsed, callInfo)
+	mock.lockMarkUsed.Unlock()
+	return mock.MarkUsedFunc(ctx, tx, apiKeyId, usedAt)
+}
+
+// MarkUsedCalls gets all the calls that were made to MarkUsed.
+// Check the length with:
+//
+//	len(mockedApiKeyRepo.MarkUsedCalls())
+func (mock *ApiKeyRepoMock) MarkUsedCalls() []struct {
+	Ctx      context.Context
+	Tx       boil.ContextTransactor
+	ApiKeyId int
+	UsedAt   time.Time
+} {
+	var calls []struct {
+		Ctx      context.Context
+		Tx       boil.ContextTransactor
+		ApiKeyId int
+		UsedAt   time.Time
+	}
+	mock.lockMarkUsed.RLock()
+	calls = mock.calls.MarkUsed
+	mock.lockMarkUsed.RUnlock()
+	return calls
+}
+
+// Update calls UpdateFunc.
+func (mock *ApiKeyRepoMock) Update(ctx context.Context, tx boil.ContextTransactor, apiKey entities.APIKey) error {
+	if mock.UpdateFunc == nil {
+		panic("ApiKeyRepoMock.UpdateFunc: method is nil but ApiKeyRepo.Update was just called")
+	}
+	callInfo := struct {
+		Ctx    context.Context
+		Tx     boil.ContextTransactor
+		ApiKey entities.APIKey
+	}{
+		Ctx:    ctx,
+		Tx:     tx,
+		ApiKey: apiKey,
+	}
+	mock.lockUpdate.Lock()
+	mock.calls.Update = append(mock.calls.Update, callInfo)
+	mock.lockUpdate.Unlock()
+	return mock.UpdateFunc(ctx, tx, apiKey)
+}
+
+// UpdateCalls gets all the calls that were made to Update.
+// Check the length with:
+//
+//	len(mockedApiKeyRepo.UpdateCalls())
+func (mock *ApiKeyRepoMock) UpdateCalls() []struct {
+	Ctx    context.Context
+	Tx     boil.ContextTransactor
+	ApiKey entities.APIKey
+} {
+	var calls []struct {
+		Ctx    context.Context
+		Tx     boil.ContextTransactor
+		ApiKey entities.APIKey
+	}
+	mock.lockUpdate.RLock()
+	calls = mock.calls.Update
+	mock.lockUpdate.RUnlock()
+	return calls
+}
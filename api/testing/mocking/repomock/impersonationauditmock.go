@@ -0,0 +1,90 @@
+// Code generated by moq; DO NOT EDIT.
+// github.com/matryer/moq
+
+package repomock
+
+import (
+	"context"
+	"github.com/volatiletech/sqlboiler/v4/boil"
+	"mysite/entities"
+	"mysite/repositories/impersonationauditrepo"
+	"sync"
+)
+
+// Ensure, that ImpersonationAuditRepoMock does implement impersonationauditrepo.ImpersonationAuditRepo.
+// If this is not the case, regenerate this file with moq.
+var _ impersonationauditrepo.ImpersonationAuditRepo = &ImpersonationAuditRepoMock{}
+
+// ImpersonationAuditRepoMock is a mock implementation of impersonationauditrepo.ImpersonationAuditRepo.
+//
+//	func TestSomethingThatUsesImpersonationAuditRepo(t *testing.T) {
+//
+//		// make and configure a mocked impersonationauditrepo.ImpersonationAuditRepo
+//		mockedImpersonationAuditRepo := &ImpersonationAuditRepoMock{
+//			InsertFunc: func(ctx context.Context, tx boil.ContextTransactor, audit *entities.ImpersonationAudit) error {
+//				panic("mock out the Insert method")
+//			},
+//		}
+//
+//		// use mockedImpersonationAuditRepo in code that requires impersonationauditrepo.ImpersonationAuditRepo
+//		// and then make assertions.
+//
+//	}
+type ImpersonationAuditRepoMock struct {
+	// InsertFunc mocks the Insert method.
+	InsertFunc func(ctx context.Context, tx boil.ContextTransactor, audit *entities.ImpersonationAudit) error
+
+	// calls tracks calls to the methods.
+	calls struct {
+		// Insert holds details about calls to the Insert method.
+		Insert []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// Tx is the tx argument value.
+			Tx boil.ContextTransactor
+			// Audit is the audit argument value.
+			Audit *entities.ImpersonationAudit
+		}
+	}
+	lockInsert sync.RWMutex
+}
+
+// Insert calls InsertFunc.
+func (mock *ImpersonationAuditRepoMock) Insert(ctx context.Context, tx boil.ContextTransactor, audit *entities.ImpersonationAudit) error {
+	if mock.InsertFunc == nil {
+		panic("ImpersonationAuditRepoMock.InsertFunc: method is nil but ImpersonationAuditRepo.Insert was just called")
+	}
+	callInfo := struct {
+		Ctx   context.Context
+		Tx    boil.ContextTransactor
+		Audit *entities.ImpersonationAudit
+	}{
+		Ctx:   ctx,
+		Tx:    tx,
+		Audit: audit,
+	}
+	mock.lockInsert.Lock()
+	mock.calls.Insert = append(mock.calls.Insert, callInfo)
+	mock.lockInsert.Unlock()
+	return mock.InsertFunc(ctx, tx, audit)
+}
+
+// InsertCalls gets all the calls that were made to Insert.
+// Check the length with:
+//
+//	len(mockedImpersonationAuditRepo.InsertCalls())
+func (mock *ImpersonationAuditRepoMock) InsertCalls() []struct {
+	Ctx   context.Context
+	Tx    boil.ContextTransactor
+	Audit *entities.ImpersonationAudit
+} {
+	var calls []struct {
+		Ctx   context.Context
+		Tx    boil.ContextTransactor
+		Audit *entities.ImpersonationAudit
+	}
+	mock.lockInsert.RLock()
+	calls = mock.calls.Insert
+	mock.lockInsert.RUnlock()
+	return calls
+}
@@ -0,0 +1,209 @@
+// Code generated by moq; DO NOT EDIT.
+// github.com/matryer/moq
+
+package repomock
+
+import (
+	"context"
+	"github.com/volatiletech/sqlboiler/v4/boil"
+	"mysite/entities"
+	"mysite/repositories/loginattemptrepo"
+	"sync"
+	"time"
+)
+
+// Ensure, that LoginAttemptRepoMock does implement loginattemptrepo.LoginAttemptRepo.
+// If this is not the case, regenerate this file with moq.
+var _ loginattemptrepo.LoginAttemptRepo = &LoginAttemptRepoMock{}
+
+// LoginAttemptRepoMock is a mock implementation of loginattemptrepo.LoginAttemptRepo.
+//
+//	func TestSomethingThatUsesLoginAttemptRepo(t *testing.T) {
+//
+//		// make and configure a mocked loginattemptrepo.LoginAttemptRepo
+//		mockedLoginAttemptRepo := &LoginAttemptRepoMock{
+//			DeleteByKeyFunc: func(ctx context.Context, tx boil.ContextTransactor, attemptKey string) error {
+//				panic("mock out the DeleteByKey method")
+//			},
+//			GetLoginAttemptByKeyFunc: func(ctx context.Context, tx boil.ContextTransactor, attemptKey string) (*entities.LoginAttempt, error) {
+//				panic("mock out the GetLoginAttemptByKey method")
+//			},
+//			RecordFailureFunc: func(ctx context.Context, tx boil.ContextTransactor, attemptKey string, staleBefore time.Time) (*entities.LoginAttempt, error) {
+//				panic("mock out the RecordFailure method")
+//			},
+//		}
+//
+//		// use mockedLoginAttemptRepo in code that requires loginattemptrepo.LoginAttemptRepo
+//		// and then make assertions.
+//
+//	}
+type LoginAttemptRepoMock struct {
+	// DeleteByKeyFunc mocks the DeleteByKey method.
+	DeleteByKeyFunc func(ctx context.Context, tx boil.ContextTransactor, attemptKey string) error
+
+	// GetLoginAttemptByKeyFunc mocks the GetLoginAttemptByKey method.
+	GetLoginAttemptByKeyFunc func(ctx context.Context, tx boil.ContextTransactor, attemptKey string) (*entities.LoginAttempt, error)
+
+	// RecordFailureFunc mocks the RecordFailure method.
+	RecordFailureFunc func(ctx context.Context, tx boil.ContextTransactor, attemptKey string, staleBefore time.Time) (*entities.LoginAttempt, error)
+
+	// calls tracks calls to the methods.
+	calls struct {
+		// DeleteByKey holds details about calls to the DeleteByKey method.
+		DeleteByKey []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// Tx is the tx argument value.
+			Tx boil.ContextTransactor
+			// AttemptKey is the attemptKey argument value.
+			AttemptKey string
+		}
+		// GetLoginAttemptByKey holds details about calls to the GetLoginAttemptByKey method.
+		GetLoginAttemptByKey []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// Tx is the tx argument value.
+			Tx boil.ContextTransactor
+			// AttemptKey is the attemptKey argument value.
+			AttemptKey string
+		}
+		// RecordFailure holds details about calls to the RecordFailure method.
+		RecordFailure []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// Tx is the tx argument value.
+			Tx boil.ContextTransactor
+			// AttemptKey is the attemptKey argument value.
+			AttemptKey string
+			// StaleBefore is the staleBefore argument value.
+			StaleBefore time.Time
+		}
+	}
+	lockDeleteByKey          sync.RWMutex
+	lockGetLoginAttemptByKey sync.RWMutex
+	lockRecordFailure        sync.RWMutex
+}
+
+// DeleteByKey calls DeleteByKeyFunc.
+func (mock *LoginAttemptRepoMock) DeleteByKey(ctx context.Context, tx boil.ContextTransactor, attemptKey string) error {
+	if mock.DeleteByKeyFunc == nil {
+		panic("LoginAttemptRepoMock.DeleteByKeyFunc: method is nil but LoginAttemptRepo.DeleteByKey was just called")
+	}
+	callInfo := struct {
+		Ctx        context.Context
+		Tx         boil.ContextTransactor
+		AttemptKey string
+	}{
+		Ctx:        ctx,
+		Tx:         tx,
+		AttemptKey: attemptKey,
+	}
+	mock.lockDeleteByKey.Lock()
+	mock.calls.DeleteByKey = append(mock.calls.DeleteByKey, callInfo)
+	mock.lockDeleteByKey.Unlock()
+	return mock.DeleteByKeyFunc(ctx, tx, attemptKey)
+}
+
+// DeleteByKeyCalls gets all the calls that were made to DeleteByKey.
+// Check the length with:
+//
+//	len(mockedLoginAttemptRepo.DeleteByKeyCalls())
+func (mock *LoginAttemptRepoMock) DeleteByKeyCalls() []struct {
+	Ctx        context.Context
+	Tx         boil.ContextTransactor
+	AttemptKey string
+} {
+	var calls []struct {
+		Ctx        context.Context
+		Tx         boil.ContextTransactor
+		AttemptKey string
+	}
+	mock.lockDeleteByKey.RLock()
+	calls = mock.calls.DeleteByKey
+	mock.lockDeleteByKey.RUnlock()
+	return calls
+}
+
+// GetLoginAttemptByKey calls GetLoginAttemptByKeyFunc.
+func (mock *LoginAttemptRepoMock) GetLoginAttemptByKey(ctx context.Context, tx boil.ContextTransactor, attemptKey string) (*entities.LoginAttempt, error) {
+	if mock.GetLoginAttemptByKeyFunc == nil {
+		panic("LoginAttemptRepoMock.GetLoginAttemptByKeyFunc: method is nil but LoginAttemptRepo.GetLoginAttemptByKey was just called")
+	}
+	callInfo := struct {
+		Ctx        context.Context
+		Tx         boil.ContextTransactor
+		AttemptKey string
+	}{
+		Ctx:        ctx,
+		Tx:         tx,
+		AttemptKey: attemptKey,
+	}
+	mock.lockGetLoginAttemptByKey.Lock()
+	mock.calls.GetLoginAttemptByKey = append(mock.calls.GetLoginAttemptByKey, callInfo)
+	mock.lockGetLoginAttemptByKey.Unlock()
+	return mock.GetLoginAttemptByKeyFunc(ctx, tx, attemptKey)
+}
+
+// GetLoginAttemptByKeyCalls gets all the calls that were made to GetLoginAttemptByKey.
+// Check the length with:
+//
+//	len(mockedLoginAttemptRepo.GetLoginAttemptByKeyCalls())
+func (mock *LoginAttemptRepoMock) GetLoginAttemptByKeyCalls() []struct {
+	Ctx        context.Context
+	Tx         boil.ContextTransactor
+	AttemptKey string
+} {
+	var calls []struct {
+		Ctx        context.Context
+		Tx         boil.ContextTransactor
+		AttemptKey string
+	}
+	mock.lockGetLoginAttemptByKey.RLock()
+	calls = mock.calls.GetLoginAttemptByKey
+	mock.lockGetLoginAttemptByKey.RUnlock()
+	return calls
+}
+
+// RecordFailure calls RecordFailureFunc.
+func (mock *LoginAttemptRepoMock) RecordFailure(ctx context.Context, tx boil.ContextTransactor, attemptKey string, staleBefore time.Time) (*entities.LoginAttempt, error) {
+	if mock.RecordFailureFunc == nil {
+		panic("LoginAttemptRepoMock.RecordFailureFunc: method is nil but LoginAttemptRepo.RecordFailure was just called")
+	}
+	callInfo := struct {
+		Ctx         context.Context
+		Tx          boil.ContextTransactor
+		AttemptKey  string
+		StaleBefore time.Time
+	}{
+		Ctx:         ctx,
+		Tx:          tx,
+		AttemptKey:  attemptKey,
+		StaleBefore: staleBefore,
+	}
+	mock.lockRecordFailure.Lock()
+	mock.calls.RecordFailure = append(mock.calls.RecordFailure, callInfo)
+	mock.lockRecordFailure.Unlock()
+	return mock.RecordFailureFunc(ctx, tx, attemptKey, staleBefore)
+}
+
+// RecordFailureCalls gets all the calls that were made to RecordFailure.
+// Check the length with:
+//
+//	len(mockedLoginAttemptRepo.RecordFailureCalls())
+func (mock *LoginAttemptRepoMock) RecordFailureCalls() []struct {
+	Ctx         context.Context
+	Tx          boil.ContextTransactor
+	AttemptKey  string
+	StaleBefore time.Time
+} {
+	var calls []struct {
+		Ctx         context.Context
+		Tx          boil.ContextTransactor
+		AttemptKey  string
+		StaleBefore time.Time
+	}
+	mock.lockRecordFailure.RLock()
+	calls = mock.calls.RecordFailure
+	mock.lockRecordFailure.RUnlock()
+	return calls
+}
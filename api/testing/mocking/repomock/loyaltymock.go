@@ -0,0 +1,264 @@
+// Code generated by moq; DO NOT EDIT.
+// github.com/matryer/moq
+
+package repomock
+
+import (
+	"context"
+	"github.com/volatiletech/sqlboiler/v4/boil"
+	"mysite/entities"
+	"mysite/repositories/loyaltyrepo"
+	"sync"
+)
+
+// Ensure, that LoyaltyRepoMock does implement loyaltyrepo.LoyaltyRepo.
+// If this is not the case, regenerate this file with moq.
+var _ loyaltyrepo.LoyaltyRepo = &LoyaltyRepoMock{}
+
+// LoyaltyRepoMock is a mock implementation of loyaltyrepo.LoyaltyRepo.
+//
+//	func TestSomethingThatUsesLoyaltyRepo(t *testing.T) {
+//
+//		// make and configure a mocked loyaltyrepo.LoyaltyRepo
+//		mockedLoyaltyRepo := &LoyaltyRepoMock{
+//			GetBalanceFunc: func(ctx context.Context, tx boil.ContextTransactor, userAccountId int) (int, error) {
+//				panic("mock out the GetBalance method")
+//			},
+//			GetBalanceForUpdateFunc: func(ctx context.Context, tx boil.ContextTransactor, userAccountId int) (int, error) {
+//				panic("mock out the GetBalanceForUpdate method")
+//			},
+//			GetBalancesFunc: func(ctx context.Context, tx boil.ContextTransactor, afterUserAccountId int, limit int) ([]loyaltyrepo.Balance, error) {
+//				panic("mock out the GetBalances method")
+//			},
+//			InsertPointFunc: func(ctx context.Context, tx boil.ContextTransactor, point *entities.LoyaltyPoint) error {
+//				panic("mock out the InsertPoint method")
+//			},
+//		}
+//
+//		// use mockedLoyaltyRepo in code that requires loyaltyrepo.LoyaltyRepo
+//		// and then make assertions.
+//
+//	}
+type LoyaltyRepoMock struct {
+	// GetBalanceFunc mocks the GetBalance method.
+	GetBalanceFunc func(ctx context.Context, tx boil.ContextTransactor, userAccountId int) (int, error)
+
+	// GetBalanceForUpdateFunc mocks the GetBalanceForUpdate method.
+	GetBalanceForUpdateFunc func(ctx context.Context, tx boil.ContextTransactor, userAccountId int) (int, error)
+
+	// GetBalancesFunc mocks the GetBalances method.
+	GetBalancesFunc func(ctx context.Context, tx boil.ContextTransactor, afterUserAccountId int, limit int) ([]loyaltyrepo.Balance, error)
+
+	// InsertPointFunc mocks the InsertPoint method.
+	InsertPointFunc func(ctx context.Context, tx boil.ContextTransactor, point *entities.LoyaltyPoint) error
+
+	// calls tracks calls to the methods.
+	calls struct {
+		// GetBalance holds details about calls to the GetBalance method.
+		GetBalance []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// Tx is the tx argument value.
+			Tx boil.ContextTransactor
+			// UserAccountId is the userAccountId argument value.
+			UserAccountId int
+		}
+		// GetBalanceForUpdate holds details about calls to the GetBalanceForUpdate method.
+		GetBalanceForUpdate []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// Tx is the tx argument value.
+			Tx boil.ContextTransactor
+			// UserAccountId is the userAccountId argument value.
+			UserAccountId int
+		}
+		// GetBalances holds details about calls to the GetBalances method.
+		GetBalances []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// Tx is the tx argument value.
+			Tx boil.ContextTransactor
+			// AfterUserAccountId is the afterUserAccountId argument value.
+			AfterUserAccountId int
+			// Limit is the limit argument value.
+			Limit int
+		}
+		// InsertPoint holds details about calls to the InsertPoint method.
+		InsertPoint []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// Tx is the tx argument value.
+			Tx boil.ContextTransactor
+			// Point is the point argument value.
+			Point *entities.LoyaltyPoint
+		}
+	}
+	lockGetBalance          sync.RWMutex
+	lockGetBalanceForUpdate sync.RWMutex
+	lockGetBalances         sync.RWMutex
+	lockInsertPoint         sync.RWMutex
+}
+
+// GetBalance calls GetBalanceFunc.
+func (mock *LoyaltyRepoMock) GetBalance(ctx context.Context, tx boil.ContextTransactor, userAccountId int) (int, error) {
+	if mock.GetBalanceFunc == nil {
+		panic("LoyaltyRepoMock.GetBalanceFunc: method is nil but LoyaltyRepo.GetBalance was just called")
+	}
+	callInfo := struct {
+		Ctx           context.Context
+		Tx            boil.ContextTransactor
+		UserAccountId int
+	}{
+		Ctx:           ctx,
+		Tx:            tx,
+		UserAccountId: userAccountId,
+	}
+	mock.lockGetBalance.Lock()
+	mock.calls.GetBalance = append(mock.calls.GetBalance, callInfo)
+	mock.lockGetBalance.Unlock()
+	return mock.GetBalanceFunc(ctx, tx, userAccountId)
+}
+
+// GetBalanceCalls gets all the calls that were made to GetBalance.
+// Check the length with:
+//
+//	len(mockedLoyaltyRepo.GetBalanceCalls())
+func (mock *LoyaltyRepoMock) GetBalanceCalls() []struct {
+	Ctx           context.Context
+	Tx            boil.ContextTransactor
+	UserAccountId int
+} {
+	var calls []struct {
+		Ctx           context.Context
+		Tx            boil.ContextTransactor
+		UserAccountId int
+	}
+	mock.lockGetBalance.RLock()
+	calls = mock.calls.GetBalance
+	mock.lockGetBalance.RUnlock()
+	return calls
+}
+
+// GetBalanceForUpdate calls GetBalanceForUpdateFunc.
+func (mock *LoyaltyRepoMock) GetBalanceForUpdate(ctx context.Context, tx boil.ContextTransactor, userAccountId int) (int, error) {
+	if mock.GetBalanceForUpdateFunc == nil {
+		panic("LoyaltyRepoMock.GetBalanceForUpdateFunc: method is nil but LoyaltyRepo.GetBalanceForUpdate was just called")
+	}
+	callInfo := struct {
+		Ctx           context.Context
+		Tx            boil.ContextTransactor
+		UserAccountId int
+	}{
+		Ctx:           ctx,
+		Tx:            tx,
+		UserAccountId: userAccountId,
+	}
+	mock.lockGetBalanceForUpdate.Lock()
+	mock.calls.GetBalanceForUpdate = append(mock.calls.GetBalanceForUpdate, callInfo)
+	mock.lockGetBalanceForUpdate.Unlock()
+	return mock.GetBalanceForUpdateFunc(ctx, tx, userAccountId)
+}
+
+// GetBalanceForUpdateCalls gets all the calls that were made to GetBalanceForUpdate.
+// Check the length with:
+//
+//	len(mockedLoyaltyRepo.GetBalanceForUpdateCalls())
+func (mock *LoyaltyRepoMock) GetBalanceForUpdateCalls() []struct {
+	Ctx           context.Context
+	Tx            boil.ContextTransactor
+	UserAccountId int
+} {
+	var calls []struct {
+		Ctx           context.Context
+		Tx            boil.ContextTransactor
+		UserAccountId int
+	}
+	mock.lockGetBalanceForUpdate.RLock()
+	calls = mock.calls.GetBalanceForUpdate
+	mock.lockGetBalanceForUpdate.RUnlock()
+	return calls
+}
+
+// GetBalances calls GetBalancesFunc.
+func (mock *LoyaltyRepoMock) GetBalances(ctx context.Context, tx boil.ContextTransactor, afterUserAccountId int, limit int) ([]loyaltyrepo.Balance, error) {
+	if mock.GetBalancesFunc == nil {
+		panic("LoyaltyRepoMock.GetBalancesFunc: method is nil but LoyaltyRepo.GetBalances was just called")
+	}
+	callInfo := struct {
+		Ctx                context.Context
+		Tx                 boil.ContextTransactor
+		AfterUserAccountId int
+		Limit              int
+	}{
+		Ctx:                ctx,
+		Tx:                 tx,
+		AfterUserAccountId: afterUserAccountId,
+		Limit:              limit,
+	}
+	mock.lockGetBalances.Lock()
+	mock.calls.GetBalances = append(mock.calls.GetBalances, callInfo)
+	mock.lockGetBalances.Unlock()
+	return mock.GetBalancesFunc(ctx, tx, afterUserAccountId, limit)
+}
+
+// GetBalancesCalls gets all the calls that were made to GetBalances.
+// Check the length with:
+//
+//	len(mockedLoyaltyRepo.GetBalancesCalls())
+func (mock *LoyaltyRepoMock) GetBalancesCalls() []struct {
+	Ctx                context.Context
+	Tx                 boil.ContextTransactor
+	AfterUserAccountId int
+	Limit              int
+} {
+	var calls []struct {
+		Ctx                context.Context
+		Tx                 boil.ContextTransactor
+		AfterUserAccountId int
+		Limit              int
+	}
+	mock.lockGetBalances.RLock()
+	calls = mock.calls.GetBalances
+	mock.lockGetBalances.RUnlock()
+	return calls
+}
+
+// InsertPoint calls InsertPointFunc.
+func (mock *LoyaltyRepoMock) InsertPoint(ctx context.Context, tx boil.ContextTransactor, point *entities.LoyaltyPoint) error {
+	if mock.InsertPointFunc == nil {
+		panic("LoyaltyRepoMock.InsertPointFunc: method is nil but LoyaltyRepo.InsertPoint was just called")
+	}
+	callInfo := struct {
+		Ctx   context.Context
+		Tx    boil.ContextTransactor
+		Point *entities.LoyaltyPoint
+	}{
+		Ctx:   ctx,
+		Tx:    tx,
+		Point: point,
+	}
+	mock.lockInsertPoint.Lock()
+	mock.calls.InsertPoint = append(mock.calls.InsertPoint, callInfo)
+	mock.lockInsertPoint.Unlock()
+	return mock.InsertPointFunc(ctx, tx, point)
+}
+
+// InsertPointCalls gets all the calls that were made to InsertPoint.
+// Check the length with:
+//
+//	len(mockedLoyaltyRepo.InsertPointCalls())
+func (mock *LoyaltyRepoMock) InsertPointCalls() []struct {
+	Ctx   context.Context
+	Tx    boil.ContextTransactor
+	Point *entities.LoyaltyPoint
+} {
+	var calls []struct {
+		Ctx   context.Context
+		Tx    boil.ContextTransactor
+		Point *entities.LoyaltyPoint
+	}
+	mock.lockInsertPoint.RLock()
+	calls = mock.calls.InsertPoint
+	mock.lockInsertPoint.RUnlock()
+	return calls
+}
@@ -0,0 +1,202 @@
+// Code generated by moq; DO NOT EDIT.
+// github.com/matryer/moq
+
+package repomock
+
+import (
+	"context"
+	"github.com/volatiletech/sqlboiler/v4/boil"
+	"mysite/entities"
+	"mysite/repositories/magiclinkrepo"
+	"sync"
+)
+
+// Ensure, that MagicLinkRepoMock does implement magiclinkrepo.MagicLinkRepo.
+// If this is not the case, regenerate this file with moq.
+var _ magiclinkrepo.MagicLinkRepo = &MagicLinkRepoMock{}
+
+// MagicLinkRepoMock is a mock implementation of magiclinkrepo.MagicLinkRepo.
+//
+//	func TestSomethingThatUsesMagicLinkRepo(t *testing.T) {
+//
+//		// make and configure a mocked magiclinkrepo.MagicLinkRepo
+//		mockedMagicLinkRepo := &MagicLinkRepoMock{
+//			GetMagicLinkTokenByJtiFunc: func(ctx context.Context, tx boil.ContextTransactor, jti string) (*entities.MagicLinkToken, error) {
+//				panic("mock out the GetMagicLinkTokenByJti method")
+//			},
+//			InsertFunc: func(ctx context.Context, tx boil.ContextTransactor, token *entities.MagicLinkToken) error {
+//				panic("mock out the Insert method")
+//			},
+//			MarkUsedFunc: func(ctx context.Context, tx boil.ContextTransactor, token entities.MagicLinkToken) error {
+//				panic("mock out the MarkUsed method")
+//			},
+//		}
+//
+//		// use mockedMagicLinkRepo in code that requires magiclinkrepo.MagicLinkRepo
+//		// and then make assertions.
+//
+//	}
+type MagicLinkRepoMock struct {
+	// GetMagicLinkTokenByJtiFunc mocks the GetMagicLinkTokenByJti method.
+	GetMagicLinkTokenByJtiFunc func(ctx context.Context, tx boil.ContextTransactor, jti string) (*entities.MagicLinkToken, error)
+
+	// InsertFunc mocks the Insert method.
+	InsertFunc func(ctx context.Context, tx boil.ContextTransactor, token *entities.MagicLinkToken) error
+
+	// MarkUsedFunc mocks the MarkUsed method.
+	MarkUsedFunc func(ctx context.Context, tx boil.ContextTransactor, token entities.MagicLinkToken) error
+
+	// calls tracks calls to the methods.
+	calls struct {
+		// GetMagicLinkTokenByJti holds details about calls to the GetMagicLinkTokenByJti method.
+		GetMagicLinkTokenByJti []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// Tx is the tx argument value.
+			Tx boil.ContextTransactor
+			// Jti is the jti argument value.
+			Jti string
+		}
+		// Insert holds details about calls to the Insert method.
+		Insert []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// Tx is the tx argument value.
+			Tx boil.ContextTransactor
+			// Token is the token argument value.
+			Token *entities.MagicLinkToken
+		}
+		// MarkUsed holds details about calls to the MarkUsed method.
+		MarkUsed []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// Tx is the tx argument value.
+			Tx boil.ContextTransactor
+			// Token is the token argument value.
+			Token entities.MagicLinkToken
+		}
+	}
+	lockGetMagicLinkTokenByJti sync.RWMutex
+	lockInsert                 sync.RWMutex
+	lockMarkUsed               sync.RWMutex
+}
+
+// GetMagicLinkTokenByJti calls GetMagicLinkTokenByJtiFunc.
+func (mock *MagicLinkRepoMock) GetMagicLinkTokenByJti(ctx context.Context, tx boil.ContextTransactor, jti string) (*entities.MagicLinkToken, error) {
+	if mock.GetMagicLinkTokenByJtiFunc == nil {
+		panic("MagicLinkRepoMock.GetMagicLinkTokenByJtiFunc: method is nil but MagicLinkRepo.GetMagicLinkTokenByJti was just called")
+	}
+	callInfo := struct {
+		Ctx context.Context
+		Tx  boil.ContextTransactor
+		Jti string
+	}{
+		Ctx: ctx,
+		Tx:  tx,
+		Jti: jti,
+	}
+	mock.lockGetMagicLinkTokenByJti.Lock()
+	mock.calls.GetMagicLinkTokenByJti = append(mock.calls.GetMagicLinkTokenByJti, callInfo)
+	mock.lockGetMagicLinkTokenByJti.Unlock()
+	return mock.GetMagicLinkTokenByJtiFunc(ctx, tx, jti)
+}
+
+// GetMagicLinkTokenByJtiCalls gets all the calls that were made to GetMagicLinkTokenByJti.
+// Check the length with:
+//
+//	len(mockedMagicLinkRepo.GetMagicLinkTokenByJtiCalls())
+func (mock *MagicLinkRepoMock) GetMagicLinkTokenByJtiCalls() []struct {
+	Ctx context.Context
+	Tx  boil.ContextTransactor
+	Jti string
+} {
+	var calls []struct {
+		Ctx context.Context
+		Tx  boil.ContextTransactor
+		Jti string
+	}
+	mock.lockGetMagicLinkTokenByJti.RLock()
+	calls = mock.calls.GetMagicLinkTokenByJti
+	mock.lockGetMagicLinkTokenByJti.RUnlock()
+	return calls
+}
+
+// Insert calls InsertFunc.
+func (mock *MagicLinkRepoMock) Insert(ctx context.Context, tx boil.ContextTransactor, token *entities.MagicLinkToken) error {
+	if mock.InsertFunc == nil {
+		panic("MagicLinkRepoMock.InsertFunc: method is nil but MagicLinkRepo.Insert was just called")
+	}
+	callInfo := struct {
+		Ctx   context.Context
+		Tx    boil.ContextTransactor
+		Token *entities.MagicLinkToken
+	}{
+		Ctx:   ctx,
+		Tx:    tx,
+		Token: token,
+	}
+	mock.lockInsert.Lock()
+	mock.calls.Insert = append(mock.calls.Insert, callInfo)
+	mock.lockInsert.Unlock()
+	return mock.InsertFunc(ctx, tx, token)
+}
+
+// InsertCalls gets all the calls that were made to Insert.
+// Check the length with:
+//
+//	len(mockedMagicLinkRepo.InsertCalls())
+func (mock *MagicLinkRepoMock) InsertCalls() []struct {
+	Ctx   context.Context
+	Tx    boil.ContextTransactor
+	Token *entities.MagicLinkToken
+} {
+	var calls []struct {
+		Ctx   context.Context
+		Tx    boil.ContextTransactor
+		Token *entities.MagicLinkToken
+	}
+	mock.lockInsert.RLock()
+	calls = mock.calls.Insert
+	mock.lockInsert.RUnlock()
+	return calls
+}
+
+// MarkUsed calls MarkUsedFunc.
+func (mock *MagicLinkRepoMock) MarkUsed(ctx context.Context, tx boil.ContextTransactor, token entities.MagicLinkToken) error {
+	if mock.MarkUsedFunc == nil {
+		panic("MagicLinkRepoMock.MarkUsedFunc: method is nil but MagicLinkRepo.MarkUsed was just called")
+	}
+	callInfo := struct {
+		Ctx   context.Context
+		Tx    boil.ContextTransactor
+		Token entities.MagicLinkToken
+	}{
+		Ctx:   ctx,
+		Tx:    tx,
+		Token: token,
+	}
+	mock.lockMarkUsed.Lock()
+	mock.calls.MarkUsed = append(mock.calls.MarkUsed, callInfo)
+	mock.lockMarkUsed.Unlock()
+	return mock.MarkUsedFunc(ctx, tx, token)
+}
+
+// MarkUsedCalls gets all the calls that were made to MarkUsed.
+// Check the length with:
+//
+//	len(mockedMagicLinkRepo.MarkUsedCalls())
+func (mock *MagicLinkRepoMock) MarkUsedCalls() []struct {
+	Ctx   context.Context
+	Tx    boil.ContextTransactor
+	Token entities.MagicLinkToken
+} {
+	var calls []struct {
+		Ctx   context.Context
+		Tx    boil.ContextTransactor
+		Token entities.MagicLinkToken
+	}
+	mock.lockMarkUsed.RLock()
+	calls = mock.calls.MarkUsed
+	mock.lockMarkUsed.RUnlock()
+	return calls
+}
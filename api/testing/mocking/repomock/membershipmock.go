@@ -0,0 +1,258 @@
+// Code generated by moq; DO NOT EDIT.
+// github.com/matryer/moq
+
+package repomock
+
+import (
+	"context"
+	"github.com/volatiletech/sqlboiler/v4/boil"
+	"mysite/entities"
+	"mysite/repositories/membershiprepo"
+	"sync"
+)
+
+// Ensure, that MembershipRepoMock does implement membershiprepo.MembershipRepo.
+// If this is not the case, regenerate this file with moq.
+var _ membershiprepo.MembershipRepo = &MembershipRepoMock{}
+
+// MembershipRepoMock is a mock implementation of membershiprepo.MembershipRepo.
+//
+//	func TestSomethingThatUsesMembershipRepo(t *testing.T) {
+//
+//		// make and configure a mocked membershiprepo.MembershipRepo
+//		mockedMembershipRepo := &MembershipRepoMock{
+//			GetCurrentMembershipByUserAccountIdFunc: func(ctx context.Context, tx boil.ContextTransactor, userAccountId int) (*entities.Membership, error) {
+//				panic("mock out the GetCurrentMembershipByUserAccountId method")
+//			},
+//			GetMembershipByIdFunc: func(ctx context.Context, tx boil.ContextTransactor, id int) (*entities.Membership, error) {
+//				panic("mock out the GetMembershipById method")
+//			},
+//			GetMembershipByNameFunc: func(ctx context.Context, tx boil.ContextTransactor, name string) (*entities.Membership, error) {
+//				panic("mock out the GetMembershipByName method")
+//			},
+//			InsertHistoryFunc: func(ctx context.Context, tx boil.ContextTransactor, history *entities.MembershipHistory) error {
+//				panic("mock out the InsertHistory method")
+//			},
+//		}
+//
+//		// use mockedMembershipRepo in code that requires membershiprepo.MembershipRepo
+//		// and then make assertions.
+//
+//	}
+type MembershipRepoMock struct {
+	// GetCurrentMembershipByUserAccountIdFunc mocks the GetCurrentMembershipByUserAccountId method.
+	GetCurrentMembershipByUserAccountIdFunc func(ctx context.Context, tx boil.ContextTransactor, userAccountId int) (*entities.Membership, error)
+
+	// GetMembershipByIdFunc mocks the GetMembershipById method.
+	GetMembershipByIdFunc func(ctx context.Context, tx boil.ContextTransactor, id int) (*entities.Membership, error)
+
+	// GetMembershipByNameFunc mocks the GetMembershipByName method.
+	GetMembershipByNameFunc func(ctx context.Context, tx boil.ContextTransactor, name string) (*entities.Membership, error)
+
+	// InsertHistoryFunc mocks the InsertHistory method.
+	InsertHistoryFunc func(ctx context.Context, tx boil.ContextTransactor, history *entities.MembershipHistory) error
+
+	// calls tracks calls to the methods.
+	calls struct {
+		// GetCurrentMembershipByUserAccountId holds details about calls to the GetCurrentMembershipByUserAccountId method.
+		GetCurrentMembershipByUserAccountId []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// Tx is the tx argument value.
+			Tx boil.ContextTransactor
+			// UserAccountId is the userAccountId argument value.
+			UserAccountId int
+		}
+		// GetMembershipById holds details about calls to the GetMembershipById method.
+		GetMembershipById []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// Tx is the tx argument value.
+			Tx boil.ContextTransactor
+			// ID is the id argument value.
+			ID int
+		}
+		// GetMembershipByName holds details about calls to the GetMembershipByName method.
+		GetMembershipByName []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// Tx is the tx argument value.
+			Tx boil.ContextTransactor
+			// Name is the name argument value.
+			Name string
+		}
+		// InsertHistory holds details about calls to the InsertHistory method.
+		InsertHistory []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// Tx is the tx argument value.
+			Tx boil.ContextTransactor
+			// History is the history argument value.
+			History *entities.MembershipHistory
+		}
+	}
+	lockGetCurrentMembershipByUserAccountId sync.RWMutex
+	lockGetMembershipById                   sync.RWMutex
+	lockGetMembershipByName                 sync.RWMutex
+	lockInsertHistory                       sync.RWMutex
+}
+
+// GetCurrentMembershipByUserAccountId calls GetCurrentMembershipByUserAccountIdFunc.
+func (mock *MembershipRepoMock) GetCurrentMembershipByUserAccountId(ctx context.Context, tx boil.ContextTransactor, userAccountId int) (*entities.Membership, error) {
+	if mock.GetCurrentMembershipByUserAccountIdFunc == nil {
+		panic("MembershipRepoMock.GetCurrentMembershipByUserAccountIdFunc: method is nil but MembershipRepo.GetCurrentMembershipByUserAccountId was just called")
+	}
+	callInfo := struct {
+		Ctx           context.Context
+		Tx            boil.ContextTransactor
+		UserAccountId int
+	}{
+		Ctx:           ctx,
+		Tx:            tx,
+		UserAccountId: userAccountId,
+	}
+	mock.lockGetCurrentMembershipByUserAccountId.Lock()
+	mock.calls.GetCurrentMembershipByUserAccountId = append(mock.calls.GetCurrentMembershipByUserAccountId, callInfo)
+	mock.lockGetCurrentMembershipByUserAccountId.Unlock()
+	return mock.GetCurrentMembershipByUserAccountIdFunc(ctx, tx, userAccountId)
+}
+
+// GetCurrentMembershipByUserAccountIdCalls gets all the calls that were made to GetCurrentMembershipByUserAccountId.
+// Check the length with:
+//
+//	len(mockedMembershipRepo.GetCurrentMembershipByUserAccountIdCalls())
+func (mock *MembershipRepoMock) GetCurrentMembershipByUserAccountIdCalls() []struct {
+	Ctx           context.Context
+	Tx            boil.ContextTransactor
+	UserAccountId int
+} {
+	var calls []struct {
+		Ctx           context.Context
+		Tx            boil.ContextTransactor
+		UserAccountId int
+	}
+	mock.lockGetCurrentMembershipByUserAccountId.RLock()
+	calls = mock.calls.GetCurrentMembershipByUserAccountId
+	mock.lockGetCurrentMembershipByUserAccountId.RUnlock()
+	return calls
+}
+
+// GetMembershipById calls GetMembershipByIdFunc.
+func (mock *MembershipRepoMock) GetMembershipById(ctx context.Context, tx boil.ContextTransactor, id int) (*entities.Membership, error) {
+	if mock.GetMembershipByIdFunc == nil {
+		panic("MembershipRepoMock.GetMembershipByIdFunc: method is nil but MembershipRepo.GetMembershipById was just called")
+	}
+	callInfo := struct {
+		Ctx context.Context
+		Tx  boil.ContextTransactor
+		ID  int
+	}{
+		Ctx: ctx,
+		Tx:  tx,
+		ID:  id,
+	}
+	mock.lockGetMembershipById.Lock()
+	mock.calls.GetMembershipById = append(mock.calls.GetMembershipById, callInfo)
+	mock.lockGetMembershipById.Unlock()
+	return mock.GetMembershipByIdFunc(ctx, tx, id)
+}
+
+// GetMembershipByIdCalls gets all the calls that were made to GetMembershipById.
+// Check the length with:
+//
+//	len(mockedMembershipRepo.GetMembershipByIdCalls())
+func (mock *MembershipRepoMock) GetMembershipByIdCalls() []struct {
+	Ctx context.Context
+	Tx  boil.ContextTransactor
+	ID  int
+} {
+	var calls []struct {
+		Ctx context.Context
+		Tx  boil.ContextTransactor
+		ID  int
+	}
+	mock.lockGetMembershipById.RLock()
+	calls = mock.calls.GetMembershipById
+	mock.lockGetMembershipById.RUnlock()
+	return calls
+}
+
+// GetMembershipByName calls GetMembershipByNameFunc.
+func (mock *MembershipRepoMock) GetMembershipByName(ctx context.Context, tx boil.ContextTransactor, name string) (*entities.Membership, error) {
+	if mock.GetMembershipByNameFunc == nil {
+		panic("MembershipRepoMock.GetMembershipByNameFunc: method is nil but MembershipRepo.GetMembershipByName was just called")
+	}
+	callInfo := struct {
+		Ctx  context.Context
+		Tx   boil.ContextTransactor
+		Name string
+	}{
+		Ctx:  ctx,
+		Tx:   tx,
+		Name: name,
+	}
+	mock.lockGetMembershipByName.Lock()
+	mock.calls.GetMembershipByName = append(mock.calls.GetMembershipByName, callInfo)
+	mock.lockGetMembershipByName.Unlock()
+	return mock.GetMembershipByNameFunc(ctx, tx, name)
+}
+
+// GetMembershipByNameCalls gets all the calls that were made to GetMembershipByName.
+// Check the length with:
+//
+//	len(mockedMembershipRepo.GetMembershipByNameCalls())
+func (mock *MembershipRepoMock) GetMembershipByNameCalls() []struct {
+	Ctx  context.Context
+	Tx   boil.ContextTransactor
+	Name string
+} {
+	var calls []struct {
+		Ctx  context.Context
+		Tx   boil.ContextTransactor
+		Name string
+	}
+	mock.lockGetMembershipByName.RLock()
+	calls = mock.calls.GetMembershipByName
+	mock.lockGetMembershipByName.RUnlock()
+	return calls
+}
+
+// InsertHistory calls InsertHistoryFunc.
+func (mock *MembershipRepoMock) InsertHistory(ctx context.Context, tx boil.ContextTransactor, history *entities.MembershipHistory) error {
+	if mock.InsertHistoryFunc == nil {
+		panic("MembershipRepoMock.InsertHistoryFunc: method is nil but MembershipRepo.InsertHistory was just called")
+	}
+	callInfo := struct {
+		Ctx     context.Context
+		Tx      boil.ContextTransactor
+		History *entities.MembershipHistory
+	}{
+		Ctx:     ctx,
+		Tx:      tx,
+		History: history,
+	}
+	mock.lockInsertHistory.Lock()
+	mock.calls.InsertHistory = append(mock.calls.InsertHistory, callInfo)
+	mock.lockInsertHistory.Unlock()
+	return mock.InsertHistoryFunc(ctx, tx, history)
+}
+
+// InsertHistoryCalls gets all the calls that were made to InsertHistory.
+// Check the length with:
+//
+//	len(mockedMembershipRepo.InsertHistoryCalls())
+func (mock *MembershipRepoMock) InsertHistoryCalls() []struct {
+	Ctx     context.Context
+	Tx      boil.ContextTransactor
+	History *entities.MembershipHistory
+} {
+	var calls []struct {
+		Ctx     context.Context
+		Tx      boil.ContextTransactor
+		History *entities.MembershipHistory
+	}
+	mock.lockInsertHistory.RLock()
+	calls = mock.calls.InsertHistory
+	mock.lockInsertHistory.RUnlock()
+	return calls
+}
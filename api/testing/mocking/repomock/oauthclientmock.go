@@ -0,0 +1,308 @@
+// Code generated by moq; DO NOT EDIT.
+// github.com/matryer/moq
+
+package repomock
+
+import (
+	"context"
+	"github.com/volatiletech/sqlboiler/v4/boil"
+	"mysite/entities"
+	"mysite/repositories/oauthclientrepo"
+	"sync"
+)
+
+// Ensure, that OauthClientRepoMock does implement oauthclientrepo.OauthClientRepo.
+// If this is not the case, regenerate this file with moq.
+var _ oauthclientrepo.OauthClientRepo = &OauthClientRepoMock{}
+
+// OauthClientRepoMock is a mock implementation of oauthclientrepo.OauthClientRepo.
+//
+//	func TestSomethingThatUsesOauthClientRepo(t *testing.T) {
+//
+//		// make and configure a mocked oauthclientrepo.OauthClientRepo
+//		mockedOauthClientRepo := &OauthClientRepoMock{
+//			DeleteFunc: func(ctx context.Context, tx boil.ContextTransactor, clientId string) (bool, error) {
+//				panic("mock out the Delete method")
+//			},
+//			GetOauthClientByClientIdFunc: func(ctx context.Context, tx boil.ContextTransactor, clientId string) (*entities.OauthClient, error) {
+//				panic("mock out the GetOauthClientByClientId method")
+//			},
+//			GetOauthClientByIdFunc: func(ctx context.Context, tx boil.ContextTransactor, oauthClientId int) (*entities.OauthClient, error) {
+//				panic("mock out the GetOauthClientById method")
+//			},
+//			GetOauthClientsFunc: func(ctx context.Context, tx boil.ContextTransactor) (entities.OauthClientSlice, error) {
+//				panic("mock out the GetOauthClients method")
+//			},
+//			InsertFunc: func(ctx context.Context, tx boil.ContextTransactor, client *entities.OauthClient) error {
+//				panic("mock out the Insert method")
+//			},
+//		}
+//
+//		// use mockedOauthClientRepo in code that requires oauthclientrepo.OauthClientRepo
+//		// and then make assertions.
+//
+//	}
+type OauthClientRepoMock struct {
+	// DeleteFunc mocks the Delete method.
+	DeleteFunc func(ctx context.Context, tx boil.ContextTransactor, clientId string) (bool, error)
+
+	// GetOauthClientByClientIdFunc mocks the GetOauthClientByClientId method.
+	GetOauthClientByClientIdFunc func(ctx context.Context, tx boil.ContextTransactor, clientId string) (*entities.OauthClient, error)
+
+	// GetOauthClientByIdFunc mocks the GetOauthClientById method.
+	GetOauthClientByIdFunc func(ctx context.Context, tx boil.ContextTransactor, oauthClientId int) (*entities.OauthClient, error)
+
+	// GetOauthClientsFunc mocks the GetOauthClients method.
+	GetOauthClientsFunc func(ctx context.Context, tx boil.ContextTransactor) (entities.OauthClientSlice, error)
+
+	// InsertFunc mocks the Insert method.
+	InsertFunc func(ctx context.Context, tx boil.ContextTransactor, client *entities.OauthClient) error
+
+	// calls tracks calls to the methods.
+	calls struct {
+		// Delete holds details about calls to the Delete method.
+		Delete []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// Tx is the tx argument value.
+			Tx boil.ContextTransactor
+			// ClientId is the clientId argument value.
+			ClientId string
+		}
+		// GetOauthClientByClientId holds details about calls to the GetOauthClientByClientId method.
+		GetOauthClientByClientId []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// Tx is the tx argument value.
+			Tx boil.ContextTransactor
+			// ClientId is the clientId argument value.
+			ClientId string
+		}
+		// GetOauthClientById holds details about calls to the GetOauthClientById method.
+		GetOauthClientById []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// Tx is the tx argument value.
+			Tx boil.ContextTransactor
+			// OauthClientId is the oauthClientId argument value.
+			OauthClientId int
+		}
+		// GetOauthClients holds details about calls to the GetOauthClients method.
+		GetOauthClients []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// Tx is the tx argument value.
+			Tx boil.ContextTransactor
+		}
+		// Insert holds details about calls to the Insert method.
+		Insert []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// Tx is the tx argument value.
+			Tx boil.ContextTransactor
+			// Client is the client argument value.
+			Client *entities.OauthClient
+		}
+	}
+	lockDelete                   sync.RWMutex
+	lockGetOauthClientByClientId sync.RWMutex
+	lockGetOauthClientById       sync.RWMutex
+	lockGetOauthClients          sync.RWMutex
+	lockInsert                   sync.RWMutex
+}
+
+// Delete calls DeleteFunc.
+func (mock *OauthClientRepoMock) Delete(ctx context.Context, tx boil.ContextTransactor, clientId string) (bool, error) {
+	if mock.DeleteFunc == nil {
+		panic("OauthClientRepoMock.DeleteFunc: method is nil but OauthClientRepo.Delete was just called")
+	}
+	callInfo := struct {
+		Ctx      context.Context
+		Tx       boil.ContextTransactor
+		ClientId string
+	}{
+		Ctx:      ctx,
+		Tx:       tx,
+		ClientId: clientId,
+	}
+	mock.lockDelete.Lock()
+	mock.calls.Delete = append(mock.calls.Delete, callInfo)
+	mock.lockDelete.Unlock()
+	return mock.DeleteFunc(ctx, tx, clientId)
+}
+
+// DeleteCalls gets all the calls that were made to Delete.
+// Check the length with:
+//
+//	len(mockedOauthClientRepo.DeleteCalls())
+func (mock *OauthClientRepoMock) DeleteCalls() []struct {
+	Ctx      context.Context
+	Tx       boil.ContextTransactor
+	ClientId string
+} {
+	var calls []struct {
+		Ctx      context.Context
+		Tx       boil.ContextTransactor
+		ClientId string
+	}
+	mock.lockDelete.RLock()
+	calls = mock.calls.Delete
+	mock.lockDelete.RUnlock()
+	return calls
+}
+
+// GetOauthClientByClientId calls GetOauthClientByClientIdFunc.
+func (mock *OauthClientRepoMock) GetOauthClientByClientId(ctx context.Context, tx boil.ContextTransactor, clientId string) (*entities.OauthClient, error) {
+	if mock.GetOauthClientByClientIdFunc == nil {
+		panic("OauthClientRepoMock.GetOauthClientByClientIdFunc: method is nil but OauthClientRepo.GetOauthClientByClientId was just called")
+	}
+	callInfo := struct {
+		Ctx      context.Context
+		Tx       boil.ContextTransactor
+		ClientId string
+	}{
+		Ctx:      ctx,
+		Tx:       tx,
+		ClientId: clientId,
+	}
+	mock.lockGetOauthClientByClientId.Lock()
+	mock.calls.GetOauthClientByClientId = append(mock.calls.GetOauthClientByClientId, callInfo)
+	mock.lockGetOauthClientByClientId.Unlock()
+	return mock.GetOauthClientByClientIdFunc(ctx, tx, clientId)
+}
+
+// GetOauthClientByClientIdCalls gets all the calls that were made to GetOauthClientByClientId.
+// Check the length with:
+//
+//	len(mockedOauthClientRepo.GetOauthClientByClientIdCalls())
+func (mock *OauthClientRepoMock) GetOauthClientByClientIdCalls() []struct {
+	Ctx      context.Context
+	Tx       boil.ContextTransactor
+	ClientId string
+} {
+	var calls []struct {
+		Ctx      context.Context
+		Tx       boil.ContextTransactor
+		ClientId string
+	}
+	mock.lockGetOauthClientByClientId.RLock()
+	calls = mock.calls.GetOauthClientByClientId
+	mock.lockGetOauthClientByClientId.RUnlock()
+	return calls
+}
+
+// GetOauthClientById calls GetOauthClientByIdFunc.
+func (mock *OauthClientRepoMock) GetOauthClientById(ctx context.Context, tx boil.ContextTransactor, oauthClientId int) (*entities.OauthClient, error) {
+	if mock.GetOauthClientByIdFunc == nil {
+		panic("OauthClientRepoMock.GetOauthClientByIdFunc: method is nil but OauthClientRepo.GetOauthClientById was just called")
+	}
+	callInfo := struct {
+		Ctx           context.Context
+		Tx            boil.ContextTransactor
+		OauthClientId int
+	}{
+		Ctx:           ctx,
+		Tx:            tx,
+		OauthClientId: oauthClientId,
+	}
+	mock.lockGetOauthClientById.Lock()
+	mock.calls.GetOauthClientById = append(mock.calls.GetOauthClientById, callInfo)
+	mock.lockGetOauthClientById.Unlock()
+	return mock.GetOauthClientByIdFunc(ctx, tx, oauthClientId)
+}
+
+// GetOauthClientByIdCalls gets all the calls that were made to GetOauthClientById.
+// Check the length with:
+//
+//	len(mockedOauthClientRepo.GetOauthClientByIdCalls())
+func (mock *OauthClientRepoMock) GetOauthClientByIdCalls() []struct {
+	Ctx           context.Context
+	Tx            boil.ContextTransactor
+	OauthClientId int
+} {
+	var calls []struct {
+		Ctx           context.Context
+		Tx            boil.ContextTransactor
+		OauthClientId int
+	}
+	mock.lockGetOauthClientById.RLock()
+	calls = mock.calls.GetOauthClientById
+	mock.lockGetOauthClientById.RUnlock()
+	return calls
+}
+
+// GetOauthClients calls GetOauthClientsFunc.
+func (mock *OauthClientRepoMock) GetOauthClients(ctx context.Context, tx boil.ContextTransactor) (entities.OauthClientSlice, error) {
+	if mock.GetOauthClientsFunc == nil {
+		panic("OauthClientRepoMock.GetOauthClientsFunc: method is nil but OauthClientRepo.GetOauthClients was just called")
+	}
+	callInfo := struct {
+		Ctx context.Context
+		Tx  boil.ContextTransactor
+	}{
+		Ctx: ctx,
+		Tx:  tx,
+	}
+	mock.lockGetOauthClients.Lock()
+	mock.calls.GetOauthClients = append(mock.calls.GetOauthClients, callInfo)
+	mock.lockGetOauthClients.Unlock()
+	return mock.GetOauthClientsFunc(ctx, tx)
+}
+
+// GetOauthClientsCalls gets all the calls that were made to GetOauthClients.
+// Check the length with:
+//
+//	len(mockedOauthClientRepo.GetOauthClientsCalls())
+func (mock *OauthClientRepoMock) GetOauthClientsCalls() []struct {
+	Ctx context.Context
+	Tx  boil.ContextTransactor
+} {
+	var calls []struct {
+		Ctx context.Context
+		Tx  boil.ContextTransactor
+	}
+	mock.lockGetOauthClients.RLock()
+	calls = mock.calls.GetOauthClients
+	mock.lockGetOauthClients.RUnlock()
+	return calls
+}
+
+// Insert calls InsertFunc.
+func (mock *OauthClientRepoMock) Insert(ctx context.Context, tx boil.ContextTransactor, client *entities.OauthClient) error {
+	if mock.InsertFunc == nil {
+		panic("OauthClientRepoMock.InsertFunc: method is nil but OauthClientRepo.Insert was just called")
+	}
+	callInfo := struct {
+		Ctx    context.Context
+		Tx     boil.ContextTransactor
+		Client *entities.OauthClient
+	}{
+		Ctx:    ctx,
+		Tx:     tx,
+		Client: client,
+	}
+	mock.lockInsert.Lock()
+	mock.calls.Insert = append(mock.calls.Insert, callInfo)
+	mock.lockInsert.Unlock()
+	return mock.InsertFunc(ctx, tx, client)
+}
+
+// InsertCalls gets all the calls that were made to Insert.
+// Check the length with:
+//
+//	len(mockedOauthClientRepo.InsertCalls())
+func (mock *OauthClientRepoMock) InsertCalls() []struct {
+	Ctx    context.Context
+	Tx     boil.ContextTransactor
+	Client *entities.OauthClient
+} {
+	var calls []struct {
+		Ctx    context.Context
+		Tx     boil.ContextTransactor
+		Client *entities.OauthClient
+	}
+	mock.lockInsert.RLock()
+	calls = mock.calls.Insert
+	mock.lockInsert.RUnlock()
+	return calls
+}
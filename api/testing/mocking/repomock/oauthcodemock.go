@@ -0,0 +1,208 @@
+// Code generated by moq; DO NOT EDIT.
+// github.com/matryer/moq
+
+package repomock
+
+import (
+	"context"
+	"github.com/volatiletech/sqlboiler/v4/boil"
+	"mysite/entities"
+	"mysite/repositories/oauthcoderepo"
+	"sync"
+)
+
+// Ensure, that OauthCodeRepoMock does implement oauthcoderepo.OauthCodeRepo.
+// If this is not the case, regenerate this file with moq.
+var _ oauthcoderepo.OauthCodeRepo = &OauthCodeRepoMock{}
+
+// OauthCodeRepoMock is a mock implementation of oauthcoderepo.OauthCodeRepo.
+//
+//	func TestSomethingThatUsesOauthCodeRepo(t *testing.T) {
+//
+//		// make and configure a mocked oauthcoderepo.OauthCodeRepo
+//		mockedOauthCodeRepo := &OauthCodeRepoMock{
+//			GetOauthAuthorizationCodeByHashFunc: func(ctx context.Context, tx boil.ContextTransactor, codeHash string) (*entities.OauthAuthorizationCode, error) {
+//				panic("mock out the GetOauthAuthorizationCodeByHash method")
+//			},
+//			InsertFunc: func(ctx context.Context, tx boil.ContextTransactor, code *entities.OauthAuthorizationCode) error {
+//				panic("mock out the Insert method")
+//			},
+//			MarkUsedFunc: func(ctx context.Context, tx boil.ContextTransactor, codeId int, familyId string) (bool, error) {
+//				panic("mock out the MarkUsed method")
+//			},
+//		}
+//
+//		// use mockedOauthCodeRepo in code that requires oauthcoderepo.OauthCodeRepo
+//		// and then make assertions.
+//
+//	}
+type OauthCodeRepoMock struct {
+	// GetOauthAuthorizationCodeByHashFunc mocks the GetOauthAuthorizationCodeByHash method.
+	GetOauthAuthorizationCodeByHashFunc func(ctx context.Context, tx boil.ContextTransactor, codeHash string) (*entities.OauthAuthorizationCode, error)
+
+	// InsertFunc mocks the Insert method.
+	InsertFunc func(ctx context.Context, tx boil.ContextTransactor, code *entities.OauthAuthorizationCode) error
+
+	// MarkUsedFunc mocks the MarkUsed method.
+	MarkUsedFunc func(ctx context.Context, tx boil.ContextTransactor, codeId int, familyId string) (bool, error)
+
+	// calls tracks calls to the methods.
+	calls struct {
+		// GetOauthAuthorizationCodeByHash holds details about calls to the GetOauthAuthorizationCodeByHash method.
+		GetOauthAuthorizationCodeByHash []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// Tx is the tx argument value.
+			Tx boil.ContextTransactor
+			// CodeHash is the codeHash argument value.
+			CodeHash string
+		}
+		// Insert holds details about calls to the Insert method.
+		Insert []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// Tx is the tx argument value.
+			Tx boil.ContextTransactor
+			// Code is the code argument value.
+			Code *entities.OauthAuthorizationCode
+		}
+		// MarkUsed holds details about calls to the MarkUsed method.
+		MarkUsed []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// Tx is the tx argument value.
+			Tx boil.ContextTransactor
+			// CodeId is the codeId argument value.
+			CodeId int
+			// FamilyId is the familyId argument value.
+			FamilyId string
+		}
+	}
+	lockGetOauthAuthorizationCodeByHash sync.RWMutex
+	lockInsert                          sync.RWMutex
+	lockMarkUsed                        sync.RWMutex
+}
+
+// GetOauthAuthorizationCodeByHash calls GetOauthAuthorizationCodeByHashFunc.
+func (mock *OauthCodeRepoMock) GetOauthAuthorizationCodeByHash(ctx context.Context, tx boil.ContextTransactor, codeHash string) (*entities.OauthAuthorizationCode, error) {
+	if mock.GetOauthAuthorizationCodeByHashFunc == nil {
+		panic("OauthCodeRepoMock.GetOauthAuthorizationCodeByHashFunc: method is nil but OauthCodeRepo.GetOauthAuthorizationCodeByHash was just called")
+	}
+	callInfo := struct {
+		Ctx      context.Context
+		Tx       boil.ContextTransactor
+		CodeHash string
+	}{
+		Ctx:      ctx,
+		Tx:       tx,
+		CodeHash: codeHash,
+	}
+	mock.lockGetOauthAuthorizationCodeByHash.Lock()
+	mock.calls.GetOauthAuthorizationCodeByHash = append(mock.calls.GetOauthAuthorizationCodeByHash, callInfo)
+	mock.lockGetOauthAuthorizationCodeByHash.Unlock()
+	return mock.GetOauthAuthorizationCodeByHashFunc(ctx, tx, codeHash)
+}
+
+// GetOauthAuthorizationCodeByHashCalls gets all the calls that were made to GetOauthAuthorizationCodeByHash.
+// Check the length with:
+//
+//	len(mockedOauthCodeRepo.GetOauthAuthorizationCodeByHashCalls())
+func (mock *OauthCodeRepoMock) GetOauthAuthorizationCodeByHashCalls() []struct {
+	Ctx      context.Context
+	Tx       boil.ContextTransactor
+	CodeHash string
+} {
+	var calls []struct {
+		Ctx      context.Context
+		Tx       boil.ContextTransactor
+		CodeHash string
+	}
+	mock.lockGetOauthAuthorizationCodeByHash.RLock()
+	calls = mock.calls.GetOauthAuthorizationCodeByHash
+	mock.lockGetOauthAuthorizationCodeByHash.RUnlock()
+	return calls
+}
+
+// Insert calls InsertFunc.
+func (mock *OauthCodeRepoMock) Insert(ctx context.Context, tx boil.ContextTransactor, code *entities.OauthAuthorizationCode) error {
+	if mock.InsertFunc == nil {
+		panic("OauthCodeRepoMock.InsertFunc: method is nil but OauthCodeRepo.Insert was just called")
+	}
+	callInfo := struct {
+		Ctx  context.Context
+		Tx   boil.ContextTransactor
+		Code *entities.OauthAuthorizationCode
+	}{
+		Ctx:  ctx,
+		Tx:   tx,
+		Code: code,
+	}
+	mock.lockInsert.Lock()
+	mock.calls.Insert = append(mock.calls.Insert, callInfo)
+	mock.lockInsert.Unlock()
+	return mock.InsertFunc(ctx, tx, code)
+}
+
+// InsertCalls gets all the calls that were made to Insert.
+// Check the length with:
+//
+//	len(mockedOauthCodeRepo.InsertCalls())
+func (mock *OauthCodeRepoMock) InsertCalls() []struct {
+	Ctx  context.Context
+	Tx   boil.ContextTransactor
+	Code *entities.OauthAuthorizationCode
+} {
+	var calls []struct {
+		Ctx  context.Context
+		Tx   boil.ContextTransactor
+		Code *entities.OauthAuthorizationCode
+	}
+	mock.lockInsert.RLock()
+	calls = mock.calls.Insert
+	mock.lockInsert.RUnlock()
+	return calls
+}
+
+// MarkUsed calls MarkUsedFunc.
+func (mock *OauthCodeRepoMock) MarkUsed(ctx context.Context, tx boil.ContextTransactor, codeId int, familyId string) (bool, error) {
+	if mock.MarkUsedFunc == nil {
+		panic("OauthCodeRepoMock.MarkUsedFunc: method is nil but OauthCodeRepo.MarkUsed was just called")
+	}
+	callInfo := struct {
+		Ctx      context.Context
+		Tx       boil.ContextTransactor
+		CodeId   int
+		FamilyId string
+	}{
+		Ctx:      ctx,
+		Tx:       tx,
+		CodeId:   codeId,
+		FamilyId: familyId,
+	}
+	mock.lockMarkUsed.Lock()
+	mock.calls.MarkUsed = append(mock.calls.MarkUsed, callInfo)
+	mock.lockMarkUsed.Unlock()
+	return mock.MarkUsedFunc(ctx, tx, codeId, familyId)
+}
+
+// MarkUsedCalls gets all the calls that were made to MarkUsed.
+// Check the length with:
+//
+//	len(mockedOauthCodeRepo.MarkUsedCalls())
+func (mock *OauthCodeRepoMock) MarkUsedCalls() []struct {
+	Ctx      context.Context
+	Tx       boil.ContextTransactor
+	CodeId   int
+	FamilyId string
+} {
+	var calls []struct {
+		Ctx      context.Context
+		Tx       boil.ContextTransactor
+		CodeId   int
+		FamilyId string
+	}
+	mock.lockMarkUsed.RLock()
+	calls = mock.calls.MarkUsed
+	mock.lockMarkUsed.RUnlock()
+	return calls
+}
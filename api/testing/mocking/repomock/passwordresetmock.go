@@ -0,0 +1,258 @@
+// Code generated by moq; DO NOT EDIT.
+// github.com/matryer/moq
+
+package repomock
+
+import (
+	"context"
+	"github.com/volatiletech/sqlboiler/v4/boil"
+	"mysite/entities"
+	"mysite/repositories/passwordresetrepo"
+	"sync"
+)
+
+// Ensure, that PasswordResetRepoMock does implement passwordresetrepo.PasswordResetRepo.
+// If this is not the case, regenerate this file with moq.
+var _ passwordresetrepo.PasswordResetRepo = &PasswordResetRepoMock{}
+
+// PasswordResetRepoMock is a mock implementation of passwordresetrepo.PasswordResetRepo.
+//
+//	func TestSomethingThatUsesPasswordResetRepo(t *testing.T) {
+//
+//		// make and configure a mocked passwordresetrepo.PasswordResetRepo
+//		mockedPasswordResetRepo := &PasswordResetRepoMock{
+//			GetPasswordResetTokenByHashFunc: func(ctx context.Context, tx boil.ContextTransactor, tokenHash string) (*entities.PasswordResetToken, error) {
+//				panic("mock out the GetPasswordResetTokenByHash method")
+//			},
+//			InsertFunc: func(ctx context.Context, tx boil.ContextTransactor, token *entities.PasswordResetToken) error {
+//				panic("mock out the Insert method")
+//			},
+//			InvalidateByUserAccountIdFunc: func(ctx context.Context, tx boil.ContextTransactor, userAccountId int) error {
+//				panic("mock out the InvalidateByUserAccountId method")
+//			},
+//			MarkUsedFunc: func(ctx context.Context, tx boil.ContextTransactor, token entities.PasswordResetToken) error {
+//				panic("mock out the MarkUsed method")
+//			},
+//		}
+//
+//		// use mockedPasswordResetRepo in code that requires passwordresetrepo.PasswordResetRepo
+//		// and then make assertions.
+//
+//	}
+type PasswordResetRepoMock struct {
+	// GetPasswordResetTokenByHashFunc mocks the GetPasswordResetTokenByHash method.
+	GetPasswordResetTokenByHashFunc func(ctx context.Context, tx boil.ContextTransactor, tokenHash string) (*entities.PasswordResetToken, error)
+
+	// InsertFunc mocks the Insert method.
+	InsertFunc func(ctx context.Context, tx boil.ContextTransactor, token *entities.PasswordResetToken) error
+
+	// InvalidateByUserAccountIdFunc mocks the InvalidateByUserAccountId method.
+	InvalidateByUserAccountIdFunc func(ctx context.Context, tx boil.ContextTransactor, userAccountId int) error
+
+	// MarkUsedFunc mocks the MarkUsed method.
+	MarkUsedFunc func(ctx context.Context, tx boil.ContextTransactor, token entities.PasswordResetToken) error
+
+	// calls tracks calls to the methods.
+	calls struct {
+		// GetPasswordResetTokenByHash holds details about calls to the GetPasswordResetTokenByHash method.
+		GetPasswordResetTokenByHash []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// Tx is the tx argument value.
+			Tx boil.ContextTransactor
+			// TokenHash is the tokenHash argument value.
+			TokenHash string
+		}
+		// Insert holds details about calls to the Insert method.
+		Insert []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// Tx is the tx argument value.
+			Tx boil.ContextTransactor
+			// Token is the token argument value.
+			Token *entities.PasswordResetToken
+		}
+		// InvalidateByUserAccountId holds details about calls to the InvalidateByUserAccountId method.
+		InvalidateByUserAccountId []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// Tx is the tx argument value.
+			Tx boil.ContextTransactor
+			// UserAccountId is the userAccountId argument value.
+			UserAccountId int
+		}
+		// MarkUsed holds details about calls to the MarkUsed method.
+		MarkUsed []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// Tx is the tx argument value.
+			Tx boil.ContextTransactor
+			// Token is the token argument value.
+			Token entities.PasswordResetToken
+		}
+	}
+	lockGetPasswordResetTokenByHash sync.RWMutex
+	lockInsert                      sync.RWMutex
+	lockInvalidateByUserAccountId   sync.RWMutex
+	lockMarkUsed                    sync.RWMutex
+}
+
+// GetPasswordResetTokenByHash calls GetPasswordResetTokenByHashFunc.
+func (mock *PasswordResetRepoMock) GetPasswordResetTokenByHash(ctx context.Context, tx boil.ContextTransactor, tokenHash string) (*entities.PasswordResetToken, error) {
+	if mock.GetPasswordResetTokenByHashFunc == nil {
+		panic("PasswordResetRepoMock.GetPasswordResetTokenByHashFunc: method is nil but PasswordResetRepo.GetPasswordResetTokenByHash was just called")
+	}
+	callInfo := struct {
+		Ctx       context.Context
+		Tx        boil.ContextTransactor
+		TokenHash string
+	}{
+		Ctx:       ctx,
+		Tx:        tx,
+		TokenHash: tokenHash,
+	}
+	mock.lockGetPasswordResetTokenByHash.Lock()
+	mock.calls.GetPasswordResetTokenByHash = append(mock.calls.GetPasswordResetTokenByHash, callInfo)
+	mock.lockGetPasswordResetTokenByHash.Unlock()
+	return mock.GetPasswordResetTokenByHashFunc(ctx, tx, tokenHash)
+}
+
+// GetPasswordResetTokenByHashCalls gets all the calls that were made to GetPasswordResetTokenByHash.
+// Check the length with:
+//
+//	len(mockedPasswordResetRepo.GetPasswordResetTokenByHashCalls())
+func (mock *PasswordResetRepoMock) GetPasswordResetTokenByHashCalls() []struct {
+	Ctx       context.Context
+	Tx        boil.ContextTransactor
+	TokenHash string
+} {
+	var calls []struct {
+		Ctx       context.Context
+		Tx        boil.ContextTransactor
+		TokenHash string
+	}
+	mock.lockGetPasswordResetTokenByHash.RLock()
+	calls = mock.calls.GetPasswordResetTokenByHash
+	mock.lockGetPasswordResetTokenByHash.RUnlock()
+	return calls
+}
+
+// Insert calls InsertFunc.
+func (mock *PasswordResetRepoMock) Insert(ctx context.Context, tx boil.ContextTransactor, token *entities.PasswordResetToken) error {
+	if mock.InsertFunc == nil {
+		panic("PasswordResetRepoMock.InsertFunc: method is nil but PasswordResetRepo.Insert was just called")
+	}
+	callInfo := struct {
+		Ctx   context.Context
+		Tx    boil.ContextTransactor
+		Token *entities.PasswordResetToken
+	}{
+		Ctx:   ctx,
+		Tx:    tx,
+		Token: token,
+	}
+	mock.lockInsert.Lock()
+	mock.calls.Insert = append(mock.calls.Insert, callInfo)
+	mock.lockInsert.Unlock()
+	return mock.InsertFunc(ctx, tx, token)
+}
+
+// InsertCalls gets all the calls that were made to Insert.
+// Check the length with:
+//
+//	len(mockedPasswordResetRepo.InsertCalls())
+func (mock *PasswordResetRepoMock) InsertCalls() []struct {
+	Ctx   context.Context
+	Tx    boil.ContextTransactor
+	Token *entities.PasswordResetToken
+} {
+	var calls []struct {
+		Ctx   context.Context
+		Tx    boil.ContextTransactor
+		Token *entities.PasswordResetToken
+	}
+	mock.lockInsert.RLock()
+	calls = mock.calls.Insert
+	mock.lockInsert.RUnlock()
+	return calls
+}
+
+// InvalidateByUserAccountId calls InvalidateByUserAccountIdFunc.
+func (mock *PasswordResetRepoMock) InvalidateByUserAccountId(ctx context.Context, tx boil.ContextTransactor, userAccountId int) error {
+	if mock.InvalidateByUserAccountIdFunc == nil {
+		panic("PasswordResetRepoMock.InvalidateByUserAccountIdFunc: method is nil but PasswordResetRepo.InvalidateByUserAccountId was just called")
+	}
+	callInfo := struct {
+		Ctx           context.Context
+		Tx            boil.ContextTransactor
+		UserAccountId int
+	}{
+		Ctx:           ctx,
+		Tx:            tx,
+		UserAccountId: userAccountId,
+	}
+	mock.lockInvalidateByUserAccountId.Lock()
+	mock.calls.InvalidateByUserAccountId = append(mock.calls.InvalidateByUserAccountId, callInfo)
+	mock.lockInvalidateByUserAccountId.Unlock()
+	return mock.InvalidateByUserAccountIdFunc(ctx, tx, userAccountId)
+}
+
+// InvalidateByUserAccountIdCalls gets all the calls that were made to InvalidateByUserAccountId.
+// Check the length with:
+//
+//	len(mockedPasswordResetRepo.InvalidateByUserAccountIdCalls())
+func (mock *PasswordResetRepoMock) InvalidateByUserAccountIdCalls() []struct {
+	Ctx           context.Context
+	Tx            boil.ContextTransactor
+	UserAccountId int
+} {
+	var calls []struct {
+		Ctx           context.Context
+		Tx            boil.ContextTransactor
+		UserAccountId int
+	}
+	mock.lockInvalidateByUserAccountId.RLock()
+	calls = mock.calls.InvalidateByUserAccountId
+	mock.lockInvalidateByUserAccountId.RUnlock()
+	return calls
+}
+
+// MarkUsed calls MarkUsedFunc.
+func (mock *PasswordResetRepoMock) MarkUsed(ctx context.Context, tx boil.ContextTransactor, token entities.PasswordResetToken) error {
+	if mock.MarkUsedFunc == nil {
+		panic("PasswordResetRepoMock.MarkUsedFunc: method is nil but PasswordResetRepo.MarkUsed was just called")
+	}
+	callInfo := struct {
+		Ctx   context.Context
+		Tx    boil.ContextTransactor
+		Token entities.PasswordResetToken
+	}{
+		Ctx:   ctx,
+		Tx:    tx,
+		Token: token,
+	}
+	mock.lockMarkUsed.Lock()
+	mock.calls.MarkUsed = append(mock.calls.MarkUsed, callInfo)
+	mock.lockMarkUsed.Unlock()
+	return mock.MarkUsedFunc(ctx, tx, token)
+}
+
+// MarkUsedCalls gets all the calls that were made to MarkUsed.
+// Check the length with:
+//
+//	len(mockedPasswordResetRepo.MarkUsedCalls())
+func (mock *PasswordResetRepoMock) MarkUsedCalls() []struct {
+	Ctx   context.Context
+	Tx    boil.ContextTransactor
+	Token entities.PasswordResetToken
+} {
+	var calls []struct {
+		Ctx   context.Context
+		Tx    boil.ContextTransactor
+		Token entities.PasswordResetToken
+	}
+	mock.lockMarkUsed.RLock()
+	calls = mock.calls.MarkUsed
+	mock.lockMarkUsed.RUnlock()
+	return calls
+}
@@ -0,0 +1,333 @@
+// Code generated by moq; DO NOT EDIT.
+// github.com/matryer/moq
+
+package repomock
+
+import (
+	"context"
+	"github.com/volatiletech/sqlboiler/v4/boil"
+	"mysite/entities"
+	"mysite/repositories/revokedtokenrepo"
+	"sync"
+	"time"
+)
+
+// Ensure, that RevokedTokenRepoMock does implement revokedtokenrepo.RevokedTokenRepo.
+// If this is not the case, regenerate this file with moq.
+var _ revokedtokenrepo.RevokedTokenRepo = &RevokedTokenRepoMock{}
+
+// RevokedTokenRepoMock is a mock implementation of revokedtokenrepo.RevokedTokenRepo.
+//
+//	func TestSomethingThatUsesRevokedTokenRepo(t *testing.T) {
+//
+//		// make and configure a mocked revokedtokenrepo.RevokedTokenRepo
+//		mockedRevokedTokenRepo := &RevokedTokenRepoMock{
+//			DeleteExpiredFunc: func(ctx context.Context, tx boil.ContextTransactor, now time.Time) error {
+//				panic("mock out the DeleteExpired method")
+//			},
+//			GetRevokedSubjectFunc: func(ctx context.Context, tx boil.ContextTransactor, subject string) (*entities.RevokedSubject, error) {
+//				panic("mock out the GetRevokedSubject method")
+//			},
+//			InsertTokenFunc: func(ctx context.Context, tx boil.ContextTransactor, tokenId string, expiresAt time.Time) error {
+//				panic("mock out the InsertToken method")
+//			},
+//			IsTokenRevokedFunc: func(ctx context.Context, tx boil.ContextTransactor, tokenId string) (bool, error) {
+//				panic("mock out the IsTokenRevoked method")
+//			},
+//			UpsertSubjectFunc: func(ctx context.Context, tx boil.ContextTransactor, subject string, issuedBefore time.Time, expiresAt time.Time) error {
+//				panic("mock out the UpsertSubject method")
+//			},
+//		}
+//
+//		// use mockedRevokedTokenRepo in code that requires revokedtokenrepo.RevokedTokenRepo
+//		// and then make assertions.
+//
+//	}
+type RevokedTokenRepoMock struct {
+	// DeleteExpiredFunc mocks the DeleteExpired method.
+	DeleteExpiredFunc func(ctx context.Context, tx boil.ContextTransactor, now time.Time) error
+
+	// GetRevokedSubjectFunc mocks the GetRevokedSubject method.
+	GetRevokedSubjectFunc func(ctx context.Context, tx boil.ContextTransactor, subject string) (*entities.RevokedSubject, error)
+
+	// InsertTokenFunc mocks the InsertToken method.
+	InsertTokenFunc func(ctx context.Context, tx boil.ContextTransactor, tokenId string, expiresAt time.Time) error
+
+	// IsTokenRevokedFunc mocks the IsTokenRevoked method.
+	IsTokenRevokedFunc func(ctx context.Context, tx boil.ContextTransactor, tokenId string) (bool, error)
+
+	// UpsertSubjectFunc mocks the UpsertSubject method.
+	UpsertSubjectFunc func(ctx context.Context, tx boil.ContextTransactor, subject string, issuedBefore time.Time, expiresAt time.Time) error
+
+	// calls tracks calls to the methods.
+	calls struct {
+		// DeleteExpired holds details about calls to the DeleteExpired method.
+		DeleteExpired []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// Tx is the tx argument value.
+			Tx boil.ContextTransactor
+			// Now is the now argument value.
+			Now time.Time
+		}
+		// GetRevokedSubject holds details about calls to the GetRevokedSubject method.
+		GetRevokedSubject []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// Tx is the tx argument value.
+			Tx boil.ContextTransactor
+			// Subject is the subject argument value.
+			Subject string
+		}
+		// InsertToken holds details about calls to the InsertToken method.
+		InsertToken []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// Tx is the tx argument value.
+			Tx boil.ContextTransactor
+			// TokenId is the tokenId argument value.
+			TokenId string
+			// ExpiresAt is the expiresAt argument value.
+			ExpiresAt time.Time
+		}
+		// IsTokenRevoked holds details about calls to the IsTokenRevoked method.
+		IsTokenRevoked []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// Tx is the tx argument value.
+			Tx boil.ContextTransactor
+			// TokenId is the tokenId argument value.
+			TokenId string
+		}
+		// UpsertSubject holds details about calls to the UpsertSubject method.
+		UpsertSubject []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// Tx is the tx argument value.
+			Tx boil.ContextTransactor
+			// Subject is the subject argument value.
+			Subject string
+			// IssuedBefore is the issuedBefore argument value.
+			IssuedBefore time.Time
+			// ExpiresAt is the expiresAt argument value.
+			ExpiresAt time.Time
+		}
+	}
+	lockDeleteExpired     sync.RWMutex
+	lockGetRevokedSubject sync.RWMutex
+	lockInsertToken       sync.RWMutex
+	lockIsTokenRevoked    sync.RWMutex
+	lockUpsertSubject     sync.RWMutex
+}
+
+// DeleteExpired calls DeleteExpiredFunc.
+func (mock *RevokedTokenRepoMock) DeleteExpired(ctx context.Context, tx boil.ContextTransactor, now time.Time) error {
+	if mock.DeleteExpiredFunc == nil {
+		panic("RevokedTokenRepoMock.DeleteExpiredFunc: method is nil but RevokedTokenRepo.DeleteExpired was just called")
+	}
+	callInfo := struct {
+		Ctx context.Context
+		Tx  boil.ContextTransactor
+		Now time.Time
+	}{
+		Ctx: ctx,
+		Tx:  tx,
+		Now: now,
+	}
+	mock.lockDeleteExpired.Lock()
+	mock.calls.DeleteExpired = append(mock.calls.DeleteExpired, callInfo)
+	mock.lockDeleteExpired.Unlock()
+	return mock.DeleteExpiredFunc(ctx, tx, now)
+}
+
+// DeleteExpiredCalls gets all the calls that were made to DeleteExpired.
+// Check the length with:
+//
+//	len(mockedRevokedTokenRepo.DeleteExpiredCalls())
+func (mock *RevokedTokenRepoMock) DeleteExpiredCalls() []struct {
+	Ctx context.Context
+	Tx  boil.ContextTransactor
+	Now time.Time
+} {
+	var calls []struct {
+		Ctx context.Context
+		Tx  boil.ContextTransactor
+		Now time.Time
+	}
+	mock.lockDeleteExpired.RLock()
+	calls = mock.calls.DeleteExpired
+	mock.lockDeleteExpired.RUnlock()
+	return calls
+}
+
+// GetRevokedSubject calls GetRevokedSubjectFunc.
+func (mock *RevokedTokenRepoMock) GetRevokedSubject(ctx context.Context, tx boil.ContextTransactor, subject string) (*entities.RevokedSubject, error) {
+	if mock.GetRevokedSubjectFunc == nil {
+		panic("RevokedTokenRepoMock.GetRevokedSubjectFunc: method is nil but RevokedTokenRepo.GetRevokedSubject was just called")
+	}
+	callInfo := struct {
+		Ctx     context.Context
+		Tx      boil.ContextTransactor
+		Subject string
+	}{
+		Ctx:     ctx,
+		Tx:      tx,
+		Subject: subject,
+	}
+	mock.lockGetRevokedSubject.Lock()
+	mock.calls.GetRevokedSubject = append(mock.calls.GetRevokedSubject, callInfo)
+	mock.lockGetRevokedSubject.Unlock()
+	return mock.GetRevokedSubjectFunc(ctx, tx, subject)
+}
+
+// GetRevokedSubjectCalls gets all the calls that were made to GetRevokedSubject.
+// Check the length with:
+//
+//	len(mockedRevokedTokenRepo.GetRevokedSubjectCalls())
+func (mock *RevokedTokenRepoMock) GetRevokedSubjectCalls() []struct {
+	Ctx     context.Context
+	Tx      boil.ContextTransactor
+	Subject string
+} {
+	var calls []struct {
+		Ctx     context.Context
+		Tx      boil.ContextTransactor
+		Subject string
+	}
+	mock.lockGetRevokedSubject.RLock()
+	calls = mock.calls.GetRevokedSubject
+	mock.lockGetRevokedSubject.RUnlock()
+	return calls
+}
+
+// InsertToken calls InsertTokenFunc.
+func (mock *RevokedTokenRepoMock) InsertToken(ctx context.Context, tx boil.ContextTransactor, tokenId string, expiresAt time.Time) error {
+	if mock.InsertTokenFunc == nil {
+		panic("RevokedTokenRepoMock.InsertTokenFunc: method is nil but RevokedTokenRepo.InsertToken was just called")
+	}
+	callInfo := struct {
+		Ctx       context.Context
+		Tx        boil.ContextTransactor
+		TokenId   string
+		ExpiresAt time.Time
+	}{
+		Ctx:       ctx,
+		Tx:        tx,
+		TokenId:   tokenId,
+		ExpiresAt: expiresAt,
+	}
+	mock.lockInsertToken.Lock()
+	mock.calls.InsertToken = append(mock.calls.InsertToken, callInfo)
+	mock.lockInsertToken.Unlock()
+	return mock.InsertTokenFunc(ctx, tx, tokenId, expiresAt)
+}
+
+// InsertTokenCalls gets all the calls that were made to InsertToken.
+// Check the length with:
+//
+//	len(mockedRevokedTokenRepo.InsertTokenCalls())
+func (mock *RevokedTokenRepoMock) InsertTokenCalls() []struct {
+	Ctx       context.Context
+	Tx        boil.ContextTransactor
+	TokenId   string
+	ExpiresAt time.Time
+} {
+	var calls []struct {
+		Ctx       context.Context
+		Tx        boil.ContextTransactor
+		TokenId   string
+		ExpiresAt time.Time
+	}
+	mock.lockInsertToken.RLock()
+	calls = mock.calls.InsertToken
+	mock.lockInsertToken.RUnlock()
+	return calls
+}
+
+// IsTokenRevoked calls IsTokenRevokedFunc.
+func (mock *RevokedTokenRepoMock) IsTokenRevoked(ctx context.Context, tx boil.ContextTransactor, tokenId string) (bool, error) {
+	if mock.IsTokenRevokedFunc == nil {
+		panic("RevokedTokenRepoMock.IsTokenRevokedFunc: method is nil but RevokedTokenRepo.IsTokenRevoked was just called")
+	}
+	callInfo := struct {
+		Ctx     context.Context
+		Tx      boil.ContextTransactor
+		TokenId string
+	}{
+		Ctx:     ctx,
+		Tx:      tx,
+		TokenId: tokenId,
+	}
+	mock.lockIsTokenRevoked.Lock()
+	mock.calls.IsTokenRevoked = append(mock.calls.IsTokenRevoked, callInfo)
+	mock.lockIsTokenRevoked.Unlock()
+	return mock.IsTokenRevokedFunc(ctx, tx, tokenId)
+}
+
+// IsTokenRevokedCalls gets all the calls that were made to IsTokenRevoked.
+// Check the length with:
+//
+//	len(mockedRevokedTokenRepo.IsTokenRevokedCalls())
+func (mock *RevokedTokenRepoMock) IsTokenRevokedCalls() []struct {
+	Ctx     context.Context
+	Tx      boil.ContextTransactor
+	TokenId string
+} {
+	var calls []struct {
+		Ctx     context.Context
+		Tx      boil.ContextTransactor
+		TokenId string
+	}
+	mock.lockIsTokenRevoked.RLock()
+	calls = mock.calls.IsTokenRevoked
+	mock.lockIsTokenRevoked.RUnlock()
+	return calls
+}
+
+// UpsertSubject calls UpsertSubjectFunc.
+func (mock *RevokedTokenRepoMock) UpsertSubject(ctx context.Context, tx boil.ContextTransactor, subject string, issuedBefore time.Time, expiresAt time.Time) error {
+	if mock.UpsertSubjectFunc == nil {
+		panic("RevokedTokenRepoMock.UpsertSubjectFunc: method is nil but RevokedTokenRepo.UpsertSubject was just called")
+	}
+	callInfo := struct {
+		Ctx          context.Context
+		Tx           boil.ContextTransactor
+		Subject      string
+		IssuedBefore time.Time
+		ExpiresAt    time.Time
+	}{
+		Ctx:          ctx,
+		Tx:           tx,
+		Subject:      subject,
+		IssuedBefore: issuedBefore,
+		ExpiresAt:    expiresAt,
+	}
+	mock.lockUpsertSubject.Lock()
+	mock.calls.UpsertSubject = append(mock.calls.UpsertSubject, callInfo)
+	mock.lockUpsertSubject.Unlock()
+	return mock.UpsertSubjectFunc(ctx, tx, subject, issuedBefore, expiresAt)
+}
+
+// UpsertSubjectCalls gets all the calls that were made to UpsertSubject.
+// Check the length with:
+//
+//	len(mockedRevokedTokenRepo.UpsertSubjectCalls())
+func (mock *RevokedTokenRepoMock) UpsertSubjectCalls() []struct {
+	Ctx          context.Context
+	Tx           boil.ContextTransactor
+	Subject      string
+	IssuedBefore time.Time
+	ExpiresAt    time.Time
+} {
+	var calls []struct {
+		Ctx          context.Context
+		Tx           boil.ContextTransactor
+		Subject      string
+		IssuedBefore time.Time
+		ExpiresAt    time.Time
+	}
+	mock.lockUpsertSubject.RLock()
+	calls = mock.calls.UpsertSubject
+	mock.lockUpsertSubject.RUnlock()
+	return calls
+}
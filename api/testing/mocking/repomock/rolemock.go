@@ -0,0 +1,326 @@
+// Code generated by moq; DO NOT EDIT.
+// github.com/matryer/moq
+
+package repomock
+
+import (
+	"context"
+	"github.com/volatiletech/sqlboiler/v4/boil"
+	"mysite/entities"
+	"mysite/repositories/rolerepo"
+	"sync"
+)
+
+// Ensure, that RoleRepoMock does implement rolerepo.RoleRepo.
+// If this is not the case, regenerate this file with moq.
+var _ rolerepo.RoleRepo = &RoleRepoMock{}
+
+// RoleRepoMock is a mock implementation of rolerepo.RoleRepo.
+//
+//	func TestSomethingThatUsesRoleRepo(t *testing.T) {
+//
+//		// make and configure a mocked rolerepo.RoleRepo
+//		mockedRoleRepo := &RoleRepoMock{
+//			AssignRoleFunc: func(ctx context.Context, tx boil.ContextTransactor, userAccountId int, roleId int) error {
+//				panic("mock out the AssignRole method")
+//			},
+//			GetPermissionNamesByUserAccountIdFunc: func(ctx context.Context, tx boil.ContextTransactor, userAccountId int) ([]string, error) {
+//				panic("mock out the GetPermissionNamesByUserAccountId method")
+//			},
+//			GetRoleByNameFunc: func(ctx context.Context, tx boil.ContextTransactor, name string) (*entities.Role, error) {
+//				panic("mock out the GetRoleByName method")
+//			},
+//			GetRoleNamesByUserAccountIdFunc: func(ctx context.Context, tx boil.ContextTransactor, userAccountId int) ([]string, error) {
+//				panic("mock out the GetRoleNamesByUserAccountId method")
+//			},
+//			RemoveRoleFunc: func(ctx context.Context, tx boil.ContextTransactor, userAccountId int, roleId int) error {
+//				panic("mock out the RemoveRole method")
+//			},
+//		}
+//
+//		// use mockedRoleRepo in code that requires rolerepo.RoleRepo
+//		// and then make assertions.
+//
+//	}
+type RoleRepoMock struct {
+	// AssignRoleFunc mocks the AssignRole method.
+	AssignRoleFunc func(ctx context.Context, tx boil.ContextTransactor, userAccountId int, roleId int) error
+
+	// GetPermissionNamesByUserAccountIdFunc mocks the GetPermissionNamesByUserAccountId method.
+	GetPermissionNamesByUserAccountIdFunc func(ctx context.Context, tx boil.ContextTransactor, userAccountId int) ([]string, error)
+
+	// GetRoleByNameFunc mocks the GetRoleByName method.
+	GetRoleByNameFunc func(ctx context.Context, tx boil.ContextTransactor, name string) (*entities.Role, error)
+
+	// GetRoleNamesByUserAccountIdFunc mocks the GetRoleNamesByUserAccountId method.
+	GetRoleNamesByUserAccountIdFunc func(ctx context.Context, tx boil.ContextTransactor, userAccountId int) ([]string, error)
+
+	// RemoveRoleFunc mocks the RemoveRole method.
+	RemoveRoleFunc func(ctx context.Context, tx boil.ContextTransactor, userAccountId int, roleId int) error
+
+	// calls tracks calls to the methods.
+	calls struct {
+		// AssignRole holds details about calls to the AssignRole method.
+		AssignRole []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// Tx is the tx argument value.
+			Tx boil.ContextTransactor
+			// UserAccountId is the userAccountId argument value.
+			UserAccountId int
+			// RoleId is the roleId argument value.
+			RoleId int
+		}
+		// GetPermissionNamesByUserAccountId holds details about calls to the GetPermissionNamesByUserAccountId method.
+		GetPermissionNamesByUserAccountId []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// Tx is the tx argument value.
+			Tx boil.ContextTransactor
+			// UserAccountId is the userAccountId argument value.
+			UserAccountId int
+		}
+		// GetRoleByName holds details about calls to the GetRoleByName method.
+		GetRoleByName []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// Tx is the tx argument value.
+			Tx boil.ContextTransactor
+			// Name is the name argument value.
+			Name string
+		}
+		// GetRoleNamesByUserAccountId holds details about calls to the GetRoleNamesByUserAccountId method.
+		GetRoleNamesByUserAccountId []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// Tx is the tx argument value.
+			Tx boil.ContextTransactor
+			// UserAccountId is the userAccountId argument value.
+			UserAccountId int
+		}
+		// RemoveRole holds details about calls to the RemoveRole method.
+		RemoveRole []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// Tx is the tx argument value.
+			Tx boil.ContextTransactor
+			// UserAccountId is the userAccountId argument value.
+			UserAccountId int
+			// RoleId is the roleId argument value.
+			RoleId int
+		}
+	}
+	lockAssignRole                        sync.RWMutex
+	lockGetPermissionNamesByUserAccountId sync.RWMutex
+	lockGetRoleByName                     sync.RWMutex
+	lockGetRoleNamesByUserAccountId       sync.RWMutex
+	lockRemoveRole                        sync.RWMutex
+}
+
+// AssignRole calls AssignRoleFunc.
+func (mock *RoleRepoMock) AssignRole(ctx context.Context, tx boil.ContextTransactor, userAccountId int, roleId int) error {
+	if mock.AssignRoleFunc == nil {
+		panic("RoleRepoMock.AssignRoleFunc: method is nil but RoleRepo.AssignRole was just called")
+	}
+	callInfo := struct {
+		Ctx           context.Context
+		Tx            boil.ContextTransactor
+		UserAccountId int
+		RoleId        int
+	}{
+		Ctx:           ctx,
+		Tx:            tx,
+		UserAccountId: userAccountId,
+		RoleId:        roleId,
+	}
+	mock.lockAssignRole.Lock()
+	mock.calls.AssignRole = append(mock.calls.AssignRole, callInfo)
+	mock.lockAssignRole.Unlock()
+	return mock.AssignRoleFunc(ctx, tx, userAccountId, roleId)
+}
+
+// AssignRoleCalls gets all the calls that were made to AssignRole.
+// Check the length with:
+//
+//	len(mockedRoleRepo.AssignRoleCalls())
+func (mock *RoleRepoMock) AssignRoleCalls() []struct {
+	Ctx           context.Context
+	Tx            boil.ContextTransactor
+	UserAccountId int
+	RoleId        int
+} {
+	var calls []struct {
+		Ctx           context.Context
+		Tx            boil.ContextTransactor
+		UserAccountId int
+		RoleId        int
+	}
+	mock.lockAssignRole.RLock()
+	calls = mock.calls.AssignRole
+	mock.lockAssignRole.RUnlock()
+	return calls
+}
+
+// GetPermissionNamesByUserAccountId calls GetPermissionNamesByUserAccountIdFunc.
+func (mock *RoleRepoMock) GetPermissionNamesByUserAccountId(ctx context.Context, tx boil.ContextTransactor, userAccountId int) ([]string, error) {
+	if mock.GetPermissionNamesByUserAccountIdFunc == nil {
+		panic("RoleRepoMock.GetPermissionNamesByUserAccountIdFunc: method is nil but RoleRepo.GetPermissionNamesByUserAccountId was just called")
+	}
+	callInfo := struct {
+		Ctx           context.Context
+		Tx            boil.ContextTransactor
+		UserAccountId int
+	}{
+		Ctx:           ctx,
+		Tx:            tx,
+		UserAccountId: userAccountId,
+	}
+	mock.lockGetPermissionNamesByUserAccountId.Lock()
+	mock.calls.GetPermissionNamesByUserAccountId = append(mock.calls.GetPermissionNamesByUserAccountId, callInfo)
+	mock.lockGetPermissionNamesByUserAccountId.Unlock()
+	return mock.GetPermissionNamesByUserAccountIdFunc(ctx, tx, userAccountId)
+}
+
+// GetPermissionNamesByUserAccountIdCalls gets all the calls that were made to GetPermissionNamesByUserAccountId.
+// Check the length with:
+//
+//	len(mockedRoleRepo.GetPermissionNamesByUserAccountIdCalls())
+func (mock *RoleRepoMock) GetPermissionNamesByUserAccountIdCalls() []struct {
+	Ctx           context.Context
+	Tx            boil.ContextTransactor
+	UserAccountId int
+} {
+	var calls []struct {
+		Ctx           context.Context
+		Tx            boil.ContextTransactor
+		UserAccountId int
+	}
+	mock.lockGetPermissionNamesByUserAccountId.RLock()
+	calls = mock.calls.GetPermissionNamesByUserAccountId
+	mock.lockGetPermissionNamesByUserAccountId.RUnlock()
+	return calls
+}
+
+// GetRoleByName calls GetRoleByNameFunc.
+func (mock *RoleRepoMock) GetRoleByName(ctx context.Context, tx boil.ContextTransactor, name string) (*entities.Role, error) {
+	if mock.GetRoleByNameFunc == nil {
+		panic("RoleRepoMock.GetRoleByNameFunc: method is nil but RoleRepo.GetRoleByName was just called")
+	}
+	callInfo := struct {
+		Ctx  context.Context
+		Tx   boil.ContextTransactor
+		Name string
+	}{
+		Ctx:  ctx,
+		Tx:   tx,
+		Name: name,
+	}
+	mock.lockGetRoleByName.Lock()
+	mock.calls.GetRoleByName = append(mock.calls.GetRoleByName, callInfo)
+	mock.lockGetRoleByName.Unlock()
+	return mock.GetRoleByNameFunc(ctx, tx, name)
+}
+
+// GetRoleByNameCalls gets all the calls that were made to GetRoleByName.
+// Check the length with:
+//
+//	len(mockedRoleRepo.GetRoleByNameCalls())
+func (mock *RoleRepoMock) GetRoleByNameCalls() []struct {
+	Ctx  context.Context
+	Tx   boil.ContextTransactor
+	Name string
+} {
+	var calls []struct {
+		Ctx  context.Context
+		Tx   boil.ContextTransactor
+		Name string
+	}
+	mock.lockGetRoleByName.RLock()
+	calls = mock.calls.GetRoleByName
+	mock.lockGetRoleByName.RUnlock()
+	return calls
+}
+
+// GetRoleNamesByUserAccountId calls GetRoleNamesByUserAccountIdFunc.
+func (mock *RoleRepoMock) GetRoleNamesByUserAccountId(ctx context.Context, tx boil.ContextTransactor, userAccountId int) ([]string, error) {
+	if mock.GetRoleNamesByUserAccountIdFunc == nil {
+		panic("RoleRepoMock.GetRoleNamesByUserAccountIdFunc: method is nil but RoleRepo.GetRoleNamesByUserAccountId was just called")
+	}
+	callInfo := struct {
+		Ctx           context.Context
+		Tx            boil.ContextTransactor
+		UserAccountId int
+	}{
+		Ctx:           ctx,
+		Tx:            tx,
+		UserAccountId: userAccountId,
+	}
+	mock.lockGetRoleNamesByUserAccountId.Lock()
+	mock.calls.GetRoleNamesByUserAccountId = append(mock.calls.GetRoleNamesByUserAccountId, callInfo)
+	mock.lockGetRoleNamesByUserAccountId.Unlock()
+	return mock.GetRoleNamesByUserAccountIdFunc(ctx, tx, userAccountId)
+}
+
+// GetRoleNamesByUserAccountIdCalls gets all the calls that were made to GetRoleNamesByUserAccountId.
+// Check the length with:
+//
+//	len(mockedRoleRepo.GetRoleNamesByUserAccountIdCalls())
+func (mock *RoleRepoMock) GetRoleNamesByUserAccountIdCalls() []struct {
+	Ctx           context.Context
+	Tx            boil.ContextTransactor
+	UserAccountId int
+} {
+	var calls []struct {
+		Ctx           context.Context
+		Tx            boil.ContextTransactor
+		UserAccountId int
+	}
+	mock.lockGetRoleNamesByUserAccountId.RLock()
+	calls = mock.calls.GetRoleNamesByUserAccountId
+	mock.lockGetRoleNamesByUserAccountId.RUnlock()
+	return calls
+}
+
+// RemoveRole calls RemoveRoleFunc.
+func (mock *RoleRepoMock) RemoveRole(ctx context.Context, tx boil.ContextTransactor, userAccountId int, roleId int) error {
+	if mock.RemoveRoleFunc == nil {
+		panic("RoleRepoMock.RemoveRoleFunc: method is nil but RoleRepo.RemoveRole was just called")
+	}
+	callInfo := struct {
+		Ctx           context.Context
+		Tx            boil.ContextTransactor
+		UserAccountId int
+		RoleId        int
+	}{
+		Ctx:           ctx,
+		Tx:            tx,
+		UserAccountId: userAccountId,
+		RoleId:        roleId,
+	}
+	mock.lockRemoveRole.Lock()
+	mock.calls.RemoveRole = append(mock.calls.RemoveRole, callInfo)
+	mock.lockRemoveRole.Unlock()
+	return mock.RemoveRoleFunc(ctx, tx, userAccountId, roleId)
+}
+
+// RemoveRoleCalls gets all the calls that were made to RemoveRole.
+// Check the length with:
+//
+//	len(mockedRoleRepo.RemoveRoleCalls())
+func (mock *RoleRepoMock) RemoveRoleCalls() []struct {
+	Ctx           context.Context
+	Tx            boil.ContextTransactor
+	UserAccountId int
+	RoleId        int
+} {
+	var calls []struct {
+		Ctx           context.Context
+		Tx            boil.ContextTransactor
+		UserAccountId int
+		RoleId        int
+	}
+	mock.lockRemoveRole.RLock()
+	calls = mock.calls.RemoveRole
+	mock.lockRemoveRole.RUnlock()
+	return calls
+}
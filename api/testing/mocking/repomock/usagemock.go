@@ -0,0 +1,176 @@
+// Code generated by moq; DO NOT EDIT.
+// github.com/matryer/moq
+
+package repomock
+
+import (
+	"context"
+	"github.com/volatiletech/sqlboiler/v4/boil"
+	"mysite/repositories/usagerepo"
+	"sync"
+	"time"
+)
+
+// Ensure, that UsageRepoMock does implement usagerepo.UsageRepo.
+// If this is not the case, regenerate this file with moq.
+var _ usagerepo.UsageRepo = &UsageRepoMock{}
+
+// UsageRepoMock is a mock implementation of usagerepo.UsageRepo.
+//
+//	func TestSomethingThatUsesUsageRepo(t *testing.T) {
+//
+//		// make and configure a mocked usagerepo.UsageRepo
+//		mockedUsageRepo := &UsageRepoMock{
+//			GetUsageFunc: func(ctx context.Context, tx boil.ContextTransactor, userAccountId int, quota string, periodStart time.Time) (int, error) {
+//				panic("mock out the GetUsage method")
+//			},
+//			IncrementFunc: func(ctx context.Context, tx boil.ContextTransactor, userAccountId int, quota string, periodStart time.Time, amount int) (int, error) {
+//				panic("mock out the Increment method")
+//			},
+//		}
+//
+//		// use mockedUsageRepo in code that requires usagerepo.UsageRepo
+//		// and then make assertions.
+//
+//	}
+type UsageRepoMock struct {
+	// GetUsageFunc mocks the GetUsage method.
+	GetUsageFunc func(ctx context.Context, tx boil.ContextTransactor, userAccountId int, quota string, periodStart time.Time) (int, error)
+
+	// IncrementFunc mocks the Increment method.
+	IncrementFunc func(ctx context.Context, tx boil.ContextTransactor, userAccountId int, quota string, periodStart time.Time, amount int) (int, error)
+
+	// calls tracks calls to the methods.
+	calls struct {
+		// GetUsage holds details about calls to the GetUsage method.
+		GetUsage []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// Tx is the tx argument value.
+			Tx boil.ContextTransactor
+			// UserAccountId is the userAccountId argument value.
+			UserAccountId int
+			// Quota is the quota argument value.
+			Quota string
+			// PeriodStart is the periodStart argument value.
+			PeriodStart time.Time
+		}
+		// Increment holds details about calls to the Increment method.
+		Increment []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// Tx is the tx argument value.
+			Tx boil.ContextTransactor
+			// UserAccountId is the userAccountId argument value.
+			UserAccountId int
+			// Quota is the quota argument value.
+			Quota string
+			// PeriodStart is the periodStart argument value.
+			PeriodStart time.Time
+			// Amount is the amount argument value.
+			Amount int
+		}
+	}
+	lockGetUsage  sync.RWMutex
+	lockIncrement sync.RWMutex
+}
+
+// GetUsage calls GetUsageFunc.
+func (mock *UsageRepoMock) GetUsage(ctx context.Context, tx boil.ContextTransactor, userAccountId int, quota string, periodStart time.Time) (int, error) {
+	if mock.GetUsageFunc == nil {
+		panic("UsageRepoMock.GetUsageFunc: method is nil but UsageRepo.GetUsage was just called")
+	}
+	callInfo := struct {
+		Ctx           context.Context
+		Tx            boil.ContextTransactor
+		UserAccountId int
+		Quota         string
+		PeriodStart   time.Time
+	}{
+		Ctx:           ctx,
+		Tx:            tx,
+		UserAccountId: userAccountId,
+		Quota:         quota,
+		PeriodStart:   periodStart,
+	}
+	mock.lockGetUsage.Lock()
+	mock.calls.GetUsage = append(mock.calls.GetUsage, callInfo)
+	mock.lockGetUsage.Unlock()
+	return mock.GetUsageFunc(ctx, tx, userAccountId, quota, periodStart)
+}
+
+// GetUsageCalls gets all the calls that were made to GetUsage.
+// Check the length with:
+//
+//	len(mockedUsageRepo.GetUsageCalls())
+func (mock *UsageRepoMock) GetUsageCalls() []struct {
+	Ctx           context.Context
+	Tx            boil.ContextTransactor
+	UserAccountId int
+	Quota         string
+	PeriodStart   time.Time
+} {
+	var calls []struct {
+		Ctx           context.Context
+		Tx            boil.ContextTransactor
+		UserAccountId int
+		Quota         string
+		PeriodStart   time.Time
+	}
+	mock.lockGetUsage.RLock()
+	calls = mock.calls.GetUsage
+	mock.lockGetUsage.RUnlock()
+	return calls
+}
+
+// Increment calls IncrementFunc.
+func (mock *UsageRepoMock) Increment(ctx context.Context, tx boil.ContextTransactor, userAccountId int, quota string, periodStart time.Time, amount int) (int, error) {
+	if mock.IncrementFunc == nil {
+		panic("UsageRepoMock.IncrementFunc: method is nil but UsageRepo.Increment was just called")
+	}
+	callInfo := struct {
+		Ctx           context.Context
+		Tx            boil.ContextTransactor
+		UserAccountId int
+		Quota         string
+		PeriodStart   time.Time
+		Amount        int
+	}{
+		Ctx:           ctx,
+		Tx:            tx,
+		UserAccountId: userAccountId,
+		Quota:         quota,
+		PeriodStart:   periodStart,
+		Amount:        amount,
+	}
+	mock.lockIncrement.Lock()
+	mock.calls.Increment = append(mock.calls.Increment, callInfo)
+	mock.lockIncrement.Unlock()
+	return mock.IncrementFunc(ctx, tx, userAccountId, quota, periodStart, amount)
+}
+
+// IncrementCalls gets all the calls that were made to Increment.
+// Check the length with:
+//
+//	len(mockedUsageRepo.IncrementCalls())
+func (mock *UsageRepoMock) IncrementCalls() []struct {
+	Ctx           context.Context
+	Tx            boil.ContextTransactor
+	UserAccountId int
+	Quota         string
+	PeriodStart   time.Time
+	Amount        int
+} {
+	var calls []struct {
+		Ctx           context.Context
+		Tx            boil.ContextTransactor
+		UserAccountId int
+		Quota         string
+		PeriodStart   time.Time
+		Amount        int
+	}
+	mock.lockIncrement.RLock()
+	calls = mock.calls.Increment
+	mock.lockIncrement.RUnlock()
+	return calls
+}
@@ -36,6 +36,12 @@ var _ useraccountrepo.UserAccountRepo = &UserAccountRepoMock{}
 //			InsertFunc: func(ctx context.Context, tx boil.ContextTransactor, user *entities.UserAccount) error {
 //				panic("mock out the Insert method")
 //			},
+//			ReplacePasswordFunc: func(ctx context.Context, tx boil.ContextTransactor, userId int, oldHash string, newHash string) (bool, error) {
+//				panic("mock out the ReplacePassword method")
+//			},
+//			UpdateFunc: func(ctx context.Context, tx boil.ContextTransactor, pgUser entities.UserAccount) error {
+//				panic("mock out the Update method")
+//			},
 //		}
 //
 //		// use mockedUserAccountRepo in code that requires useraccountrepo.UserAccountRepo
@@ -58,6 +64,12 @@ type UserAccountRepoMock struct {
 	// InsertFunc mocks the Insert method.
 	InsertFunc func(ctx context.Context, tx boil.ContextTransactor, user *entities.UserAccount) error
 
+	// ReplacePasswordFunc mocks the ReplacePassword method.
+	ReplacePasswordFunc func(ctx context.Context, tx boil.ContextTransactor, userId int, oldHash string, newHash string) (bool, error)
+
+	// UpdateFunc mocks the Update method.
+	UpdateFunc func(ctx context.Context, tx boil.ContextTransactor, pgUser entities.UserAccount) error
+
 	// calls tracks calls to the methods.
 	calls struct {
 		// ActiveUser holds details about calls to the ActiveUser method.
@@ -105,12 +117,36 @@ type UserAccountRepoMock struct {
 			// User is the user argument value.
 			User *entities.UserAccount
 		}
+		// ReplacePassword holds details about calls to the ReplacePassword method.
+		ReplacePassword []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// Tx is the tx argument value.
+			Tx boil.ContextTransactor
+			// UserId is the userId argument value.
+			UserId int
+			// OldHash is the oldHash argument value.
+			OldHash string
+			// NewHash is the newHash argument value.
+			NewHash string
+		}
+		// Update holds details about calls to the Update method.
+		Update []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// Tx is the tx argument value.
+			Tx boil.ContextTransactor
+			// PgUser is the pgUser argument value.
+			PgUser entities.UserAccount
+		}
 	}
 	lockActiveUser                 sync.RWMutex
 	lockGetActiveUserAccountById   sync.RWMutex
 	lockGetActiveUserAccountByName sync.RWMutex
 	lockGetUserAccountByUserName   sync.RWMutex
 	lockInsert                     sync.RWMutex
+	lockReplacePassword            sync.RWMutex
+	lockUpdate                     sync.RWMutex
 }
 
 // ActiveUser calls ActiveUserFunc.
@@ -312,3 +348,91 @@ func (mock *UserAccountRepoMock) InsertCalls() []struct {
 	mock.lockInsert.RUnlock()
 	return calls
 }
+
+// ReplacePassword calls ReplacePasswordFunc.
+func (mock *UserAccountRepoMock) ReplacePassword(ctx context.Context, tx boil.ContextTransactor, userId int, oldHash string, newHash string) (bool, error) {
+	if mock.ReplacePasswordFunc == nil {
+		panic("UserAccountRepoMock.ReplacePasswordFunc: method is nil but UserAccountRepo.ReplacePassword was just called")
+	}
+	callInfo := struct {
+		Ctx     context.Context
+		Tx      boil.ContextTransactor
+		UserId  int
+		OldHash string
+		NewHash string
+	}{
+		Ctx:     ctx,
+		Tx:      tx,
+		UserId:  userId,
+		OldHash: oldHash,
+		NewHash: newHash,
+	}
+	mock.lockReplacePassword.Lock()
+	mock.calls.ReplacePassword = append(mock.calls.ReplacePassword, callInfo)
+	mock.lockReplacePassword.Unlock()
+	return mock.ReplacePasswordFunc(ctx, tx, userId, oldHash, newHash)
+}
+
+// ReplacePasswordCalls gets all the calls that were made to ReplacePassword.
+// Check the length with:
+//
+//	len(mockedUserAccountRepo.ReplacePasswordCalls())
+func (mock *UserAccountRepoMock) ReplacePasswordCalls() []struct {
+	Ctx     context.Context
+	Tx      boil.ContextTransactor
+	UserId  int
+	OldHash string
+	NewHash string
+} {
+	var calls []struct {
+		Ctx     context.Context
+		Tx      boil.ContextTransactor
+		UserId  int
+		OldHash string
+		NewHash string
+	}
+	mock.lockReplacePassword.RLock()
+	calls = mock.calls.ReplacePassword
+	mock.lockReplacePassword.RUnlock()
+	return calls
+}
+
+// Update calls UpdateFunc.
+func (mock *UserAccountRepoMock) Update(ctx context.Context, tx boil.ContextTransactor, pgUser entities.UserAccount) error {
+	if mock.UpdateFunc == nil {
+		panic("UserAccountRepoMock.UpdateFunc: method is nil but UserAccountRepo.Update was just called")
+	}
+	callInfo := struct {
+		Ctx    context.Context
+		Tx     boil.ContextTransactor
+		PgUser entities.UserAccount
+	}{
+		Ctx:    ctx,
+		Tx:     tx,
+		PgUser: pgUser,
+	}
+	mock.lockUpdate.Lock()
+	mock.calls.Update = append(mock.calls.Update, callInfo)
+	mock.lockUpdate.Unlock()
+	return mock.UpdateFunc(ctx, tx, pgUser)
+}
+
+// UpdateCalls gets all the calls that were made to Update.
+// Check the length with:
+//
+//	len(mockedUserAccountRepo.UpdateCalls())
+func (mock *UserAccountRepoMock) UpdateCalls() []struct {
+	Ctx    context.Context
+	Tx     boil.ContextTransactor
+	PgUser entities.UserAccount
+} {
+	var calls []struct {
+		Ctx    context.Context
+		Tx     boil.ContextTransactor
+		PgUser entities.UserAccount
+	}
+	mock.lockUpdate.RLock()
+	calls = mock.calls.Update
+	mock.lockUpdate.RUnlock()
+	return calls
+}
@@ -0,0 +1,152 @@
+// Code generated by moq; DO NOT EDIT.
+// github.com/matryer/moq
+
+package repomock
+
+import (
+	"context"
+	"github.com/volatiletech/sqlboiler/v4/boil"
+	"mysite/entities"
+	"mysite/repositories/useridentityrepo"
+	"sync"
+)
+
+// Ensure, that UserIdentityRepoMock does implement useridentityrepo.UserIdentityRepo.
+// If this is not the case, regenerate this file with moq.
+var _ useridentityrepo.UserIdentityRepo = &UserIdentityRepoMock{}
+
+// UserIdentityRepoMock is a mock implementation of useridentityrepo.UserIdentityRepo.
+//
+//	func TestSomethingThatUsesUserIdentityRepo(t *testing.T) {
+//
+//		// make and configure a mocked useridentityrepo.UserIdentityRepo
+//		mockedUserIdentityRepo := &UserIdentityRepoMock{
+//			GetUserIdentityBySubjectFunc: func(ctx context.Context, tx boil.ContextTransactor, issuer string, subject string) (*entities.UserIdentity, error) {
+//				panic("mock out the GetUserIdentityBySubject method")
+//			},
+//			InsertFunc: func(ctx context.Context, tx boil.ContextTransactor, identity *entities.UserIdentity) error {
+//				panic("mock out the Insert method")
+//			},
+//		}
+//
+//		// use mockedUserIdentityRepo in code that requires useridentityrepo.UserIdentityRepo
+//		// and then make assertions.
+//
+//	}
+type UserIdentityRepoMock struct {
+	// GetUserIdentityBySubjectFunc mocks the GetUserIdentityBySubject method.
+	GetUserIdentityBySubjectFunc func(ctx context.Context, tx boil.ContextTransactor, issuer string, subject string) (*entities.UserIdentity, error)
+
+	// InsertFunc mocks the Insert method.
+	InsertFunc func(ctx context.Context, tx boil.ContextTransactor, identity *entities.UserIdentity) error
+
+	// calls tracks calls to the methods.
+	calls struct {
+		// GetUserIdentityBySubject holds details about calls to the GetUserIdentityBySubject method.
+		GetUserIdentityBySubject []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// Tx is the tx argument value.
+			Tx boil.ContextTransactor
+			// Issuer is the issuer argument value.
+			Issuer string
+			// Subject is the subject argument value.
+			Subject string
+		}
+		// Insert holds details about calls to the Insert method.
+		Insert []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// Tx is the tx argument value.
+			Tx boil.ContextTransactor
+			// Identity is the identity argument value.
+			Identity *entities.UserIdentity
+		}
+	}
+	lockGetUserIdentityBySubject sync.RWMutex
+	lockInsert                   sync.RWMutex
+}
+
+// GetUserIdentityBySubject calls GetUserIdentityBySubjectFunc.
+func (mock *UserIdentityRepoMock) GetUserIdentityBySubject(ctx context.Context, tx boil.ContextTransactor, issuer string, subject string) (*entities.UserIdentity, error) {
+	if mock.GetUserIdentityBySubjectFunc == nil {
+		panic("UserIdentityRepoMock.GetUserIdentityBySubjectFunc: method is nil but UserIdentityRepo.GetUserIdentityBySubject was just called")
+	}
+	callInfo := struct {
+		Ctx     context.Context
+		Tx      boil.ContextTransactor
+		Issuer  string
+		Subject string
+	}{
+		Ctx:     ctx,
+		Tx:      tx,
+		Issuer:  issuer,
+		Subject: subject,
+	}
+	mock.lockGetUserIdentityBySubject.Lock()
+	mock.calls.GetUserIdentityBySubject = append(mock.calls.GetUserIdentityBySubject, callInfo)
+	mock.lockGetUserIdentityBySubject.Unlock()
+	return mock.GetUserIdentityBySubjectFunc(ctx, tx, issuer, subject)
+}
+
+// GetUserIdentityBySubjectCalls gets all the calls that were made to GetUserIdentityBySubject.
+// Check the length with:
+//
+//	len(mockedUserIdentityRepo.GetUserIdentityBySubjectCalls())
+func (mock *UserIdentityRepoMock) GetUserIdentityBySubjectCalls() []struct {
+	Ctx     context.Context
+	Tx      boil.ContextTransactor
+	Issuer  string
+	Subject string
+} {
+	var calls []struct {
+		Ctx     context.Context
+		Tx      boil.ContextTransactor
+		Issuer  string
+		Subject string
+	}
+	mock.lockGetUserIdentityBySubject.RLock()
+	calls = mock.calls.GetUserIdentityBySubject
+	mock.lockGetUserIdentityBySubject.RUnlock()
+	return calls
+}
+
+// Insert calls InsertFunc.
+func (mock *UserIdentityRepoMock) Insert(ctx context.Context, tx boil.ContextTransactor, identity *entities.UserIdentity) error {
+	if mock.InsertFunc == nil {
+		panic("UserIdentityRepoMock.InsertFunc: method is nil but UserIdentityRepo.Insert was just called")
+	}
+	callInfo := struct {
+		Ctx      context.Context
+		Tx       boil.ContextTransactor
+		Identity *entities.UserIdentity
+	}{
+		Ctx:      ctx,
+		Tx:       tx,
+		Identity: identity,
+	}
+	mock.lockInsert.Lock()
+	mock.calls.Insert = append(mock.calls.Insert, callInfo)
+	mock.lockInsert.Unlock()
+	return mock.InsertFunc(ctx, tx, identity)
+}
+
+// InsertCalls gets all the calls that were made to Insert.
+// Check the length with:
+//
+//	len(mockedUserIdentityRepo.InsertCalls())
+func (mock *UserIdentityRepoMock) InsertCalls() []struct {
+	Ctx      context.Context
+	Tx       boil.ContextTransactor
+	Identity *entities.UserIdentity
+} {
+	var calls []struct {
+		Ctx      context.Context
+		Tx       boil.ContextTransactor
+		Identity *entities.UserIdentity
+	}
+	mock.lockInsert.RLock()
+	calls = mock.calls.Insert
+	mock.lockInsert.RUnlock()
+	return calls
+}
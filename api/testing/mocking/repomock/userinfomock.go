@@ -0,0 +1,258 @@
+// Code generated by moq; DO NOT EDIT.
+// github.com/matryer/moq
+
+package repomock
+
+import (
+	"context"
+	"github.com/volatiletech/sqlboiler/v4/boil"
+	"mysite/entities"
+	"mysite/repositories/userinforepo"
+	"sync"
+)
+
+// Ensure, that UserInfoRepoMock does implement userinforepo.UserInfoRepo.
+// If this is not the case, regenerate this file with moq.
+var _ userinforepo.UserInfoRepo = &UserInfoRepoMock{}
+
+// UserInfoRepoMock is a mock implementation of userinforepo.UserInfoRepo.
+//
+//	func TestSomethingThatUsesUserInfoRepo(t *testing.T) {
+//
+//		// make and configure a mocked userinforepo.UserInfoRepo
+//		mockedUserInfoRepo := &UserInfoRepoMock{
+//			GetActiveUserAccountWithUserInfoFunc: func(ctx context.Context, tx boil.ContextTransactor, userAccountId int) (*entities.UserAccount, error) {
+//				panic("mock out the GetActiveUserAccountWithUserInfo method")
+//			},
+//			InsertFunc: func(ctx context.Context, tx boil.ContextTransactor, userInfo *entities.UserInfo) error {
+//				panic("mock out the Insert method")
+//			},
+//			UpdateFunc: func(ctx context.Context, tx boil.ContextTransactor, userInfo entities.UserInfo) error {
+//				panic("mock out the Update method")
+//			},
+//			UpdateMembershipFunc: func(ctx context.Context, tx boil.ContextTransactor, userInfo entities.UserInfo) error {
+//				panic("mock out the UpdateMembership method")
+//			},
+//		}
+//
+//		// use mockedUserInfoRepo in code that requires userinforepo.UserInfoRepo
+//		// and then make assertions.
+//
+//	}
+type UserInfoRepoMock struct {
+	// GetActiveUserAccountWithUserInfoFunc mocks the GetActiveUserAccountWithUserInfo method.
+	GetActiveUserAccountWithUserInfoFunc func(ctx context.Context, tx boil.ContextTransactor, userAccountId int) (*entities.UserAccount, error)
+
+	// InsertFunc mocks the Insert method.
+	InsertFunc func(ctx context.Context, tx boil.ContextTransactor, userInfo *entities.UserInfo) error
+
+	// UpdateFunc mocks the Update method.
+	UpdateFunc func(ctx context.Context, tx boil.ContextTransactor, userInfo entities.UserInfo) error
+
+	// UpdateMembershipFunc mocks the UpdateMembership method.
+	UpdateMembershipFunc func(ctx context.Context, tx boil.ContextTransactor, userInfo entities.UserInfo) error
+
+	// calls tracks calls to the methods.
+	calls struct {
+		// GetActiveUserAccountWithUserInfo holds details about calls to the GetActiveUserAccountWithUserInfo method.
+		GetActiveUserAccountWithUserInfo []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// Tx is the tx argument value.
+			Tx boil.ContextTransactor
+			// UserAccountId is the userAccountId argument value.
+			UserAccountId int
+		}
+		// Insert holds details about calls to the Insert method.
+		Insert []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// Tx is the tx argument value.
+			Tx boil.ContextTransactor
+			// UserInfo is the userInfo argument value.
+			UserInfo *entities.UserInfo
+		}
+		// Update holds details about calls to the Update method.
+		Update []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// Tx is the tx argument value.
+			Tx boil.ContextTransactor
+			// UserInfo is the userInfo argument value.
+			UserInfo entities.UserInfo
+		}
+		// UpdateMembership holds details about calls to the UpdateMembership method.
+		UpdateMembership []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// Tx is the tx argument value.
+			Tx boil.ContextTransactor
+			// UserInfo is the userInfo argument value.
+			UserInfo entities.UserInfo
+		}
+	}
+	lockGetActiveUserAccountWithUserInfo sync.RWMutex
+	lockInsert                           sync.RWMutex
+	lockUpdate                           sync.RWMutex
+	lockUpdateMembership                 sync.RWMutex
+}
+
+// GetActiveUserAccountWithUserInfo calls GetActiveUserAccountWithUserInfoFunc.
+func (mock *UserInfoRepoMock) GetActiveUserAccountWithUserInfo(ctx context.Context, tx boil.ContextTransactor, userAccountId int) (*entities.UserAccount, error) {
+	if mock.GetActiveUserAccountWithUserInfoFunc == nil {
+		panic("UserInfoRepoMock.GetActiveUserAccountWithUserInfoFunc: method is nil but UserInfoRepo.GetActiveUserAccountWithUserInfo was just called")
+	}
+	callInfo := struct {
+		Ctx           context.Context
+		Tx            boil.ContextTransactor
+		UserAccountId int
+	}{
+		Ctx:           ctx,
+		Tx:            tx,
+		UserAccountId: userAccountId,
+	}
+	mock.lockGetActiveUserAccountWithUserInfo.Lock()
+	mock.calls.GetActiveUserAccountWithUserInfo = append(mock.calls.GetActiveUserAccountWithUserInfo, callInfo)
+	mock.lockGetActiveUserAccountWithUserInfo.Unlock()
+	return mock.GetActiveUserAccountWithUserInfoFunc(ctx, tx, userAccountId)
+}
+
+// GetActiveUserAccountWithUserInfoCalls gets all the calls that were made to GetActiveUserAccountWithUserInfo.
+// Check the length with:
+//
+//	len(mockedUserInfoRepo.GetActiveUserAccountWithUserInfoCalls())
+func (mock *UserInfoRepoMock) GetActiveUserAccountWithUserInfoCalls() []struct {
+	Ctx           context.Context
+	Tx            boil.ContextTransactor
+	UserAccountId int
+} {
+	var calls []struct {
+		Ctx           context.Context
+		Tx            boil.ContextTransactor
+		UserAccountId int
+	}
+	mock.lockGetActiveUserAccountWithUserInfo.RLock()
+	calls = mock.calls.GetActiveUserAccountWithUserInfo
+	mock.lockGetActiveUserAccountWithUserInfo.RUnlock()
+	return calls
+}
+
+// Insert calls InsertFunc.
+func (mock *UserInfoRepoMock) Insert(ctx context.Context, tx boil.ContextTransactor, userInfo *entities.UserInfo) error {
+	if mock.InsertFunc == nil {
+		panic("UserInfoRepoMock.InsertFunc: method is nil but UserInfoRepo.Insert was just called")
+	}
+	callInfo := struct {
+		Ctx      context.Context
+		Tx       boil.ContextTransactor
+		UserInfo *entities.UserInfo
+	}{
+		Ctx:      ctx,
+		Tx:       tx,
+		UserInfo: userInfo,
+	}
+	mock.lockInsert.Lock()
+	mock.calls.Insert = append(mock.calls.Insert, callInfo)
+	mock.lockInsert.Unlock()
+	return mock.InsertFunc(ctx, tx, userInfo)
+}
+
+// InsertCalls gets all the calls that were made to Insert.
+// Check the length with:
+//
+//	len(mockedUserInfoRepo.InsertCalls())
+func (mock *UserInfoRepoMock) InsertCalls() []struct {
+	Ctx      context.Context
+	Tx       boil.ContextTransactor
+	UserInfo *entities.UserInfo
+} {
+	var calls []struct {
+		Ctx      context.Context
+		Tx       boil.ContextTransactor
+		UserInfo *entities.UserInfo
+	}
+	mock.lockInsert.RLock()
+	calls = mock.calls.Insert
+	mock.lockInsert.RUnlock()
+	return calls
+}
+
+// Update calls UpdateFunc.
+func (mock *UserInfoRepoMock) Update(ctx context.Context, tx boil.ContextTransactor, userInfo entities.UserInfo) error {
+	if mock.UpdateFunc == nil {
+		panic("UserInfoRepoMock.UpdateFunc: method is nil but UserInfoRepo.Update was just called")
+	}
+	callInfo := struct {
+		Ctx      context.Context
+		Tx       boil.ContextTransactor
+		UserInfo entities.UserInfo
+	}{
+		Ctx:      ctx,
+		Tx:       tx,
+		UserInfo: userInfo,
+	}
+	mock.lockUpdate.Lock()
+	mock.calls.Update = append(mock.calls.Update, callInfo)
+	mock.lockUpdate.Unlock()
+	return mock.UpdateFunc(ctx, tx, userInfo)
+}
+
+// UpdateCalls gets all the calls that were made to Update.
+// Check the length with:
+//
+//	len(mockedUserInfoRepo.UpdateCalls())
+func (mock *UserInfoRepoMock) UpdateCalls() []struct {
+	Ctx      context.Context
+	Tx       boil.ContextTransactor
+	UserInfo entities.UserInfo
+} {
+	var calls []struct {
+		Ctx      context.Context
+		Tx       boil.ContextTransactor
+		UserInfo entities.UserInfo
+	}
+	mock.lockUpdate.RLock()
+	calls = mock.calls.Update
+	mock.lockUpdate.RUnlock()
+	return calls
+}
+
+// UpdateMembership calls UpdateMembershipFunc.
+func (mock *UserInfoRepoMock) UpdateMembership(ctx context.Context, tx boil.ContextTransactor, userInfo entities.UserInfo) error {
+	if mock.UpdateMembershipFunc == nil {
+		panic("UserInfoRepoMock.UpdateMembershipFunc: method is nil but UserInfoRepo.UpdateMembership was just called")
+	}
+	callInfo := struct {
+		Ctx      context.Context
+		Tx       boil.ContextTransactor
+		UserInfo entities.UserInfo
+	}{
+		Ctx:      ctx,
+		Tx:       tx,
+		UserInfo: userInfo,
+	}
+	mock.lockUpdateMembership.Lock()
+	mock.calls.UpdateMembership = append(mock.calls.UpdateMembership, callInfo)
+	mock.lockUpdateMembership.Unlock()
+	return mock.UpdateMembershipFunc(ctx, tx, userInfo)
+}
+
+// UpdateMembershipCalls gets all the calls that were made to UpdateMembership.
+// Check the length with:
+//
+//	len(mockedUserInfoRepo.UpdateMembershipCalls())
+func (mock *UserInfoRepoMock) UpdateMembershipCalls() []struct {
+	Ctx      context.Context
+	Tx       boil.ContextTransactor
+	UserInfo entities.UserInfo
+} {
+	var calls []struct {
+		Ctx      context.Context
+		Tx       boil.ContextTransactor
+		UserInfo entities.UserInfo
+	}
+	mock.lockUpdateMembership.RLock()
+	calls = mock.calls.UpdateMembership
+	mock.lockUpdateMembership.RUnlock()
+	return calls
+}
@@ -0,0 +1,438 @@
+// Code generated by moq; DO NOT EDIT.
+// github.com/matryer/moq
+
+package repomock
+
+import (
+	"context"
+	"github.com/volatiletech/sqlboiler/v4/boil"
+	"mysite/entities"
+	"mysite/repositories/usermfarepo"
+	"sync"
+)
+
+// Ensure, that UserMfaRepoMock does implement usermfarepo.UserMfaRepo.
+// If this is not the case, regenerate this file with moq.
+var _ usermfarepo.UserMfaRepo = &UserMfaRepoMock{}
+
+// UserMfaRepoMock is a mock implementation of usermfarepo.UserMfaRepo.
+//
+//	func TestSomethingThatUsesUserMfaRepo(t *testing.T) {
+//
+//		// make and configure a mocked usermfarepo.UserMfaRepo
+//		mockedUserMfaRepo := &UserMfaRepoMock{
+//			DeleteByUserAccountIdFunc: func(ctx context.Context, tx boil.ContextTransactor, userAccountId int) error {
+//				panic("mock out the DeleteByUserAccountId method")
+//			},
+//			GetUnusedRecoveryCodeFunc: func(ctx context.Context, tx boil.ContextTransactor, userAccountId int, codeHash string) (*entities.UserRecoveryCode, error) {
+//				panic("mock out the GetUnusedRecoveryCode method")
+//			},
+//			GetUserMfaByUserAccountIdFunc: func(ctx context.Context, tx boil.ContextTransactor, userAccountId int) (*entities.UserMfa, error) {
+//				panic("mock out the GetUserMfaByUserAccountId method")
+//			},
+//			InsertFunc: func(ctx context.Context, tx boil.ContextTransactor, mfa *entities.UserMfa) error {
+//				panic("mock out the Insert method")
+//			},
+//			MarkRecoveryCodeUsedFunc: func(ctx context.Context, tx boil.ContextTransactor, code entities.UserRecoveryCode) error {
+//				panic("mock out the MarkRecoveryCodeUsed method")
+//			},
+//			ReplaceRecoveryCodesFunc: func(ctx context.Context, tx boil.ContextTransactor, userAccountId int, codeHashes []string) error {
+//				panic("mock out the ReplaceRecoveryCodes method")
+//			},
+//			UpdateFunc: func(ctx context.Context, tx boil.ContextTransactor, mfa entities.UserMfa) error {
+//				panic("mock out the Update method")
+//			},
+//		}
+//
+//		// use mockedUserMfaRepo in code that requires usermfarepo.UserMfaRepo
+//		// and then make assertions.
+//
+//	}
+type UserMfaRepoMock struct {
+	// DeleteByUserAccountIdFunc mocks the DeleteByUserAccountId method.
+	DeleteByUserAccountIdFunc func(ctx context.Context, tx boil.ContextTransactor, userAccountId int) error
+
+	// GetUnusedRecoveryCodeFunc mocks the GetUnusedRecoveryCode method.
+	GetUnusedRecoveryCodeFunc func(ctx context.Context, tx boil.ContextTransactor, userAccountId int, codeHash string) (*entities.UserRecoveryCode, error)
+
+	// GetUserMfaByUserAccountIdFunc mocks the GetUserMfaByUserAccountId method.
+	GetUserMfaByUserAccountIdFunc func(ctx context.Context, tx boil.ContextTransactor, userAccountId int) (*entities.UserMfa, error)
+
+	// InsertFunc mocks the Insert method.
+	InsertFunc func(ctx context.Context, tx boil.ContextTransactor, mfa *entities.UserMfa) error
+
+	// MarkRecoveryCodeUsedFunc mocks the MarkRecoveryCodeUsed method.
+	MarkRecoveryCodeUsedFunc func(ctx context.Context, tx boil.ContextTransactor, code entities.UserRecoveryCode) error
+
+	// ReplaceRecoveryCodesFunc mocks the ReplaceRecoveryCodes method.
+	ReplaceRecoveryCodesFunc func(ctx context.Context, tx boil.ContextTransactor, userAccountId int, codeHashes []string) error
+
+	// UpdateFunc mocks the Update method.
+	UpdateFunc func(ctx context.Context, tx boil.ContextTransactor, mfa entities.UserMfa) error
+
+	// calls tracks calls to the methods.
+	calls struct {
+		// DeleteByUserAccountId holds details about calls to the DeleteByUserAccountId method.
+		DeleteByUserAccountId []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// Tx is the tx argument value.
+			Tx boil.ContextTransactor
+			// UserAccountId is the userAccountId argument value.
+			UserAccountId int
+		}
+		// GetUnusedRecoveryCode holds details about calls to the GetUnusedRecoveryCode method.
+		GetUnusedRecoveryCode []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// Tx is the tx argument value.
+			Tx boil.ContextTransactor
+			// UserAccountId is the userAccountId argument value.
+			UserAccountId int
+			// CodeHash is the codeHash argument value.
+			CodeHash string
+		}
+		// GetUserMfaByUserAccountId holds details about calls to the GetUserMfaByUserAccountId method.
+		GetUserMfaByUserAccountId []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// Tx is the tx argument value.
+			Tx boil.ContextTransactor
+			// UserAccountId is the userAccountId argument value.
+			UserAccountId int
+		}
+		// Insert holds details about calls to the Insert method.
+		Insert []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// Tx is the tx argument value.
+			Tx boil.ContextTransactor
+			// Mfa is the mfa argument value.
+			Mfa *entities.UserMfa
+		}
+		// MarkRecoveryCodeUsed holds details about calls to the MarkRecoveryCodeUsed method.
+		MarkRecoveryCodeUsed []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// Tx is the tx argument value.
+			Tx boil.ContextTransactor
+			// Code is the code argument value.
+			Code entities.UserRecoveryCode
+		}
+		// ReplaceRecoveryCodes holds details about calls to the ReplaceRecoveryCodes method.
+		ReplaceRecoveryCodes []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// Tx is the tx argument value.
+			Tx boil.ContextTransactor
+			// UserAccountId is the userAccountId argument value.
+			UserAccountId int
+			// CodeHashes is the codeHashes argument value.
+			CodeHashes []string
+		}
+		// Update holds details about calls to the Update method.
+		Update []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// Tx is the tx argument value.
+			Tx boil.ContextTransactor
+			// Mfa is the mfa argument value.
+			Mfa entities.UserMfa
+		}
+	}
+	lockDeleteByUserAccountId     sync.RWMutex
+	lockGetUnusedRecoveryCode     sync.RWMutex
+	lockGetUserMfaByUserAccountId sync.RWMutex
+	lockInsert                    sync.RWMutex
+	lockMarkRecoveryCodeUsed      sync.RWMutex
+	lockReplaceRecoveryCodes      sync.RWMutex
+	lockUpdate                    sync.RWMutex
+}
+
+// DeleteByUserAccountId calls DeleteByUserAccountIdFunc.
+func (mock *UserMfaRepoMock) DeleteByUserAccountId(ctx context.Context, tx boil.ContextTransactor, userAccountId int) error {
+	if mock.DeleteByUserAccountIdFunc == nil {
+		panic("UserMfaRepoMock.DeleteByUserAccountIdFunc: method is nil but UserMfaRepo.DeleteByUserAccountId was just called")
+	}
+	callInfo := struct {
+		Ctx           context.Context
+		Tx            boil.ContextTransactor
+		UserAccountId int
+	}{
+		Ctx:           ctx,
+		Tx:            tx,
+		UserAccountId: userAccountId,
+	}
+	mock.lockDeleteByUserAccountId.Lock()
+	mock.calls.DeleteByUserAccountId = append(mock.calls.DeleteByUserAccountId, callInfo)
+	mock.lockDeleteByUserAccountId.Unlock()
+	return mock.DeleteByUserAccountIdFunc(ctx, tx, userAccountId)
+}
+
+// DeleteByUserAccountIdCalls gets all the calls that were made to DeleteByUserAccountId.
+// Check the length with:
+//
+//	len(mockedUserMfaRepo.DeleteByUserAccountIdCalls())
+func (mock *UserMfaRepoMock) DeleteByUserAccountIdCalls() []struct {
+	Ctx           context.Context
+	Tx            boil.ContextTransactor
+	UserAccountId int
+} {
+	var calls []struct {
+		Ctx           context.Context
+		Tx            boil.ContextTransactor
+		UserAccountId int
+	}
+	mock.lockDeleteByUserAccountId.RLock()
+	calls = mock.calls.DeleteByUserAccountId
+	mock.lockDeleteByUserAccountId.RUnlock()
+	return calls
+}
+
+// GetUnusedRecoveryCode calls GetUnusedRecoveryCodeFunc.
+func (mock *UserMfaRepoMock) GetUnusedRecoveryCode(ctx context.Context, tx boil.ContextTransactor, userAccountId int, codeHash string) (*entities.UserRecoveryCode, error) {
+	if mock.GetUnusedRecoveryCodeFunc == nil {
+		panic("UserMfaRepoMock.GetUnusedRecoveryCodeFunc: method is nil but UserMfaRepo.GetUnusedRecoveryCode was just called")
+	}
+	callInfo := struct {
+		Ctx           context.Context
+		Tx            boil.ContextTransactor
+		UserAccountId int
+		CodeHash      string
+	}{
+		Ctx:           ctx,
+		Tx:            tx,
+		UserAccountId: userAccountId,
+		CodeHash:      codeHash,
+	}
+	mock.lockGetUnusedRecoveryCode.Lock()
+	mock.calls.GetUnusedRecoveryCode = append(mock.calls.GetUnusedRecoveryCode, callInfo)
+	mock.lockGetUnusedRecoveryCode.Unlock()
+	return mock.GetUnusedRecoveryCodeFunc(ctx, tx, userAccountId, codeHash)
+}
+
+// GetUnusedRecoveryCodeCalls gets all the calls that were made to GetUnusedRecoveryCode.
+// Check the length with:
+//
+//	len(mockedUserMfaRepo.GetUnusedRecoveryCodeCalls())
+func (mock *UserMfaRepoMock) GetUnusedRecoveryCodeCalls() []struct {
+	Ctx           context.Context
+	Tx            boil.ContextTransactor
+	UserAccountId int
+	CodeHash      string
+} {
+	var calls []struct {
+		Ctx           context.Context
+		Tx            boil.ContextTransactor
+		UserAccountId int
+		CodeHash      string
+	}
+	mock.lockGetUnusedRecoveryCode.RLock()
+	calls = mock.calls.GetUnusedRecoveryCode
+	mock.lockGetUnusedRecoveryCode.RUnlock()
+	return calls
+}
+
+// GetUserMfaByUserAccountId calls GetUserMfaByUserAccountIdFunc.
+func (mock *UserMfaRepoMock) GetUserMfaByUserAccountId(ctx context.Context, tx boil.ContextTransactor, userAccountId int) (*entities.UserMfa, error) {
+	if mock.GetUserMfaByUserAccountIdFunc == nil {
+		panic("UserMfaRepoMock.GetUserMfaByUserAccountIdFunc: method is nil but UserMfaRepo.GetUserMfaByUserAccountId was just called")
+	}
+	callInfo := struct {
+		Ctx           context.Context
+		Tx            boil.ContextTransactor
+		UserAccountId int
+	}{
+		Ctx:           ctx,
+		Tx:            tx,
+		UserAccountId: userAccountId,
+	}
+	mock.lockGetUserMfaByUserAccountId.Lock()
+	mock.calls.GetUserMfaByUserAccountId = append(mock.calls.GetUserMfaByUserAccountId, callInfo)
+	mock.lockGetUserMfaByUserAccountId.Unlock()
+	return mock.GetUserMfaByUserAccountIdFunc(ctx, tx, userAccountId)
+}
+
+// GetUserMfaByUserAccountIdCalls gets all the calls that were made to GetUserMfaByUserAccountId.
+// Check the length with:
+//
+//	len(mockedUserMfaRepo.GetUserMfaByUserAccountIdCalls())
+func (mock *UserMfaRepoMock) GetUserMfaByUserAccountIdCalls() []struct {
+	Ctx           context.Context
+	Tx            boil.ContextTransactor
+	UserAccountId int
+} {
+	var calls []struct {
+		Ctx           context.Context
+		Tx            boil.ContextTransactor
+		UserAccountId int
+	}
+	mock.lockGetUserMfaByUserAccountId.RLock()
+	calls = mock.calls.GetUserMfaByUserAccountId
+	mock.lockGetUserMfaByUserAccountId.RUnlock()
+	return calls
+}
+
+// Insert calls InsertFunc.
+func (mock *UserMfaRepoMock) Insert(ctx context.Context, tx boil.ContextTransactor, mfa *entities.UserMfa) error {
+	if mock.InsertFunc == nil {
+		panic("UserMfaRepoMock.InsertFunc: method is nil but UserMfaRepo.Insert was just called")
+	}
+	callInfo := struct {
+		Ctx context.Context
+		Tx  boil.ContextTransactor
+		Mfa *entities.UserMfa
+	}{
+		Ctx: ctx,
+		Tx:  tx,
+		Mfa: mfa,
+	}
+	mock.lockInsert.Lock()
+	mock.calls.Insert = append(mock.calls.Insert, callInfo)
+	mock.lockInsert.Unlock()
+	return mock.InsertFunc(ctx, tx, mfa)
+}
+
+// InsertCalls gets all the calls that were made to Insert.
+// Check the length with:
+//
+//	len(mockedUserMfaRepo.InsertCalls())
+func (mock *UserMfaRepoMock) InsertCalls() []struct {
+	Ctx context.Context
+	Tx  boil.ContextTransactor
+	Mfa *entities.UserMfa
+} {
+	var calls []struct {
+		Ctx context.Context
+		Tx  boil.ContextTransactor
+		Mfa *entities.UserMfa
+	}
+	mock.lockInsert.RLock()
+	calls = mock.calls.Insert
+	mock.lockInsert.RUnlock()
+	return calls
+}
+
+// MarkRecoveryCodeUsed calls MarkRecoveryCodeUsedFunc.
+func (mock *UserMfaRepoMock) MarkRecoveryCodeUsed(ctx context.Context, tx boil.ContextTransactor, code entities.UserRecoveryCode) error {
+	if mock.MarkRecoveryCodeUsedFunc == nil {
+		panic("UserMfaRepoMock.MarkRecoveryCodeUsedFunc: method is nil but UserMfaRepo.MarkRecoveryCodeUsed was just called")
+	}
+	callInfo := struct {
+		Ctx  context.Context
+		Tx   boil.ContextTransactor
+		Code entities.UserRecoveryCode
+	}{
+		Ctx:  ctx,
+		Tx:   tx,
+		Code: code,
+	}
+	mock.lockMarkRecoveryCodeUsed.Lock()
+	mock.calls.MarkRecoveryCodeUsed = append(mock.calls.MarkRecoveryCodeUsed, callInfo)
+	mock.lockMarkRecoveryCodeUsed.Unlock()
+	return mock.MarkRecoveryCodeUsedFunc(ctx, tx, code)
+}
+
+// MarkRecoveryCodeUsedCalls gets all the calls that were made to MarkRecoveryCodeUsed.
+// Check the length with:
+//
+//	len(mockedUserMfaRepo.MarkRecoveryCodeUsedCalls())
+func (mock *UserMfaRepoMock) MarkRecoveryCodeUsedCalls() []struct {
+	Ctx  context.Context
+	Tx   boil.ContextTransactor
+	Code entities.UserRecoveryCode
+} {
+	var calls []struct {
+		Ctx  context.Context
+		Tx   boil.ContextTransactor
+		Code entities.UserRecoveryCode
+	}
+	mock.lockMarkRecoveryCodeUsed.RLock()
+	calls = mock.calls.MarkRecoveryCodeUsed
+	mock.lockMarkRecoveryCodeUsed.RUnlock()
+	return calls
+}
+
+// ReplaceRecoveryCodes calls ReplaceRecoveryCodesFunc.
+func (mock *UserMfaRepoMock) ReplaceRecoveryCodes(ctx context.Context, tx boil.ContextTransactor, userAccountId int, codeHashes []string) error {
+	if mock.ReplaceRecoveryCodesFunc == nil {
+		panic("UserMfaRepoMock.ReplaceRecoveryCodesFunc: method is nil but UserMfaRepo.ReplaceRecoveryCodes was just called")
+	}
+	callInfo := struct {
+		Ctx           context.Context
+		Tx            boil.ContextTransactor
+		UserAccountId int
+		CodeHashes    []string
+	}{
+		Ctx:           ctx,
+		Tx:            tx,
+		UserAccountId: userAccountId,
+		CodeHashes:    codeHashes,
+	}
+	mock.lockReplaceRecoveryCodes.Lock()
+	mock.calls.ReplaceRecoveryCodes = append(mock.calls.ReplaceRecoveryCodes, callInfo)
+	mock.lockReplaceRecoveryCodes.Unlock()
+	return mock.ReplaceRecoveryCodesFunc(ctx, tx, userAccountId, codeHashes)
+}
+
+// ReplaceRecoveryCodesCalls gets all the calls that were made to ReplaceRecoveryCodes.
+// Check the length with:
+//
+//	len(mockedUserMfaRepo.ReplaceRecoveryCodesCalls())
+func (mock *UserMfaRepoMock) ReplaceRecoveryCodesCalls() []struct {
+	Ctx           context.Context
+	Tx            boil.ContextTransactor
+	UserAccountId int
+	CodeHashes    []string
+} {
+	var calls []struct {
+		Ctx           context.Context
+		Tx            boil.ContextTransactor
+		UserAccountId int
+		CodeHashes    []string
+	}
+	mock.lockReplaceRecoveryCodes.RLock()
+	calls = mock.calls.ReplaceRecoveryCodes
+	mock.lockReplaceRecoveryCodes.RUnlock()
+	return calls
+}
+
+// Update calls UpdateFunc.
+func (mock *UserMfaRepoMock) Update(ctx context.Context, tx boil.ContextTransactor, mfa entities.UserMfa) error {
+	if mock.UpdateFunc == nil {
+		panic("UserMfaRepoMock.UpdateFunc: method is nil but UserMfaRepo.Update was just called")
+	}
+	callInfo := struct {
+		Ctx context.Context
+		Tx  boil.ContextTransactor
+		Mfa entities.UserMfa
+	}{
+		Ctx: ctx,
+		Tx:  tx,
+		Mfa: mfa,
+	}
+	mock.lockUpdate.Lock()
+	mock.calls.Update = append(mock.calls.Update, callInfo)
+	mock.lockUpdate.Unlock()
+	return mock.UpdateFunc(ctx, tx, mfa)
+}
+
+// UpdateCalls gets all the calls that were made to Update.
+// Check the length with:
+//
+//	len(mockedUserMfaRepo.UpdateCalls())
+func (mock *UserMfaRepoMock) UpdateCalls() []struct {
+	Ctx context.Context
+	Tx  boil.ContextTransactor
+	Mfa entities.UserMfa
+} {
+	var calls []struct {
+		Ctx context.Context
+		Tx  boil.ContextTransactor
+		Mfa entities.UserMfa
+	}
+	mock.lockUpdate.RLock()
+	calls = mock.calls.Update
+	mock.lockUpdate.RUnlock()
+	return calls
+}
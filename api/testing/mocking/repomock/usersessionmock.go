@@ -0,0 +1,376 @@
+// Code generated by moq; DO NOT EDIT.
+// github.com/matryer/moq
+
+package repomock
+
+import (
+	"context"
+	"github.com/volatiletech/sqlboiler/v4/boil"
+	"mysite/entities"
+	"mysite/repositories/usersessionrepo"
+	"sync"
+)
+
+// Ensure, that UserSessionRepoMock does implement usersessionrepo.UserSessionRepo.
+// If this is not the case, regenerate this file with moq.
+var _ usersessionrepo.UserSessionRepo = &UserSessionRepoMock{}
+
+// UserSessionRepoMock is a mock implementation of usersessionrepo.UserSessionRepo.
+//
+//	func TestSomethingThatUsesUserSessionRepo(t *testing.T) {
+//
+//		// make and configure a mocked usersessionrepo.UserSessionRepo
+//		mockedUserSessionRepo := &UserSessionRepoMock{
+//			GetUserSessionByTokenIdFunc: func(ctx context.Context, tx boil.ContextTransactor, tokenId string) (*entities.UserSession, error) {
+//				panic("mock out the GetUserSessionByTokenId method")
+//			},
+//			InsertFunc: func(ctx context.Context, tx boil.ContextTransactor, session *entities.UserSession) error {
+//				panic("mock out the Insert method")
+//			},
+//			MarkUsedFunc: func(ctx context.Context, tx boil.ContextTransactor, session entities.UserSession) error {
+//				panic("mock out the MarkUsed method")
+//			},
+//			RevokeAllByUserAccountIdFunc: func(ctx context.Context, tx boil.ContextTransactor, userAccountId int) error {
+//				panic("mock out the RevokeAllByUserAccountId method")
+//			},
+//			RevokeFamilyFunc: func(ctx context.Context, tx boil.ContextTransactor, familyId string) error {
+//				panic("mock out the RevokeFamily method")
+//			},
+//			RevokeOtherFamiliesFunc: func(ctx context.Context, tx boil.ContextTransactor, userAccountId int, keepFamilyId string) error {
+//				panic("mock out the RevokeOtherFamilies method")
+//			},
+//		}
+//
+//		// use mockedUserSessionRepo in code that requires usersessionrepo.UserSessionRepo
+//		// and then make assertions.
+//
+//	}
+type UserSessionRepoMock struct {
+	// GetUserSessionByTokenIdFunc mocks the GetUserSessionByTokenId method.
+	GetUserSessionByTokenIdFunc func(ctx context.Context, tx boil.ContextTransactor, tokenId string) (*entities.UserSession, error)
+
+	// InsertFunc mocks the Insert method.
+	InsertFunc func(ctx context.Context, tx boil.ContextTransactor, session *entities.UserSession) error
+
+	// MarkUsedFunc mocks the MarkUsed method.
+	MarkUsedFunc func(ctx context.Context, tx boil.ContextTransactor, session entities.UserSession) error
+
+	// RevokeAllByUserAccountIdFunc mocks the RevokeAllByUserAccountId method.
+	RevokeAllByUserAccountIdFunc func(ctx context.Context, tx boil.ContextTransactor, userAccountId int) error
+
+	// RevokeFamilyFunc mocks the RevokeFamily method.
+	RevokeFamilyFunc func(ctx context.Context, tx boil.ContextTransactor, familyId string) error
+
+	// RevokeOtherFamiliesFunc mocks the RevokeOtherFamilies method.
+	RevokeOtherFamiliesFunc func(ctx context.Context, tx boil.ContextTransactor, userAccountId int, keepFamilyId string) error
+
+	// calls tracks calls to the methods.
+	calls struct {
+		// GetUserSessionByTokenId holds details about calls to the GetUserSessionByTokenId method.
+		GetUserSessionByTokenId []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// Tx is the tx argument value.
+			Tx boil.ContextTransactor
+			// TokenId is the tokenId argument value.
+			TokenId string
+		}
+		// Insert holds details about calls to the Insert method.
+		Insert []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// Tx is the tx argument value.
+			Tx boil.ContextTransactor
+			// Session is the session argument value.
+			Session *entities.UserSession
+		}
+		// MarkUsed holds details about calls to the MarkUsed method.
+		MarkUsed []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// Tx is the tx argument value.
+			Tx boil.ContextTransactor
+			// Session is the session argument value.
+			Session entities.UserSession
+		}
+		// RevokeAllByUserAccountId holds details about calls to the RevokeAllByUserAccountId method.
+		RevokeAllByUserAccountId []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// Tx is the tx argument value.
+			Tx boil.ContextTransactor
+			// UserAccountId is the userAccountId argument value.
+			UserAccountId int
+		}
+		// RevokeFamily holds details about calls to the RevokeFamily method.
+		RevokeFamily []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// Tx is the tx argument value.
+			Tx boil.ContextTransactor
+			// FamilyId is the familyId argument value.
+			FamilyId string
+		}
+		// RevokeOtherFamilies holds details about calls to the RevokeOtherFamilies method.
+		RevokeOtherFamilies []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// Tx is the tx argument value.
+			Tx boil.ContextTransactor
+			// UserAccountId is the userAccountId argument value.
+			UserAccountId int
+			// KeepFamilyId is the keepFamilyId argument value.
+			KeepFamilyId string
+		}
+	}
+	lockGetUserSessionByTokenId  sync.RWMutex
+	lockInsert                   sync.RWMutex
+	lockMarkUsed                 sync.RWMutex
+	lockRevokeAllByUserAccountId sync.RWMutex
+	lockRevokeFamily             sync.RWMutex
+	lockRevokeOtherFamilies      sync.RWMutex
+}
+
+// GetUserSessionByTokenId calls GetUserSessionByTokenIdFunc.
+func (mock *UserSessionRepoMock) GetUserSessionByTokenId(ctx context.Context, tx boil.ContextTransactor, tokenId string) (*entities.UserSession, error) {
+	if mock.GetUserSessionByTokenIdFunc == nil {
+		panic("UserSessionRepoMock.GetUserSessionByTokenIdFunc: method is nil but UserSessionRepo.GetUserSessionByTokenId was just called")
+	}
+	callInfo := struct {
+		Ctx     context.Context
+		Tx      boil.ContextTransactor
+		TokenId string
+	}{
+		Ctx:     ctx,
+		Tx:      tx,
+		TokenId: tokenId,
+	}
+	mock.lockGetUserSessionByTokenId.Lock()
+	mock.calls.GetUserSessionByTokenId = append(mock.calls.GetUserSessionByTokenId, callInfo)
+	mock.lockGetUserSessionByTokenId.Unlock()
+	return mock.GetUserSessionByTokenIdFunc(ctx, tx, tokenId)
+}
+
+// GetUserSessionByTokenIdCalls gets all the calls that were made to GetUserSessionByTokenId.
+// Check the length with:
+//
+//	len(mockedUserSessionRepo.GetUserSessionByTokenIdCalls())
+func (mock *UserSessionRepoMock) GetUserSessionByTokenIdCalls() []struct {
+	Ctx     context.Context
+	Tx      boil.ContextTransactor
+	TokenId string
+} {
+	var calls []struct {
+		Ctx     context.Context
+		Tx      boil.ContextTransactor
+		TokenId string
+	}
+	mock.lockGetUserSessionByTokenId.RLock()
+	calls = mock.calls.GetUserSessionByTokenId
+	mock.lockGetUserSessionByTokenId.RUnlock()
+	return calls
+}
+
+// Insert calls InsertFunc.
+func (mock *UserSessionRepoMock) Insert(ctx context.Context, tx boil.ContextTransactor, session *entities.UserSession) error {
+	if mock.InsertFunc == nil {
+		panic("UserSessionRepoMock.InsertFunc: method is nil but UserSessionRepo.Insert was just called")
+	}
+	callInfo := struct {
+		Ctx     context.Context
+		Tx      boil.ContextTransactor
+		Session *entities.UserSession
+	}{
+		Ctx:     ctx,
+		Tx:      tx,
+		Session: session,
+	}
+	mock.lockInsert.Lock()
+	mock.calls.Insert = append(mock.calls.Insert, callInfo)
+	mock.lockInsert.Unlock()
+	return mock.InsertFunc(ctx, tx, session)
+}
+
+// InsertCalls gets all the calls that were made to Insert.
+// Check the length with:
+//
+//	len(mockedUserSessionRepo.InsertCalls())
+func (mock *UserSessionRepoMock) InsertCalls() []struct {
+	Ctx     context.Context
+	Tx      boil.ContextTransactor
+	Session *entities.UserSession
+} {
+	var calls []struct {
+		Ctx     context.Context
+		Tx      boil.ContextTransactor
+		Session *entities.UserSession
+	}
+	mock.lockInsert.RLock()
+	calls = mock.calls.Insert
+	mock.lockInsert.RUnlock()
+	return calls
+}
+
+// MarkUsed calls MarkUsedFunc.
+func (mock *UserSessionRepoMock) MarkUsed(ctx context.Context, tx boil.ContextTransactor, session entities.UserSession) error {
+	if mock.MarkUsedFunc == nil {
+		panic("UserSessionRepoMock.MarkUsedFunc: method is nil but UserSessionRepo.MarkUsed was just called")
+	}
+	callInfo := struct {
+		Ctx     context.Context
+		Tx      boil.ContextTransactor
+		Session entities.UserSession
+	}{
+		Ctx:     ctx,
+		Tx:      tx,
+		Session: session,
+	}
+	mock.lockMarkUsed.Lock()
+	mock.calls.MarkUsed = append(mock.calls.MarkUsed, callInfo)
+	mock.lockMarkUsed.Unlock()
+	return mock.MarkUsedFunc(ctx, tx, session)
+}
+
+// MarkUsedCalls gets all the calls that were made to MarkUsed.
+// Check the length with:
+//
+//	len(mockedUserSessionRepo.MarkUsedCalls())
+func (mock *UserSessionRepoMock) MarkUsedCalls() []struct {
+	Ctx     context.Context
+	Tx      boil.ContextTransactor
+	Session entities.UserSession
+} {
+	var calls []struct {
+		Ctx     context.Context
+		Tx      boil.ContextTransactor
+		Session entities.UserSession
+	}
+	mock.lockMarkUsed.RLock()
+	calls = mock.calls.MarkUsed
+	mock.lockMarkUsed.RUnlock()
+	return calls
+}
+
+// RevokeAllByUserAccountId calls RevokeAllByUserAccountIdFunc.
+func (mock *UserSessionRepoMock) RevokeAllByUserAccountId(ctx context.Context, tx boil.ContextTransactor, userAccountId int) error {
+	if mock.RevokeAllByUserAccountIdFunc == nil {
+		panic("UserSessionRepoMock.RevokeAllByUserAccountIdFunc: method is nil but UserSessionRepo.RevokeAllByUserAccountId was just called")
+	}
+	callInfo := struct {
+		Ctx           context.Context
+		Tx            boil.ContextTransactor
+		UserAccountId int
+	}{
+		Ctx:           ctx,
+		Tx:            tx,
+		UserAccountId: userAccountId,
+	}
+	mock.lockRevokeAllByUserAccountId.Lock()
+	mock.calls.RevokeAllByUserAccountId = append(mock.calls.RevokeAllByUserAccountId, callInfo)
+	mock.lockRevokeAllByUserAccountId.Unlock()
+	return mock.RevokeAllByUserAccountIdFunc(ctx, tx, userAccountId)
+}
+
+// RevokeAllByUserAccountIdCalls gets all the calls that were made to RevokeAllByUserAccountId.
+// Check the length with:
+//
+//	len(mockedUserSessionRepo.RevokeAllByUserAccountIdCalls())
+func (mock *UserSessionRepoMock) RevokeAllByUserAccountIdCalls() []struct {
+	Ctx           context.Context
+	Tx            boil.ContextTransactor
+	UserAccountId int
+} {
+	var calls []struct {
+		Ctx           context.Context
+		Tx            boil.ContextTransactor
+		UserAccountId int
+	}
+	mock.lockRevokeAllByUserAccountId.RLock()
+	calls = mock.calls.RevokeAllByUserAccountId
+	mock.lockRevokeAllByUserAccountId.RUnlock()
+	return calls
+}
+
+// RevokeFamily calls RevokeFamilyFunc.
+func (mock *UserSessionRepoMock) RevokeFamily(ctx context.Context, tx boil.ContextTransactor, familyId string) error {
+	if mock.RevokeFamilyFunc == nil {
+		panic("UserSessionRepoMock.RevokeFamilyFunc: method is nil but UserSessionRepo.RevokeFamily was just called")
+	}
+	callInfo := struct {
+		Ctx      context.Context
+		Tx       boil.ContextTransactor
+		FamilyId string
+	}{
+		Ctx:      ctx,
+		Tx:       tx,
+		FamilyId: familyId,
+	}
+	mock.lockRevokeFamily.Lock()
+	mock.calls.RevokeFamily = append(mock.calls.RevokeFamily, callInfo)
+	mock.lockRevokeFamily.Unlock()
+	return mock.RevokeFamilyFunc(ctx, tx, familyId)
+}
+
+// RevokeFamilyCalls gets all the calls that were made to RevokeFamily.
+// Check the length with:
+//
+//	len(mockedUserSessionRepo.RevokeFamilyCalls())
+func (mock *UserSessionRepoMock) RevokeFamilyCalls() []struct {
+	Ctx      context.Context
+	Tx       boil.ContextTransactor
+	FamilyId string
+} {
+	var calls []struct {
+		Ctx      context.Context
+		Tx       boil.ContextTransactor
+		FamilyId string
+	}
+	mock.lockRevokeFamily.RLock()
+	calls = mock.calls.RevokeFamily
+	mock.lockRevokeFamily.RUnlock()
+	return calls
+}
+
+// RevokeOtherFamilies calls RevokeOtherFamiliesFunc.
+func (mock *UserSessionRepoMock) RevokeOtherFamilies(ctx context.Context, tx boil.ContextTransactor, userAccountId int, keepFamilyId string) error {
+	if mock.RevokeOtherFamiliesFunc == nil {
+		panic("UserSessionRepoMock.RevokeOtherFamiliesFunc: method is nil but UserSessionRepo.RevokeOtherFamilies was just called")
+	}
+	callInfo := struct {
+		Ctx           context.Context
+		Tx            boil.ContextTransactor
+		UserAccountId int
+		KeepFamilyId  string
+	}{
+		Ctx:           ctx,
+		Tx:            tx,
+		UserAccountId: userAccountId,
+		KeepFamilyId:  keepFamilyId,
+	}
+	mock.lockRevokeOtherFamilies.Lock()
+	mock.calls.RevokeOtherFamilies = append(mock.calls.RevokeOtherFamilies, callInfo)
+	mock.lockRevokeOtherFamilies.Unlock()
+	return mock.RevokeOtherFamiliesFunc(ctx, tx, userAccountId, keepFamilyId)
+}
+
+// RevokeOtherFamiliesCalls gets all the calls that were made to RevokeOtherFamilies.
+// Check the length with:
+//
+//	len(mockedUserSessionRepo.RevokeOtherFamiliesCalls())
+func (mock *UserSessionRepoMock) RevokeOtherFamiliesCalls() []struct {
+	Ctx           context.Context
+	Tx            boil.ContextTransactor
+	UserAccountId int
+	KeepFamilyId  string
+} {
+	var calls []struct {
+		Ctx           context.Context
+		Tx            boil.ContextTransactor
+		UserAccountId int
+		KeepFamilyId  string
+	}
+	mock.lockRevokeOtherFamilies.RLock()
+	calls = mock.calls.RevokeOtherFamilies
+	mock.lockRevokeOtherFamilies.RUnlock()
+	return calls
+}
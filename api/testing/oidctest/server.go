@@ -0,0 +1,247 @@
+package oidctest
+
+import (
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/json"
+	"math/big"
+	"mysite/pkgs/auth"
+	"mysite/pkgs/env"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"slices"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/golang-jwt/jwt/v5"
+	"github.com/google/uuid"
+	"github.com/pkg/errors"
+)
+
+// Server is a mock OpenID Connect provider serving discovery, jwks and a token endpoint
+// which redeems the codes of Authorize, so that the code flow runs in process
+type Server struct {
+	*httptest.Server
+	ClientID     string
+	ClientSecret string
+
+	// Tamper changes the claims of the ID tokens issued afterwards, before they are signed
+	Tamper func(claims jwt.MapClaims)
+
+	mu    sync.Mutex
+	key   *rsa.PrivateKey
+	kid   string
+	codes map[string]pendingCode
+}
+
+// Identity the user who logs in at the provider
+type Identity struct {
+	Subject       string
+	Email         string
+	EmailVerified bool
+}
+
+type pendingCode struct {
+	identity      Identity
+	redirectURI   string
+	nonce         string
+	codeChallenge string
+}
+
+func NewServer(clientID string, clientSecret string) (*Server, error) {
+	s := &Server{
+		ClientID:     clientID,
+		ClientSecret: clientSecret,
+		codes:        map[string]pendingCode{},
+	}
+	if err := s.RotateKey(); err != nil {
+		return nil, err
+	}
+
+	mux := http.NewServeMux()
+	mux.HandleFunc("GET /.well-known/openid-configuration", s.discovery)
+	mux.HandleFunc("GET /jwks", s.jwks)
+	mux.HandleFunc("POST /token", s.token)
+	s.Server = httptest.NewServer(mux)
+	return s, nil
+}
+
+// Provider the oidc config of a client of this server
+func (s *Server) Provider(redirectURL string) env.OidcProvider {
+	return env.OidcProvider{
+		Issuer:       s.URL,
+		ClientID:     s.ClientID,
+		ClientSecret: s.ClientSecret,
+		RedirectURL:  redirectURL,
+	}
+}
+
+// RotateKey signs the ID tokens issued afterwards with a new key of a new kid
+func (s *Server) RotateKey() error {
+	key, err := rsa.GenerateKey(rand.Reader, 2048)
+	if err != nil {
+		return errors.Wrap(err, "failed generate key")
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.key = key
+	s.kid = uuid.NewString()
+	return nil
+}
+
+// Authorize logs the identity in at the authorization url, as the browser would, and returns the code and state of the redirect
+func (s *Server) Authorize(authCodeURL string, identity Identity) (code string, state string, err error) {
+	u, err := url.Parse(authCodeURL)
+	if err != nil {
+		return "", "", errors.Wrap(err, "invalid authorization url")
+	}
+	query := u.Query()
+	switch {
+	case !strings.HasPrefix(authCodeURL, s.URL+"/authorize"):
+		return "", "", errors.New("not the authorization endpoint")
+	case query.Get("response_type") != "code":
+		return "", "", errors.New("response_type must be code")
+	case query.Get("client_id") != s.ClientID:
+		return "", "", errors.New("unknown client_id")
+	case !slices.Contains(strings.Fields(query.Get("scope")), "openid"):
+		return "", "", errors.New("scope without openid")
+	case query.Get("code_challenge") == "" || query.Get("code_challenge_method") != "S256":
+		return "", "", errors.New("missing S256 code_challenge")
+	}
+
+	code = uuid.NewString()
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.codes[code] = pendingCode{
+		identity:      identity,
+		redirectURI:   query.Get("redirect_uri"),
+		nonce:         query.Get("nonce"),
+		codeChallenge: query.Get("code_challenge"),
+	}
+	return code, query.Get("state"), nil
+}
+
+func (s *Server) discovery(w http.ResponseWriter, r *http.Request) {
+	writeJSON(w, http.StatusOK, map[string]any{
+		"issuer":                                s.URL,
+		"authorization_endpoint":                s.URL + "/authorize",
+		"token_endpoint":                        s.URL + "/token",
+		"jwks_uri":                              s.URL + "/jwks",
+		"response_types_supported":              []string{"code"},
+		"subject_types_supported":               []string{"public"},
+		"id_token_signing_alg_values_supported": []string{"RS256"},
+	})
+}
+
+func (s *Server) jwks(w http.ResponseWriter, r *http.Request) {
+	s.mu.Lock()
+	key, kid := s.key, s.kid
+	s.mu.Unlock()
+
+	writeJSON(w, http.StatusOK, map[string]any{
+		"keys": []auth.Jwk{{
+			Kty: "RSA",
+			Kid: kid,
+			Use: "sig",
+			Alg: "RS256",
+			N:   base64.RawURLEncoding.EncodeToString(key.N.Bytes()),
+			E:   base64.RawURLEncoding.EncodeToString(big.NewInt(int64(key.E)).Bytes()),
+		}},
+	})
+}
+
+func (s *Server) token(w http.ResponseWriter, r *http.Request) {
+	if err := r.ParseForm(); err != nil {
+		writeError(w, http.StatusBadRequest, "invalid_request")
+		return
+	}
+	if !s.authenticate(r) {
+		writeError(w, http.StatusUnauthorized, "invalid_client")
+		return
+	}
+	if r.PostForm.Get("grant_type") != "authorization_code" {
+		writeError(w, http.StatusBadRequest, "unsupported_grant_type")
+		return
+	}
+
+	s.mu.Lock()
+	pending, ok := s.codes[r.PostForm.Get("code")]
+	delete(s.codes, r.PostForm.Get("code"))
+	s.mu.Unlock()
+
+	sum := sha256.Sum256([]byte(r.PostForm.Get("code_verifier")))
+	switch {
+	case !ok, pending.redirectURI != r.PostForm.Get("redirect_uri"):
+		writeError(w, http.StatusBadRequest, "invalid_grant")
+		return
+	case base64.RawURLEncoding.EncodeToString(sum[:]) != pending.codeChallenge:
+		writeError(w, http.StatusBadRequest, "invalid_grant")
+		return
+	}
+
+	idToken, err := s.signIDToken(pending)
+	if err != nil {
+		writeError(w, http.StatusInternalServerError, "server_error")
+		return
+	}
+	writeJSON(w, http.StatusOK, map[string]any{
+		"access_token": uuid.NewString(),
+		"token_type":   "Bearer",
+		"expires_in":   3600,
+		"id_token":     idToken,
+	})
+}
+
+// authenticate confidential clients use client_secret_basic, public clients send their client_id
+func (s *Server) authenticate(r *http.Request) bool {
+	if s.ClientSecret == "" {
+		return r.PostForm.Get("client_id") == s.ClientID
+	}
+	id, secret, ok := r.BasicAuth()
+	if !ok {
+		return false
+	}
+	id, _ = url.QueryUnescape(id)
+	secret, _ = url.QueryUnescape(secret)
+	return id == s.ClientID && secret == s.ClientSecret
+}
+
+func (s *Server) signIDToken(pending pendingCode) (string, error) {
+	now := time.Now()
+	claims := jwt.MapClaims{
+		"iss":            s.URL,
+		"sub":            pending.identity.Subject,
+		"aud":            s.ClientID,
+		"exp":            now.Add(time.Hour).Unix(),
+		"iat":            now.Unix(),
+		"nonce":          pending.nonce,
+		"email":          pending.identity.Email,
+		"email_verified": pending.identity.EmailVerified,
+	}
+
+	s.mu.Lock()
+	key, kid, tamper := s.key, s.kid, s.Tamper
+	s.mu.Unlock()
+	if tamper != nil {
+		tamper(claims)
+	}
+
+	token := jwt.NewWithClaims(jwt.SigningMethodRS256, claims)
+	token.Header["kid"] = kid
+	return token.SignedString(key)
+}
+
+func writeJSON(w http.ResponseWriter, status int, body any) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(status)
+	_ = json.NewEncoder(w).Encode(body)
+}
+
+func writeError(w http.ResponseWriter, status int, code string) {
+	writeJSON(w, status, map[string]string{"error": code})
+}
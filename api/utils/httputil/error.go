@@ -1,6 +1,7 @@
 package httputil
 
 import (
+	"mysite/constants"
 	"mysite/dtos"
 	"mysite/utils/ptrconv"
 	"net/http"
@@ -34,4 +35,59 @@ var (
 			StatusText: ptrconv.String("Unauthorize error"),
 		},
 	}
+
+	ErrForbidden = ErrResponse{
+		HTTPStatusCode: http.StatusForbidden,
+		ErrorResponse: dtos.ErrorResponse{
+			StatusText: ptrconv.String("Forbidden"),
+		},
+	}
+
+	ErrEmailNotVerified = ErrResponse{
+		HTTPStatusCode: http.StatusForbidden,
+		ErrorResponse: dtos.ErrorResponse{
+			AppCode:    ptrconv.Ptr(constants.AppCodeEmailNotVerified),
+			StatusText: ptrconv.String("Email not verified"),
+		},
+	}
+
+	ErrLoginDelayed = ErrResponse{
+		HTTPStatusCode: http.StatusTooManyRequests,
+		ErrorResponse: dtos.ErrorResponse{
+			AppCode:    ptrconv.Ptr(constants.AppCodeLoginDelayed),
+			StatusText: ptrconv.String("Too many failed login attempts"),
+		},
+	}
+
+	ErrAccountLocked = ErrResponse{
+		HTTPStatusCode: http.StatusTooManyRequests,
+		ErrorResponse: dtos.ErrorResponse{
+			AppCode:    ptrconv.Ptr(constants.AppCodeAccountLocked),
+			StatusText: ptrconv.String("Account temporarily locked"),
+		},
+	}
+
+	ErrRateLimited = ErrResponse{
+		HTTPStatusCode: http.StatusTooManyRequests,
+		ErrorResponse: dtos.ErrorResponse{
+			AppCode:    ptrconv.Ptr(constants.AppCodeRateLimited),
+			StatusText: ptrconv.String("Too many requests"),
+		},
+	}
+
+	ErrWeakPassword = ErrResponse{
+		HTTPStatusCode: http.StatusBadRequest,
+		ErrorResponse: dtos.ErrorResponse{
+			AppCode:    ptrconv.Ptr(constants.AppCodeWeakPassword),
+			StatusText: ptrconv.String("Password does not meet the policy"),
+		},
+	}
+
+	ErrQuotaExceeded = ErrResponse{
+		HTTPStatusCode: http.StatusTooManyRequests,
+		ErrorResponse: dtos.ErrorResponse{
+			AppCode:    ptrconv.Ptr(constants.AppCodeQuotaExceeded),
+			StatusText: ptrconv.String("Quota of the membership tier exhausted"),
+		},
+	}
 )
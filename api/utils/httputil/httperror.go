@@ -1,9 +1,12 @@
 package httputil
 
 import (
+	"math"
 	"mysite/dtos"
 	"mysite/utils/ptrconv"
 	"net/http"
+	"strconv"
+	"time"
 
 	"github.com/go-chi/render"
 	"github.com/pkg/errors"
@@ -13,10 +16,15 @@ type ErrResponse struct {
 	Err            error `json:"-"` // low-level runtime error
 	HTTPStatusCode int   `json:"-"` // http response status code
 
+	RetryAfter time.Duration `json:"-"` // sent as Retry-After header when set
+
 	dtos.ErrorResponse
 }
 
 func (e ErrResponse) Render(w http.ResponseWriter, r *http.Request) error {
+	if e.RetryAfter > 0 {
+		w.Header().Set("Retry-After", strconv.Itoa(int(math.Ceil(e.RetryAfter.Seconds()))))
+	}
 	render.Status(r, e.HTTPStatusCode)
 	return nil
 }
@@ -31,6 +39,30 @@ func (e ErrResponse) WithErrorText(err error) render.Renderer {
 	return e
 }
 
+// Is matches by status and AppCode, so that errors built by the With helpers still match the base error
+func (e ErrResponse) Is(target error) bool {
+	t, ok := target.(ErrResponse)
+	if !ok || t.HTTPStatusCode != e.HTTPStatusCode {
+		return false
+	}
+	if e.AppCode == nil || t.AppCode == nil {
+		return e.AppCode == t.AppCode
+	}
+	return *e.AppCode == *t.AppCode
+}
+
+// WithFailedRules lists the rules which rejected the request
+func (e ErrResponse) WithFailedRules(rules []string) ErrResponse {
+	e.FailedRules = &rules
+	return e
+}
+
+// WithRetryAfter tells the client how long to wait before trying again
+func (e ErrResponse) WithRetryAfter(d time.Duration) ErrResponse {
+	e.RetryAfter = d
+	return e
+}
+
 func NewFailureRender(err error) render.Renderer {
 	errRender := ErrInternal
 	if errors.As(err, &errRender) {
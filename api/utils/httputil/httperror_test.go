@@ -1,7 +1,10 @@
 package httputil
 
 import (
+	"net/http"
+	"net/http/httptest"
 	"testing"
+	"time"
 
 	"github.com/pkg/errors"
 	"github.com/stretchr/testify/assert" // Using testify for better assertions
@@ -45,3 +48,34 @@ func TestNewFailureRender(t *testing.T) {
 	}
 
 }
+
+func TestRetryAfter(t *testing.T) {
+	{ // header rounded up to whole seconds
+		renderer := NewFailureRender(errors.Wrap(ErrAccountLocked.WithRetryAfter(1500*time.Millisecond), "locked"))
+		w := httptest.NewRecorder()
+		r := httptest.NewRequest(http.MethodPost, "http://example.com", nil)
+		assert.NoError(t, renderer.Render(w, r))
+		assert.Equal(t, "2", w.Header().Get("Retry-After"))
+	}
+	{ // no header without delay
+		renderer := NewFailureRender(errors.Wrap(ErrInvalidRequest, "invalid request"))
+		w := httptest.NewRecorder()
+		r := httptest.NewRequest(http.MethodPost, "http://example.com", nil)
+		assert.NoError(t, renderer.Render(w, r))
+		assert.Empty(t, w.Header().Get("Retry-After"))
+	}
+}
+
+func TestErrResponseIs(t *testing.T) {
+	{ // variants match their base error
+		assert.ErrorIs(t, errors.Wrap(ErrAccountLocked.WithRetryAfter(time.Minute), "locked"), ErrAccountLocked)
+		assert.ErrorIs(t, errors.Wrap(ErrWeakPassword.WithFailedRules([]string{"minLength"}), "weak"), ErrWeakPassword)
+	}
+	{ // same status, other AppCode
+		assert.NotErrorIs(t, errors.Wrap(ErrWeakPassword, "weak"), ErrInvalidRequest)
+		assert.NotErrorIs(t, errors.Wrap(ErrAccountLocked, "locked"), ErrLoginDelayed)
+	}
+	{ // other status
+		assert.NotErrorIs(t, errors.Wrap(ErrNotFound, "not found"), ErrInvalidRequest)
+	}
+}
@@ -2,6 +2,7 @@ package httputil
 
 import (
 	"encoding/json"
+	"net"
 	"net/http"
 
 	"github.com/pkg/errors"
@@ -16,7 +17,27 @@ func SetCookie(key, value string) *http.Cookie {
 	}
 }
 
-func ParseBody[T comparable](r *http.Request, body *T) error {
+// ClearCookie tells the browser to drop the cookie
+func ClearCookie(key string) *http.Cookie {
+	return &http.Cookie{
+		Name:     key,
+		Value:    "",
+		MaxAge:   -1,
+		Secure:   true,
+		HttpOnly: true,
+	}
+}
+
+// ClientIp is the address of the peer, RemoteAddr already holds the real ip when a proxy middleware rewrote it
+func ClientIp(r *http.Request) string {
+	host, _, err := net.SplitHostPort(r.RemoteAddr)
+	if err != nil {
+		return r.RemoteAddr
+	}
+	return host
+}
+
+func ParseBody[T any](r *http.Request, body *T) error {
 	if r.Body == nil || r.Body == http.NoBody {
 		return errors.Wrap(ErrInvalidRequest, "empty body")
 	}